@@ -5,6 +5,32 @@ function graphSeries(element) {
     return element.getAttribute('data-plot').split(',');
 }
 
+function isRate(element) {
+    return element.getAttribute('data-rate') == 'true';
+}
+
+// seriesValue returns the value for the series k. For rate graphs,
+// it returns the increase per second since the previous data point,
+// while series with the form a/b return the increase of a divided
+// by the increase of b (e.g. the average latency).
+function seriesValue(data, prev, k, rate) {
+    if (!rate) {
+        return getDottedKey(data, k);
+    }
+    if (!prev) {
+        return 0;
+    }
+    var delta = function(key) {
+        return getDottedKey(data, key) - getDottedKey(prev, key);
+    };
+    var parts = k.split('/');
+    if (parts.length == 2) {
+        var d = delta(parts[1]);
+        return d > 0 ? delta(parts[0]) / d : 0;
+    }
+    return delta(k) * 1000 / INTERVAL;
+}
+
 function initializeGraphs() {
     var elements = document.getElementsByClassName('chart')
     var graphs = [];
@@ -44,6 +70,7 @@ function initializeGraphs() {
         yaxis.render();
         graphs[ii] = g;
     }
+    var prev = null;
     setInterval(function () {
         sendRequest('/_gondola_monitor_api', null, function(req) {
             var data = parseJson(req.responseText);
@@ -53,12 +80,13 @@ function initializeGraphs() {
                 var series = graphSeries(elements[ii]);
                 for (var jj = 0; jj < series.length; jj++) {
                     var k = series[jj];
-                    graphData[k] = getDottedKey(data, k);
+                    graphData[k] = seriesValue(data, prev, k, isRate(elements[ii]));
                 }
                 console.log(graphData);
                 graph.series.addData(graphData);
                 graph.render();
             }
+            prev = data;
         }, 'json');
     }, INTERVAL);
 }
@@ -0,0 +1,155 @@
+package app
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// access contains the authorization requirements declared
+// in HandlerOptions.
+type access struct {
+	user      bool
+	admin     bool
+	authorize func(User) bool
+}
+
+func newAccess(opts *HandlerOptions) *access {
+	if opts == nil || (!opts.RequireUser && !opts.RequireAdmin && opts.Authorize == nil) {
+		return nil
+	}
+	return &access{
+		user:      opts.RequireUser || opts.RequireAdmin || opts.Authorize != nil,
+		admin:     opts.RequireAdmin,
+		authorize: opts.Authorize,
+	}
+}
+
+// allowed returns true iff the given user, which might
+// be nil, meets the requirements.
+func (a *access) allowed(user User) bool {
+	if a == nil {
+		return true
+	}
+	if user == nil {
+		return !a.user
+	}
+	if a.admin && !user.IsAdmin() {
+		return false
+	}
+	return a.authorize == nil || a.authorize(user)
+}
+
+// accessHandler returns a Handler which checks the requirements
+// before calling handler. Requests without a signed in user are
+// redirected to the sign in handler, unless they were made via XHR,
+// they include an Authorization header or the App has no sign in
+// handler, in which case they receive a 401. Requests from users
+// who don't meet the requirements receive a 403.
+func accessHandler(a *access, handler Handler) Handler {
+	if a == nil {
+		return handler
+	}
+	return func(ctx *Context) {
+		h := ctx.Header()
+		addVary(h, "Cookie")
+		addVary(h, "Authorization")
+		h.Set("Cache-Control", "private")
+		user := ctx.User()
+		if a.allowed(user) {
+			handler(ctx)
+			return
+		}
+		if user != nil {
+			ctx.Forbidden()
+			return
+		}
+		if !ctx.IsXHR() && ctx.R.Header.Get("Authorization") == "" {
+			if signIn := ctx.signInURL(); signIn != "" {
+				ctx.Redirect(signIn, false)
+				return
+			}
+		}
+		ctx.Error(http.StatusUnauthorized)
+	}
+}
+
+// signInURL returns the URL for the App sign in handler, with the
+// current URL in the SignInFromParameterName parameter, or an empty
+// string if there's no sign in handler.
+func (c *Context) signInURL() string {
+	root := c.app.root()
+	name := root.SignInHandler
+	if name == "" {
+		name = SignInHandlerName
+	}
+	signIn, err := root.reverse(name, nil)
+	if err != nil {
+		return ""
+	}
+	u, err := url.Parse(signIn)
+	if err != nil {
+		return ""
+	}
+	values := u.Query()
+	values.Set(SignInFromParameterName, c.URL().String())
+	u.RawQuery = values.Encode()
+	return u.String()
+}
+
+// handlerAccess returns the access requirements for the handler
+// with the given name, searching also in the included apps, and
+// a boolean indicating if the handler was found.
+func (app *App) handlerAccess(name string) (*access, bool) {
+	for _, v := range app.handlers {
+		if v.name == name {
+			return v.access, true
+		}
+	}
+	for _, v := range app.included {
+		if a, found := v.app.handlerAccess(name); found {
+			return a, true
+		}
+	}
+	return nil, false
+}
+
+// CanAccess returns true iff the current user meets the requirements
+// declared in the HandlerOptions of the handler with the given name
+// (see HandlerOptions.RequireUser, HandlerOptions.RequireAdmin and
+// HandlerOptions.Authorize). If there's no handler with the given
+// name, it returns false. CanAccess is also available in templates
+// as can_access, which allows hiding links to the handlers the current
+// user can't access. e.g.
+//
+//	{{ if can_access "admin-users" }}
+//		<a href="{{ reverse "admin-users" }}">Users</a>
+//	{{ end }}
+func (c *Context) CanAccess(name string) bool {
+	a, found := c.app.handlerAccess(name)
+	if !found {
+		return false
+	}
+	if a == nil {
+		return true
+	}
+	return a.allowed(c.User())
+}
+
+// ReverseAccessible works like Reverse, but returns an empty string
+// without an error when the current user can't access the handler
+// (see CanAccess). It's also available in templates as
+// reverse_accessible.
+func (c *Context) ReverseAccessible(name string, args ...interface{}) (string, error) {
+	if _, found := c.app.handlerAccess(name); found && !c.CanAccess(name) {
+		return "", nil
+	}
+	return c.Reverse(name, args...)
+}
+
+func template_can_access(ctx *Context, name string) bool {
+	return ctx.CanAccess(name)
+}
+
+func template_reverse_accessible(ctx *Context, name string, args ...interface{}) (string, error) {
+	return ctx.ReverseAccessible(name, args...)
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type accessUser struct {
+	id    int64
+	admin bool
+}
+
+func (u *accessUser) Id() int64     { return u.id }
+func (u *accessUser) IsAdmin() bool { return u.admin }
+
+func newAccessApp() *App {
+	a := New()
+	// Load the user from a header, to avoid
+	// setting up sessions in the tests.
+	a.AddTransformer(func(handler Handler) Handler {
+		return func(ctx *Context) {
+			switch ctx.R.Header.Get("X-Test-User") {
+			case "user":
+				ctx.user = &accessUser{id: 1}
+			case "admin":
+				ctx.user = &accessUser{id: 2, admin: true}
+			}
+			handler(ctx)
+		}
+	})
+	ok := func(ctx *Context) { ctx.WriteString("ok") }
+	a.HandleNamed("^/sign-in/$", ok, SignInHandlerName)
+	a.HandleOptions("^/user/$", ok, &HandlerOptions{Name: "user", RequireUser: true})
+	a.HandleOptions("^/admin/$", ok, &HandlerOptions{Name: "admin", RequireAdmin: true})
+	a.HandleOptions("^/odd/$", ok, &HandlerOptions{Name: "odd", Authorize: func(u User) bool { return u.Id()%2 == 1 }})
+	a.HandleNamed("^/public/$", ok, "public")
+	a.HandleNamed("^/links/$", func(ctx *Context) {
+		for _, v := range []string{"public", "user", "admin", "odd", "missing"} {
+			if ctx.CanAccess(v) {
+				ctx.WriteString(v + ";")
+			}
+		}
+	}, "links")
+	return a
+}
+
+func accessRequest(a *App, path string, user string, xhr bool) *httptest.ResponseRecorder {
+	r, _ := http.NewRequest("GET", "http://www.example.com"+path, nil)
+	if user != "" {
+		r.Header.Set("X-Test-User", user)
+	}
+	if xhr {
+		r.Header.Set("X-Requested-With", "XMLHttpRequest")
+	}
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	return w
+}
+
+func TestAccess(t *testing.T) {
+	a := newAccessApp()
+	cases := []struct {
+		path string
+		user string
+		xhr  bool
+		code int
+	}{
+		{"/public/", "", false, http.StatusOK},
+		{"/user/", "", false, http.StatusFound},
+		{"/user/", "", true, http.StatusUnauthorized},
+		{"/user/", "user", false, http.StatusOK},
+		{"/admin/", "", true, http.StatusUnauthorized},
+		{"/admin/", "user", false, http.StatusForbidden},
+		{"/admin/", "user", true, http.StatusForbidden},
+		{"/admin/", "admin", false, http.StatusOK},
+		{"/odd/", "user", false, http.StatusOK},
+		{"/odd/", "admin", false, http.StatusForbidden},
+	}
+	for _, v := range cases {
+		w := accessRequest(a, v.path, v.user, v.xhr)
+		if w.Code != v.code {
+			t.Errorf("expecting code %d for %s (user %q, xhr %v), got %d", v.code, v.path, v.user, v.xhr, w.Code)
+		}
+	}
+	w := accessRequest(a, "/user/", "user", false)
+	if vary := w.Header()["Vary"]; len(vary) != 2 || vary[0] != "Cookie" || vary[1] != "Authorization" {
+		t.Errorf("expecting Vary Cookie and Authorization, got %v", vary)
+	}
+	if cc := w.Header().Get("Cache-Control"); cc != "private" {
+		t.Errorf("expecting Cache-Control private, got %q", cc)
+	}
+	w = accessRequest(a, "/user/?page=2", "", false)
+	expect := "/sign-in/?" + SignInFromParameterName + "=" + url.QueryEscape("http://www.example.com/user/?page=2")
+	if loc := w.Header().Get("Location"); loc != expect {
+		t.Errorf("expecting redirect to %q, got %q", expect, loc)
+	}
+}
+
+func TestAccessNoSignIn(t *testing.T) {
+	a := New()
+	a.SignInHandler = "login"
+	a.HandleOptions("^/$", func(ctx *Context) {}, &HandlerOptions{RequireUser: true})
+	if w := accessRequest(a, "/", "", false); w.Code != http.StatusUnauthorized {
+		t.Errorf("expecting 401 without a sign in handler, got %d", w.Code)
+	}
+}
+
+func TestCanAccess(t *testing.T) {
+	a := newAccessApp()
+	cases := map[string]string{
+		"":      "public;",
+		"user":  "public;user;odd;",
+		"admin": "public;user;admin;",
+	}
+	for user, expect := range cases {
+		if s := accessRequest(a, "/links/", user, false).Body.String(); s != expect {
+			t.Errorf("expecting links %q for user %q, got %q", expect, user, s)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"gnd.la/log"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// DefaultRequestIDHeader is the default value for
+	// App.RequestIDHeader.
+	DefaultRequestIDHeader = "X-Request-ID"
+
+	requestIDLength    = 20
+	maxRequestIDLength = 128
+)
+
+// AccessLogFormat indicates the format used for writing the
+// access log entries.
+type AccessLogFormat int
+
+const (
+	// AccessLogCombined writes entries using Apache's Combined Log
+	// Format, followed by the quoted request ID.
+	AccessLogCombined AccessLogFormat = iota
+	// AccessLogJSON writes each entry as a JSON object, one per line.
+	AccessLogJSON
+)
+
+// AccessLog writes an entry for each request served by the App
+// to its Writer. Assign an AccessLog to App.AccessLog to enable it.
+// e.g.
+//
+//	f, err := os.OpenFile("access.log", os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+//	if err != nil {
+//		panic(err)
+//	}
+//	App.AccessLog = &app.AccessLog{Writer: f, Format: app.AccessLogJSON}
+//
+// An AccessLog is safe for concurrent use, it serializes the writes
+// to its Writer.
+type AccessLog struct {
+	// Writer is the io.Writer where the entries are written to.
+	Writer io.Writer
+	// Format indicates the entries format. The default is
+	// AccessLogCombined.
+	Format AccessLogFormat
+	mu     sync.Mutex
+}
+
+type accessLogEntry struct {
+	Time      string  `json:"time"`
+	RequestID string  `json:"request_id,omitempty"`
+	Remote    string  `json:"remote_addr"`
+	User      string  `json:"user,omitempty"`
+	Method    string  `json:"method"`
+	URI       string  `json:"uri"`
+	Proto     string  `json:"proto"`
+	Status    int     `json:"status"`
+	Bytes     int64   `json:"bytes"`
+	Elapsed   float64 `json:"elapsed_ms"`
+	Referer   string  `json:"referer,omitempty"`
+	UserAgent string  `json:"user_agent,omitempty"`
+}
+
+func (a *AccessLog) log(ctx *Context) error {
+	var buf bytes.Buffer
+	switch a.Format {
+	case AccessLogJSON:
+		entry := &accessLogEntry{
+			Time:      ctx.started.Format(time.RFC3339Nano),
+			RequestID: ctx.requestID,
+			Remote:    ctx.RemoteAddress(),
+			User:      accessLogUser(ctx),
+			Method:    ctx.R.Method,
+			URI:       ctx.R.RequestURI,
+			Proto:     ctx.R.Proto,
+			Status:    ctx.statusCode,
+			Bytes:     ctx.written,
+			Elapsed:   float64(ctx.Elapsed()) / float64(time.Millisecond),
+			Referer:   ctx.R.Referer(),
+			UserAgent: ctx.R.UserAgent(),
+		}
+		if err := json.NewEncoder(&buf).Encode(entry); err != nil {
+			return err
+		}
+	default:
+		user := accessLogUser(ctx)
+		if user == "" {
+			user = "-"
+		}
+		size := "-"
+		if ctx.written > 0 {
+			size = strconv.FormatInt(ctx.written, 10)
+		}
+		buf.WriteString(ctx.RemoteAddress())
+		buf.WriteString(" - ")
+		buf.WriteString(user)
+		buf.WriteString(ctx.started.Format(" [02/Jan/2006:15:04:05 -0700] "))
+		buf.WriteString(strconv.Quote(ctx.R.Method + " " + ctx.R.RequestURI + " " + ctx.R.Proto))
+		buf.WriteByte(' ')
+		buf.WriteString(strconv.Itoa(ctx.statusCode))
+		buf.WriteByte(' ')
+		buf.WriteString(size)
+		for _, v := range []string{ctx.R.Referer(), ctx.R.UserAgent(), ctx.requestID} {
+			buf.WriteByte(' ')
+			if v == "" {
+				v = "-"
+			}
+			buf.WriteString(strconv.Quote(v))
+		}
+		buf.WriteByte('\n')
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, err := a.Writer.Write(buf.Bytes())
+	return err
+}
+
+// accessLogUser returns the id of the user, but only if it
+// was already loaded by the handler.
+func accessLogUser(ctx *Context) string {
+	if ctx.user != nil {
+		return strconv.FormatInt(ctx.user.Id(), 10)
+	}
+	return ""
+}
+
+// RequestID returns the ID for the current request, which is either
+// taken from the incoming request header named by App.RequestIDHeader
+// or randomly generated, and it's also sent in the response headers.
+// The request ID is included in the messages written via Context.Logger,
+// the access log and the errors reported by the App, so they can be
+// correlated. If the App has request IDs disabled, an empty string is
+// returned.
+func (c *Context) RequestID() string {
+	return c.requestID
+}
+
+func (app *App) setRequestID(ctx *Context) {
+	header := app.RequestIDHeader
+	if header == "" {
+		return
+	}
+	id := ctx.R.Header.Get(header)
+	if !isValidRequestID(id) {
+		id = stringutil.Random(requestIDLength)
+	}
+	ctx.requestID = id
+	ctx.Header().Set(header, id)
+}
+
+// isValidRequestID returns true iff the id is not empty and contains
+// only characters which can't be used to tamper with the logs.
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for ii := 0; ii < len(id); ii++ {
+		c := id[ii]
+		if !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && !(c >= '0' && c <= '9') &&
+			c != '-' && c != '_' && c != '.' && c != ':' && c != '+' && c != '/' && c != '=' {
+			return false
+		}
+	}
+	return true
+}
+
+// requestLogger prefixes all the messages with the request ID. It
+// uses log.Logger.Write and log.Logger.Writef, so the caller is
+// correctly reported.
+type requestLogger struct {
+	logger *log.Logger
+	prefix string
+}
+
+func (r *requestLogger) Debug(args ...interface{}) {
+	r.logger.Write(log.LDebug, 1, r.prefix+fmt.Sprint(args...))
+}
+
+func (r *requestLogger) Debugf(format string, args ...interface{}) {
+	r.logger.Writef(log.LDebug, 1, r.prefix+format, args...)
+}
+
+func (r *requestLogger) Info(args ...interface{}) {
+	r.logger.Write(log.LInfo, 1, r.prefix+fmt.Sprint(args...))
+}
+
+func (r *requestLogger) Infof(format string, args ...interface{}) {
+	r.logger.Writef(log.LInfo, 1, r.prefix+format, args...)
+}
+
+func (r *requestLogger) Warning(args ...interface{}) {
+	r.logger.Write(log.LWarning, 1, r.prefix+fmt.Sprint(args...))
+}
+
+func (r *requestLogger) Warningf(format string, args ...interface{}) {
+	r.logger.Writef(log.LWarning, 1, r.prefix+format, args...)
+}
+
+func (r *requestLogger) Error(args ...interface{}) {
+	r.logger.Write(log.LError, 1, r.prefix+fmt.Sprint(args...))
+}
+
+func (r *requestLogger) Errorf(format string, args ...interface{}) {
+	r.logger.Writef(log.LError, 1, r.prefix+format, args...)
+}
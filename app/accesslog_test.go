@@ -0,0 +1,88 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"gnd.la/log"
+)
+
+func TestAccessLog(t *testing.T) {
+	var buf bytes.Buffer
+	a := New()
+	a.AccessLog = &AccessLog{Writer: &buf}
+	a.Handle("^/hello/$", func(ctx *Context) {
+		ctx.WriteString("hello")
+	})
+	r, _ := http.NewRequest("GET", "http://www.example.com/hello/?a=b", nil)
+	r.RemoteAddr = "127.0.0.1:1234"
+	r.Header.Set("Referer", "http://www.example.com/")
+	r.Header.Set("User-Agent", "test \"agent\"")
+	r.Header.Set("X-Request-ID", "abc-123")
+	r.RequestURI = "/hello/?a=b"
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	re := regexp.MustCompile(`^127\.0\.0\.1 - - \[[^\]]+\] "GET /hello/\?a=b HTTP/1\.1" 200 5 "http://www\.example\.com/" "test \\"agent\\"" "abc-123"\n$`)
+	if line := buf.String(); !re.MatchString(line) {
+		t.Errorf("unexpected combined log entry %q", line)
+	}
+	buf.Reset()
+	a.AccessLog.Format = AccessLogJSON
+	r.Header.Del("X-Request-ID")
+	w = httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatal(err)
+	}
+	id := w.Header().Get("X-Request-ID")
+	if id == "" || entry["request_id"] != id {
+		t.Errorf("expecting request ID %q in JSON entry, got %v", id, entry["request_id"])
+	}
+	if entry["status"] != float64(200) || entry["bytes"] != float64(5) || entry["uri"] != "/hello/?a=b" {
+		t.Errorf("unexpected JSON log entry %v", entry)
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	a := New()
+	a.Logger = log.New(log.NewIOWriter(&buf, log.LDebug), 0, log.LDebug)
+	var id string
+	a.Handle("^/$", func(ctx *Context) {
+		id = ctx.RequestID()
+		ctx.Logger().Infof("handling %s", "request")
+	})
+	for _, v := range []string{"", "bad id\n", "good-id"} {
+		buf.Reset()
+		r, _ := http.NewRequest("GET", "http://www.example.com/", nil)
+		if v != "" {
+			r.Header.Set("X-Request-ID", v)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		if id == "" || (v == "good-id") != (id == v) {
+			t.Errorf("unexpected request ID %q for incoming %q", id, v)
+		}
+		if h := w.Header().Get("X-Request-ID"); h != id {
+			t.Errorf("expecting response request ID %q, got %q", id, h)
+		}
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if !strings.Contains(line, "["+id+"] ") {
+				t.Errorf("log line %q does not contain the request ID", line)
+			}
+		}
+	}
+	a.RequestIDHeader = ""
+	r, _ := http.NewRequest("GET", "http://www.example.com/", nil)
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	if id != "" || w.Header().Get("X-Request-ID") != "" {
+		t.Error("request IDs should be disabled with an empty RequestIDHeader")
+	}
+}
@@ -6,22 +6,28 @@ package app
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/http/pprof"
 	"os"
+	ossignal "os/signal"
 	"path"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"text/template/parse"
 	"time"
 
 	"gnd.la/app/cookies"
+	"gnd.la/app/metrics"
 	"gnd.la/app/profile"
 	"gnd.la/blobstore"
 	"gnd.la/cache"
@@ -55,6 +61,13 @@ const (
 	// DID_PREPARE is emitted when App.Prepare ends without errors.
 	// The object is the App.
 	DID_PREPARE = "gnd.la/app.did-prepare"
+	// WILL_STOP is emitted when a *gnd.la/app.App starts shutting
+	// down, before it stops accepting new connections. The object
+	// is the App.
+	WILL_STOP = "gnd.la/app.will-stop"
+	// DID_STOP is emitted after a *gnd.la/app.App has finished
+	// shutting down. The object is the App.
+	DID_STOP = "gnd.la/app.did-stop"
 )
 
 var (
@@ -101,7 +114,41 @@ type handlerInfo struct {
 	pathMatch []int
 	re        *regexp.Regexp
 	rc        *regexpCache
-	handler   Handler
+	methods   []string
+	params    map[string]*PlaceholderType
+	cors      *CORS
+	access    *access
+	// included is true for the handlers which serve
+	// an included App.
+	included bool
+	// base is the handler wrapped with its own transformers,
+	// while handler also includes the App transformers.
+	base    Handler
+	handler Handler
+}
+
+// acceptsMethod returns true iff the handler accepts
+// the given HTTP method. Handlers with no methods accept
+// any method, while handlers accepting GET also accept HEAD.
+func (h *handlerInfo) acceptsMethod(method string) bool {
+	if len(h.methods) == 0 {
+		return true
+	}
+	for _, v := range h.methods {
+		if v == method || (method == "HEAD" && v == "GET") {
+			return true
+		}
+	}
+	return false
+}
+
+// corsPolicy returns the CORS policy for the handler,
+// falling back to the App one.
+func (h *handlerInfo) corsPolicy(app *App) *CORS {
+	if h.cors != nil {
+		return h.cors
+	}
+	return app.CORS
 }
 
 type includedApp struct {
@@ -158,6 +205,43 @@ type App struct {
 	// logging at all and gain a bit more of performance.
 	Logger *log.Logger
 
+	// AccessLog, if non-nil, receives an entry for each request
+	// served by the App. When it's set, requests are not logged
+	// to Logger. See AccessLog for more details.
+	AccessLog *AccessLog
+
+	// RequestIDHeader is the name of the header used for reading
+	// the request ID from incoming requests and sending it back in
+	// the response. Its default value is DefaultRequestIDHeader.
+	// If empty, request IDs are disabled. See Context.RequestID.
+	RequestIDHeader string
+
+	// Metrics, if non-nil, enables collecting metrics and serving
+	// them in the Prometheus text format. Note that it must be set
+	// before calling Prepare. See Metrics for more details.
+	Metrics *Metrics
+
+	// Health, if non-nil, enables the health and readiness
+	// endpoints. See Health for more details.
+	Health *Health
+
+	// SecurityHeaders, if non-nil, contains the security related
+	// headers sent with every response, including the
+	// Content-Security-Policy. See SecurityHeaders for more details.
+	SecurityHeaders *SecurityHeaders
+
+	// Authenticators are consulted by Context.User, in order, to
+	// resolve the credentials sent with each request to a User,
+	// before looking for a signed in user in the session. See
+	// Authenticator for more details.
+	Authenticators []Authenticator
+
+	// SignInHandler is the name of the handler which users are
+	// redirected to when they try to access a handler which requires
+	// a signed in user. If empty, SignInHandlerName is used. See
+	// HandlerOptions.RequireUser.
+	SignInHandler string
+
 	// CookieOptions indicates the default options used
 	// used for cookies. If nil, the default values as returned
 	// by cookies.Defaults() are used.
@@ -174,12 +258,44 @@ type App struct {
 	// it defaults to AES.
 	Cipherer cryptoutil.Cipherer
 
+	// Compression indicates the options used for compressing
+	// responses. If nil, which is the default, responses
+	// are not compressed. See Compression for more details.
+	Compression *Compression
+
+	// SessionStore is the store used for the sessions returned
+	// by Context.Session. If nil, which is the default, a
+	// CookieSessionStore is used. When using a server side
+	// store, the signed in user id (see Context.SignIn) is stored
+	// in the session, so logins can be revoked by destroying
+	// the session.
+	SessionStore SessionStore
+
+	// CSRF enables CSRF protection for every handler which
+	// doesn't set CSRFExempt in its HandlerOptions. If nil,
+	// which is the default, CSRF protection is only performed
+	// by gnd.la/form. See CSRF for more details.
+	CSRF *CSRF
+	// CSRFExempt disables the CSRF protection for all the handlers
+	// in this App. Since included apps use the CSRF configuration of
+	// their parent, this allows including apps which can't send the
+	// CSRF tokens.
+	CSRFExempt bool
+
+	// CORS is the CORS policy used by every handler which doesn't
+	// set its own policy in its HandlerOptions. If nil, which
+	// is the default, handlers without a policy don't support
+	// cross-origin requests. See CORS for more details.
+	CORS *CORS
+
 	// config received in New or defaultConfig, never nil
 	cfg *Config
 	// used for Get/Set
 	values map[string]interface{}
 
 	handlers           []*handlerInfo
+	tree               *handlerTree
+	transformers       []Transformer
 	trustXHeaders      bool
 	appendSlash        bool
 	errorHandler       ErrorHandler
@@ -200,6 +316,13 @@ type App struct {
 	o                  *orm.Orm
 	store              *blobstore.Blobstore
 	prepared           bool
+	server             *http.Server
+	redirectServer     *http.Server
+	pending            sync.WaitGroup
+	abandon            chan struct{}
+	listening          bool
+	stopping           bool
+	stopped            chan struct{}
 
 	// Used for included apps
 	included  []*includedApp
@@ -221,31 +344,110 @@ func (app *App) HandleNamed(pattern string, handler Handler, name string) {
 // HandleOptions adds a new handler to the App. If the Options include a
 // non-empty name, it can be be reversed using Context.Reverse or
 // the "reverse" template function. To add a host-specific Handler,
-// set the Host field in Options to a non-empty string. Note that handler patterns
-// are tried in the same order that they were added to the App.
+// set the Host field in Options to a non-empty string. To restrict the
+// HTTP methods accepted by the Handler, set the Methods field. Several handlers
+// might share the same pattern as long as they accept different methods.
+// Note that handler patterns are tried in the same order that they were
+// added to the App.
+//
+// Besides regular expressions, patterns might also use placeholders
+// like {name} or {name:type} e.g. /users/{id:int}/posts/{slug}/.
+// Each placeholder becomes a named capture group matching its
+// type, whose value can be retrieved with Context.ParamValue or
+// parsed with Context.ParseParamValue. Patterns with placeholders
+// which don't start with ^ are matched literally, except for the
+// placeholders, against the whole path. The available types are
+// int, slug, uuid and path, while additional ones might be added
+// with RegisterPlaceholderType. Placeholders without a type
+// match any non-empty string without slashes.
+//
+// Handlers might be wrapped with Transformers (middleware) by
+// setting the Transformers field in the options, by registering
+// them in a Group or by using AddTransformer. See AddTransformer
+// for the order in which they're executed.
 func (app *App) HandleOptions(pattern string, handler Handler, opts *HandlerOptions) {
+	app.handleOptions(pattern, handler, opts, false)
+}
+
+func (app *App) handleOptions(pattern string, handler Handler, opts *HandlerOptions, included bool) {
 	if handler == nil {
 		panic(fmt.Errorf("handler for pattern %q can't be nil", pattern))
 	}
-	re := regexp.MustCompile(pattern)
+	expanded, params, err := expandPlaceholders(pattern)
+	if err != nil {
+		panic(err)
+	}
+	re := regexp.MustCompile(expanded)
 	var host string
 	var name string
+	var methods []string
+	var cors *CORS
+	csrfExempt := false
+	var acc *access
 	if opts != nil {
 		host = opts.Host
 		name = opts.Name
+		for _, v := range opts.Methods {
+			methods = append(methods, strings.ToUpper(v))
+		}
+		acc = newAccess(opts)
+		handler = accessHandler(acc, wrapHandler(handler, opts.Transformers))
+		handler = timeoutHandler(opts.Timeout, handler)
+		csrfExempt = opts.CSRFExempt
+		cors = opts.CORS
+		if err := cors.validate(); err != nil {
+			panic(fmt.Errorf("invalid CORS policy for handler %q: %s", pattern, err))
+		}
+	}
+	// Included apps perform their own CORS and CSRF handling
+	if !included {
+		if !csrfExempt {
+			handler = csrfHandler(handler)
+		}
+		handler = corsHandler(cors, handler)
 	}
 	info := &handlerInfo{
-		host:    host,
-		name:    name,
-		re:      re,
-		rc:      newRegexpCache(re),
-		handler: handler,
+		host:     host,
+		name:     name,
+		re:       re,
+		rc:       newRegexpCache(re),
+		methods:  methods,
+		params:   params,
+		cors:     cors,
+		access:   acc,
+		included: included,
+		base:     handler,
+		handler:  wrapHandler(handler, app.transformers),
 	}
 	if p := literalRegexp(re); p != "" {
 		info.path = p
 		info.pathMatch = []int{0, len(p)}
 	}
 	app.handlers = append(app.handlers, info)
+	if app.tree == nil {
+		app.tree = new(handlerTree)
+	}
+	app.tree.insert(literalPrefix(re), len(app.handlers)-1)
+}
+
+// AddTransformer adds a Transformer which wraps every handler
+// registered in the App, including the ones registered before
+// this call. Transformers act as middleware and run in the
+// following order, from the outermost to the innermost:
+// the ones added with AddTransformer, then the ones from the
+// Group the handler was registered in, and finally the ones
+// from its HandlerOptions. Within each level, they run in the
+// order they were added. Transformers added to an App which is
+// then passed to Include run after the parent App ones, since
+// the parent ones wrap the whole included App.
+//
+// When a Transformer is executed, the request has already been
+// matched, so Context.HandlerName and the parameters are available.
+func (app *App) AddTransformer(tr Transformer) {
+	app.transformers = append(app.transformers, tr)
+	for _, v := range app.handlers {
+		v.handler = wrapHandler(v.base, app.transformers)
+	}
 }
 
 // AddContextProcessor adds context processor to the App.
@@ -375,7 +577,7 @@ func (app *App) include(prefix string, child *App, containerTemplate string) err
 		}
 	}
 	// All checks passed, add the included app handler
-	app.HandleOptions("^"+prefix, includedAppHandler(child, prefix), nil)
+	app.handleOptions("^"+prefix, includedAppHandler(child, prefix), nil, true)
 	return nil
 }
 
@@ -784,6 +986,9 @@ func (app *App) reverse(name string, args []interface{}) (string, error) {
 func (app *App) reverseHandler(name string, args []interface{}) (bool, string, error) {
 	for _, v := range app.handlers {
 		if v.name == name {
+			if err := v.checkPlaceholders(args); err != nil {
+				return true, "", fmt.Errorf("error reversing handler %q: %s", name, err)
+			}
 			reversed, err := formatRegexp(v.rc, args)
 			if err != nil {
 				if acerr, ok := err.(*argumentCountError); ok {
@@ -817,7 +1022,12 @@ func (app *App) reverseHandler(name string, args []interface{}) (bool, string, e
 }
 
 // ListenAndServe starts listening on the configured address and
-// port (see Address() and Port).
+// port (see Address() and Port). If the TLSCertFile and TLSKeyFile
+// configuration fields are set, the App serves HTTPS and, when HTTPPort
+// is non-zero, it also listens on that port for plain HTTP requests
+// and redirects them to HTTPS. When the process receives either
+// SIGINT or SIGTERM, the App is gracefully stopped by calling
+// Shutdown and ListenAndServe returns once it finishes.
 func (app *App) ListenAndServe() error {
 	if err := app.Prepare(); err != nil {
 		return err
@@ -827,28 +1037,247 @@ func (app *App) ListenAndServe() error {
 	}
 	signal.Emit(WILL_LISTEN, app)
 	app.started = time.Now().UTC()
+	certFile, keyFile := app.cfg.TLSCertFile, app.cfg.TLSKeyFile
+	useTLS := certFile != "" && keyFile != ""
 	if app.Logger != nil && os.Getenv("GONDOLA_DEV_SERVER") == "" {
+		scheme := "HTTP"
+		if useTLS {
+			scheme = "HTTPS"
+		}
 		if app.address != "" {
-			app.Logger.Infof("Listening on %s, port %d", app.address, app.cfg.Port)
+			app.Logger.Infof("Listening on %s, port %d (%s)", app.address, app.cfg.Port, scheme)
+		} else {
+			app.Logger.Infof("Listening on port %d (%s)", app.cfg.Port, scheme)
+		}
+	}
+	server := &http.Server{
+		Addr:    app.address + ":" + strconv.Itoa(app.cfg.Port),
+		Handler: app,
+	}
+	var redirectServer *http.Server
+	if useTLS && app.cfg.HTTPPort > 0 {
+		redirectServer = &http.Server{
+			Addr:    app.address + ":" + strconv.Itoa(app.cfg.HTTPPort),
+			Handler: http.HandlerFunc(app.redirectToHTTPS),
+		}
+		if app.Logger != nil {
+			app.Logger.Infof("Redirecting HTTP requests on port %d to HTTPS", app.cfg.HTTPPort)
+		}
+	}
+	app.locked(func() {
+		app.server = server
+		app.redirectServer = redirectServer
+		app.stopped = make(chan struct{})
+	})
+	stopped := app.stopped
+	serveErr := make(chan error, 2)
+	go func() {
+		if useTLS {
+			serveErr <- server.ListenAndServeTLS(certFile, keyFile)
 		} else {
-			app.Logger.Infof("Listening on port %d", app.cfg.Port)
+			serveErr <- server.ListenAndServe()
 		}
+	}()
+	if redirectServer != nil {
+		go func() {
+			if err := redirectServer.ListenAndServe(); err != http.ErrServerClosed {
+				serveErr <- err
+			}
+		}()
+	}
+	sigs := make(chan os.Signal, 1)
+	ossignal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	defer ossignal.Stop(sigs)
+	listening := time.After(500 * time.Millisecond)
+	for {
+		select {
+		case err := <-serveErr:
+			if err == http.ErrServerClosed {
+				// Shutdown was called from somewhere else,
+				// wait until it finishes.
+				<-stopped
+				return nil
+			}
+			// One of the servers failed, make sure
+			// the other one is not left running.
+			server.Close()
+			if redirectServer != nil {
+				redirectServer.Close()
+			}
+			return err
+		case <-listening:
+			app.SetReady(true)
+			signal.Emit(DID_LISTEN, app)
+		case sig := <-sigs:
+			if app.Logger != nil {
+				app.Logger.Infof("Received %s, shutting down", sig)
+			}
+			return app.Shutdown()
+		}
+	}
+}
+
+// Shutdown gracefully stops the App. If the App has a Health, it first
+// reports itself as not ready while it keeps accepting new requests for
+// the number of seconds indicated by the ShutdownDrainDelay configuration
+// field, so load balancers have time to stop sending it traffic. Then, it
+// stops accepting new connections and waits until the in-flight requests
+// and any pending background work spawned with Context.Go have finished,
+// up to the number of seconds indicated by the ShutdownTimeout configuration
+// field. Finally, the ORM, cache and blobstore connections held by the App
+// are closed. The WILL_STOP and DID_STOP signals are emitted at the beginning
+// and at the end of the process, respectively. Scheduled tasks from gnd.la/tasks
+// are automatically stopped when the App emits WILL_STOP. Note that
+// ListenAndServe calls this function when the process receives
+// SIGINT or SIGTERM, so you don't need to call it yourself unless you
+// need to stop the App for other reasons.
+func (app *App) Shutdown() error {
+	var server, redirectServer *http.Server
+	var stopped chan struct{}
+	alreadyStopping := false
+	app.locked(func() {
+		alreadyStopping = app.stopping
+		app.stopping = true
+		server = app.server
+		redirectServer = app.redirectServer
+		stopped = app.stopped
+	})
+	if alreadyStopping {
+		return nil
+	}
+	signal.Emit(WILL_STOP, app)
+	app.SetReady(false)
+	if delay := app.cfg.ShutdownDrainDelay; delay > 0 && app.Health != nil {
+		time.Sleep(time.Duration(delay) * time.Second)
+	}
+	ctx := context.Background()
+	if timeout := app.cfg.ShutdownTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
 	}
 	var err error
-	time.AfterFunc(500*time.Millisecond, func() {
+	if redirectServer != nil {
+		err = redirectServer.Shutdown(ctx)
+	}
+	if server != nil {
+		if serr := server.Shutdown(ctx); err == nil {
+			err = serr
+		}
+	}
+	pending := make(chan struct{})
+	go func() {
+		app.pending.Wait()
+		close(pending)
+	}()
+	select {
+	case <-pending:
+	case <-ctx.Done():
+		// Cancel the background contexts, so
+		// they can finish as soon as possible.
+		app.abandonBackgroundContexts()
 		if err == nil {
-			signal.Emit(DID_LISTEN, app)
+			err = fmt.Errorf("timed out waiting for background contexts: %s", ctx.Err())
+		}
+	}
+	if cerr := app.closeConnections(); err == nil {
+		err = cerr
+	}
+	if err != nil && app.Logger != nil {
+		app.Logger.Errorf("error shutting down: %s", err)
+	}
+	signal.Emit(DID_STOP, app)
+	if stopped != nil {
+		close(stopped)
+	}
+	return err
+}
+
+// SetReady sets whether the App is ready to handle requests, as reported
+// by the readiness endpoint (see Health). ListenAndServe calls it once the
+// App starts listening, so it only needs to be called by apps which are
+// served by other means (e.g. from their own http.Server).
+func (app *App) SetReady(ready bool) {
+	app.locked(func() {
+		app.listening = ready
+	})
+}
+
+// Stopping returns true iff the App has started shutting down.
+// See Shutdown for more information.
+func (app *App) Stopping() bool {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	return app.stopping
+}
+
+// closeConnections closes the ORM, cache and blobstore used by
+// the App, if they were opened. It returns the first error.
+func (app *App) closeConnections() error {
+	var err error
+	app.locked(func() {
+		if app.o != nil {
+			if oerr := app.o.Close(); oerr != nil && err == nil {
+				err = oerr
+			}
+			app.o = nil
+		}
+		if app.c != nil {
+			if cerr := app.c.Close(); cerr != nil && err == nil {
+				err = cerr
+			}
+			app.c = nil
+		}
+		if app.store != nil {
+			if serr := app.store.Close(); serr != nil && err == nil {
+				err = serr
+			}
+			app.store = nil
 		}
 	})
-	err = http.ListenAndServe(app.address+":"+strconv.Itoa(app.cfg.Port), app)
 	return err
 }
 
+// redirectToHTTPS is the handler used by the plain HTTP listener
+// started when the App serves HTTPS and HTTPPort is non-zero.
+func (app *App) redirectToHTTPS(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if port := app.cfg.Port; port != 443 {
+		host = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+	http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+}
+
+// hstsHeader returns the value for the Strict-Transport-Security
+// header or the empty string if HSTS is disabled.
+func (app *App) hstsHeader() string {
+	if app.cfg.HSTSMaxAge <= 0 {
+		return ""
+	}
+	value := "max-age=" + strconv.Itoa(app.cfg.HSTSMaxAge)
+	if app.cfg.HSTSIncludeSubdomains {
+		value += "; includeSubDomains"
+	}
+	return value
+}
+
+// root returns the App at the top of the inclusion
+// hierarchy, which is the one serving the requests.
+func (app *App) root() *App {
+	for app.parent != nil {
+		app = app.parent
+	}
+	return app
+}
+
 // MustListenAndServe works like ListenAndServe, but panics if
 // there's an error
 func (app *App) MustListenAndServe() {
 	err := app.ListenAndServe()
-	if err != nil {
+	if err != nil && err != http.ErrServerClosed {
 		log.Panicf("error listening on port %d: %s", app.cfg.Port, err)
 	}
 }
@@ -972,6 +1401,22 @@ func (app *App) recoverErr(ctx *Context, err interface{}) {
 	if isIgnorable(err) {
 		return
 	}
+	// Only panics caused by the cancellation itself are special
+	// cased, any other error must go through the normal path.
+	if e, ok := err.(error); ok {
+		switch {
+		case errors.Is(e, context.Canceled):
+			// Client went away, there's no one
+			// to send the response to.
+			if !ctx.background {
+				return
+			}
+		case errors.Is(e, context.DeadlineExceeded):
+			ctx.Logger().Warningf("handler %s timed out after %s: %v", ctx.HandlerName(), ctx.Elapsed(), err)
+			app.handleHTTPError(ctx, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+	}
 	for _, v := range app.RecoverHandlers {
 		err = v(ctx, err)
 		if err == nil {
@@ -1007,6 +1452,9 @@ func (app *App) logError(ctx *Context, err interface{}) {
 	}
 	elapsed := ctx.Elapsed()
 	fmt.Fprintf(&buf, " (after %s): %v\n", elapsed, err)
+	if ctx.requestID != "" {
+		fmt.Fprintf(&buf, "Request ID: %s\n", ctx.requestID)
+	}
 	stack := runtimeutil.FormatStack(stackSkip)
 	location, code := runtimeutil.FormatCaller(skip, 5, true, true)
 	if location != "" {
@@ -1119,6 +1567,15 @@ func (app *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	defer app.closeContext(ctx)
 	defer app.recover(ctx)
+	if hsts := app.hstsHeader(); hsts != "" && ctx.requestScheme() == "https" {
+		ctx.SetHeader("Strict-Transport-Security", hsts)
+	}
+	if sh := app.SecurityHeaders; sh != nil {
+		sh.apply(ctx)
+	}
+	if app.serveHealth(ctx) || app.serveMetrics(ctx) {
+		return
+	}
 	if app.runProcessors(ctx) {
 		return
 	}
@@ -1133,13 +1590,25 @@ func (app *App) serveOrNotFound(path string, ctx *Context) {
 }
 
 func (app *App) serve(path string, ctx *Context) bool {
-	if handler := app.matchHandler(path, ctx); handler != nil {
+	handler, allowed := app.matchHandler(path, ctx)
+	if handler != nil {
 		handler(ctx)
 		return true
 	}
+	if len(allowed) > 0 {
+		// The path matched, but no handler accepts
+		// the request method.
+		ctx.SetHeader("Allow", allowHeader(allowed))
+		if ctx.R.Method == "OPTIONS" {
+			ctx.WriteHeader(http.StatusOK)
+		} else {
+			app.handleHTTPError(ctx, "Method Not Allowed", http.StatusMethodNotAllowed)
+		}
+		return true
+	}
 
 	if app.appendSlash && (ctx.R.Method == "GET" || ctx.R.Method == "HEAD") && !strings.HasSuffix(path, "/") {
-		if app.matchHandler(path+"/", ctx) != nil {
+		if h, _ := app.matchHandler(path+"/", ctx); h != nil {
 			prevPath := ctx.R.URL.Path
 			ctx.R.URL.Path += "/"
 			ctx.Redirect(ctx.R.URL.String(), true)
@@ -1150,28 +1619,86 @@ func (app *App) serve(path string, ctx *Context) bool {
 	return false
 }
 
-func (app *App) matchHandler(path string, ctx *Context) Handler {
-	for _, v := range app.handlers {
+// matchHandler returns the first handler which matches the given
+// path and the request method. If no handler matches, but there
+// are handlers which match the path with a different method, the
+// methods accepted by them are returned.
+func (app *App) matchHandler(path string, ctx *Context) (Handler, []string) {
+	if isPreflight(ctx.R) {
+		// Preflight requests are answered using the CORS policy
+		// of the handler which would serve the actual request.
+		method := strings.ToUpper(ctx.R.Header.Get("Access-Control-Request-Method"))
+		if info, _ := app.matchInfo(path, method, ctx); info != nil && !info.included {
+			if policy := info.corsPolicy(app); policy != nil {
+				return func(ctx *Context) {
+					policy.preflight(ctx, info.methods)
+				}, nil
+			}
+		}
+	}
+	info, allowed := app.matchInfo(path, ctx.R.Method, ctx)
+	if info != nil {
+		return info.handler, nil
+	}
+	return nil, allowed
+}
+
+// matchInfo works like matchHandler, but receives the method
+// and returns the *handlerInfo.
+func (app *App) matchInfo(path string, method string, ctx *Context) (*handlerInfo, []string) {
+	if app.tree == nil {
+		return nil, nil
+	}
+	var allowed []string
+	var buf [16]int
+	// Only test the handlers whose literal prefix matches the path.
+	// Candidates are returned in registration order.
+	for _, idx := range app.tree.candidates(path, buf[:0]) {
+		v := app.handlers[idx]
 		if v.host != "" && v.host != ctx.R.Host {
 			continue
 		}
+		var m []int
 		if v.path != "" {
-			if v.path == path {
-				ctx.reProvider.reset(v.re, path, v.pathMatch)
-				ctx.handlerName = v.name
-				return v.handler
+			if v.path != path {
+				continue
 			}
+			m = v.pathMatch
 		} else {
 			// Use FindStringSubmatchIndex, since this way we can
 			// reuse the slices used to store context arguments
-			if m := v.re.FindStringSubmatchIndex(path); m != nil {
-				ctx.reProvider.reset(v.re, path, m)
-				ctx.handlerName = v.name
-				return v.handler
+			if m = v.re.FindStringSubmatchIndex(path); m == nil {
+				continue
 			}
 		}
+		if !v.acceptsMethod(method) {
+			allowed = append(allowed, v.methods...)
+			continue
+		}
+		ctx.reProvider.reset(v.re, path, m)
+		ctx.handlerName = v.name
+		ctx.params = v.params
+		return v, nil
+	}
+	return nil, allowed
+}
+
+// allowHeader returns the value for the Allow header
+// given the methods accepted by the matched handlers.
+func allowHeader(methods []string) string {
+	seen := map[string]bool{"OPTIONS": true}
+	for _, v := range methods {
+		seen[v] = true
+		if v == "GET" {
+			seen["HEAD"] = true
+		}
 	}
-	return nil
+	values := make([]string, 0, len(seen))
+	for k := range seen {
+		values = append(values, k)
+	}
+	sort.Strings(values)
+	return strings.Join(values, ", ")
 }
 
 // newContext returns a new context, using the
@@ -1179,9 +1706,14 @@ func (app *App) matchHandler(path string, ctx *Context) Handler {
 func (app *App) newContext(w http.ResponseWriter, r *http.Request) *Context {
 	p := &regexpProvider{}
 	ctx := &Context{R: r, ResponseWriter: w, app: app, provider: p, reProvider: p, started: time.Now()}
+	if app.Compression != nil {
+		ctx.compressor = newCompressWriter(w, r, app.Compression)
+		ctx.ResponseWriter = ctx.compressor
+	}
 	if app.trustXHeaders {
 		app.readXHeaders(r)
 	}
+	app.setRequestID(ctx)
 	return ctx
 }
 
@@ -1212,7 +1744,19 @@ func (app *App) CloseContext(ctx *Context) {
 		v(ctx)
 	}
 	ctx.Close()
-	if !ctx.background && app.Logger != nil && ctx.R != nil && ctx.R.URL.Path != devStatusPage && ctx.R.URL.Path != monitorAPIPage {
+	if ctx.background || ctx.R == nil || app.isInternalPath(ctx.R.URL.Path) {
+		return
+	}
+	if metrics.Enabled() && (app.Metrics == nil || ctx.R.URL.Path != app.Metrics.path()) {
+		app.recordRequest(ctx)
+	}
+	if app.AccessLog != nil {
+		if err := app.AccessLog.log(ctx); err != nil && app.Logger != nil {
+			app.Logger.Errorf("error writing access log: %s", err)
+		}
+		return
+	}
+	if app.Logger != nil {
 		// Log at most with Warning level, to avoid potentially generating
 		// an email to the admin when running in production mode. If there
 		// was an error while processing this request, it has been already
@@ -1226,7 +1770,19 @@ func (app *App) CloseContext(ctx *Context) {
 			logger.Info(message)
 		}
 	}
+}
 
+// isInternalPath returns true iff the path corresponds to
+// an endpoint which shouldn't be logged nor measured, since
+// it's periodically polled.
+func (app *App) isInternalPath(p string) bool {
+	if p == devStatusPage || p == monitorAPIPage {
+		return true
+	}
+	if h := app.Health; h != nil && (p == h.healthPath() || p == h.readinessPath()) {
+		return true
+	}
+	return false
 }
 
 // closeContext calls CloseContexts and stores the context in
@@ -1376,6 +1932,13 @@ func (app *App) Clone() *App {
 		panic(fmt.Errorf("can't clone app %s, it has been already included", app.name))
 	}
 	a := *app
+	a.handlers = make([]*handlerInfo, len(app.handlers))
+	for ii, v := range app.handlers {
+		h := *v
+		a.handlers[ii] = &h
+	}
+	a.transformers = append([]Transformer(nil), app.transformers...)
+	a.tree = newHandlerTree(a.handlers)
 	a.values = make(map[string]interface{}, len(app.values))
 	for k, v := range app.values {
 		a.values[k] = v
@@ -1403,6 +1966,12 @@ func (app *App) Prepare() error {
 		}
 	}
 	signal.Emit(WILL_PREPARE, app)
+	if err := app.CORS.validate(); err != nil {
+		return err
+	}
+	if app.Metrics != nil {
+		metrics.Enable()
+	}
 	if s := app.cfg.Secret; s != "" && len(s) < 32 && os.Getenv("GONDOLA_ALLOW_SHORT_SECRET") == "" {
 		if os.Getenv("GONDOLA_IS_DEV_SERVER") != "" {
 			os.Setenv("GONDOLA_IS_DEV_SERVER", "")
@@ -1417,9 +1986,18 @@ func (app *App) Prepare() error {
 		child.CookieCodec = app.CookieCodec
 		child.Hasher = app.Hasher
 		child.Cipherer = app.Cipherer
+		child.Compression = app.Compression
+		child.SessionStore = app.SessionStore
+		child.CSRF = app.CSRF
+		child.CORS = app.CORS
 		child.languageHandler = app.languageHandler
 		child.userFunc = app.userFunc
 		child.Logger = app.Logger
+		child.AccessLog = app.AccessLog
+		child.RequestIDHeader = app.RequestIDHeader
+		child.SecurityHeaders = app.SecurityHeaders
+		child.SignInHandler = app.SignInHandler
+		child.Authenticators = app.Authenticators
 	}
 	// Add hooks from each included app to all the other apps
 	for _, h := range app.hooks {
@@ -1456,10 +2034,12 @@ func (app *App) Config() *Config {
 // Transform transforms all the registered handlers using the given
 // Transformer. Note that handlers registered after this call won't
 // be transformed. This function might be used to cache an entire
-// App using gnd.la/cache/layer.
+// App using gnd.la/cache/layer. The transformed handlers are still
+// wrapped by the Transformers added with AddTransformer.
 func (app *App) Transform(tr Transformer) {
 	for _, v := range app.handlers {
-		v.handler = tr(v.handler)
+		v.base = tr(v.base)
+		v.handler = wrapHandler(v.base, app.transformers)
 	}
 }
 
@@ -1471,10 +2051,11 @@ func New() *App {
 	cc := defaultConfig
 	cfg := &cc
 	a := &App{
-		Logger:         log.Std,
-		cfg:            cfg,
-		appendSlash:    true,
-		templatesCache: make(map[string]*Template),
+		Logger:          log.Std,
+		RequestIDHeader: DefaultRequestIDHeader,
+		cfg:             cfg,
+		appendSlash:     true,
+		templatesCache:  make(map[string]*Template),
 	}
 	// Used to automatically reload the page on panics when the server
 	// is restarted.
@@ -1489,6 +2070,8 @@ func New() *App {
 				"started": strconv.FormatInt(a.started.Unix(), 10),
 			})
 		})
+		// Collect metrics to be charted in the monitor
+		metrics.Enable()
 		a.Handle(monitorAPIPage, monitorAPIHandler)
 		a.Handle(monitorPage, monitorHandler)
 		a.addAssetsManager(internalAssetsManager, false)
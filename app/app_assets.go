@@ -4,4 +4,4 @@ import "gnd.la/internal/vfsutil"
 
 // AUTOMATICALLY GENERATED WITH gondola bake -dir=_assets -name=appAssets -o=app_assets.go -- DO NOT EDIT!
 
-var appAssets = vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\xbd\xef\x9e\xdb6\xb2 :\x9f\xfd\x14\xb4r2C\xba%\xb5\xa4n\xff\xebv\xdb?\xc7vNr\xd7q\xb2v6;\x99v\x1f\xff(\x91R\xb3\x9b\x12e\x92\xea\x96<\xf1\xf9r\x9fi\xbf\xddO\xfb\x00\xf7\xbeҭ?\x00\b\x80 \xa5\xb6\xe3\xcc\xcc\xee\xce9q\x8b@\xa1P(\x14\n\x05\xa0P\x18\x87E\xdc?/\xe7韾\xdc\xff\x06\xc3\x01\xfe\xefO\x83\x81\xf3\xef\xdd\xc3\xc3\xc3?\rG\a\xf7\xee\x8e\x0eFÃ!\xc0\x0f\a\a\x87\u007f\xf2\x06\u007f\xfa\x03\xfe\xb7*\xca0\aR>\xbb\x91V\xe3\xfeE\xfe\xf7\xf7\xbf\xef߹\xe5y\xc9b\x92\xae\xa2\xf8ț\xaf\xd6\xfd\x8b\xe2֝\xfd\x8f\x1fo=\xba\xfd\xfc\xc7g?\xff\xfa\xd3\v\x0f\x05\xe4\xf1\xadG\xf2O\x1cF\xf0\xa7L\xca4~\xfc\xf7\xbf{e<_\xa6a\x19{\x1dJ\xeax\xfd\x8f\x1f\x1f\xeds\xf6\xadGE\xb9Ic\xaf\xdc,\xe3\x93N\x19\xaf\xcb\xfdIQt\x1e\xdfڿ\x03X\xcb\xe5\xd1\xfe\xfe<\xde\xc4\xf9u<\xeeO\xb2\xf9~\x9c'\x93\xfd2\xcb\xd2\x02\xe1\xf6\xf3\xb8\x88\xcb} л\x1a\xf5\a\xdeo\xde\b\x85c8\xba\x87I/\x93I\xbc(\x80\xe8E\xb6\x88=\u007f\xb9\x1a\xa7\xc9ċ\xb2y\x98,\x02h\u00ad[Hp\xd7\x1bgѦ\xebE\xc9U\xd7+\x96\xe1\xa2\xeb\x85\xcbe\x1a\x97]/\x1b_\xc4\x13\xf8\x9bL\xf3p\x1ewo\x9d\x0f\xbb\xde\xf9\b\xfe;\x80\xff\x0eΏ\xf0\u07fd\xae\xb7\x04\x1ci6\xb9|\xbf\xca\xca\x18>s\x80\r\x01\xcdx\x9cÿ\x93<[l\xe6\xf0#\x8a\x80\xdc\x02`\x93Yכ$\b:\xc9\"\x80\x8db\xa0\"\x9aB\xcd1\xc0%s\xc8N\x16\x00x9\x8e\xba\xde{\xa0\n\xfe?\x9c/\xbb\xb7\x8ay\x98\x02hQ\xe6\xc9eL\u007f\xb3\x05\x00\x17\xab1\xfe\x03d\x94@\xedU\x98woA\xc2\n\xb0@\r\xf1\xa2\x8c!!\xc2* 7\x02\x94\x19\xfc^\xc1\u007fiҽ5M\xe24*\xb0\xb5\xd3,\x87\xda\xd3p\x8cԤ\xf1,^D\xdd[e8N\x91\xcepY&\x19\x10X2\xb3\xcai\x96A\x91\x12\xbb\x1a\xfe\xe4\xf8\x13\xfe\x83\x02a^&\x13,\x12\x16ID%\x17W!4 \x8a\xcb0I\vl\xe28\x8e\xb0\xde\xd9\n\xf8\xe4\xc1_\x85\x1c\x91\x02\xad\x1eb\xa5\xbf\xb3<\x83Vݚ\xc7\vh\xcc\"\x84\x0e\xcaV\xe5r\x055\xe7\xab1\x90Q@\xf7P\xc9b5\x9f\x87\xf9\x06\xe8M\xa0\xa3<\xf8}\t\x14\xac\xa2$\x03~\x00\x1d\x99\xf7w\x90\bH\x9e%\x8b#op\f\x1fK\xe8\x8fd1\x13_\xe3,\x87*\xc5\xc74[\x94\xbd\"\xf9\x00\xa2\x03\xaa\xe9k\x99t\x04\xbdr\x0e\x02Xb\xc2U\x8c\r\r\xd3^\x98&3\xc0\x89\x8a2M\x16\xf1\xf1\xad\x8f(\xbb\xdf\xfd\xfc\xc3˻ S\x05H\xfe\xa6\x97g \xe2$\xab\xc8e\xe0?\xd4\xe5\x8d\xf3캈\xf3\xc2\x03I\xb4\xb9\xa6\xb8e\xb0\x87Yv\xab\x81M\x9e\xc6&\xc1\x18j\xb5 \xe2\x88e\x14\xe9\xc3>\xa4,$\xb8w\x1e'\xb3sh\xdc\x10\xb3X4Df\x01\\\xc0\xd1\xc9#\x88Jjb\xfe\x9e\xa0\xe8\xa3pA\x1c\x8dchl\xac\x8f\x8d\xa3pJ\xc2\xf8^\xe5\xbd\xe7$B5\x01\x1e\xc7\xc8\xe6\xbf\xfc\xe5X\xff\x94\xa8I\x16\t\x92{\xab7\xc9\xd24\\\xe2\b\x97\xbf\xaa\xae\xec\xc1P\x9e\xc8\xfe\xa5.y\xb1\x88\xbcl*\xba\x01x\xae\xb8@\xdd=\r\xe7I\nL\xfa%Σp\x11\xdabpo\xb9&\xdc\xe1\xe4\x12\xb9\xbd\x88\x8e\xbc\xaf\x9e?\xc3\xffC\xec\xe7\xc3\nѵ`\xe7\x18z\xd9\xc2r0`,,\x87\xbdqV\x96\xd9\x1ce\f\x93\x01\xcbA\x85\x85\v\x8cDN?\xce\xf3\x8c\x99dP\xf0\xed\xb7\xcf\x06Ͼ!\x88\xeb0_@s]0\xdf>\xfd\x96x\xd0O\x16\xd3\xcc\x01\xf0\f\xff\x8f\x00P\x1d9\x00\x06\xdf>\xf8\xf6[\x05П\xaf\xd229Z\x94\xe7\xbd\xc9y\x92F~\x16EAk\xbd,\xa8\x04\xa2F\xddH\xf0B\xf4\x96\xe2\xc5r\xed\x15Y\x9aD:{\xfb\xc5j2\x01\xd5Y\xaf\xe4\xf9\xb7\xdf\x0e\x9e?@\x18P\xba\xbah\xe4a\x94\xac@,\xefr-\xf6\x18@\xe9J3\x18\xef_=\xfb\xf6\xf97/\x9eUC|\x01Z0L\xbd\xe1h\xb9\xde\x1f\xc1?\xde\x0f\xd9\"\x9cd]\xf8\x93\xa1H\xc5\xde\xedd\xbe\xcc\xf22\\\x94\xa6D\xf4$\xca\xd1\x14\xff\xcf\xcaL\xe6\xe1\fz\x14\xc4c|\x99\x94\xbd<^\xc6a\t\x9c\xe8\xe1\x18\f\xf3\xde\f)\x06q\xf7\xcb\f\x86\xf2W`\xf3x\xc0!\xf1\v\t\xe9J\xc4\xd6\xd7\xe1\xe1r\x1d4T6\xcf>\xfcQ5\x15\u007fPE\xd9\x1fS\xcf\x17\xa8\xa4\x9ap\xbc\xe1]]\x11\x80\xd4\xc7s\x9ey2\x98V\xa6iv}\x04\x93W\x99\xa1\\Ӕo\xeb\x85\xe1\xb0I\xacт\x92S\x12O\xfdF=R\xd3\f\xbd\n\xafB\x92,x\x02\xab\x06ǽ{\xf7\x8e\xcdy\x93\aZ\u007f\xb2\xcas\xc0^\x1f\x90\x0f\xbeA\xebV\xc7!\xd5K\xad\xa2\x9e\"\xfa:\x89\xca\xf3j\xae\x95\x13\x12\xf2q\v\xe3\x06^\x8f\x93\x98.\xb4\xb8t-\xa0k\x95\b~ǑN\x0f4\xa2@\x12\x97Y\u008c\x02\x93v\x9f&<0K\xf7\x85\x15\x8b\xb3\xc4\xe3[`\xc5\x02c\xc1\"\xf2\xd0\xee\xdd\xe7D\x80!\x83\xf7O\xff\v\xffo\xbc\x02\r\x0f\xcc\xff\x92k\xc0-\xeb\xbf\xd1\xe1݁\xbd\xfe\xbb?\x1c\xfe\x9f\xf5\xdf\x1f\xb8\xfec\xe1/\xd8\xcc%Y\xd0\x17\x85y\x9cfa\xd4\xe7\xd5T\xb1L\x16\fA\x8bD\x189Q<\x85\xe1\xaeV\u007f\x1f?~#\x84ʃ\xcc\xfe+XZAZ\xbf\xdf\xc7A&F\x18,ƼI\x1a\x16\xc5IG\x18\x0f\xc2\x04\x80\x95\xa1\xe7=:\x1f>n\xc0\x01crH \x88\x81\xc62b\x10\xfad\x80\xea\x0f\x86{\x91\xa0qLt\x83\x8e\xbf\x8a\x8f\t\xab(\x94D'\x1dl\xc3\"\xce;\x8f\x1f\xedC\x12\xe1\xe3\x1f\xf2\x0fi\xcfǯФ\xf5~>O\n\xd0Q\xb3\u0603\xbf\xa8\xb6\xe7!\xad\fҍ`\fh\x9dl\x01\x86\x83F\xa9\x87RU\x16@.c\xbae,\x93\x15\va\xa9L\xec(&y\xb2,\xf5E\xf2E\b\xab*J\x05\xdaa\xc5\xe7e˲\xf0N\x94U\x0f]5\x84U\xea\xfe>\x90\x17{\x8b\x15,\xbar\xb4~)\xcf+3/\xca\xc3k\x84\x8d\x173Խ\xa3\x81\x02\xe6$\x04\x8e\xc3\xc99\x95\xd0ut\x05\x87\x9dZ\x9e'\x93K@Y\x00\x88\xb4\xb9\x0e*\x18NB\\\xb0T\U00108a70\xf2\xcda\xa9C3D\x0e\xdfH)\xc1?\xa3O\x8ff\x12\xc4\xe8\xf9\x83~\u007f\x18 \xe2\xac\f\x91\xd3\x1a^L\xc1\x05N6\x9d\x829O\x93K\xcek\x1e\x89n\b\x13 N0\xd7I\x01\xeb\x8c\x1e~\x02f\xd0\xf3*\xb5\x9a\xa4\xfe\xf2\x15\fɿP\xb1\xaf\xf2\xd9\xd8\x03[\xfb\xab<\x9f\xcd\xc6\xf43\xcc\xf3p\x83m hli\xb1\x8c\xe3HV\xf4\x1a\t\x06\t@!\x8da\xd1\x12\xe14\x9c\xc3\xf2\xedȻ\xc7\x14?\xc5%\xce\f\xa6u\x04\xc2Y\x19\x84\x05\xb1\x9c\x87\x11N\xf5e\xbe\x8a\t\uefdf\xc7\xc0\xa6\x1c\xbb\afW\x94\xfaP\x00\xe1\xacx\x1d\xc2\bH\xdd\xe0\xab\"\xf6\xce\xc3<\x82U\x00̂\b\x17\xe7\xc4 l#\x8e#\x94;h\xa7\x10\xec\xbf(F>{\xf3\x86\x01\x10\v\xfc\x01\xc3\x01\u007fao\tX\xc0\xf0\xe1{ f\rB\x12?T\x05?\xf4\x12L\xf4|\x18\xdd!,\b\b\xc1h \xff\x87\xdd\x06\x96\x12Tyw\xf0\xb5\xa8.[\xaa\xb1\xa7\x86\x1e\x96Z\x86hK\x900NKQ\x02\v\xbc\x84\xcf\xd6\x12\x1f\x8fI\xf4a(\xcd`Iw\x02S\xfcd\x05\x8b\xe0\xb2\x0f\x9f/\xd2\x18\u007f~\xb3\xf9>\xf2U\xb3\x03. >\xa1\xc4\"\xbe\xf6\xde\xf0\x97\x8f#(\xe8c\x9e\xcf\x18\x01\x1a\xc6'\r2k\x80j\nO\f\xd1\xffU\xe7\xff\xe8\xa0\u007f5\xea_\x14\u007f\xfaG\xed\xff\xde\x1f\x1d\xc2\u007f\xd6\xfc?8<8\xf8?\xf3\xff\x1f\xf1?\u007f\xbaZ\x90Z\xf5y\x89/?\xbd\xe8\xe0\x1d\xe9\r\u007fRf9\xeeyfK\xdc\x11\x8b\v\x86C\x15\xb8\x11\xbf<\xda\xf4\xf2q\xe4]\xc6\x1b\x98\x05\x1c\xd0\xf8\xbf\x1fi\xbb\xb5\xcf\xe6\xc2O\f\xb2!\xfc}(Pf8\xfdu\x11EW+\xe4yWa\xba\x02\xddV\xe1<\x05\x90\xb3\xae\x06\x11\xc3\xfc\a\xfap\x8c;Y\xd30%\xcd\xcf\xff\xfbH+3\xfaE\u007f?z\x93\xb0\x84IϏ+\xc2L\x02@iT5qa,\xfa\xd1\xe2\r\xcd\x19ϲ\xe5\xc6_\x16\xf1*\xca\xe8[\xe2DN$\x80\xa8\a\x13\xc8\x02\x11V }\x9e}\xbbb\xd29\xf1Nϸ\x92\xeb\xf3$\x8d=\u007fo/\xf1\x1ey\x8b\x80\xf3\xfb\xcbUq\xae\xd7p\x9a\x9c\x89\x16\xe5q\xb9\xca\x17\fv\xdcD\xe0\x9b4\x99\xc4.\nE\xe9\xa7\\\x89l{\xbf@\xf8>\xda6F!\x17\xfe\x1f\xc2%\x8a\x8c\x9d\x9cD\xa0\x94\x13\xe8\xd6Ȫ*r!\x01\xeb\xa2\xf0-@Ls\xc2´X\x83\x854\x17,\xfd\xcer\xffʆ\x87F\xc2<\u007f坜\x9cx\x1dY\xa2\xe3=\x81\xa4#\xcf\x1a\nZ\xb9+!\b\xae\xba\xf2x\f3\xa5\x98R\xc0<\xceV`\x02\xe0fmy\x9e\xd9LpԀ\xb2B\x12\x0e\xb2\xc0e\xfax<\xb1\xf1%\"\xc0K\x93^\xa1DYu\xbd\xc8\x102\x05\xad\x103\xe5\x11cl!\x9a\rF\u007fmѷ\xf6nÜ\xb9JS\xef\xcf\u007f\xf6n'`U\xbc\xf2\xd7\xce\xee\xff\x90,_R\xad\xf5\x8e\x16\xe4\xb8J\x15\xcb4\x81Uz^/\x04=B\x15\xbbJ\xc9\xcd_\xbf\xb0J\x15\xfd2O\xe6~\xd0\xcfc\x98\xb8A\xd2\xf7\xdf\x16{\xfb\xb3\xae\xd7\xf1:N\xaa\xf3p1\x8b\xdf\xe1n\xc0,\xce߀\x9c\xc7\x15\vH}A'\f\x8d\xf1\xb8\xf6\xee@\xea\xd7\xde0\x80?w w`\f\xbfKW-\xb8\x0f\x82Z\xc65>d\u07bb\xf8\n7\x9b\xe4\xa7$B\x81r\xb6)&\x1f\x80:ܴ\x8fѪ\xee\x1a\x1a\xe6\x83\xd2+\xa9\x94\x12[\xa1$S\xcfO\x11\x14tH?\x83\x84T\b\x1a\x0e\xb8\x161\x93$V\xba\x90\xa9Q\x94\x90\x12\xeb\xaa\xefo6\xb4\x06bۋ\x15\x05\x17\xa5&A͐\xa5\xc6\xc1\x02\xcf\xddTQ\xb3\xb9\xa9\xd6\\F\x8av\x1f\x15\t\xa0\xf5\x92LlWm(<\xf2F\x81l\x00\ts\n\x15\xeb%R}bJ\x99*)\u007f\"Qk\x9f\xfa\xcd\x1a\xd2\x1f0\xf7\xabd\xb2\x95\u007f\x9c\x02ڠ\x0fK\x04\x98d|\xbbL\xe2\xed\x81\x14\x05\xf5\nD\xdb\xf2x\x9e]\xc5\xdc<s\xd2\x12\x14+&U\x98iz\xb0\xf0\x14\x82G\xfa$\x8a\xab&\t&Q\a[:ڐs\xea;\xa7\xa6\xc5\xed\xf4\xf2\xdd\x12\x16gI\x81=\xba\x06[\xc1\x1a\xa6K\xaf\x87\x03\xe9\x897\x04\x16\xfc\x10\x96\xe7\xfdi\x9a\x81v\xa6\x9fi6\x83<\x91\xbe̮}\\\x816\x02\x06\xde>g\xbc|5\x1c\x04\x80w\x19XI\xb0j\vZ\b\xc5\x19\xe09\xafh\x1c\xdao\xcf\xebtZ\n\xd39\x9cO\xba՞\xea)\xb1\x0f\x06S\xf9\xbd\x10\x85N\xbf\x03b:\x85\xbc\xc4{|\xe2\rp\x92!\xa0b5\xc6\x03\xde\xc5\xccO\x90\\_+.\xc6p\aK\x96u\xe3\x00\x10y\xd0Ē\xfb\xbd\x86\xcd\xeb\x9dx\a(\x99{\xdeA`\x9a\t%\x88\xd7\x15H\f̠\xfd\x8b\fV@\x9dn'\x00\xb8isk\u007f\xca\xc1N[\xfb\x11 \xb4U\xa4\xd1W\xf4\x11\x8e\v\xff\x01t\a\x80ށʍ\xba\xa5\x18\x16\xa8o\x8f\x90\x1b\xde\x03`\x86\xd2\x01\x91>\x14\xd5|\xb0Ϻ\x95\x8d\xb6\xa3m\xd0w4hi\x19\x16\x9b\xf98\x83\xf5t\xd4<\r\xc6a\x11\xa3\x99;_\xfaӦ\xa9\xba\xacY\x03\xa5\xf7\x88\xfbs\x00\x84\x95عC\x12n\xa0\xd2/\x83\xe3-\xd5Ɏ\xb8A\x85C\xe0\xec\xd4\xc7\u007fwA?M\xc1ʾ\t\xfa\xfe]\xe0\x9f\x0f\xad\xc2_\xd01\xfe\b\xbeK\x94\xcd\x11U\x8c\xffr\xd2\xd6ڕ\xf9WږWc\x91e\x06sP\xbc;\xb5J\xf8\xc0؊\xb7\x12T$\x8b\x1a-\xc8H\xc22\xc9\nh\xf6\x1d\xfe\xf8\xe9{\x90\xb9QЈ)^/k\x98\x14-#\xd0Y\x03\xe6bOjy\xb7\xb0\xd1\xe6X3I\xc5\xfb\xbc\xe4\x9e&\x867S\x83\xaa&\x99\xf8\xa1\xa6ni\xeb\x83\x19\xd2<).a\xfc\xf6\x0fﶂ\r+\xa6\x87\x95E\x04#\n\xd72\xc0\xa5C\xc1u/\x86\xb5\x96J%\xb1\x11\xac\f\xe4\xcf\x10;`\b\xb9\xa1\xa9\x14Z\xa5}\x0f\xaa\xbdSgn\xafTh\x11+\xf1\xba\xc0\xa4\x91ч˭B\x81'Z\x951\x89L\xb8\r_؎a\xff\xfe`x\xf7\xc1\xf1Κ\xe0\x0e\xfd\xe7\xfb\x05\xcd\xee\xf4\x89Dm\xa5 \x83\x94\xba\x18\xe0\x18Df\x8d\xfa\xf7q$\xde\xef\u07fd7\xba+\xea(I\xd9@\x1f:\xf2\x91\x15H\xfc]\x91Ǆ\xecy\b&J\xa9\xbcz\xb9Q\u007fT+\xf8\xf0\x80\x8a\xd6 \xef9@\x1f\x1c\x02\xb0\xb3\xa9h5<\v\xa1\xa5\xa92e\xa3\x83>\x1b\x82E\x99-q\x13 \x9c\x85\xbc$:6\xf3\x979\xfd\x95\x93u\xd0X\xc1\x1bZ(\xf9\xfa(@\xf3S\"\xea\xca1!&Q\xecf\x986\xa9\xd0\v\x04\b\b\xbc0\xed\x9d\xc6ڞK\xe3^\xec!j\xb5J\x03\xaa\xb2}e\n\x9b\x8b\x03\xb6\xd5\xed\x01\xe7^\xffˢ\xa7\n\x1c\xec\xf63jW\xe3\"BpP|\xf6\xb3\xa9nj\x83\x99\xff\xa12\xf3?\xd4dY\x01\xc6C}\x8aշy\x14{\a\xc8á\xceD\x8d\xe1\xc7\xfa\xcḛ\xafvo\xf9\x87\x9e+K\x106#C6\x1e˃\xc5vV-U\x8c6Te>z\xd3dAg2\u007fo\xa8`\xa0\x01KS\xc1e\xea\xeafp}\x0f\"\\\x14h\x1e\xf9s\xbd\xe3s\xe4ǩ7\xef\x836\x9e\xf7\xc7\x1e\xac\x86\xf2\xa1H\x9a`R\x84I\x97k\xee=\x85\xe4\x15\xb9\xa2$\x1fb?\x1f\x80\xc1w\xf9\xc1\xca\u007f\x9e\x95\x90\x83\xb80w\xd3XZO}\x96\xcd\xc7\xc9\x020\xc2\xc2\x10\xcb\xf6.?\x80\x85\xfc\xdboޠ\xd2\xf8\xf9\xe0tp\x06\xa37\x1f\x9e\x0e\xcf@\xda\xe0/\u007f\x0f\xe0[\x93\v\x06\xc3E\xe6q\x954\xb4\x92\xa0U\xe6\xf7\a\xfd\x9b9\x8d+\xcc>\x1f\xf2@#\xfcK\\\v\xf0\xecP\x86\x8b\x91OX\xbb\\\x1fZ\x1dZV\x8fh\xeb2\xa9\x01\xaa\x1c\x83A\xf1,\x8f\xe5.\x1dUBY)׃\xdcǍ\x98\xfe\xd4;\xd3@\xc8\f\xa5\xec\xcb5\xb1\xd5ȼ\x84\x81{\x82\xa9\x06\x85\x97\x1f\x10\xb2\xa1zOx\x156\n\v\xf6#H\xc6\xd8R\xf6!s}\x8c\u007f`\xc6#\xce\xc2\xd7\xf0\xac\x15Y\xd5\xed\xa1\xd3,'\x03\xc2Q{(\x8d7\x94\x80Km\x82\xc7\xea\xf7O*\xfb\x99\b\xa9\x12>n\xdd\xee\xa8\xc9\x1e\xb6\x15\xf8%\xeb\xa0\x1a\xf6Np\xff\x84\x1a{|KU\xa3R\x87g殦\xab\x1er\xe2\x00k\x11z\x97\x97\xba\xbcV6\x99\xba\xa0\xbd\x87\x13\xaf\xa3\xa8\xc2\xdd=\xd0\x03Z\xe9\x9fe\x16\xf4\x9c\x99\xe3\xaav\xb5\xd0\x00^\xf1\xbe\x99ޛc\xe0\xfc\x18W\xb8h*\xedA\xa7<\xa1)\xdcǴ0\x90\xc2QW\xb3\xeb\x9a\x06\x86u0\x15\x01\x86\xb4\xd8\x0f\x06=\xcfh\xed\xf2e\xc8!q\x9a\x87k_.\xf2\xe6h\xcauu2[\x17\x04\xcf\xf04յ\a\x96\xcf\xc6~\xde\xf5f\xf5!!\xa6\xcd\xd7\x1a\x80\v\xf3\xeb:\x02V1\xd0\xe4\\\x1b\xcd3\xf8\x9ei\xdf\xc4\x1b\xe7\xbe\xe0l\xfc\xee<^\xd7\xf6\x8b\xaf\xd0\x1e\xbb\a,\xec\f:rKBp\xe4*\xe8\x97\xd9\x1b^y\x0f\xef)\xb5\x85<\x1aݽ[\xcbo\xaau\x19\xd2Z\x90Vܠ\xe6f0p\u038bԘ\\\xd8r\x98\xf1\x9f1\xff\x99C?\xccG]\x12wn\xe0\x1c'\x9c}\xff4\xec}8\xdb\v\xde\xfa~\xffN\xf06\xd8O\xfa\xf1:\x9e\x88\n4\r0\xd7&\xf9\xf9\b\xf7\x9e\x87\xa7\xa3\xb3>m\xd0\xd2怲\xf9\xaf\x13:0\x99\x0f\x8d\xc9\xc1\x9b\x80\x19\xebu\x80\xd6Α\x9aW\xf5\xa9Wp\x10\x00|j\xe3\xb7i\x16\x96\xfe|\x84:\xbe\xeb\x99I\x88x\x1f\xdd\xc3쌑\xcc\bꓷ\xa0\x00X\xd6N\x01J\x9b\xcel1\x84%%\xee\xacas\x16\xd0\xe4\xa0棦,\x91\xbf\xac\x87$\n\xfc*h\xf7RtD\xa0S\xb7\xa0\xed?\xee\xcc\xfeL\xfc\x1dk\x9dŅ\xf4\xbdyN\xe9O\xce\xc3\xfci\xe9\x0f\x02>\xd0\xf8\xaaSuPUN\xae\xeb\x10\xe4\xd0\xd8=A\xf3\xd0@4\xd4Z\x96\xa3vΫ\xefY\rz\x14\xe8\xb9{j\xacI5dB\x1f\x04z\xeeމTrj\x1d\xe9\xa6\xf8~\x03\xc5վ\x17\f\x85\x83\xc0Ig\x05s\xd0\xf5\xee\x06N\xea*\x18\x18\xb4\xf7k;\xafX#\t\xc0\xf7`hC\x1f\x89\xa1,+RY3#k\xacg\x8d\xb5,c6ͷ\xa89\xd2J0~,U\x87J\x01Ԍ\x9c\xf0Q\xe3\xe48a\x93ڙ\xa9_c\xf1+\xc0\xeb\x12k\x05\r\xdaK\xe2\xeb\xe2\xb1\ve\xf6\x10!h\x1e\xba\x8a\x82\xfb\xee>\xa6\xeea*\x0e\xc0Q%\x8a\xda\xf6\x1bm\x8c\xcbM#ܰ\xd3\v\x1dq\nn\x1d\xa9\n\x02}\x03>\xc7Y\x1a\xb2\x02\x0f\xd7K\xfe\f@\xc6XW\x04\x18\xe0\xeb\x11\xd0\xffĻ\x87\xf3Up\\\xc9\xc7\xcc,\x85\x93Z.K\x8d\x04\x1c\xe5\xe4\x903\x939\x87\xb2\xe2s\xb4M\xef\r\x8c\xed\v\xbd9Xt\xe0\xe8)\xe8\f\xec\b\xc1\x9f\xc6\xceJ\xc3\xfa\xc4V)\x81\xf5惟\v\x1e\xcc\xcc\xe4\x99H\x1e\x9b\xc9R\a`\x8f\x8b\xc5\x03$S5~\xffp8:\xbc{\xef\x10\xedv\\\x85\x1fܽ\u007f\xf7\xfe\xbd!|\xce\xf0s\xf8`\x80\xabr\x9a\xa4\x91\v\aX\xea\xdd_\xa1\xcf76\xa2\xd1pt\xef\xfe\xe8\xa1Dt\u007fxwxw4\x92\x88\x06\xf7G\xc3\x1a\x9e_\x01\xcf\a\x1b\xcf`\xf8\xf0\xe0\xe0@\xe1\x19\x0e\x1f\x0e\x1f*4\x0f\xef\x0e\x0e\x06\x87C\v\xcf\xdf\xccM!N\xf5\x870\xe5\xde\x01:{0n`\xdc\x0ehc\reh\x03Վ\xf8\x13s?\x04\x8d=\xc1\xbc6's5J\x02ܿ\xed\x0f\x0e\a\x87(\xb99\xce0\xa3>\x90zTm9\xf9Ԅ\x01\xc2B.\xfe\x80\x9a\xfb\x87\xedӸ\x98#&\xfa@\x9dJM\xc0s\xda\xc4l\xf0D*ǉTy\xb8{\xc8\xca\xfc\xeb\x8e\\\x83\x90ǜ?ō\xae>Rt\xe4\u07b6\xd7%\xd4iV}\xb7E\x84\xbf\xab# \xb3\tGŹ\xbeH\xaa\xb6K\xe8\x9b\x0e\xea\x1a(\"c\xcfB\xaa@\xae\xfcss\xc2:\xf7\x1e{\a\xf7\x068\xba\xf1,\x03Fj5\xf4qc\x92r\xf68\xc7(\xf6\xc8\xc3R\xa2\xb9s\xdcA\x84\xa9\x1a\xb5\x0e\xedɝC'\xd6K\xc0\x10\xa9\x8a\x8c\xec\xdc\xd1a\vB\x1fr\xe1\xe3<0\x10+h]\x83T\x8d5Z\xab\xdb\xd8ܿ\x94}\x87\xe4\xd3>jek\x8fS\xa93\xbc\xaf+\x164\xf2\x86\xba\t3\xc4IE\x01̕\a\x15\xa2\xfbR\xa1\xc2%H\xaa\x81\x88\xa3P2\x0eS\x1a18ZRl=\xb2\xa3\b\b\x1e~\x00#0\xb5\xd0\xecP\x1c\xf6)rkd\x8fo\x14\a\xe4\x04n\x97\x8ep׃\xd8\xc2\u007fP\xf8!\xcd)\x9b\xe7\x13\x92\xcdI\xb3pk\x00N\xe1\xae#p\n\xf7\x04\xbe';\t\xf7$%m\xe5\xa6J\xa8\xb2\xb4[\x9d4\xd0\xe4SmS\x93\xf8\xdd\xc1\xb2jG\x9b\x04`\xe2$_ \v\x1b\xd7L/5\x00\x17\x82\x97u\x04f\xfb\xc47.\x1b\xc3]\xd6L\xa8\xbf\xb17-\xa4(\xaf8\xc5\xf8(\x1b\xb80\x82\x96\xa2\x1a\xc7\xf9kC;\xfb\xfb\xa8\xd2y\xfe@->F\x03c dV\xccr\x88\x1bU\xf8Zl\xb9\xd0\xdc\xc5\x10\x1b\x13b\xa3A\xfc\xca\x10\x1fL\x88\x0f\x1a\xc4\xdf\\\xe2(&1\xfcy\xd0\x1f\xe1\xacp\x88\xf2\x8b\x13ΰ\u007f\xf7\xe0\xfe\xf0\xe0\xc1]1\x1f\xf5\x0f\x1f>\xb8{0\xc4I\xf7\x03/\x10d\xc9^\xff\u1f47\xa3{\xf7\xa8 4\xbc\xff\xe0\xfe\xbd\xc1p\xf0\x80\n\xe2Tr8\xbc{\xf7^\xbd\x1cN-\xf7\x0e\x0f\x0eE\x85\xfd\x11LK\xa3\xbb\x0fE1\x9cy\xee\x8fFwGT\xb0I0hp\xb8\x85C\x8c\x1cm+k\x8c[A\xf2\xc8\x1c\x04\xf1\x0e\nbW\xdb;£\x97\x10\xea\x1e\xd3\\\xdd4\x9c\xb4\x1e\xb2\xce\xd0\x1fc#\xee=xx0\x80V=\xf1\xd6\xd42\xfc\xef\x88'\xf1C\xec\xef\x87H\xc2\xfd\xfe\xfd\a\xf7\a\a\xf7]\xf8\xa5Y\xe1\xc4?\x18<xp\xf7\x9e\x9c\x1eq\xbe^wi\x97\xe3 \xa03\vF+\xba\x82\xebk\xaa\x83lpש\x1e+e\xd0ƨ\xf0r\xb6\x18ЄA\xedH\xd6\x02\x9a9Gl\x1b\xe8\x87UyW\x9c\xe0\x1c\xa2{\x02\x99\x10N\x06\x16q\xca\xfe\xec>y\x15\x14\xd5\xf9\x88p\xdd[\x8d\xd9\xff\x92\xb3\xbbF\x99\x9f\xa4ǞiN0hkm\xe2W\x96\xfb\xf2\xc7v\x17\xb5J\x96\xb8\x8c*\xda%\x15Ѷ\aT\xaf\xf7i\x9a~F\xd5z\xe9\x9b\xd4\x1e\x96e.\xbcb\f\xf7\r\x05\x8d\x00\xaf`q\xad\xd5\xcd[J\xe4\x95\xf3\x14r\x93\xf1\xaa4\xdcs>\xba1\xbcz\xb3\x05\a\x00\xd0\n\x9fn\x9b\x8a\xd5~\x9aM´\x19\xef\xb3lQ\xe0\x85T\x1bs\x11\x97&i\xb2y[1թ\xd4q5\x92\xb8\x15\xff\xb7n\x0fǵ\xf2qi\xf79Ccf-}\x01\x83\xb6.`ð\x89\v\xeb\xed\x14\x1a\x1c\xf8\x9dil\xe9\xe2\x06\xba[8n\xb6Em,\xf5\x17E\xff\xfd*L\x93\xe9F\x97J\xb9s\xc9.\xa5b\xc7艆\x10>*Q\x05\r&?\xf0\xa0X\xb8Ǌ\xb2\x96\x8bl\rE\xc5I\x81F&\xc0g\r\xb8\x12<\x01,\x13\xda\a.\xe9\xc08z\xed\xde\xec\a\xd3\xe7u<{\xb1^\xfa\x1d\xff\xc9\xd1\u007f\xfc\xf6\xf6m\xb1\x17\xe0N-\xb0'\x8f)$\x81(\xb7\xe7!\x04\xe6\xff\xf6oA\xa7\xebuf\x9d`\xa7\x9a[\xb5\x86\x80q\f\xcf\xca\x05\xbc\xc9\x1f\x13\xf1\x9e&gB\xccZ\x86\x95\xa8\xa4adI/\xd0]\xa5\xb7\x9d\f\xa7\xacQ_\nO[\xde &?۠?\x0f\x97\xbe\x8bg\xaf*qD\x12\x17\x12\x87~\xdemyd\xbbE\xcej\xbawds|\xa7.\xac\x9d\x15Ѷ\xba؞m\x145\xf71\xc9\x02\x03\xba\x98\xd2\xc0\x1a\x01\xd7\r\x98\xd9'4/\x93\xa2\f̱\b\xad\xe9\x87Q$\b\x81\x968=>\x914\x13\xd5+\x92\xbf\t\xda\xe1\x93>^\xcf\xfc\x05Ɣ\xd8\n\x86\xf4+L\x1f\x13v\x99y$\x170L\x99E,\xb6\xa9rT\xac6\xbc\x94+\fd\x97qQ\xfa\x93\xab 06\u0529.\xdd\x1f\x1b\x12\xf6\xd0\xd7\x1a\xfe5\x1a\xa1x\x02\xe6hE\x16\xd0y%ԟ\xd9:Ωo\xa9Wk\xffɕN\x89\x83\x0e\xe5\x01\x8e1G\xd0\xfb;0\x1b\xf5)\x94|\xdcɬ\xc1+\xa8\xba\x8e\xc0\xab2I\x96'妦-\b\xd6edP\x86\x10\au/\xa6\xd9\xd2 \xf0F\x93\x80p\xc1\xd4b \xaa\x13\u05cc\xf9\vL\xe1-\r\xd4\xe7\xc3\x06\xd2\xd7\rd7Lu\x8a\xcd[g4\xa3\xb9\xb8+\xa23\xb6\xbdߗ\x0e\xee\xd6:\\\x02Y}\x1e\x01\x96\x92\xdb|\x8a\xc5Ϝ}!\v7t4\x97\x94}Ҋ\xe1\xf7\xed\xd0:\xf5U\x17*\xa2\xd6;\xf4\x93Ν\xad]e\xb7\xc5;\xaa1\xa8\xbd\xc3\"X\xfe\xbe\x82\xc1\xee\xe3\x0fˎ\x90\\yGP\xef\xde\x1dy\xf8w\x97\xe1?MR\xbc\xce\xf2\xe9\v\x9a\x1f\xd0\xd5(.\x04\xf3\x15\x9eݖTY^>\xcb\xe6\xcb0\x0fqA7Q?\r\xcfF\xdb\xdd\f\x14\xa1\x02dC2,&\xf1\x02\xefڻ\xa7<}[\xa1ڹ\xae\xea\r\xf1@2\xecK\xe6\xe1\xc9\x13$\x8cU\xc2n\x8dA\x0f5\xba\xff'\xef)pP\xb2U^\x1fV\xd9\xe25Ϝ\xa6<_\xe7 \xc7t\xeb\xd7\x1eY\xcc\t\x91o8\xb7U\xb6;y\xb1\xbd\x14u\vRD\t\xf5\xa3\xffoڴ\xd20\x8aͣ`\x8d\xe6\xa70\xf7Wu\xab\f\x81\xda7fh\xba\xf0\xeft\xa9\xd3=ڪT\xe8\xe3\x02\xbdl\x84\xdd 9\u007f\xdc\xe8\xc5'\x99,\x86>[5\x88\xe5&.uY\xd31\xb80\xfb0\xd8\x14w\x06\xe3\xd6}\x1feQٗ|\xe9P[\xd0\xcb\xed\xc8(r\xf5\x8c\xa1l\xea݄\xf3\xb8\x10\x1ee\xf7\x8a\xbcwڽ\x1d{\x9f]ػ\x9dw\xefP\xe9\xecy\\\x17Z\xd8t;2\xd1nvT{\xef\xf2R\x06\xdf\x1d\xe5k\x94\xeaL\x19/\n\x99\x96\xa4\xac\x1bt\x1a\xc9\x04(2Ƀ\xf6\x01\x82\xc1\x1a\xd4f\x10r\v\xbd\x98\xd5ؐ\x17p/\x84\u007f\bz\xbe\x10\xac4\xba!\xe7\x917\x87?{{A\xfd\xde.\xc1\xaa2\xa7\x17g\x86\xc7*\xa5\xaa\xdb)د\xc7\x1e-\x1f\xe0\x8f\x8eN\xb8>p\xbfS!\xbc\xaf\xaa\x88\x15b\x96t\xbd\x8b\xc05Xn\xb0\x8bE\x91\x8f|\xf5ݸy\xa6 \xba\x8e\xf2\r\xbbh\n\xaa\xd1\xd9-\xd1\xf6\xed\xa0=\x18!1\xa9V\x17m;xUq\xbbr\ntp\x1dǋ¸:\xd7\xf5\xe2ʽV],\xecPx\x11\\Ƃ\xe2\xc6\xcbB\xe8T\xae\xf9\x87R\r/\xc2B\xa8\b!\t\t\x1e\xf7'\x91\x91\x86\x84\xa3\xd8&s\x13\x96H\xa9\xdfԣds\xe1\xd3z\xf7\x8e[T\xdd\xdb\xd3\xf5\xb1\xa8B\x19\x8a\fj,\x8a\x84e\xc19\xd5\xc56\xa6\xc2:v\xab\xc4Fy\xf4\x8a\xb6\b樦8Vo\x8eYRz\x82+.z\x92\xcb\xed\x96\n'\x93g!\xc2\v\r\x1b\x1d\xb4\\\xb1l#\x9d\xdc\xc9+'nc\x8a\xbcAG\x18\x92A\xba\x845\xae\x94MM\x12\xab\x9b\x9avu\xdbɆF\xa3(\xe5UX\x81\x98\x96hQ\xb3\x05T\xd3m\xd6Z\x9bԅ\xa6b\xb4\xc5\n\x8c\x81:~u_2\x9b\\\xf6\xc3\t\x85\x14y\fb\xafX\x81\x17\x0f|c\xad\xaa\x83V#D\xccB,|\xa0)_ \x91\x8a8\nSP[Z\x1bkne_\x13\xa7\xb95t\x9d\xce.!j\x89#\xedb\x9fA\xa0\xe6\xf5f\x045\xa8:\x8b\x98Q\xab\xc8^\x90\xdf.\x13PÒeA\xd5]\x98\xdeEuO\x8a\xec\xb8\xeeE7t8\xe0U]a\xa0m\xed\x89\xdb''\xed}Az\x10\xcf\x0f\x05:\xaf\x87\x96\x16F.\xd8\xf7\xa2\x15\a\xbd\xe9ҍ\r\x1c]~\x19\xf0\x14%\x19\xa8o1\x19\x1b^\v\x9e\xa6MƋR\xa7\xbd\xde\xe2Lg\x9eɂ\x8fV{\xf8ʟ\x8d\xab\xde\x14\xcf\x1cu\xdfG5ᒽ\aZ\xbc\xb5\xef\\\x98\x06&@\xbd\x9ft\xc2?\xba\x86O\xb6\xf4\xeb\x9du\xbbף\xfe\xa2`Jj\xb5'\xccJM\x85\xbc\xdbUH\xd4\xc4\x16G\xe2\xa68\xf5\xa7\xb4U飫\xbaV%\xab\xaeFjP\x1e\x84YA\xb9\xf8O`у\x97\x1c|\a\x9d\"h\x962\x95I\x0e1֔\xbe\xb3\x85\x8d\x854-n\b;\xc75\x98\xd3{{\x15\x8f\x8c\x15\x01\xb4\xeb\x11H\xa6\x10\xdd'\xb6\x8e:\xaaF\x1c\xe5\b^X\xc3N\x8e\xef\x8f\xf6\x88\xdcj Y*\u07b7\xcdD\xda0\x8elC\xe1\xfb\xa8ـ\xa8z\xcb\xc8z^\xeb53[\xa4\xcbI\xc1\x96]2\xed\xe5P\xa8\xd5)\x01h\x80\xabka\xf6t\xc1\xeb\x8a\xd6ɢN\xb2!u\xc7N\xb0\x06A\x94\xc0\x92\xa5\xd5xՅ\xb2kJ\x8ca\xec~\f\x9a\xd8\xd1Ɖ\xca\xfep\xb6FkHS3\xcc\x16l\x8b\xb2\x82#\x95\xf6\xab\"\"\xde\xde*\tq\xeb\xb9\xd3\xc1\x15\xbe\x84n^\xbcP\xb6\xb8\xab1\xe6C\xad\x9a\u007f\x02[\x9a\x98\xdd|\xc5\xc3yRC\xc3\xe8\x1dX&K\xf3\xea\x1f\x8f5\xec\b\xbc\xc9\xf3\x1cPA\xcf\\\xfbx\xa3~(\xe4\x94J\xbe_\xc5r\xdfLL\x11\xa5\xa6\xd3\xe5\x10>!<=(\xdb/\xcfc#\x84\x84\x04\x81\xc9\x00r\xc5$\x05\xbf\xa6)L\xe2\x1e%\xaa\x95\x8f\xd4\x00jm;d\x80E\xbc.\xedU\xa8>\xe0\x88RB\xe8\xa3;\x01Т\xb9\xe2\x12\xdccothځI\xf1m\xb2Hʘ\x01\fcc\x92\xc6a\xfe3 \xcdV|I\x88\U00017720\xefmXY\xe8I\x16\x97\xb5\x82\xc8|\xa1 j\x8e\xd3\n\x88\xfa\xf4\x8av\xddݎ\xb7.\xc8ᱝI!\xfaͪ\x83\xe6\xf0L&\xe3*\xe9(\a\x9e<9qH\x03\x05\xbc\xc7!\xf3\xfdb\x8a,\xdc4\x8a\a\x99\x01\xa2\xabu\x06\x8b\xe9R\xa1\x1do\xbe\x8fN5A\x00\xa5w\xa6\xedʐ\x14\xa0\xf3]9 Q\xa8\x84\x82\xa6\n\x9d6[^jl\xf4$\xf1\xca+\x1c\xbf\xbbRrq;CJ\xa9E\x81O\\\x81\xe6\x99\xe8\xeb+s9\x02lnϳU\x11\xff\x84Qv}\f\xea\x1e&\xb4}g\x1c\xb7\x15W\xe8\xf2\xacr\xfb\xd95\xfc\xfb\xe6\x97\u007f\x17a\xfcp\xf2V\x99\x95\x88C\xa9\xfe$\x8fa\f\x03(U`n\xf9Qd_\x14\xce\x1a\x9co,5%\x8d\xefƫ\xd9\xe1\xe1\xe0\xc1\x01\xf9<\x82\x0e\xf3\xaf\x93E\x94]\xf7\x8bI\x9e\xa5\xe9_\x91\f#\xe5Wc\xf8p#@g\t?\x18\x15\x8f\x10\xe3\x05\a\xb8\xd2\x03C\x0e\x16\xe7W\xb3N\xc0\xa7\x19~G\xc67\xc4\xc5z8.\xb2tU\xc6U.\xd8`\x901P\xdf\x18!\x91\x12\x8e\r\xfbiRι\x95\xa7\x833\xf8\u007f\\O\xbf\x81\xf6Ƌg?\xff\xe0\x9b\xe3\xd6j\xe7\x89wۇ\xd2\xfd)q\x18~\xe8\xa6-\xb2M\xac\xb7\x9d!gj\xe8tf\x10\xef\xfbk\xbaM\x8d\xd1Q\xffzlemT֯\x8d\"\xab#\x99\xa4\x18\u007f\xbb\x01\rg\xfejS)\x05\x80A\xe7a\x99'ku\xbd\xaf\x12F\x8ba\xfdd!\x82\xa1\xd8K\xdaSIQW\xd5~f\xebg\x8cCj\xc82 \xff\x06\xbdŒ\xc5\xec\x19\x91\xf9\x1a\x85ô\xd9N\xab\x06\xe2\x9d\x05\x8c\x83\x87}\r\xbf+<\x9c\x8fQ1\xbbU\x8b%4H\x8a\x03\x18cn:\xef\x8b.\xb2\xcc\x19\x9f\x8dn\xbf\xbe\xc0h\xbf(\xbe\xf4R\x8a>J\xd1(\xa5@\x9b\x98C7oq\x99P\xa5\xf0\x1f\xcdYݼ\xb7\xc9\xe5\x1fq\xa1'\xd0ha\xe5\xd2\xf7\x19\xe8\xb4S\xfa\xd9\x15\x80Nʉ\xc2\xd7\x18\xa8˧\x9fv\xcc/L\xebS /n\x06Z\xd9v\x9aϦ\xb6\xd1Z\r\xc8op\xc9C\x88w\v\f\xd6\xc4\xed\x04#\x02>\f\xe3y\xc0\xbb\xa2\xc9P\xf1DcF\xd7[\x0f*^%Ⱦ\xf5PK\xc0[\xceѺRo\x90\xf9\b\x8ah\x06+\x8e\x84\x04\xf1#\x9ed(+Z\x1f\xeb\x00끨h=\x94\x15\x18\xe7m\xb4\t\v\x84\xe3T\x87\x8dYc<\x11\xa8F\xabGQ(`D\xa0\xa5u\xa5x*\x9a%\xc8$NR\xc0\xe5:\x82e\xe0v\x9e\xaa@\x0eug\xcb\xe6\x82\x1c\x9c_u\a\xf5\x1e>\x9e\xa3\xccĮG\xe1{jgDyL\b\xac#\"F\xa7ϑF\x1f\x8a\n\xd4\x16 \xd8VtyX\x10\x83\x91j\x04\x86J\xbaލ\x13N\xebz\xc6\xcd\\\xd4\x10H\x19#\xa8_\xdae\x14\x8e\xcb\xc5\xead\x84^¡\xd3\n\x17\x0f\x8c\x92\x06K\xaa\x99oa \x10\x05%\x1e\v\x81y\x11\xda\u058b\xd4T\xb7ˀ\x19EO\x95`\xf2\xfdu\xed2\x83|ᇨ\x137\x15hh\x92R.\xf5\xdd\xceM\r+\x15\xf27\x81\xb1\xe9\xc8Ź]z\xf1\xf5\xae{\xbc\x95\xf8V\x92\x8fË\x1c\x9c\xb8Wl\x86(\xf9rPB\x8c\xfe$B\xa8\xa4\xaaI\xa0Yߴj\nX\xddP\u007f]\x8bB\xae\xde\xf3\xbe)\b\x84\xcbU\x13\v\xf7\xa74\x92J\xaa\xe5\xb4@s\x93F\x9a\xa3\xec\xe6\x04X\x17\xfey\xa8\xe8(oB\fn}\x16:\x19s\xe7V\xb7\xa6\xcd~\xc6\x12j8ϛ\x90~˗~o\x8a\x99\x8b\xb5\xa3\x17\x93\x83\xc3=\xc1=\vZռ\xc2I\xf1\x06,\x9adˍ\xbb:w3vP\xf7\xae\xc0-\x06\tm\xd3\xc9k\x8e\xd8JI]\xa1\x1d\xeb\xfb\x12\xb9\x03\nlx\xa2\xa9#\u007f\xd0\xf0\xc0/\x8dF\xfc$*;[)yE\\V\x82\x1b\xad\x9d\xc1\xf5\xf8*\x82\n\x82\x18գ \x0e\xad{\x94k\\ۨC^\x9cڏ\x9c\xe3ļ\xf2 l\x00\xdch\xe7\x8b7\xebZL=\xb4\x01vAŶ\x82\vS\x8b/\x88%\xc9l\x03V\x82\xaco\xf2\xb0\xe5w\xd2`\xd1\xf2;\u007f\xb8n\xa0d\x8c4ғ\xbf٦\x8d\x97NN[\xe1&\t\xcb>V\xad\xf3\x1b\x8f[s4\"\xe6\x90L w\bce\xd7a6^\x18\x19\xde\r\xb8.\x0e\x1c[\xf93J\x80\x03\r\xe0\xae#\xff\xbe\x96/\xaeҩf\xc8\x06\x10\xab\xab\xd4}*\x10\x184Ul8\xd1\xdbY%\x9b\x85\xf0R\x1fU\xea\xf5\xef\x1a\bF\x88\xa0B+OH)s\x97\x1e-꽩\x8d72\xbd\xaa\xd3\xd2\x1d$\"إҚB\xac\xd5̑\x00\xfcN\xb7o\a\xf9蹤b\a\xc2D\xe0\n%3t\x03l\x18\x90\x1b\xfa\xb4M-Hc\xf2\x06֞6,V\xd0=\x06\xa4\xaf-\xe4\xc4O\x8al\x81\xbe\x1d:\x18\xd5\u0081\x96\xe8\xd7\xf0,\xd85JL\xe2\xaf \xb5\xd5\xc7\xcb2\x9e?\xbdm\xa7\xc2-\x05\xff\nG\x97˝\x8d\xf9\x9e\xdcT\xa4\x9d\rf\xc6%\x86\xbfR<\xb2\xd7G\xd5\x12\x8f#\xf8\x06U\x10U\xdbF\xe3\xaa\x1a\xc0>\x9a\x01\xde.phk\x11\x98V|\xea\xeb\xec\xb8\vZc\xabλ\xd0b\x8f$\\\xacލ\xb2\x8c\xf8RE>n\xebQ\xe9?\x04Cb\x9c\x14\xbc\xc1\xc5=\x85\xd7\xeb(\xa2S\xafڙ\x95\xfd\x0f5\xf8+\xfcg\x17)\xc0\xf1#\xa7R\xf8]w\x91nXM\b\xc9\xe1\x18\xc0\xb5%Œ\xb6\xfa!\x13\xb5y\xfb\x92\xa2\x8e\x1a'\x00F/\xc0\xad\x86\xfc\x0e\xeb\v\x81\x9e1\xf8|o\x02\xaaU]\t\x94\xa3Չ\xea\x9b\xefb\xeb\xfcZ\xe8\x8bM\xf8V\xa6\xb3\xa3՞U\x15\xaf`\x90\xd1m\v\xba\xddLC\x13\xb3i(Z\r\xec:W\xfe;\xd3P3\xa9M\x11m\x9a\xfc-\"\xf0\xc0G :5\xfc@\xb9<z1\xe6\x1buX\x12\xd8\ue5c9s\xae\xa4\x90A\x17\xae\xa9\x97\xf4ꊶ\"\xaf\r\xe8+3M\xa9\xd6\xca;bFNDF\x9f\x9b\xe7\xf2\xd4\x0e\xf1\x16\x04`J\x02\xc39\x80\x9c\xf8\xc8\x13\x0f\xa4\xe7\xe2\xd8\v\xb4\xe78\xa0\xee\x87\xc7\xf0\xe7\xb1\a\xe6\xc7e\xaf\x17\xd4qa\xecd\xc3\xeb\xd3ڢ\xad*`\xf4\xe4\xf1g\xd40\xc4\x1a\x1e\x91\x85s\x89\x99۪ho\x90檀\x95\x90\x1b\xe21\x978Mp\x80\xac\xa4ס\x05yA~l\x88X\xba<r!և\xc0\x82\xabc\xef\x02Y\xa0\x15\x94\x12\xc2\xe5D\xa0\xf6\xba\u007fbu\x02\x82p;/\xd8\x16]\x11eh\x17G\xad\xa9,\xae\v\x02\xe3\xdc\x12\xea~\xa8\x14\xcd\xd4\x007\xe2\x02\xa2Eӧ\xd7\x02\xf6\xf5a\x06\xdaH(+\x8eU\xee\x94F\xd0H~L\xf7\xcc\xe2\xdep\xa4\xdb\xca\x14\xc0\x1c\xb3\xf4\x1c\x1c\x16h$\x1f7\x04\x18m\n\xf8\x8d\xa20%\x1d\x1f\xa1\xa5\x84c\x12gJ\fI-l8\xac\x8e#\xb4\xd7\xc3w\xdex\xad\xa9\xa6\"*\x84Z\v\x15\xa53$\xe8\x0e\xeb\xc7\xd69oY\xbbǮž\xaf\xc2o\x98\v\xbbV\x8c\x8b\x1aƞ\x86\xf2\xb1B\xd9\xdb\x15g5\rBϭ\x97\xd9\"\xae\x0e\xdbv\x9d\x9e\x01ǲa~^\xea\x82\r\xdf?\x91F\x14\xd5\xe0a\xcb\xf5\xd8\x01\x817\xe9\x15\xd4'L\xec\xe3\u007f\xd0\xcc>\x0e\xda\xe6cdǧO\x86;\xee/\xe9MC\xd9\xfeB\xfbL;U\xd3lT\x18ۑN\xd3®\xc0\xb5\x17\xe5\xaaS\x8a\xcd'u\xab,\xackR%%&E\x9a5\xd6&\xe3h\xdf\xe9\xb0;J{\x03\x87x\x9b\xe3\xb3ԟf\xf6J\xf5g\xd5\xfd{\xea@ɍ\xa6\vR\xf5\xd1+\xf5bO\xed\xd2\xf4\xd6\xe4SpdD\xfd\x88\xb7\xaf9\xb2<\xc2{,\xe6\xb23\xdfY\xb7\xf12\xca\xf7\xe9\xf6\ay\xb2\xaf)\xa02\u007f\xa3S\xfaZ\xae\xcfآ\x01%\xc3{r\xde\xd7\xc6\x12\xf4\xac\xe1\xcae\xbc,|uN\x1a/\x1d]&6\xea\x8d\xe5-\xeb\x1a\xc5\xc0\xc41\xa7\xf2!\xab\xda\xccIj\xfe\x85ձ&\xb6E-\xc8\xe9\xcf7\xe1\"\xfa\xb2\xc7+\x9aU.n\"\xdb/\x06\xd5_R[\xab\xc5\xfd:i{\xe8\xe86\xf7\xceyX\xf8\xeb\x84\xd6V\t\x86\x8f\xd6\xfa,\xb1:-qk\xe4S\x96\x96~)C\x90\v\x89\x17\xc9\xe1\x1fz\xfe#\xfbE\xf7\xfeeB\f'\xd7\xf2HmSW7Ď\xaa\xeb\a\xa6\xf9\xb4u\x06\"T\xe4Tc\xccC\xe8\"\xc1\x0fw\xefb\xdd\nP\x9dr\xdd\xe1`\xad\xfb\x1a\xac)\x1c\xb8ؤ\xf5\v\xf6| H\xb4e|e\xce\x0e\xbb\xf5#x4\x1c%Y6#y\xa8\x99E\x1e\xd1\x11\xaf_\r\x95lI\x11/\xe96\xb01|d\v S\f\xd4O\xee\x17f\xe6\xef\xd8;XyC\xe7t\xf1\xc05\xce\u007f\xfa\xac\xaej\x00>\b\f\xe4\x14\xea0\xaa.\x92Jw\x19\xda\f\x13\xfd\x8a\x0e\x0f\xa2\xafe\xcfK\x80\x9eg\xca\x00y\xbePV\x9b4\xd8\x12 \xa9ߣ tF\xdb\xdd\xf2`u\xb3^\xc2\xeag\n[\xca\x14\x05B\xb3\xbb7\x04\x85,\b\x8c\xf4\x9c\x8bS&\x1băz\xf3w\x94\x0e:\xa4\xfa\xdfFD\xb4M\x9bϒ\x16>\xe6\xa1;\xd2:\x1a\f\xadހ\xc5<ti\x923\xedH\x8f\xf1\xe3\xabC\x9f)i\x1aN\x97\xd0\xed$u\x95\x94\xfcΊ\xa9\xd5\x1e\xb5l\x8d:\x86\x17r\xb7o\xbbM+\xf6\x01\xe5Ԍ\xdbo\x9fe\"7\x18\x8e.\xeb\xb8\xc9<w[\xa4\xefW\xe1\xa2\x04\xa3\xc5ļ\xa3\x0f\xd3eu/\xd7:\xefx\xaf\x0e\"\xcc[P\xe59`:\xcf\xd2\xc8\xdc\vUv\x13n\u07bd\x0f4\xb0\xd3K\xde2\xa3\xa3\x80\x1a\xb1\x97 \xb1\xef?\xc3A\x88}\xd4\xe9iQ|\xa2`]\x05C\x87\xb4c\x97\xf1]\x1dHT4b\xac\xa2\x9akf\x95\xfd\xa5=\x83D\xe0\x87\xd6=-\xf1\x80j\xa4]\xec\xe9c\xcc\x06_\x8f\xb9\xf0/\xe2X$\xa5\xa0h\x1d:6\xff?q\xe05\x8c\x8fOt\xfa`l\x1f@\x06\xd1or\xd84\xda\xda\xd7\u007f\xce\xc70\x12\xc3m\x00_\xc2\xe1\x10\x97\xe8k\x19\x04\xee\xf5^}Pӻ@\xfa\xb8ŉJ\xfal\xaa\x19\xc1\x86\xa9\x9f\xc4Ն i\x8b\xb5z*\xf5\xb3\a\xc3){\x9e\x0e=\xa5B\xc8\vuo\xad\x1eu\xf1\xd8\x1d\x15R\xd6u\x1f\xe1\u007fv\x11\xbf\xa9|\xd6$\xea\x13\xe5S\r\x9a-\xd3A\xbb\x80:\x0emM\tl\xea\xfew\x9f\xa9\v\xdf\xdd\xc4\x1e\xf8\xa4\x8a\x9d=\xbbk\xb5\xbb\xf7jS?4\xce\xf7\xcd]Z\xbd\x82n\xb8\xd8+@\x95_\xef\xcd=ӕ[\x00V\x1b\xde2\xe5\xd8\xccWݡR>}$\xb5\xba\xc3J\xfc\xf6\xbce\xd2\xf5\xd1\"\xefw\xf6\x924\xf0~\x11GIU\xc3\xee\xf2cw\xbaKnt.\xd5D\xe7j\xf6.\xcc'\xdf/\x16q\xfe:\x8c\x92U\xe1x[=\xa9r[p\xfc\x88\v\x99F\x1cY\x95ۂ\xe3\r\xaeW\x9e.f8\r\xd7P\x14*\xb3\x05ËE\xd4T>\x16YM\xa5\xb1\x9b\xfce\x9e]\x98\xf1]\x14\x18\xe5둼t=\x19\xcf\xe6\xe6\xe3\xe9\x9eL\x13gΝ\x1f:\x86\x9f\x91V\x93O\xd7~\nt\x1e\x88\x17\xf8\xa0uP;\n\xa6}+\x81O8#-\xe5\xee\x98\xf4P\x92\xfb\x95H\xa2\xb2ϣ\xae7\x15\x01ΉXt\xb6\xc4c\u05cd\x99\xb6i\x8c\xd5i\xc6ى\xe2i\x82!\x0fT\xe4$\xbe\xb2\x8eU\x9e&g\xf58\x13L$s\xe0\xd4ۛ\xae\x8d\x92\b߅\xd4M-\xd5;\xab\xbb\a`\xfd\x02\x9fT\x1ezU\xaa\v\x8ek\xf5\x1bK\x90z\xe0(\a\xe2\x1a2\x15\xa7gfl6<\xa9R\xf8\x89\xeb\x0e\x1e\x16T\x8f\xba\u007f\xb4\xdfR\x91\x82\xf6W\xf5(\x8aL\xf9\x15o\xb5\x12w\xe5\xcd\xf6\x95\xe9\x99f\x82\xbf\x14\xe7\xa6\x1a\xc0\u007f\x897\xa6\x8f]\x9f\x02\x86\b\xa1\xc27x\xef\x8b'\x18\xa0,]\x80\xbb\xf9\xbc\xa8\x8c\x9b\xb5c>D\xbc\x86\x06\xa2\x8a6\x9fT\x91\xba)\xbfٹ\xa2\x8a}\x9f`hpY5\x01)T\xbbU\xddp=\xe0\xdd'\\\x0f\x80^4\"\x16q\xe0\x9fwVП\xa0\xb5\xe3\x89n1jl0\xbfQ\xa4\xbeW\x19Y\xce\xf1\x93\xdeѩSM\xea\x02\x14\xac\x9d\xf8R\xc9\xde\xcdy\"ʪ\x1d\x05\x85jk\x97X\xe9M\xca\xfe\xaf\xf5IB\xac*\x9aJ\xfc\xea(1l-\xf1Rz)\x90\x867\xcb\n\x9dÊ\xe3e\x83\x8b\xae\x81\xe7Y\x9aaxh'\xb6\xe6J\xf7\xbc\xce\xdf:m\xc8ߔ\xf1\xf2\x9bx\x9a居Z\x1e\x85\x89\xbd\x1fCIv\xe9YoN\xa2\xad\xd3e\x88ϧ\xe1uV\xfa\xecta\xc2[\xe2\xde\xea\x99\xfb\xb5\xe1%\x1d\xa9\xd2\xdc\xf8\v\x80\xfa\x1a\xba\xe4,\xa0\xf3\x98\xcew\x84c`\xb9\x06SI\xa9o\xb75\xec\xe9\x147M\xfe1\xed\xfa\xce\xd1.\xc2\xf1\x8b\xc0\xf1\x89\xedz\x16\xf26\xe1\x8f\xcbXZ\x0f\x95\xf1\xe0\x142\xb59~(\\=ݢr$)ŇRu\xb8\xef\xe2|\x8e\xf1\x1d\x04:\xf6Z\x1bv-\xf4x\x18\xd6u\x11\xfa3.\f\x00\xb0F\xecN\xcd4\xc4~׆\x1e\xec\xd6\xd0ACC}\xddtQ\xb0\x81l\xf1\x96f\x9eJ\xf46\u007fF 6`\xdf/&\xb0\f\x90\xed9\u0558~\x16ܐ76W\xf0\xb6R\xa6\x85\xc4\xfa2ܑ\x95\xfen]m#.\x05\x16=\xac\xacLӼ\x10qz2\x9bv\xfbĳ\xe1\xfe\xfc\xe7\xed0{x\xc4\xe4Xe9\xf9c\xdbv\xefWad\xab\x11G%J\x95tp\xf4\x0f,-S}\xa2\xe6\xe3\xf0\x18\xa2<\x01P\xa0\x0e|\xad4\xa9b\x95 W\xb0\xf2\x8at\xaaa\x0f\xaa\xf8\xafx\xaf\xc4G\x95\x85\x91d\xc4\xeb\xdb#~\xaff\x0f\x95\x18g\x0fE\xf6Б\xbd\xe4\xee\x97\x1f՞\x9aN\xbb\x96\x8a\x84\x8d\xecK\xe16\xa3\x1f\xebQ\xccJ\xbd\x91\x1a\xa6\n\xfd2\xd1\xf0\xef\xed\x1d\xdb\xcd|\xc6\xed\x180\xa9%?\xe7]\xb5o\xc0\x8a\xac\xe4WƵv3[,h\xc1\r\x03\xb6\x91\t\xca\x1d\x99\x9bM\xae\xcaVc\xc9i\xb8K\x84\x1bQ,\x9cͳ\xb8\xa1g\xa8־\xf9ݨ\xb7ߎ5\xa5\x88\xee\xad7\xf4\x82C\xc0\xf6\x88\x96&\xf9\xda#\x9a깩A\\ZQ\xf7ў\x13wу\x8djG\x8f\xc4#`Ģ\x19\x9f\x1d\xa33K\x05J~\x16K\x1cdCkt\x8e\xcc\xe1Ic\xd0a<\xb8-\x82\xbf\x1b\xe3F\xed\xa1s%\xea\x15@\xbd\x8e\x8a\xdfJ\xa4\xc4\x1a\x1a\x9f\xca\xf2\x97#\x16\x01\x92\xfb\xa0\xab\x12\x87\"\x11E\xe0\xccu\x05Gbk\xe3\xe87a\x91\x14\x96\xc1T[\"\xf31\xfbn\x8aR\x0e\x92\xa1\xdb\xdaJLV\xd3\xfe\xfe2\xa1\xdf\x1b\xf1\x1bY\xbe\\\x93\x11Ɓ(\xf8?_/;$\xdb\xcaC\xc8\rAn\x06]\xf5\x1f\xe1\xf0tc\x0e˓)\au\x9cU\xf1\xdet\x1e|\x13\u007fH\xd0t\f\x89L\xf4]\x90\xdb$\x8d\x1d\x9c\xb8\xbap\xb9\xee\x17\xe7\xc9T\xdbM\x80\x14\xb6+\x92ʶ\x85\xd4M\rn\xa3\xe0\xb4;\x1c\xbb\xd3)Ʒ\xf6&\x8cA\xb96\xe5\xfd\xa3i\xbc\xa9\x05LH5\xf3\xb7MP\x0fo\"\xa8R@\xacM\xb5\x9a\xd0*\x81\x1ch\"7p\xae\t\x0ev\x10\x107\xabk\x8cefU+\f\xadAϳ\xf2\xd0w\xb3\xfd\x00\x89\xad\x14\xef\xee\x856r'\xb2\xd7K\xfe\x15%\xff\x93\xa4ʹȖ\xa2\xd1*\x15\x180\v\x83\x9e\x1d\xea\x02\xa2\xa4\xc3%\x1a\x87\r\x1c\xf4\xbe\xf6\x16\x9f%\x1e$\x8e7\x91\x0e\xa1\ro\"\x1bR\xd8\xdd\xc21߱i\xffZ\x02\xb2ZDi\xdcfc\xd4\xc4¼\x04\xbc\xb0\xdeC1V\x01ڜ\xa7\x928~S\x95\xb48\xe3\xa9\x1f\xe7\xafhc\xa4\xd3쏳\x9d\x14\xd1%P\xe8\xda\xd7?17\xf6\x97\xae\xa1\xcb\xe6h\x02&\xd1B3D9\x06\x80܊\xbb#\xedKӊB\x8f\x04\xbc~^R\x14\x86@/>\xb4\x8b\x0f\x9d\xc57\xaa\xf8\xa6-j\u007f\x93\xd1\xd2փ$ՎWKC\xb6`\xc7ܠ\x90-\xd61\xd3\x17b\x14\x02\xfc\x1a\xf1\xd7\x01\u007f\x1d\x9cm\xd5%\x86\xfc\x81\xf8\xa9\xe8B\xda&ѳ\x9d\x87\x1d\x86\u07ba\xd9@\x1dv\xe9\x01\xf3\x1b\x94\x18ݸ\x8eэ\xeb8\xb8q\x1d\aXG\xfbV_\x9a-a`\x92\xedn?¾\x1c\x1a\x96\xf1>\xa5h\x06t\x1b^\xbe\xaa\xfb<\x99N\xe3<^L\xe2\xa2y+\xf1\xc25\xf4yV\xa0Y\xc0^\xf1\x0f\xcd5\x05n!\xccy\x805\xb5ˡf\xb5\xd0\xc3s\xbc\xab\nK\x9a\b\x87T\xe4FC\v\x10\xb3\xee\x04\x1f\xfd=\xc3\v$\xfb\xe6\xf2]\xe0\x8b\x8c=\xcay\x1b\xb3~\xc8\x16Y\x99-bk1ֲ\x06\x8b\xf8\taz\xac}n\x92\xdc\xc8\xf9J\xc1]4+\xdb&&\xb9\xf9\"\xb5_\xd7b\x8a~\x12\xc3\x0f\x1a\x8f\xe9t\xfb\x11^>\xbd\xa7+Q\xc1\xac\xb9(\xa9\xbb)\u05ee7\x87\f\x881P\xb4\xd0\xecc\xbd\xb8\x91C\xaf\xd8\xe8O$\x9b\xb7\xb9\xf1\x91\xf5\x87\xd6a(\xb6\x12 \x0f\xe4ELzf\xb90\xceF\x05\xc5\x05\"\xb6\xd2e\x13\n\xb3\xb2\x8f\xae̓\x86\xc5ŉ\xcet$G2Yy\xb2]`?BE\x81\x98ь|\xf6zKhG\xf9\x8c6:`\xd4ޓ\x0f\xc1\x13\xe9w\xe8OuVn\xaf\x94\v\xdc\x1c\xc4wi\x18\x98??}Y,e\xbb\xf5,\xe7\v\xee\xaf6\x8d\xadV\xed\x85\x1e\x17j\x8fذe1\xa5\u0558ͻR,\x1am~=\x10\xaan;\xe42Qs\xd2\vU\x9a~\xb4\x80~#\xd3i\x11\x97\xc7:NV\x82\xb9|\xe2\x1a\x1f\xb2\x0f\x03\x13bh@\xe0C\xf6\xa1Ӱ#Қ\xfdE\xe2\xb0\xcd\xe3\x83\xf2\xbf\xa0\xc7\xc7P\xdb\xec\xefz/\r\xe8\xd7\xc2\xf7\xbeVhP\xb9\xe5\x1b\xc5;\u007f\xeb\xec\xee620\xbe\x86;y\x91\f,7\x92\x01\xf9\x91X\xa9\x1bJ%\x8fL,p\x82\x01C\xf12}ݕɫ\xb9\x14|\xe4\xa8#\xaa\x82!U\x80\xa86\x8cj\xb3\rզ\x01\xd5\x06Q\xa1\xf9\xf5%\xbd\\\x06R\xf1\x90\x8b9\xf0\xcb\xe1벡\xac͠\xdd\xe1Eb\x1cj\x8e3C\xb7\xe7\xcc\xf0\x86\xae3\x83\x9b\xfa\xce\f,\xe7\x19ϐ\x99]\x9cj\x06_ҫfPs\xabY\x0f\xeb\x9e6\"\x82\xeef\xf8\a\xb9\xdc\xd4Ǳ\t\x05\x83\x1d\x8f\x81^v\\\xde9\xa8u>\xd5;ghxI\x13+lo\tDoxKp}\x83O\xabp`T\xb8c]\xc3\xcfm\xdc\xee\xed\xfaD\xe7#U\x15\x89\xce\xe6\x06\xf5}\x1a\x1f7\x03\xa3\xc2\x1d\xeb\x1a~n\xe3vo\xd7\x1f\xe2[\xe5\xae\xfa\u007fcߪmzD\x9a\x02\x1c\x1b\xa0\x16a\xf6\xa5\x05͞\x01\xa0^\xc14\xc1\xa86/;;u\xc0\x1f\xe2\xc4U\xab\xdaJw\xd9o\x93\xf3,\x8f\xded\xab|\xe2\xf4\x17\xa6\x8cֲ?\x03\xddq\xe9([RFk\xd9FW\xe7\xbc\xd5˙i\xfe<?g\xc2\xf1\x19\x9eΰ&\x98e\x8b0\xfd\xa9\xb2-m$\xa7\x80\x06cL\xc8\xc7\b\xda\xd0\xf0\"CCV7\xa9\x9b\x1f'\xa60'\xb86Pe\x1a\x9e\x86\xeeҲ\"\xa2\xad\x93\x90~\xb5.$T;\xaceD\xd7^5\xa8\xc7\x16\x9a\x9aYl\xe6\xe3,}\x83wo\xac\xe6\xdc;l/\xf33\xe8\x17\xbbLg\x92\xe4\x934\ued17|F@~\x01\x95\xea\xab6\x15F\x9e\x97\xf3\x90+\xd7\xf7?}o\xba\x92u~\x18\xd0YO\x8e\xa7>Oկ*\r\x96\xc2\xc3\xee\xd0c\xa8\xden`y\xbb+a\xb8N\x8a\xbf\xeaϟ\xaa\xdb\x1f*\xad\x1f\x96e\xeewJ\xf9\\F\xa7\xeb\f\xd1%\x1bAp\xb4v\xc2\xea\xd7\x1c\xa3\xab\xd3\x1d\x04\x1d\xfdy\xab&Z~\xd5i\xd9\xfc~\xb4036\x82\x9a\xddhy\xb3\x1aG\xc9U\x12\xc52\x98\a\xddN\xd1B\xc3\x16\xab\xb1\x1d:\x105\xeb\x1c\xbd\x97\xf4\bs\xa6\x03M[lB3f\x10^\xf7\x16U\x98\v=\x1d9\xafs|\x8ea\xc7\xee$\xf4\x93\xf7_\xf6\xf6\xe6]\xef\xa2\xeb]m_E\xa9\xe0x\xf3c\xaf\u05fb\xe0X\x80\xf5\x87\xff|\fT\xb8\xa7\xe2\xec\xf5\xbc\v\xdc\xf3\x0f\xf0y\xab*\x9c\xa1\xfd\xe6\xa1h\x85x\xb72h\u007f|\xf0\xa3\xee\xad\xd3\xeb%\"\x88\xeb\xb1G1Q=b\xafEŞ\xa4\xe2Q\x15\xc4\xd8$\xbe\x89\x04\xc7\x11\x85\x04u\xc9\xc68>\x0f\xaf\x92,\u007f\xf7!\xcb\xe6\xcf\xe3\xb4\f}\xe3\x15\xf5\x1aHreDM\xb73\x8dw\x82:\xf8>P\xa7z \b\xa4\xafz\x02\xe8*)\x12\f\xfb[n0p\xf8y\x12E\xf1\xa2\xf9\x81\xa0\xf38\x99\x9d\x97F\xd2u\x12\x95\xe7FJ\x06\x86\xc84ͮ{\x84\x92_2j\xaa^!\xec\x8c\x06\x83\xc1r\r9\xf8\x84\x9d\x1f\xf4\x970\xdd/J|3\xdf^\xe9\xc5\xda\xd3\xe4\xf4\xfeW)\xcc\x05\xfd\x99q\aSģJ?S\xf8\x86a|p\xdc\x02*\xdf:\xa6\u05ff\xfd*\xde<\xd5\xc6\xc5\xf1iжJ\xe4kc\x13\xc4\xe3q\xb4\x05\xad\xd3\x04\xa2\xb8\u007f}\x1e\xc7)\xf59\xdan=\xbc\xbcP\x86I\n\x82w\xd7\xf5։l\xae-Bi\xb8\xc9V\xe5\xbb1\x1dK\xfe\x04\x13\x01\xc6\u05fat=\xaf\x83\xe9\xc2$\xeaz1EH\xa0$\xb6t\xba^:\tY\x85\x18(_\xc6a\x81\xef\xfdϳ\xc5S܂\xc7\xcd\x14\x117\np\x04\xfa-$\xfd}\x1d\xa5\x1a8\r\x1f`\x05\xfc\xda~\xb0 \x8a_\x90\xe5N7\xf6\xf7\xc4\xd0\xe2 \x1a\xb5\xfbŭ^WضZ\x85\xd2myI~\xcb\xfc\xecl\xbc\xa8n\xc93C\xf0IT\x9d\x98\x1d\xb7\x12\r\x8eI6\x15\xf4N\xa3\xde\x13\xa1̑{oT\x93|\xc9Q\xafW\xb4D\x00ܖ\xafU\xcb\xc6(D\xcc$\xaaG6D<\x0ei\xb1T\xd6\xc4?\x1cmlF)\xcdp\t\xb0\x95\x01.\x91\xd1\xcf[)h\v\xad\xb8\xc6j\x95\x10V\xfe7!\x0e\xfe\xc2!\x8a\x15cш\x1bo\a\x1b\xa3c\xdc+\xe6\ac\xed/\xf1\x81*Q\x18R\xc7Fjq\x0el\x89DV\xb5\xd9$:C`:\x11\xa54Y\u058b\x85\x95\xea\xf2\x9c\x95\xcb<\x17\t\x0e\xa1\xab\x90\xb7\xf0\x1d\xe6\xb6I\xfc<\x0fg\xfc\xf2\xab\xb2]\xa2\xfe4Y\xc3z\xef7ᖻ\xad8*\xeaZ\xe1?\vg\xe3\xb6\xc2?\xe0\x83m8\x018\xeb>ܭ\xf8\xaatV~\xb0\xad\xf4\xd3\xc9d5_\x91a\x86N\xac\xd0\xe7\xe9\xf2\x1c\x04nr\x8e\x9a\xcd8\xf4\x98\xacy\x1fp\xb2\xa9\x8e\xe7\xb0L\x9fa\xabD\x9a~)'\x8déin-\x84\xe0Q6}\xb0\x1dE?\x95\x1d%-\xac\xc9v[i\"\v\x1b\xde\x17H¤z\xa9\x1e\x1faK\x16\xab\xd8xw\xaf\x81\r\x93\x1a\x0f\xaaRzs\xf7N\xbc\x89\xf8]\x01\x00\x8f\xb4t\x98\x8f\xe0\xe7Z\xcb\xdeԳ7mN\xc5\xfd\xa5\xf9\x84b\x13k\x05i\xf2q\xbe=\x19`(\\\x80\x05K\x0f\x91ʇ!,\xd8M;\xecG+\x8c\rq\x06\xe9f֜j\x98(R\x9f\xea\x02\x8bS\x15\xdc3),\x97\xeaA!\xa2\xf7\x12\xb0\xeamP\xb9\x1bG\xeeF\x1f\xec\\\x15\n\xe7\x04\xdf/\xd1j\xd6e\x14\x85\x16\xfe\xa9\xe5\xb7\r\x8e\x970\xc5?O@/,8b\xfa\xa5\xb5\x1a\x1d\rv\xc1\xf0\xa6\xccI\xac]\x18ڔ\x03\xd4;\xb9tܲc\u07b4\x15r\\\xb4\xebo\xb6\x15\xfa\x11U\x88\xf0\xf9\xad4\t\xef\xe0\x8a\x81\x1dц\xf0vLy\x14\xe7\xf2\xf18\xfd\x00\xb0\x16\x1e\xb3:%\v\xb6\"\xa5\xfd\x89\xbf\xc5yf\xa0\x94/ \xf4\x84\xab\b\x9d2\rΔ&\xd9\f\\\u0383\x17\xeca\xb7\x19\x9c^h\xbe\x06\xc6nr\x1b-?\x84\xeb\xefQ\xda\xfd0\xcfÍ\xed\xcb2TO]\xe0\x9a\x82@\xa4+\xda%+;J3\xac/-`\xfb\x02WW\x899\xe4\xfdK\x85)9#/\x9c\xc7ޕ>\xfa\xb1\xc2D{\x81T\x1f_\x8eu\xd5Ŷ\x16\xbe\x8e\xa3\xd5$~\xb3\x9a\xbbv\xe8(ϷJ\x00\xac|\x05\xb5\r1\xa2\xc4\x17\x88m\a\x00܋j\xb8\f*\x8a\x9f\xc3@\xccfy8\xff&Y\xbc\x81B\xa0~\xe4\x03yWa\xba\x8a\x1d7:\x1d%\xbfřQ\x96\xab\x9e\x04P\x91\xb8\x19\x95ڇU\x81\xb8G\xe4k\x11\xecH\x9fQ\x8b\xe1\xe0\xb8\x16\xb2\x8a\xee*{\xd5#.(\xba\xbe|\xc9\x054\xf0\x18\xab^p\x80\xf7\x9a\xf4\xae\x85\x0f\xe2\xf4tMN3\xa0\x1b\xd7\xe8\xdbb>\x00\xb3\v\xa9\xfc\n\x8e\x93}\xe8\xffJ\x8e&\"\x97\xde\x1bD\xc7\x12\t\xdd\xdeWq\x1e\xe6\x93\xf3\x8d\b:\x9c\x8dqw\xb2\xeb\xa9t\xa5`\u052b]5\x10\\\ag9\xads'\xd0\xf2\b\x94(\xfe\xa6\xea\xe5Y=\x17\xea\xa3b5\xadY\x85\x05\x15pa\x00K\vĵ[\xe5@\xf0\xfd\x02O\x17p\xa7'_\xd9\xf1\xf1}\v%\u007f\x06~\xe4\x8c$¹\xbbp\xed\x99h\xafc\xf0IV\xec\x82\xe6\x17d\x95\x03\a\xb1p\x17\x04o0\xf4\x99\xc3\xdbs\xcc(@N\xc3ݑQO\xd0ڨ>R\xfb\xf3\x18\xc63g\x9aQ\x92y\xb1U\xdbO\x14\xe9\x8a\x1d\xb8\rpzfEX\xa6L\x87\x9f\x83qvOk\xfa#\xb1\xb6\xeb\xea/^\xd0\xd2\xfe\xc8#,\xd5\xceX-$s\xabRX\xa2\xcak\xe0b\xa8\xb88\xde\xcaE\xc4\xf3\xfd\xa2\x88-Ld\x96\xe3\xd4\xd0'\x88w\xd5k\xe0z\n.\x948u,R\x97yLs\x93\x99*`\x85\xd1=1a\xc7\xdbZɛ\x03:u\xbb\xd3\xd0\xda\xea\x12\xcf\xe8&eQk99v\x8f\xfbk\x92õp\xe8\x1e\x83\x8d\x82ߠ?\xa2\x9cX\x83\xfb\xee\xe3~n\xc6F\xc7c\f\xf8\xa7\x87H\xd0\xcf\x1a\u007fm\xc8e\x1a\xa6\xd6\xfe`0\xdc\xd6\xdcd\fzaf\xeeS(\xd81\x05\xf6\\\x18+\xdd\xf5\x0f\x89\xf1\xd6;\xed[`\xbd\xf4#\xef\x12\x80Zk\xac\xc1\xc6\xd0\x1f\x02\x11\xd0{\x1atX9\x87o\\\xb87\x1a\ue34e{\xe3½\xd1po*\xdc\xd5r\xe4\xb6/\xd5\x1c\x81ɑG簐g\xad\xe1T\x88\xc8joB\xac\xf6\x04\x1f\xe4\x83\xfd]\xd9\xd4^\x95\xb2\xb1A65\x10v{\x85\xb5ZB\x9b\xeahXqML\x04\xd8S/\xc2ɹov\x1a\xaa ѮP-\x1b\xa5\x93\x1d{\x8d\xf4B))\xec\xfd LC\xeeP\xe9$GW\x11̣\x84\xb1\xc2W\xdd\x12\x1d\xf7Y@\xf3*a\xa3{\xb62ұ\xe1&\x8bhG\xee\xf5\xed\xe8̵|\xc5v\xfd\x94\x86b\xe8\x01K\xb4\xe5*W0\t\x9a\xcaU\x1aE\x87\t\xcd\x01:\xd9Rz\x82\xa3\xd2\xf4í\xab#\xe3q\xa0\x03e\xe8ZW{[ۥ\xad\xf3\x8dS\v2\xbb\v~\xe1\x1dlnr\xad\x02\x03\xab\x18U\x17\xbd\xadS\x15]\xdf\x1d\x83\xe9\x8c\xfb\x9d\xe3c\xb2\xa1/\xb4,\xc4eӧ^\xd9wk\xa8\v\xe4\xa4]\xc2S\xe4\x19\xe4\x10\xb3\xf28\xbc4\x13?\xder\xff\xe6\x18\xac\x84\xe7D\x17<\xadm\x97\x1a\xeb\xb1\xf3\xf0\x91'lۅ\x95\x06k\x03-\x05YUo斆^\xba\x1b\xeal\x91َ\x1dZ\xe8by\x81\x0f\xafC\x9f\x82\xb2\xc1\x1e>\xc1\xdf\u007f\xfe3\x0e.H\x871\x1b4OGح\x81\xf09q\x03!GL)\xa6j{=\xe3t\xac\xfe\xcc\xd6n\xe3I\x8e\x8a\x89\x95T\x1b\x9c\xcd'p\x1f\xcdM8\x9ft\xe8\x1e\xcf\x01|\xed\x9a\xf67\xfc\r\xa7o\xb4\xf4\xbc\xd28\xfa\xeb\\\xae\x01\xe8\xdaJ\x9b\xe0\x14\x85;+U\u0086\x12Զ\xd5$\xd7\xe7\x92\tL\x1f\x13\x9ar\xab\x93v\xc4q\x870\xedQq\xfc\xbd1\xdf\x1c\xe2\x99\a\x99\x94oW\xe3\xffm\x91JE\xde2C\xa3\xb27\xa6`\xaaðH\xb4\x14\xa1\xec\x16\xed{\xc5U\xed\x06\xe6(N\xe32\xb6+8nȣa\xd8^\xc7\xcf\xf2T\x9d\xaaa\xe7ۮg\x9cL)\xcbך\x8f+\xf6\xd1\xec\xa3\xf6\xd68E˥\xad\x1c#ws\xac\xf7\xc5\x1d\xb5\x85@\x9b\xa9j\xba\xff{\xc3C\x1f\x12\xc2\nem\xef\xdc65T\x16'\x17a\xd9\xdeJDZ\x98e\xce~\xba}8V\xf6\xdf\x04͚v{Q{jtL\xe7\xda\x00\x8e.f\x9b\xc0r\xb7\tyRWXq\xd8H+rOZ\x91\xea\xf03DFF\xea\x8a\b\xe0\xc6\xef\xb1\x1eL\x1f\xc9\xeaߥkGc\xb4CC\xba\xae\x81\xe1\xed\xa3\x89\xf0z\xae\x06\x93~\xc1\x83@(z\x01\x14\xdcCh\x8au?\xc6\x01\x8a\x1fw<\x81\x90\xe0\x02\x1d\xad>\xbeO\xc8\xf6\x01u\"\xdb`6aB\xa2\x12\x92\x85\xb8f\x13\xb9\xc7 2\xf0\xa9\xa9\x19u\x94UC\x15\x96\xad}:IW\x05L4\xbf\xd6dN\ue652\xdf\x12k\x1b\x01\xa1\xf9\x9eX\x8b>\xf9\x82=\xa6\xaa\xba\xdbT\x87\xa8\xfd\xafM\xb5+1\x17\xdb`\xfa\xeb\f\xee\xbaI\xedQ\xfd\x92_\xb8Q\x06}\xe1\x181\xedD\xbd\x8c\xa7e\xed`\xb4]\x11XT\xa3`[\xd5\xf2U\x1bGEjD\xa2\x17\xc9\xd16\xd5(J\xbeF߄\x9diTV\xbb\x83LߡT\x02\x17\xb1\\\xa5\xa2vA\x01\xacw\xa0\xb8\xcc\xe3\xf8M\f\v\xff\x90\xfaϹB\x97g\xbf8\xe2\xc5\xef' \x80G\xadG\x82\x88\xf8Ku\x95\xd6)\xa2\x85}\xaa\xaf\x8f\xb1\x90\xc3h\vU_\xbew\xacn\xf8\x04\x1a\xdfĸ]$&\xbeI6G\xb6\xef\xceD}\xaej\xa4\xd3T\xe8\x94\xdb\xc5\xddV\xfd6\u07b6[.\x822\xae\xcc\xd8{\xd4\x1aaLj\xb2-]\x8f;\xe01j\x01ݜ\x14\xde\aT\xa8\xfdڠ\xe8\x90\x1d\x04\x9cz|\x9e\x15\r;Pk\xda}Z\xef \xccM8\xd4|\xba\x05\xc7\xf38^\xc6MdD\xf1\x92n\x9f\x8e\xf9\xd7\x16T\xbf$ERr`B!%a\x9a\x8e\xc1\x12\xa8\xedà\xa7V)\x80\xd0\xecJ\xb2U!vn\x1c2\xd0(T7\x16+C\xb0d\xc5τ\xa4\xe0\x91\xb4\xe6DX\xf5t\xb3\xc0y\x9e\x143M\xa2\x8cE05\xd4U\xa1y)\xc9\"\xa5U\xd4<\xc5\xd7\x06\x0e\x1a\x9eE\x1a\xa7\xe9\xcc}\xdb(\xa7x\x14\xb6&\xa2(\x15\xc2\xcb\xe0\\DC\x1ft[\x14TR\uf1ae\xde*\xc1\xd2^/A\xafHm\xbc9\xf8\xc9J\xeaX\a\xe8C\x93\xd3d\x8eV2\x91ffγ\xa8\x96\xc3-\xd8\x13\x88\xfb\xe2\x13\x85\x87\x9a\xa32\xf8{\xbb\x81\x8b$\xfd\x90]\x81}+<sX{t\xb9\"\xb5\xd3*2\xd1\xc2\x12?\xf5\xd6\b\xc5Ri\xe2cM\x9b\xca'\x8f\x88R0\x10\tj\xb1\x9a\x8fc\xdc\x1eU\xe88E\xee\x9e\xc9T\xbdYU\xcc\u007f\xd1I\x94\xd5sd)&i\xacc7.\x17\xbb)\xc7\xe6u\v\xbb\x94\xdf\xd4U2\x97ܒ\x04[\x9a\a \xc4ԤZT\xcd\xf8\x9ac\x19LluP\x98\xdb\xe4\xcf-\x14\xcd\xc3\xe5Oa\xf4\n\x86\x85!\xf1\xd6q\xc4Z̕ky\n\xb1\x11\t\x1b\x99\x10I\x90H\xc1D\x12(\xda4\xfb\xe1\xd7H\x91\x03\xda|?L\xaeE\xd4^\xb3\xc8?=8\xe3U\x88\xda(\x96\x19\x03\x19^EPU\xbd`%BFh\b\"\x85\x81\x16\x10\x15\n\xedc\xa4\xf9nG\xf4\x9e\xa8\xb6\u007f\x8e\xfd\x1f\xad\xab0\v\x1eW=\xb07\xaa\x01\xbdQ\x90\x16\xb9\xd1\xc6(\xb8\xb1\v\xd6\xfbB\xef\x06\xa3\aL\xe6\xb7\xf1=*\xae\xfc\b%:\x81\xb9\xaa\xeb͓y\x8c\x97\x1aj\xd3\x14\u00ad\xf2\xb4>\x91\xd1\x01i\x19\xafKΖ\b\xb4\xe5\x0ef\x1a\xdb\xc5Rec\x06\xceT\x80\x1b\x85\xb8\x88\x194p?)\xaaH\xe1w\xbb_g\xd7~\x9e]\xd7\x1f.ɮ\xf9\x88\x8d\xa0\xe8|1\xe0K\xa6\xaa\x99mh\xf9@\xd2$Y\xbd\xba¯;@k\xc1H`\x90'\xde_:\u007f\xc1P6\xf0\x05˭%\xad\xf3\xf7\xdfv\xf6g]\xc8\xe9\xfc\x05/\x10 \xc4\x11A\xd8~\xaey\xfcS\xc8W\xbe\xf0\x91\xd2\xd7\xf1\xec\xc5z\xe9w\xde\xe6o\x17\xbf\x9dR\x1c/I1^C\xc0\xe43<^\x9eu\xf0\x8eK\xac^\xa8\xd0\xca\xfe唨1\xcbA\xa9\xa0[\xa5=cM\xab\xbe\xc9\xd1\b\x13\x9f\x96\xbe|\x1aH\xf5\x88~\bm2\x05\xe9?\aS9έ\x13gU\x16z\xa8\xa0B\x9a,@\xef\xd0\x15f\xd3PMLC\x02Qg\xf8\x9e\xdcǮ\xe18\xc3\xd5Y\x9b7.\xa7\x99\xec\x94AO/\xce(\x8a@v\r\xbf\xf4\x02\xf2\xb8\xbbv\x8fZ'\x83qpyG\xe1ʁ\u0530K\xccsu\x83\x1963\x81/\x8e\xb8\x03ev\x19\x9bw\xdf\xf9\xe1>\x12\x95~\x1a\x16%9\xf6\xa0\xb1@Rg\xdd\xc7{\xf1㷦\x8fa\x9c\xa5&{!\x01)\t\xa1\xbd\x8ef\xbd\xf8\xf1e\xbdU\x95\x13S\x8d\x0e\xb32\xa2H\x13\xa8\x8b\x80<j\x0f\x0e\xeb=\x8cvх\xa3\x17\x93\xbd=\f\xb4\xaa7\xad\xbe\xd7nד\xb8\xebi\x04'W\x19:y\xc0\"\xce\x13\x01-\x18m\xfb\xa1@\xbdkN(\xee\xc9\xc8>\x02\x9ax\xa2\xcbj\x94\x1c[g\f\x13j\xcd\xf0\xc0n\rw\x9d\xee\xe6\xd1\xde\xc6\x11\xb3e8\b\xeaT\x9a\xcd\xd3\xe2\bLT\x99]*\xffX\x17!\xa2\xa4X\x8d\x8b2\x87\x19ӿ\xc0&⨯\x14dG(ȿ\x04M\xb26\xd7d-^\xc7\x13\xd6>\xa6\xb0\xcd]r\x8d\x01\x96L\x95F\xddl\xa8\xbf\xe3\xed4\x83\xc6awQ'\x85\xae.\xd7$\xf6\xf8\xd6\x16\xec\xce8\x1e0\xf2\x84҃1,~\xe5\xac6\xf06\xc1\x82\x17\x1ax1#K+\x9d['d`\xed\x0e\xf8\x14v\x98\xb5\n3\x03\xf0\xdb\v\xc1Њ\xf6 ʖ\x02\xfe%\xddRs\x15\xc6\xc3X\xbaRP\x1aR\xac\xd5\xe9b v\xdf\x14qަ\xa3\xad)\xae\xb9\x17{{\x18\xa3\xaa\xee\b\x8dL\xe0:\xc2\x1a\xe3\xaaY\xbf\xa8)\xdei\xed\r'\x04sY\f\x85f2\x80\xa6\x16\x06\x03L\x9d\x1d\xf7\xb3\xf5\x85\xf3td\x16g\xef\xf0\xc69];/(\xf4\x04\xfa\x97\xb2\r\xd2pOV\xf8c\xd94q21\x1dq\xe1\x9b\xe3?^/~ʳe\x9c\x97\x1b\xe9\xd4U\x92\xa5\xf6\x84aN\xb5\xc43\x85\xf7Ƞ\xa2\xe5\",ƫ{\xc7WW}\xbe\xe0l\xdfk\x9d\x8b;\xa2\x94\x89\x96Eh~Z\xb9\xe6\xfdS\xdc\xd3\xff\xa4\x92{f\xc9\x0f\x9d&\xce\xd3ae\xe1gڬ*·\xabܟ\x9d\xccd>\x06^\x1d\xf44\x93\xecD\xac\xed5\u007f\x1b\x87\xc0\xa7\xd8 \xc0\"\xad\x0f\x1f\xf3\xb8\xcc7;c{\x96\xa5\xe22\xab\x81W\x85&\xc7\xc1\x93\xf5\xa7\f,\xee}\x8a7/\xc2*F\xb9\xe3 դ\f\xa3\xdeԈs/\xfa\xabR\xff.\xe0w\xa3Q`O>\x93\xca]\x89{I\x8f\x83\x90\xb6m!j\x92\xf1s\xbc卨\x9a\x8a\v\xec\xbcK\x16V\xde\x1e\xed$\xfd\x00\xa30\xf9\xa2tI$t\xa8H\xcc\x12^\xdes:\x0f\x94e\xc9J\x86?\xa6K\x85٪1X̎k\xaf[Z\xf7S\x96nf\xedr\xf0;5\x8d\xd6\xf5\u007f\\\xeb\xe8q}\xb3Y\x06B\xa3][\x86\xf6͘D\r\xfdr\xa29\x03ӷ|\x9aO>!Ȇ^\xfc\x13\xe2l\xe8\xc5\xf5\xe0)~\xfdaK-Ě\xb5\x02\xfdF\x1d<\xc3|]ҹ5\x9e)_v\xbd\xa7zN\x84\x17\xddE\x06\x9aHO\xf1'\x85\xb0\xfd\x86~\ry\xf3\x88\x927*y\x03\xc9\x1fDr\xa1\x92\x8bͰ\x16\x82\x82#G\x96\xe1b\xe4o(*¾l!\xce\\\xe1\xa2\xe8\xea \x1f\xba\xdaa\xf9Z\xb8\xc6\xe3i\xf5&\xa8\x97\xf4\xce\xea!\xb1\xf8\n\xcc\x04\xff)\xf0\x9fKL\xba܈wc\x91\xec\t\xfeS\xe0?\xd48jJ\xd4U\xde\x12ZȘH\\\x1er?GI3huI\r\x1d\xb3\x86*\xe2$1Vp9\xc4\xe0\x1b\xea\xe0\x1fW\xec\xcaӓ(\x190\xe7\xf0\x9c\x99~\x03\x81z\xd8\x0e\xf921\xfe\xcf\xdeO0\x9f\xd0\xd4Hg\xd1l\x88ZÞH\x03I\x1fUB\x91\x978\f\xb0\xc9d\xbc\x1cZ\xc1bôW\x92'\x1b\x03\v\xc7o\xe2\xf0\xc1\x16\x16\xb5þ1pm*\\\x97T\x8b`A\x15[\xebrS%\x17Urd\xdcS\xf5\x1c\xf1\x8e\x1ay\xc3㮕7C\x837\xc3V\xde\f\r\xde\f5\xde\x18X8\xde\xd4\x16\xde\x18\xb86C\x8d7Cb\u0090x3\xacxS%\x17U\xf2\ry\xe3\xce\xdeM'\xc55\xb7\xee\x84OS\x9bU\x98\x90MX\xb0\x88\x9eP>\xb4\x89\x1aq\xbey\x01;i h\xfe\x0eWD\x80\x8eb\n\xf9\xb3<\x89\xec\xdd\xf0\x01\xab\xaf\x81q\xa0\x84\vus cQ\x9fbT;n\x1c\x9c\x92G\x93wv\xec\b\xf3\xb7\xa6\x1d\x01cC`ͮY\xba\xbf\xe8\xa65$.{z\xf5\xec\x02\x06\x8e֙x\xfe\xee\x1c\xba\xfbٳ\xff\xee'\xb8\x9b0\x82\xff\x0e\xba\xd5\x153\n=\xac9]\x83\xb6\xc3Mh\x11B\x02j\xba:M\xa4\xdf3N\xb2U\xe8\xd21}\xc9<\x01*\xb7\xf9'\x06h\xe4\x02=\x10\x9f\xb1\x01:5@坐)_\x9c\xba\x83\xbb,=/dg(\x95\x16s\xdaco\xd0(\vK6\x1e\xbe_\x14\x18jf\xc9\r\xb6\xc3a\x8f\xf9\xc8\"$\xb7\x98;\xea堐\xae\xe5=\xc2|\xed\xfb\x8ezo(l\n\x95m\xd6,<l\xfd\x89\n\xeel\xc8#\x8dV~\xe8e\xa4\xe29\xad\x0f\xc8n\xa3\x9f\x87h\xaeq$|\x86\xc5k\x87\x9b\x91\x88\xf8\x04?\x19\x96~2,\xfe\\\x0f\x0f8\xe0!\xcc\x13\x18^|D\x11HG\xf8\x89Q\xd3\x0f)\xf7P\xe4n\bx\x83\xc0\x1b\xfc$\xe0\r\x02\xe3D\xb8!\xe0͡\xc8]\xd13A\x88\xe1\x0e\x15\xec\x11\xc0\x1d\xac\x91\xdc\xd1\xc4\u05c8\xaaf\xa8\xd1\xd0\x1c\xbb\xa7H\xd8\x1eb\"@j\x99\xf8ĺ\x9d\xf7Ю\xb2<[d\xc9;\xb0\xf1\x8a8%5\x83\xafq'\x134\x8c\xedS\x16\xe4웤\xa4\xbb\rrL\xa5\xa0G\x8e<YƼWte\xed\xb2;\xae\x15\xd1\xee֑\x97\xe8\x17\x8a\xe0\xfb\n{FK\xda`\xd2\xf0\xccu\xbb\xa8\x8f\x97ߪ:uI0\\'\xf0\xb0\v}\x18\x9fx=\xf4K\u0084\xc7\"\x81\xbf\xd7\x04\xb0\xae\x00\xd6\x04\xb0\x16\x00\x95R\t\xba\xea^BYfs\xe4\b\x87\r\xd5U=\xed\xa5E\xb3\xf8%\xf0\xa7ƮSո4\x9e\x96/\x16\x11\x97\x97\x899\xfa\xa2\xd4R\xf1BǑ;.\xad\xac\xa8/\xd0A\x85*i\x82SC\xfc]\x98N1I\xd8䝴\xa3\xed\x88)XY\xf1\xa7\x96\x17Փ\x83r\riK}\xfdT/ \xb0\xb8\xf0\xe3?\xab\x05\xbf\xd1a\xc3w\xebx\xab\xd5UW9a\xeb\xad\xd1\xd8\x19Gx\x05\x15\xf5\xd9\x16\x89\x8d\xa9\x1c\x81\xeb\xd7\xe3\xa0\xe0\x11\xfd\xab\xa7\xe2\xb0@\xf1ֻ\x91z\xbd\x9e\x94k\"dJx\xd5\xff\xe84\xaf\x91\x9c\xc2\x1csn\x90{\x1e\x13'S-\x98\xfa9\xbb\x8b\xa7\xda\xed\x1a\x8f\xbe\b\xf2<6\x13\x8d\xa4\x8f\xba\x90~\x83\xabF\xad\xf2\xa5\xbdw{\x1e\xb7\xf6a\x94Y\xc7ZT\x95N\xd4Gi3@\xdem\x87\xfc\xe0>$\xee\xf6p\u008fS\x80\xebz\xcb \xd0\xdb\xcaH\xd3\xdaַ\xa3QQ\x9cjͩ\xf3Q0C\xa7\x10\xbf\x04\xdb\xf4:\xe0\v\xc5\xc12\x05?\x1a\xa3\xb9\xb1*E`U\x91\xc1\xf7\x1d\n\xa6\u0382\xaf\xe3\x19\x14ڡ8\xd3\xceă\xae#\x05߯T\x1b\xa8>\x01\xd4\xcf\t\xe5)|\xa2\xa0\x9f\xb9[\xfa\xe5\xeaզ\xabl\xb9\xcc\n\x00R\xb4\xd8/\x0eVJ\x18EFS\xc0c\xba9\xa3\x91W\xd0\x1d([\x9a\x17\xf1\xf5\v\xeeӿ\x1b\xc74\xdc6\xf3H\n\xe4\b\x90\x18I0\x9a\x8b\x91~>\xa5g\xc7K\a\x06[\x1f\xd45\x82q\xeb\xe5ؠ\x96\xfc;\x8a\x11y\x18\x16C\xe5\xb2\x0f)\x1bN\xc1\x8b\x80\x04\x14\xe1\xa46@\xef\xe050\xb8\x17\xad1\x83\x9e\x88\xdfȌ\rel\xaa\x1a\x04/\xfah\x85\"v\xe9\xfd\x8ex\xd9\xcd}\x8f\xbcPL\xc7~\xb4\xe9\xf4X*\x14\x90\x89\xaa\x0f\xa3\x8d\xe5\xcf)*\b\xed\xab_2c\xec\tǔh\xedʞx\xfb'F\x96\xe3\x1c]G\xe5\xac#\xf4\x84\u05cc\xdet\xbb\x8e\x8d\xfb\x1c\x8e\x8f\xe2\x19ҡ\xb7\x85\xad\xaa\xcf6)H]\x9c\xd6\xc4.F\x13\x112\xfb<\x1d\xc5#\xfa\x1cѧ\xc9\xca\xdb1\xbdJ{;\xa6\xab^\xf1P\f\x12\xd4ZPd\xa4>\x03K\x80[\xbc\x06\xaa(\xa9\x80\x0e\xadF@\x83\xd7\"\xe0k\xcc_\xa1IC\xed\xa9\x12\xfb\xb8v\x87\xea\xd6\xfc\xb8\x83\x0f\x95L\xb4*G\xfc\x055\xd3f\x14ز\x12N\xe5\x84L\x9a(\x15\n\xb8x\x98s\x03$\x03\x90\x8b\x944ғ\xc0\x86\xb1\x18\n\x05\xc9F\x04h\xf8K<\xc5\x1f\xc4M\xfc\x01\xb3\x0f\xa6\xac\x05\xc8\xda:\xf6M\xb9\xe3tщ\x89\x90V\xa9\x14\xc5F\xb5b\xa3&~\x89ɏ4\xe4\ts\x0f\xc3X\xaa\xb6\xf5-_\b\x1d\x1e\x9b\x90\x92\xbe\xe4P\xd4i\x87D\xa8\r$\xefܨG\x1d\xf6\x12z\x88%f\xc4\x00t\x14\x83\xa4\x16[G\x90d\xcc\"8\xd9\xdbÅ\xe7b\x1e,e\xb6,\x98+\xb1\xd6\xd3&\xbf\x96d\xd4\v\xc8-\xac\x12S\x8bd\x95\x93\x0fæc\xe5\xdbm\xc8\x1a\x98:hBFʩ~\x9b\x95F놞U#=/\x9b\x857\xfa\xd7K\xd1\xda*\x19T\xfd4,\x84\xd7p8ήb}\xab\xa4\x89pԘ\x8f`b\x00Aq\xe4<>\xf1j\x1e\x1a\x12\xb5\xa8\f)$\t\x1d\xd3\x04\xb1\xdczUT\x96_\xb2k%\xcd1T\x17\xfc;\xa9{\x9c\b\x02\xeb.7\x12\xcfm\xfa\xd1\xecE#c\x8b#T\x1d\x8bh\xc5p\xf7\xcbǷ\xb1\x88\x8d\x88g\xe9\x82\xfc\x11Dw\xa0\xfa\x92\x82\x9a\xf6\xd7\xc7N&0\xdb|\xecO\xe2\x1e\x85_\xa0ߛ%*]\xc4yG$ћE#\x01\xb7OY{\x02\x01\xfc\x1b|\x01\xd6}l\xd3m\xd8\xe4\r\xe9\xb7\xfe\x84ڊS\xca\x12Ű\x1c*\x99݀\".G.Y-i{$\xd5\xe5Z'D\x92X\xe2\x8e,\xfc\xf3\x18\xd1\xdc\xc1\u007f\xf6\xb0(\xfc:hQP\xd6\xd8\x06\xb3\x87\xf1\x1dYMV\n)^Dt\xfa\xe6\\1\u2fe5!;d\xb6\xc6\xcbS\xb2M\xd1lJ\xca\xda\xfc-@\\V-\x9b\xb4fd\b\xc3i\x15\v\xd7\x17\xb7rOW\xb7n\xbb^ikM\xb6\x16\x99ߕ\xad\xc8*Dg\xb2\xe0UuJT\xb7\xf1\x1a\xadn\x8am\xfb_W\xf1*n\xd9\xfc\xa8\xadh\xcf\x05+\xbb^FQ̬5\x19/\xa7k서\rƤ\x14\x19\xe4x\x9d\x19Qڭg\xd4A\xff\xa5\xbc-S\x91I{\v\x90N\x01k\x8b\xd28\xdfL\x1d\x01\x18x\x91\xb0.\x05\xbcu\xcd\x04{X\x91\xf5\x98\x00\xc5\a\xe8ъ\xde\x13=\aT\xaaP\f\xa2\x007\u05f64<\x87oR\x83&\xady\x14~t\x8c\bj\xad\x88X\x9bP\xc4\xdas\x97h\xb5\xac\x96k\xbc-\x1a9[\x98|\xc56\xa7\x14\r\xfb6m<\x88\x80n\x1aq\x85FڰNV<_\x96\x9b\xa3\xd6ǚ,B\xe4\rA:K\xa8\xe1C\xbeS\x81/\xd0X+\\\x9d\x14\x13n\xff\xc9\tm\xe5\b\x9aS\xf92`\xdbrõ\xd70O\x16\r\xdc s)\x8d\xe7uj\xb5\xd7Ӛ\f8,\xa8\xc4Ѳ\xe4(\x8f$\xb8Ş\x03\xb6\xe6\xe1\xa4\xfc\xa1\x91\xbc\x86β^\xe35Ԍ\xda \u008dQ\tS\xdbE8\x11Iutb\x99\xdf\b\xd5\xc5\xdc\xef\x17%\x9exU%\xd21\xee4\xe6\xf4o*>\xf8\x8bw\x162\r\x16\xc8 \xb3\x14\x9f_\x9a/\xe9\xa1ܫ*\x17\r\xda\xf9\x96ò\xdb\x1aCH\xd6}\xe3\u052c\"\xd0\xecV<\xf0\x0e\\gh\xb2\xbdx3\xaf\x8e\x1a\x95\x93\x80\xa0\xa5P\x85\x9eWDU\x1ei.-\x13\xf0\xc9̵Ypm\x10\x8c\f\xb3\xb7\t\xbf\xe1\x18P\\\\\xb3Rr\v\x96\xccO\x1f1\x18\x91{\xca\x1a\f\xef@ِ\xd8ɴ}\xc8\xfd\xe4S\xe7\xd4k\x95\xbdز\x05\x1e7\xee\u007f\xf3\x84槆<\xe8/\x13K\x1aԞ@3,\xbdlf\xaa\v\xad\xc7@\x1f\xdb\r4\xf2\rJ@\xee\xa8Vu滬Z\xeet\x8c\x15\xdddJ\xf4\r\xb8\x93\xff^ܑP<\xe2v推\xa9*}c\x0e\xb4)\x06\xebty\x87q*\xc5^\x03R\nQwMN]\xe3\xc3\xee\xe7\x9d\xc6E\xee\x86\xca[GO\xb5ml\xa3+\xe9]\x03\xe70\xb3P^\xd1a\xc3\"\x12\xd3E\x95A\xfc\x97f4\xe1\x17\xebv\xfa\xc9f\xb4\xfĕ\t\x9fW\xf0\xb9\x1b^\x11\xe7\x18\x1a\xd6\xc0\xb1H6JZyK\x9c0a\xb4\x9b\x92\a|\xa3\xe3C`K\xdf\xda\xcbDM\x8fC'+\xf5\x91Ɍ.3c\xf5\xcbL\xc5\x02\xc6\xd5c\xaa1︤ҩ\xc3\x00͍\xf5\xd7r\xde6@\x1bG\xa8\xd9'q\xd7s-^\x96\xf33\xb3g\\Z\xefs\xd4\xde.z\xafY\xf1\x01\xcf\xdcC\xbe]\xfd\xfc\xfe\xfa\xa7\x81\x90\x9a%oX\xf1zp\x032ES\xe78\xb7\xcf\xe1\x82c\xd6A\xaes\xb5c/m\xd6(\xdaEp\xb9\xf6TC\xb7ݩs\xfe\x0e\x03\x88\xe3U\xdaW\xf4\xe8\x8a\xfb./Fg?\xa2\xeb<]\xedi\x89B_%.y\xddm\x1e\xb0\xb7VHq\x0f\xfc\xa9\xbc\xd3,\xdd\x11\xd7\xf8\x84\xfc\xc8x\xfbf\xea;AL'\xb5\x82C\x89\xe1\xee\xcaz\xc4\xc7\x1a\xe8LHq\xc40q\xa3\x12\xed\xfb\xf7\xd4\x16Wh\x04\xf2\x89i$[\x83\xaaH+\xd6Xi\xe0\xc46\xdc\t\x1b:\xb0 \x16\xd9\xd0&l\xa3\x9d\xb0\x8d\x04m\xc5F`\x1d\xb9\xb1\x1d\xec\x84\xed\xe0L\xb6Pu\u008e\xd2źh\xd9|W|\xa9\xf9\x92l\xf0S\xf8\x918\xe5\xa8L\xe6\xf1\xbbU9Q\xf2\x8a\xef\xc3\xf5߉\v\xae\xcf\xc9\r\xcfz\x84\x10\xe3[zO(\xaf\xff\xdf~~\xd6\xf0\xae\x1cz\x94ȏ&\x0f'\xaa\x9c\xef\xff<\x1d\x8f1\x88\x04ַ\b\xe7\xf6\xc5\x1dL\xd2nr\xc12\xf4\xa0\x19!_j\x8e\xe8=X\x9cl\xf8eX.ڭ\x9eO'wL\xd2T\x9a\x9f\xb7\x84W!#\xe6\xf4\x9c\x0e\x16u=\x8ac\xc5\xe3@9\xb8\xc0\xcd߹Z\xd9VQc&:z\xfd\xc6\xe0ޞ)H\xb8\xd88\xb8\xaf\xebڥxGW\xb5./N\rT\x02\x8d\xf5\xce\xc5\xed%.b(\xb2\xe5R\xf0\xa3\xe2B\xc0;\xa152\x8d+\x89\xb7U\xe3\xf5ۥxc\xac\xbe\x94\xed\ro\x1aW^\xeb\xfe\xd7\xdc\xed\xf6\xed'\xfd\x82\xf6\u007f\xf8O\x8e\xf0j\x12\xc1\x91\xdf\x15\xc5\b\u007f\xbf\xcaJy\u05fc\xf3[G\xbc\x1d\a6y\xd2\xd9&s/\xb3\xecr\xb54+\xa6[\x90\xe1R\xc8?\xc0\xff\x10.͇ݸ~\x870\xa8\xf0,P\xbe_\xc4%#\xc6;>e\xf62\xbb\x8e\xf3g!\xbe.\x8e>b\x86\x1b\x1b\x80\xb7\xcb\xf2\u007f\x8f\xe3\xcb(\xdc\xf0\x10\xb1:2\xd1.=Q\t\x05\xf8\xday?\x916\x014q\xd2\xc1\xe9Ƨ\xe8\xefj\xb0%\x81I\xee\x02\xc6~\x82\x91\x13\x16\xd5s\nx\x82>ܩ\x11;\x90\xbf3\xe1_\x9a\xe4\x1f\xb2Ey\xbe\x1b\xd7\xe7\x15\xe8n\xe4\x9b\x05vo\x06\x11ҟ\xbb1\xb1@\xd33\xb8\xd4RC\xeeP\xf0,\x1e\x04\xbb2a\xa7\xe6ߠ\xe1\xbfG\x93\xbfDc_f\xf8\xb8\xe3\xb7\x18\x8f\xa5\xa1\xc5\xd5\x1b\x12\xb5\xb9\xc6T.E\u007f\x02$\x89Kg\x81\x8ei\x17\x12h\xe2\xfd|\x12֟A\xc2\xcf\xf0\xfd;\x90\xf0\xd7O\"\x01\xbb\xe0\xd78̷\t\x1e\a\x1f\xdaM\xf2\x14l\x83\xe8\x91\xc8x\x87\x8d\x12\x88\x06\xf0\xdeB\xdc\f\xbb\xb9l\xfdc\x9a3jm\x8e\xc4\x15\xaf\x97\xe1\"\"\n\xa9\x857\x1f>\x1a\x86\xdau4r\x97\x02\xab\xf1\xde\x03t-~8\x18`\x98\xc4x\x9b\x05G\x8a\xe7\x155\xf2\x9f\x89m\xa8\x87\x16\xec\xb9?\xfc4Ax\xbe}\x16\xfc#\x1b\x14}\x9eX\u007f\x97\xad\xf2\xd1\xe1?S\x83\xbe\xfb\xbc\x06\xfd\x90,Ve\\\xfc3\xb5\xe8\x87\xcfkћx\x92\xe1M\xee\u007f\xa2\x16\xbd\xf9\xdc>JS\xdck\xfa\xc74렱Y/?\xafYO\xe7?͛\x9ac\x93\x1a\x02\xacf\x025\xd0z\x02}`YF\x16\xe9\x95C2\xdd\x02\xe1v\xd0\xfa\xa7u\x96\xfe\x90-\xb4{\xcbH\x1c\xdeٍ\x90\x164\x1c0\x9b\x1ff\xc3Y\xff\x03\x9e\x19\u007f\x10\xfe\xb6\x9d^\a\xea\xe9\xec\xc1\x1a\xed\xc39\xa4\xff\xe7\u007fV~\x95\x1fЩ\xf1\xde\x00\x8b\xcc\xd5\x1dWN\xff\x1a\xd2\r\xd2?\x14\xfcL=\x933\x05\x89\x18\xf9\x1f\xce\x03G\xe2|\xdbB\xf0\xfb\"{\x15\x96\xc9\x15\xdb0\xf6\xf4E\x17;\xb3\xef\xdf\xfc(͘Fl\xb4\x91z\x15\xa6~\x8a\x16\x14vb\x8c\xe7.\x1c Ѿ_\x9d\xd31\xa0^\xa1\xf0V\xc1K\xa9\x84\x803\xc1\xa4B\xf7!v\xf0\xf0\xa3\x81\xee\x17\xa0\x91\x88\x8eR\x03t\x90\x1aR`oHx\x82\tG\x90\xe0\f\xbcF\xaf\x9b\x99\xb5#\xb9\x94\xa4\b\x10k\xe0R\x9a\x828\xe9\xa1m\xed\xa4\xc0Y\x8d$\x9b\x84\xfa\xd2]\x97^˞h\xf3\xe5\xce5\xf0\x8b\x81%\x06\xe9\xc1\xeb\xd6\xd6UuD\x8a\xd7ݰ\xb5%\n\x16&\x14F\xd8\x1d\xbam]\x9a\xaf\xb6TG\xd4X\xfe\x11\xa0\xae;2\xdc\xf6\xb9g\t\x06\x05\x14\xea\x0e\x18\xbfx\xeeW\xee\xa0\xed\x11\x84\xb1\x85O\xed\xc7\xe4\xae\x11\x88\xaaqS\xbcNNKE]7z+z\x0f!hf)\xed\n\xbaت\xbf\f\xae\u052c\xa6\x99\xa0\x9c\xe9\xad\x0f\tf/\x9b \xf0A\x1b\x8e\xe5\xa0\xe6\x96\xc1}\v\x00\x8a\x8aj\x9bj\x9a,\xc24u\x93\xf2<\xac\xfc\xa5\xf4\xdd(\x92\xea\xfe4\xcd輈\xbe\x8e\xb5\f\x1a\x92\x14zn%\xaf\xf2p\x06\x8a\x8f\x90\"=\x99\x85[\rN\x03\x95\x88\x9e\x9aW\x11N+V0\b\xff\xb6u\a\xb1\x9d\x00D[\x91=\x92b\xc57L\x94\xd4:1P\xa6\x86A\xb4\xc0\t\x8by\x1a\xa8j\x95\x13X8\xaaid\xe8-\xadzVz\xf6H\x1e\xb7*L\xc2<\x8f\xcb\xf3,j\n\xd9T\xd3 _J\f\xa3P\xf7\xb5\x93{tD\x1bK\xe2e\xd0\u007f\xf7\xc9b\xd8Ȋ\x02\xd7\xdc\xf8\x12,,\xa1\xba\xa2\xbe\xa2+\xe2c\xd6&\x0f\x86^\xd7\"Xqy\xdf|\xa4\x8b\x80\xfb\xc9\x02O\xa8\xf5\xd8\x04\xf5\xe2\x061\xa4Q\x18\xa1(\f%\xcc\xc8\\\x8c9\xca\xe6!\xbd\xcb\xe5\xc2̾\xc6\xd6\x11B`\xd2+0\xf8\x81\xd8ֵ\x88P\x83ބ^\xdbS\x04\x15pзH\xccx\x15\xf3Z\xbb\xf5v`\xfd\xf4\xfd\x0e\xcb\xf9FV\xd0m\xf5\xe6\x9aWWf]|*\x93ɥ\x11\x8ern\b4\xf9H\xadK~R\xde`\xc2\vJ\xb5H1\x0e\r0^V6\xf5\xe6\x14+\xae#+\xe8\xd8\x0eq\x05,\xd2\xd1A\x99\xf0\xf1ut\xf1\x1bMV\x15\xbd\x82\xc0\xf6\xbdyW\x84\\\x90\xc7\xef\x06Qo`\x8a)d!\xeb\xa48A\xb3\xb2\x0em\xf7\xbe\x90\xf2\xfe\x06e\x96\t\x01ѷp\xddNli!>B/n\x91\x16\xed\xce\x0e>\xe0*Z\xb7\xef\xa0\xeb4\x11OC<\xf2\x14\xb8\v\xea\fJ\x8b\xf6\x06^\xaf\xa7\xbd\xab\x8b֪h\x8d\xe1\x19{I\xb1\x11u\xbfƹ\x8c\x96\xa8\xcd\f\xb5\xa9y\xeek\xddR\xcd\xeeU\xbfa\xf8J\xed\x1d\x1c[ʾ\xad\xc5ૉ<+\x16G\xf9I\xb6ܴ\x96ti,*\x85\xc3\xc3V\\\xce@~\xeamWB\xd1\xf5\xa4\xd6\xeb\x10O:\xf2\xc7k\x9c\xc3\xe8(\xa7\n\nB\xaf\xbe\xa6\xe1|\xd9r\xba\xa3\x8f\x19\x1e-F(y\f\xe8\x81bM9\xc4\xe1\x82=SD\n\xff\x91\xc7\x04=ϊ&\xc1\xe5\x1fq\xa1'\xde)'\b$\xf8\xae\xc5)\xfd\xec\n\xc0\xb3v2\x9f\x1bᕴc/\x91\xd1^\x9a\xfbY\x84P,ڦ\xce\xfa\xf3Lr\x83\xb6j'\xbf*,\xd251\x16\xb6\xe7\xed)H\x1e#\v\fH\x18\tg\x96*\x9e\x02_\x19\xf4x\x97y\xefM\\\xd2֡\xf1dv\xa4\x1fBo\xc8S[ڲ\x11\x9e\xaf\xa9=⍹\xa6\x8d\xda\xeb\xfawQ\x97\xb1x\xdd\xc8ūB\x8a뮁\xad\x88+:Ū̝\xabE\x97\x95O\x87\xf3\x1e(\xae\xd1(\xba\x05\xaf\xd2\x06[\xba\xf7\xbf\xfd\xfcl;g\xe49\xbcb\x91\xce#H\xffD6A\xc9vN騝\xcc2\xa8w\xf1K\a\xb89\xcbhn\xa0\xc6/0 \xb9\xfc\xe5R]\x02\xe3\x9eZ(1\x12\xfc\xa7\xb2\"\xa3lB6\x8a\xf0\x1e{\x91\xc6\xf8\xe5w\xa2\xe4\xaa\x13\xf4\x8br\x03\xba\x118\xaa\x02Xv\xb2e8I\xcaM\x87\xd8\xde\x11\xfaț\x84\xe5\xe4\xdc\xf3\xe3<\xaf\x1e\x15\xa0>\x03\xab\x02q\xbc[\xe6Y\x99\xe1|\r\x94>{\xf3\xe6\r&>\x8fA\xa7\xf1sC}\x95߭\xcah\xf52\x13-T:a\xc7r\xf7\xab\x05Fg\x12\x9eR\x8b\x97\xd2\xf1%\x8f$ˡQF0\xc8\x1a\r}\xf4\x8d\x12\x1e\x1fZq<z\xefh8\xac\xa1\x1f\x1d\xa8\xab1`L\x16t\xb1\xbc3\xea\x0f\a\xfd\x83\x8e\xec\x0e\xc1)zў[J?\xdf\xe0\x95\b\xab\xbbD\x96\xaf\xfaM\xfe\x10=Ǐ\x81\xbc\xa2g\xa5q\xbeE\xdf$\f/jvS`\xa1\xd3*}\x06S\x9a\xa4]\xa7\xeb\xcdj\f\xbdU\xf0&B\xff\x1ds\xf8\xdd;\x98\v\xaa\xa0,\b\x87\x9c\x10\xbcW\xcfq\xd0\xfb\xfeU\x91\x93\n\x84\xc9:ڎC]\xc8X\xca\xce\x04\xdb[\x83\xa2r\xa72\xf3L\xaf\xa3JU\xf2O\xef\xa5-uy0c\xe3\xba\xfc!\x8eM:.c\"A\x96\x93\xde\x0f\x90\xdc\x15\x89\xa7\xf0\xfb\xcc\xed\xf4\xc04\xbc#\x86\xfa\xd2݂\xab>\x0f\v\x8d\x1d\x80\xc2e\x89\x00\x1e|7q\x9a\xe0--A\t\n\xa6|P\x8d\xfe\xd0sݭ\x98\xb0ȩ\x03ݙ\x81\xa7\xb0\xf1\b\xc1\xdf\x1d\x1dƮ\xd2B\x02w\x05K\xe6\xd9U\xdcD!6\xea\xc4\xd5Tk\xca\xd5\x1aO\xcf;\xf0\xf3\x92DI\xad!\x90P8/\xa6\x88\xfe47\xc8\xc8%L\xbe\xb8\xe9&\x92Q\xf2n\x14f؏I\xe8T\x9a}C\xech&\x86\xb3w#\xa7\xd6\x1b\x12;\xd3ř\x8d\x941\xa8A\x1bh\x11\f\x9d\xdbH\x9c\xc8\xffd\xeaDy&O\xdf^\x04\xf0#\xfcǈ\xad\x83\xa5\x8f\xf8O\xb5_\xd8\xdc\x1e\x81\xdch\x90\xa0Kk\xd0\xd4\x11\xedU\x93$\xfbA\x04Ȳ¼\x9f\x9cX\xc2\xf9,\x8b\xack\xc5S}\xbe@\f\xd5)\x05\xaeb\x94\x88\x06mo\x9bq\xfb\x84\x1eֆ\x02,w\xdf\x0e:\xdd:\r\xf6\xa0\xa9\xbf\xb8\x01\xba\x8f\xf8@[k\x00+>D\x16/Q\x8c\xd7#h\xe9\a\x86=E\x19\xb4#\x13\n\xf70{\xabC.\x89ܮb\x8c\xf2\x94A\xf4\xd2`~\x9f1Ub\xa9dV.\xff\x8a\x92\x96r\xd5¿\x8aƄ\xc5$^\xe0K:z{\\/\xaeaL0\x15\x11\f\xe3\x81\xc9pa\xe8\u0588\x1fx\xa4\xf0*|\xa5#\x87\x19v7\xecx\xfb:\x94\xd8\xf1\x82{(\xb0\xd3\xc5\xfaЍ}\x1e\x87\xc6ޒ\x98\x17\xa7\xf6\xc1\x18ϭ\x92\xe9!\xbbn\x0e*\u07fd\x8b\xea؏B\xb3\xd8^\xadV\xc0\x01\xbb\xa7DTs\xb1\xe5\x1f\xca\n1\xd6/\xccyx\xd2\xe6c\x80\x8e9ڪ{{\x17\xce\aP\xb7\xe3\x14CE\xb4Qր'qm\xb5\x18\x9e\x96\xc0\xc59p\x11\x96\xce \xf5\x8b82y\x89aA۸\xe9d\r\x1d\x8dH\xf3\x88\xf9Lq\xef\xe4\xa3_F\xce4I\xf1\x01>\xd5.S4\xf5^\xa27;\xfb\xefW\xe1\xa2\x04\xbe0)\x1c\xddN\x97X\xd0\x10\xfd\xbbAc\x8b\x92\xad¡;oքd\xfc\xa9\x12AW\nM9Pg\xa9\xbf\xfd\x06\xc2|\x1br\xa0\xd3\x10\u00a0\xbcI\x10\xfc\xb1\x81\xeb\xb6\xc0\x05\xd5\xd08dL\xe3\x1d䪢\xacE\x9a~\aZ۰\xb7So\b\x84i\x8a\xae\xff\x97\xefM\xd2{\xff\xb2\xbd頾\xb17\xd5f\xf6gthW\xbe\x8e\xff9}:٩_'\x9f\u05f7\xb6\xa9T\x97\xfb\xca\xc3\xff\x11\xe6L\xf4\x9c\x8f7\x91\x84\xc9?\x834\xfc.\xed5ùB\x87O<cm\x9a\x87\x8bH\vg\xb7\xc0\x1dG=0\xc3\xff\xfc\x1f]\xef\xff\xfb\xbfM\xab\xdce\x85\xe9g%\v g\x14@1=̍\xcc\x00i\xfa\x9f\xffC\x8f\x13do\xa6\xdaG*\xfc\"}\xde\x18\xf8q-\xfdX\xb8)>^\x9d\x1a\x99\x11\x99\xbd\xea%\xf76\xa0\x9cb9kq\xeb\x1d\xa1$oS\xc0\x8d\x9cf\xec\xda!&4l\x0f\x9b}\x87\xd0TaN\xe8\x99\x18u\xf0\x91\xa3mQ\xddP\xfch, \x00\xe0\xd5\x1f\xdf\a\x14\aLJ\x82\x92\x8a>K\x83\x1f\xec\xd2Uzl\x97x\xbd\xf4+^H\x967\xb48ɯ\x93\xc5w0\x18\x8e\xda\x0e\x10\x9du\xaa\x05U\xc1\x96([\xa0\xc6S\x19\x05\x9atF\xcf\xd7z\xad\xc0\x938\x9b6m\x88\x14\xab\xf96\x15[h\x0fY\xd8\x1aV\u007f\xda\xfaS\xed\xe2\xdbI\x01F;\xa9\xa6=\xcd*\xa6ƅ;[\xc2:\x96V;\xd8\xc0k\x1c\xcd\xe8\xaaCڕ:sx\x8d\xaf\x05VC\ue83f\xa8\xc8\xd1\x0eCp\b.\xf9\r\xb5s9:\xc9\r\xc3\xff\x0e,\xd2+\xb9\x91S\x9c\xf2\x11Q\x97\xee*\u007f\a\xbf\xcf\r{\x17ݰ\xae0,\x15ƫ\x92%\xf0\xdc\xf5\nM\xda+\x9d\xe0\x12D\xa0Xf\xe6\xf3\x8f\xf3\x10\x16\xca\xeb\x9a\xdf}\xffC\xb2\x14\x17\xef\xa2\x03X\xea0\x94\x8e\r\x00\\[\xe1\xc2q\xc91<\x03u\xcc*73\x8c\xd0/\xf29H\xb6\xb9+1\xa1\x957T\xf6\x92\x91t=\xf8]\x88\xed§\xb4A;\x0f(\x12\fK\xbdc\xbbA>6\xb9\xa0\xb2\xe8)\x98\xf0a\xab\x8edAH.\xf8\xf1\x15}\x90\x01\xf0\xe9ř\xb1t\xbe8\xd3\x0e\xaf\x1c\x13\r\xe2\xd7Y\xa5]!\xaf\xef\x8b\xd4\xee\xef\x1a!ja\x00\xc1\x04\x90f $\xb5\xf75k#\xe9\x91w\x10\x00\xac\x19\x85\xae\x01\xf20\x00\x8c\xfa\xc33\xb6'\x18\xe0ydU*vk\x93\x88\\\x8c@\xe8\x00\xc3\xe3Ǐ\xcdY\x84ހ\x13\xa3\vߪ\x01\xf03|@\x96BZ\xae\x05}\x88\x03_\b\xe0AK\x84@RKp\x9e4\xdb\x1a\x06\xf8_\x93U\x18\xdd\xc5ͮ\xa0\xe2\v\xb3\xc9\xe0\xdcN\xac\xd2\xceG\xb4\xcd-M\x1c5\xe1\xac\xf6\x12k\xf7+\x8e\xd5\xf6\x98\x82Ǉ\xf9y\xfbH\x96\xa7\x9b\xf1\x06\x8c\x8e\xff5v\x96U\x80:P\xee\x93%y\xb1\x93%\xef\x9ef\x94\xbd\x8c\xa7\xed;,ҦL\x9b\xda\x03po\x9e\xe9.Fք\xd1\xf5L\x1b\xfd\xb1\x19\xf5/\xdcb\x8a\x1a˘4\xfc\x97n\xfa\xa3\x93\xcfh\xfb\".\x9cn$\xea\xcc\x18\xf7\x81D\xcdQ\xbcԟ\x8d\xa5\xcd-L½=:\x1d\xb0\xbc\u007f\xf2,MW\xe8B\xc1?\xb8\x15X\xa1\x98\xf0q\x82\xc4ݠ_\xf8\b\xe0\x89\xa7m\x10U\xe9|\xd1\x1c2\x8e-\xff\x06\xf7\x9a\x92OS\x90\x9cS\"no\xef\x8c\x12\u007f\xe1\xf3O>\xaf\x12[\xf5\xc57\x9b\xffB\xe0\xfaU`iB\xf0S\xc9M\v)S\xbd\xa9C\f\r-y\xedˊ\x99$q\xf4fl-\xdaJ\xab:\xce\x10\xe7m\xc7\xed!)\xab\xfa\n\xad\xbe.,\xb6Deͻ\xeefqב\x86\xc0u%:\xa2\xaa9;\x95\xb983\xa3\x8cH\xb6\xb1\x904\x1eZdN\u007fdq\x94\x01\xc6\xd0\xf2\xe6b6\x0f\x97\xbah\x84\xe2aW\x94 \xee]\xf1\xcb\x12\x88c\xddL\x11'\"\x80\xca\x18I78\xb8)\x8e\xf4V\xd0q\x87l\x8a\xeb0Gk\x9c \x0fVֻ\xbd\xfc!\xe0\xfd\xb0O\x87N\xe3~\xeby\\h\xbfs&F<>\x83\xab\x0e\x02\x15\xb7\xf4\xcf_D\x8f\xf2\xe8e4X\xd8>O\x16c\xd9Z0izc`:\x89\x11\x8e\xeadm\v\x1e\x8d\xa9\x15>7N\x1e\xfa\xf5iT?\xbb\x8cl\xfe`\xc9:*\x8d\x1dչy\x1e\xc5Z\xf4q%U\x9aL\xd2J\x01\xdd\xc5\xf3\xfa\x03\xee\xcd\x15\xfd\"\xb5G[U\nhw\xdcB\xff\x9e8\x0f\x03\xab\xccm\xb8\xact9s\\Z\xec\xd1Ǝ\xe3\x90\xd9>{$hב\xb2u\x8c,k\xbb\xaa\xf1Ȫ\xafv\x8e\xec\xacQ\u05efr\x98\x9a\x15W\xa7\xc4j\x97\xb3.\xaaV\xdd\xf5cbg\xe5\xce\xd3`[\xa1\x88S`I\xdc-}P\xd7\x0f~%\x8d\xcb8\x9f\xaf\xca\xd8a\xc5\xd0{Df\xf8\v\x01,Ǻ>\x97\n\xe8\xd6\xe8\x17\xb28\xaf\xe0\xc4L\xc6\xe5\xf0P\xd3 U\u009agT\xf9\xacN\xa9\xed\xdcH\xab\xc2\xcaW\xaa?\xc9\x16\x93\xb0\x14Kb$[\x94\xd21cdխ\x96\x1c\x97\x13mפF\xb9H5\xd9\x16-\xa6\x1d\xeduM\x85\xe7\x16\x12Q\xca!\xba\x83]'g\xce+a(\x98\x9b\xb1\xb6\xab\x83\xe1\x8bP\xb3\bh\xf5olu\x88s=\xddE\xa2У\xdb5\xfbO8,G\xc2dmߚ}\xa9\xf9\xca\xf2%\xa5\xd6sGZ\x05\xea7\xc6\xec\xddB7\xa7\xab\xf6\n\xc7^\xaa\xf5XKe\x0f\xe0\x81\xab-\xb4\x05N\xe5z\f\x88ۡ\\7\x18\xe8\xdf/\xa6\x18}\x15\xe6\xa1\xf2<Ϯ\xc92|\x81\xae~~'ᬘ/\xc2t\xb4\xa0\xab\x92\v(D\x97\xe2P\x9f.ˠ_\xf3,\xceߐ#\xb5\xba\xecHl\t\xb4\x83k\xe1\x9dMT\xdf9\xc1\xd7T\x89>\xf93柕\xfcQ\x12E\xf7\x91o\xd1_H&\xe0+&T\x80c\xf3>&L\x01SȽ|\x01ͽ\fĒv[\xf9G\x8d\xe5oٷ\xb8\f\xb1\xe0\xa8=\x86`X\xe3\xbb\x00\xa0e\x1a\xe2\xe3\xb8\xe8\x02A\xf0\xf0\xb7\xebu\u07be\xfd\xb7?w\x02{\xd1\\\x81\x00\xd6\xfdӷo\xdf\xfe\xc7\xdb\u007f{{\xe7\xed\xde\xdb'o\u007f{{\xfa\xf6\xec\xad\xff6x\xdb\u007f\xfb\xf7\xb7\x1f\xcf\xf6g\x92\x0eq\x83\xaa\xba\xb7ҭ\x06\xa1v\xb9\x977h\xe9\xbe&\xee\x9c\xd3\xf6\x19\xa5-\xb3k\u007f\x88뾀ސ]\xc0ZD\x875\xb4\xcf\xfa\xdc\xd8`Z\xe5)\xee#\xa0\xf3\xa4\xeb\xd99h\x8fXx\xfc\xf5\x87\x97ߕ\xe5\xf254PM\xbdͣE⢭\bD>\xe7\xabH\x14=\xb9\n\xedD\xa9\u007f\xfe3V\xd3Ϯ\xe2<O\xa2\xf8\aH\xfb\x99\\\b]\xa9TD\xf5+\xe4/\xe3\x85\xdf\xf9\xf7\x17?w\xba\x1e\xb5\x85\x82\xf9V\xe4\x118A\xc2\xc2C\x10\xff]\x1c\x82q\xe2w\x9eN&`\xf9v\x988\x1d\xe7\"\a\b\x8c\xad\\Ɠs[w\x98\xda\x11\xe1\t\xfaMI\xb7Hap\x1e֮\xbb\xe0\xb54\xa4\x00@V\x85#\xac\xfc\xedB2!\x8f\x8be\xb6\x00\xee\xfc\xf6\x1b\x14\x83\xa5\xc4h0\xa0\b\xe5\xc8\xd6\xc1\x80\x93\xb1\x92\x83\xc1!.Y\xa1{8D\x9f;V3\xb7\x1b\xc3\xfcJ\x10\xb5\xcb\xcb1\xd4w\x91\x83\xea>&\xb6\x13[\xec\x88N\x88\x94Xm\xf8\x19\xaa0.\x80mW\xae\x96\xd8\x1c\xab\xf0\xdas\U000d9bcf\xc2Y\x16\xa5Y'\x9d(4\xdayQd\x8bZ;\xed\x16\n~pf\a'\xefdB^\xd0\xfbX\xbc\xa3ݶB0G뉛O\xbc\xff\xeb͏\xaf\xfa\x1c\x85\x86\x01\x8d\xbe\xf9h\x10v^\xce\xd3\x1b\x12\x86?\xf7\xb1\\3Et\t\v\x89q\x9c\x8e\xeas\x80\xe5e\xfe\x9a.\xb8\xea\x87>\xa4I\x8b\x18\xdf\xf6\xa78\x92\xaa\xc08\xd3\xde\x1f\xc0\xb8\xab$G\f\xcfȞe\vL]\x85\xe9\xb7y8#\xef\xf5\xb2\x12\x85jUip\xcfɣ\xb5\x83E\xbf\xaf\x8c\x82V\xfbǊ\xa8\x987\x16\xc5Oҏ\x90+*\xaefG^\xe7\x1c4\xee\xd1\xfe\xfe\xf5\xf5u\xff\xfa\xa0\x9f\xe5\xb3}\xd0\a\x83}\xc8\xec\xb0%\xbeFqp\x02\x0e\x1f>|\xb8\xbffia\xd04Y\\\xb6\x80b\xb6\x04m\xc0\t\xdcB\xe0\a\xfb\x14Rn\t\xd3bU`Q4\xd3K\xd9\xfb\x1d}C\xafz˔\xdd\"\x8ft.0\xd2\xf7 A\xc9T\u007ff@\x0f\xc9X\xed\xb0QTF2\xed\u007f\x9c\xfa\x9d\xa3N\xd0\xf5\x94S&\xe6\xe9\x96ZR{:\xc7\x005\x83;&\x9a\x9ccn\x1d(\xd1\xee\x8b\xd4n\xcfi\r\ua7c7ŏ\xd7\vui\x83+\r@ehF\"\xf2\xd3`\xc3)\x83i/\xb2\xd25\xe6#\xa2B\xd9\xd6GZ#\xf5\x03\xd9(\x01\x8cx\xbb\xc01w\x91\xd4U\xf9bcQ\xa6؋\v\xd7\xf9\xb9\xbdb\x90eO\x1d\xae\xa32\xef]|Ew\xe1ħu\x0fH\xa4\xea\xee\xf82M[`\x99ڜ\xef\xa7`\x90\xf0x\x11\xe7\xf6N8\x15P\x82\xd1G\xc1\x10\xdd\xd8\xe9T\x16Bb\xee\xca\v\b\xbe\xaa\xd2\xd0\xd1\xe2ڌ\x05\xa3I\x8c\xb5\x1e\xa9\xaf\x0e\xa0\xe7\xc9\xdf\x18Q\x9c\xf5\x95h\x1f9R\xb5\xf6\xe9\xbaqZ\xbb[Y,\xe3I2ML>\xccE\x17\xa3_1\x95\x00\xd3T\x84\x95Q\xe00\x9b$iJ;\xa4\u0603\xc3343:^\a\x1f\x85\x99-T\xfa\x01\xa7c\xc0\x14\x03\xfe.\xac'\xae\x93\b\xcf\x0e\xbc=N\xba\aI\x93l>\x0f\x15\xd0\xfd3\x1a\x95\x93\x04/ܨ\xd4\axݗ\x99\xc9\t\x0fqW\x0f\xd7!\xec\xc9\\\xac\xa6¹\xba\xd3\xf5\xc42\x85\x9e\xb8\x02\x03\xb2\xeaA\x8570\xaa\xd8S\x1f\x86\xa7wU\x8e\x9a\xa1\x9d\x04s\xa3:\x83\x8e\x11\a\x15\x9b\x11\x88\x06\xf6\x8c\x13x_$\xe2y\xc9>\x98}\xc6e\xf7\xeb\x84n\xf6\xe8Wf`\xee\x00ˮ\xb3\xe8\x1cɹD\xb0\bmVK\xb6:3E\x85\x1e\x88\x9a1|\xad0(^\r\xd4*\xb2\xe2\xd9\xd7\x1d\x1b\xe9\xb4\x05\xe9\xf2S\x91\xe6-H#\x85\xb4\xea=\xbd\xb5z\u007f\r\x9a\xd1\x145ڪ0\xaf\xaddT\xb3:\x83\x11\x1c\x1a\x01\xb75\xa1\xb1Y.\xbe\xab!\x83\t\x05\x9d\x94pw\xc2003\x9f\xc7\xd3p\x95\x96\xc7\xce{\xaf\xd6}\v\xd29\x82\x19@\b\xef\xa7|\x8d2$\nv:\xfaA\xc1\"\x9eQL ylC\xaf¡\v\x9e܈\xe9\t\xfc2\xa4\x11\x8eY]\x80\x99c\xd6{c|qK\xcc|J\x9b\xf0\xb4\xe3\xabKx\x92C\xd6F\f\xdd\xe5\xa2i\x8d\xef^\x9b;2\x9a\xb0H\xa8\xcd|\x9c\xa5\x8d\xbbA\x8c\xf3Ή\x1e8\xc1:\xfc\x11ʶ\x992\xc7p\x16\xefƈSM\xc6#5\xf0\x9ebk\xed\xb0\x9e\xde\x06\x92z\x9a\x06x\xa0h\xf0+G\x109\xf4\x15\xc2a \xa2\xff2\x15{\xfa\x05+K\x97Ht\x95\f\xcd`\xa5\xbe\xac\xf1Q\xc2)\x11\xb0\x90:\xf7\xd5~\xe7:Z\xb8\xf8\xa5y\xf6\xd1q1\n7~H\xba\x8e\xddN\vj\x8e\xc3\xed\x17\xffɑ\u007f\xfa\x1f\u007f?\v\x9e\xf8\xa7\x8f\x1e\x9f\xfc\xc7Y\x80\xbf\xf6\xde\xf6<L\xfa\n\xfe\x1b`\u00a0\xf7\xf0l\x0f~tῷ}\xf5y\x1a\xf6><\xed\xfd\xedk\x80ݯ\xd7@*A\xf8!\x85K\xb1O>;2\xf6p\x96\xb5c\"\f\x84\xfa\x93\x14^_\xbe\x1f!\xafy\xedR\xfa\xc5\x1a\xd6-`L$aj\x97\x9f\xeeR\xfe\xdbd\x1dGv\xc9|[I\xb9IE\xb9\x86T\xa9\xa1\xc8\x05\x03U\x05͓\xf3p\x8df\x11\xff\x86~\x1e\r\bH\x8f1\x1f\xd4x\xcbZ\x88\xf7\x90\xbd\x0e^l\xee|\xc0\u007fB\xfcg\x8a\xff,\xf1\x1f\\\x97w\xfe\xdf\xff\a\xff\x9d\xe3?\xf8\xdf%\xfe\xf3\x03\xfe\xf3\xef\xf8\x0fn\nu~\xc2\u007f^\xe0?\u007f\xc3\u007f~\xedxg2b\x87^_`\xd8Uj5fjq]\xf5\xd8n\x17\x95]\xe1P\xf8Ju\a\x95\xba\xeb\x19{\xc8\x1a\xe2j\xec\n\xae\x8b\xba]\\\xaf\x91U\xa9D\x9c\xc1a\xbch\x06\v>\x15<\x92I\xe8d+(\xdd甗\xaf\x86\x03\xa3\xb8\xea\xc3\xde\xe8P\xefE\xf9!̠\x84\x9c;8\xb85ݣJ\xa4Mt\x80\xee\x8b\a\x81\xcb*\xaeu\xf7\xe9\x03(\x9d`\xa13{T\xc7`\x04\xd0\xc3\x03,z\xf4\xb9\xccҍ?\n\xba*a\xb2\x1a'\x13\x1b\xe0@\x03\x88x\x92n\xb9\x99/!\x93\bG\x18\xdfa\xb7\xe9\xb0\xc7<\a\r9\xb2k၅4\x1c\x19\x14\xa9\xa5mt\xd4\x1e\xdbD\xb5\xd9\x18\xa9\xd4\xc8]J\x12\xa0yk\xb8\xe1\x118\xab \x80\x99Zi\xbdܥX,_\x98\x95\x84&\xf9$\x8dw\xa2\x94 \xcd:\xd1\xd7\t۩\xb0s\x02\xe7\xe2\xeeK\x95\x85_\"\x1d\xc6\xcad\xa7*\x19\xb2A\t\x11\xc4\\\xde\xdc\xd4z\xba\x93\x80\xe5^\x93\x11\xae;[\x95UV\x1ec`\x01\xf1\xb2\n\x94\xeaAnGϞ\u2d9aȆ\xbc\x1e!v\x1e\u007f\x9bt\x8b\x92\xbeU\x11\x94\xa8iT<\x11\x0eM\x1f^}\xf7Ľw\xd2\xc3%2\x0e\x10\xde&\x81\x11\xed\xda\x14\x11;\x0e\xec\x87\xdb\x04\xc9\vf\x8c\xb0\t\x8d\x13fu58ٖ\x96\x86\xb4>n\x18t\xae\x8dc\xec\n\x82\x9f\x1b\xf0\xfa\x18\xad\xcb\x14\x86\xe6\xf1\xe7\xbe<\x89\xa5W\xfek\x87\xb5\x05\x86s\x90^kʏ\x18\x8c\x92\xc0XiӮ\x85\xb6\xb7'}\xa4Q}\x99\x87\x8a\xd8r\x9dó\xfaN\xab\x88\n\xf1\xea\x8dO\xbb`}i\"_ͺ\xb8\x001\xf7D\xfcݪ\x02\xc3\x03O7\x9e\x96\x90:^\x95\xb1\xdfQ\x85:2\x84k\xa0\xaf)\xb05\xb3\ns\u007f\f<\xfb\x05\xe3\vf\x8b\"K\x13\f\x98\xe3\xd7=P8`\x9d,\xe4\xe3\x867z٠˷wd\xe4}/\xfaFm\xea\xfa\x1a\x11rs\xa7\xaa\xbe\xea\x11\x19\xa3\xbdEGs\xdb0\x06\x93\x8f\xcfp\xd0=w\x95D\xafn\x006#\x97\xbf)\xab\xb8\x8c\xaf\xffZ\xe5\xe0'\xa7\xd3:\xa6J\xa7%\x13\xbd\xe0aO\x01\x8a\xea\xe7\xf1,\x8f\xc9H\x19>\x18\xc8\x19\xf4\xa7\xef\xbbNF\xa8\xcd\xce\xf0\xc8\x1b\nmu\xe4\r\x84\xaaT\xbf\"\x95\x1b\xab4\xb0\xea\x06\x9a0j1\xa8\x9an9˱m@gyu\x13|jl\xe1\xf5zb\f\xe3\xaa؟:\n\x9e&g\\A\x10x\xe6\x12\xf7\xd8M\x19\x87Ko\xa2o\x8c\xdb(\xa1{\xad\\\xd6t_\b\xfd\x80Oi\x97\xb6\xc5oV\xf9\xda>C\xfd\xf4\x1a\xb5\xa3SUy\xd0^{]j\xed\x0e\xc1 v]\xef\x02\x86\xe3\x80}t\x8b\xa1\xf8+N\xe4ߋ\xbf\x8b\xae\xf4>\x04AҪ\x10ט]\xe1\xa3ɍ\x87mP\xa99\x1d\x05i\xd3o\x1cX\xcf\xe1\xd2\x19)\xcf\x00\x81'<,ƚ\x1e/$\xa9\x12H\xe9\x85\xf7\xb6\xdbar\xe4)\x19\xbb\xa5\xbdb\x8ba\xe6j~\u007f2d\xac~tY\f\x1a\x89W\xad\xb6\xb7u\n\f6<V^$\xce\x16H\x9a+>\xf1\xce\xf6{\xf5P\xb0\xbf\x85mxs\x11\x86G\xc2\xf7>\f\x06\xa2\x03\xee{\xed\xa2\a\x12\x95\xf5\u05f8\xd54\xa7\xe7\xc6\xcc\xe5y\xd6w\xbcE\\\x9cB2=<,\xefL\xdaoO3\x04\xf9\xed\xed\x9dx\x99\xfdl\xbdz\xae\x19\xa0\xccH\xbe\xaa\xdd\x174Y\xd3m\x8a\vٌ\x8b\x00H\xbf8\xeb'\xbd\xde\xd6G\xadk\x14\xe0R\\\xd1\xddF\xce\xe8\xc6\xe4\xe0p\x1d\x1d\xef\xfa\xe0\xfd\r8H^\x8f&\xf3\x9a\x1b+\x80wl'\xdf\xd1\xfal\xd6;.l\xbcw\xbe\xc5\xedy\v\xbd\xa8\x86\xa7\xd6\"\x12G\xa7\x8a\xf6\xf9\x95\x934\v\xc1\xbc\xe2\a7\xb4\x94\xac\xaf\rw\xdd9\xc9\xfd\xec\x18\x8d\x84\xfe2[\xfaƶ\\{\u05f8\xba\xa5ր\x1d\xba\xa3\xad+$+\x15\xc34\xf5\xd1p\xbdP\xba\xff\xe0\v\x1bUh\xfa\xf7\x18\xc0k\xed\xb5\xbf\xa5=v\\\x92l\x9dvt\xf5\xadk\x98\xe2ԗ\xca%P\xcd\xf7\xcbZ\xb8Y\xf1\xe8Xg\xcb\x1c\xf5\xb3ː\xb4\xa7)\xc7l\xf4\x94e\xa72\xfcB\x90\x92o\xec\xc41\xae\x1c\xf0,\xe3ie\x8dA\n\xde\x01\xf9FO\xc9\x19\x86m2|T\x96\x00\xe4\xe75\xe7\xa2]\x06\x1f\x9c\xc7\x1f\x97\"\x87#u^\x8a\xacjØ\xb6\xf8C\xec\xae\xdb'P-\xfe\x80\xc5\x02\xa4\fe\xcaP\xd3\xc5b\x9a\xd0mɮǋ\x84N\xb7\xfa\x19tZg\xbb\xa11\xcd9\xc7\x17\x91\xd4e\x82\x02u5\xcd3\xd0\x1c\xec\x84f\xc8h\x86\x15\x9a\xc0\xb8\xd2J\fP\xed\xde\xda\\4u\xc7l\xe2\x06Ϋ\xb1\xb2L\xa7\xe6\x1f\x91\xd3e\xfe|l9Ba@\x98\x1c\xe32\x80=\x1c\xe0/\x98&\x0e\xee\r4o/H\xeb\xa1\x00H\x90P\x82\xb4Z\x14\x94V\xb0^A\x82\xa5y\xaf\xf7\x12\xe0\x1dm\xe7b\x1e\xa2\xc0\xb5\xf0OoSs\xc5\xf0;\xb7X\xa7xsM\xbc\xb9\xd6\xf0\xec\xd6(\xb10\xb9y\x9b\xaeC\x1c(-m\xbanmS\xb5 \xbanjҥ\x1cU\x97R\xba.娺4\xc5\fm*G\x15\xbc\xb6\xfa\x84\xf1\xb5\x00\x16\x1cng\xc1%\x8f\xb1˶1\xb6؍\x9b\x97<\xce.\xb7\x8c3\xe6\xc4m\xdc_Ev\x8c\x05;\x86\xad\x9cVK\xccK\x17\xa7\r{tی\xa1\xdf-˚\xee~\xfd\xce\xd3\xc7\xebٸi\xe2\b\xc5^\xf5l\fsñXq\xa9\xa4\xb1\xe6\x82\x1c\xe6tA6\xefz\xe1\x8c~\xcd\xe0\x17\xdd\x13\xec\x8f\x01!\xe6\x8e\xfb9\xf4\x15\x86a\x1e\xcf\xe8s\x86\x9f\x007\x1e\xd3'\xea\x90p\xbc\xebZ\xae\xf3U\x87_b\x01Jޝ\xc7k__\xdc帺\xcbiy\x174C\xcd\x10j\xb6\r\n\xfbt\xccK\xc5-\x8c\xfc\xaeH\xb70\xf2\xbcHmFb\x92\xce\xc8\xf3\x011\xed\\\xac'\xc3~\xd1\xf5R\xfe\x05\x03\xeb|H\xac³\xb6s\xb9~\x83%\x11\xba\x92\xc3gʟ)\x9e\xc4i\xa7\x16PH(f\xf8ճu7\xa4=\x02y\x13ٺ\xde\xde\xde\t\xc02\xa0\x1f\xd9\xe6\x03\xdd{\x88\x00\xf8D\xa4\xef!q\xf4\x91\xe2GJ\x1f4^:\xed\\|\x19n\x13\xc74\xac\x89#&\x19\xe2\x98J\x8e\x85tA\xb6\x1f\x1a\xe2\x98*>\xe1+:c\xba\xb1\xda\xc7i.\f?Q\x1c\x81\x13@\x03q\x02\xea\xde\xc3*\xa8\xf1!m4\x84\xe2C\x93\xa5\x1d8\xf1\xddd\xab<M\xea\xf241\xe5)<\x97\xf2\x14R\x94\xa2\xfe\xa4\xabqg|\xae\xe4)\x04\x901\x85\xd0\xe9O\xf0sb\U000694a7\U00079527\xf1y]\x9e\xc6\xe7J\x9e\xe0\xe7\xcd\xe5i\x92\xbe\xa3\x0e\xc6cd@\xc0\x8c\x9b\xe0\xc7D|T\xfc݅\x8bOE\xf0\xb8F\xcbx-,≴\x8cC\xed\xae?|\xb28\xa8ρ:>\xc3(\x15*]\xedTt\xbd\xa4\xbeyC\x9a{ \xcc\xff\xb5\xb8\nh\x90\xe9\x87t\xe5fL\x97r5\x04\xa2l(\xcaNĭ'\xb5/\xa1\x03\x8dM\xa0\xb1\x02\xba\xc9\x1ae`\"Y\xe3\x1eamn\x99\xb4\xf3\xfcGyϸu\x1b\x13\xaf\x80N\xe4UP\xad5\x97xc-4\x8dPJ3n\xa3&\xa7\x97g\xb5\xbd\x9do6\xaf\xc2y\xec_\x06\xc0\xceKb\xe7\xe5Yи\xfa\x9c0\n\x04u-\x86+b,\x8b\xf8\xb6$1\xa8#\x1b7 \xdb\xd6\x05\x84\x91\xd8Nh\xb0u;\xb0]\xec]\xd7w\xb7\xe8\x92Jo\xef\xec\x89\xff\xe4\xe8m\xb4\xf7\xb6\xff\xe4mt\xe77\xfa\xb3\x17@\xdai\xfc\xe2\x8c\xf2\xf1\xfb\x89\xba\xb0b\xec\x0e\xd31\xbd\xabc\xbb[\x82p&\x8b\xa2\f\x17\x13|0\x84G\x1f\x86\v\xae\x0fJ.Lmi\xc7(_\x1f\ti1\xdf\xe1\xed\xbf\x0e-\x878cld\x04\xf5\xfa\xc4\xcbj\xa1\x88 NvZ\xe7&U\x9b5P`I\xb2\x16\xf8\xb1\xee\xf3\xb0\x00\xa5\x8b\x04\xed\xff\x87\xff\xd5o\x90\xf1\xd6\xff\rfƷ~\xb0\xdf/\xe3\xa2\xc4\xdc#\x933\x80\xe0Y\x96b\f\xf7\x1a\xb5\xafq\x16ّ7Fh$\xc2%RPm\xed\x8d\x1d\u0605E\\U\xc0\x11\xd1$=7;\xb4\xe1s\x18\xa0\xb7R\xc5\xea\x12\x96iW\x82\xdd7săuF\xa9\xc0\v0\x16\xaf\xd0J\x95\\\xf7s44s\xb41\xf3>qV\xf4\x06\xdf\xd0\xe8\xd0B\xae+\x12\xbb\x9a\x95R\x81\xfa\xff\xf9\x9f\x00\xf1\x9f\xff9\xc3\u007f\xc6\xf5\xdb^\xb3\xf1OZ\x18}\xae\xbf\xafG\xd6\x17\xa7f\xc42\xc1>\xbdP\u007fL\xd1M̳\x12u\xa9\xe1R\xbf\xdfտ߭\xb3\xe1\t\xc0\x1c\xa9\x9d.\xba\xe0\x81\xaer\xc4\xed.\x1d?\xd2\xef\x19\a\xe3\xa0\xdfc\xf6\xee>\xd0,\xbf\xdb\xe4\x88x{F\xff\x8e\x03m\x9aE\x16$tr\x91h~\xb3\x04\x9e\xc3,\x80O\xe2\xa0\x16\xaa\xb2\bǌ\xb3ff֘\xe36Rָ\xca2+\xab|L\xee\xde5\x9cLr\xba\xcf\x17\xe8^(\x16\xc4l+Ę!\x8cSH\xa37\xa20\xbf\xfc\x9d\xfa\xc2\xd1,&\xe2\x12-\x12\xea\xa0\xc0 N\xa5\xcf\x1a\xd2\xc7-\x84\x9f\x9bk\x8a\xba[\v-Y\n\x0e~\x8d\xa2!\xc5B`nD\xbcˁ\xac\xbd\xc4\x12\xads$\xce\\\x89\xcea\xc5\x1a\xd3\xf6\xc0\bq\x87wL7\xcf;_M\a\xd3\aө\xb8\x80\x821\xd6ޯbX\x03\x97\x9c\x19\xc6\xe3\xe8\xbe\xcc|\xbf\n1q0\x98N\xab\x12\x908\x0f\xa1m\x04\u007f\x1f2\xa2C\x99\xf5a\x95\xcb*\xaa\x02\xe38\x99q\xea\xdd\xe9\xddh\"S\x93⽠g\x1a\x1fN$\x8aqJN*X'\xfe\xafJ]L\xce\xe3(L\xe7\xd9\"\x12\x85ƓHe3&,QU\v\x89WI\x96b\xcc\xff\xceW\x0f\xc2\xd18\x1eɬ<\xbb\xc6\xc7+\xbe\n\xef\x8e\xc2Q(SWy\xba\xb9\xce2\xc2\x1f\xc5\xe3\a\x0f$\x1f&a\x14\x97\xb2\x92\xbbӇq(\tØ\xe4e\x1e\xaf\nŌA\x95\x95MhB t\xa3{\x0f\x87\xf2\xd2\xcf$\xcb\xf12\n6\xe2\xfe\xf4\xee\xa0J]\x80\xe4^ǹ\xac\xe9\xde\xe1ûq\xa4e\x17Iz\xc9\xe5\xa6\x0f\x14#'y2/\xfe\u007f\xf6\u07bd\xbf\x8d\x1bY\x14<\xffn>E\x9b\xbb\x99\x90\x16E\x93\xd4[\xb2\x9c\x9f\xe3$\x93\xdc\x13'\xd9ȓIFW\xe3_\x93ݔ\xda\"\xd9\f\x9b\x94HO\xf4\xdd\x17U\x85G\xe1\xd1͇\xe5d\xceݙ\x9cc\xb1\xf1(\x14\n\x85B\xa1\x00Ta,\x8e\xff;\xe9w\xf6\xf7t\xfa2\x1e{\x83\a\x13\x95S\xeb\xb8\xc72L\x8d㞕q\x9d\x0f\x93t<%\xca\b\xba\x1c\xb6\xad\\\xa1k 1O\xe0?+#M%\xc0\xc3}M\x17\x99\x13\xaer{\x13\xdff\xd8J\xd2;:䭌\xe2k!4\x90\x1d\x8f{\x0e\xe2\xf90\xbbKuk\a\a\x87\xbd.\xefp\x8e\x8f\xec\x88p\xc7}\v\x91|ڿɰ[''{\xdd~\x9feM\xd3D5fU)\x90\t!+=99<\x8ayV\x1ak,\x8e\a\xbd\xfe1\xc7\x02\xedǊ\xf6\xfb\xc7{\x89\xd5\x05\xccU\x94\xec\x0e\xf6\xc5\u007f~n\x1a\xce\x15\xd2\xe4\xb7y\x9e\x15rT\xfbi\xd2a\xb9f\x0e\x9c\x88AH\xf6TV\x9aN&\xf4\xb0M\x90\xa5\xb3\u007f\xc23\x8aۥ\xe1\x92\x1ec\x9fl\xa4p<<\x81\xffxz\x1aHϓk\xc3ϝ\xf4\xc4L\xcfA6M\xc5\"N\x93\xbdׅ\xff\xa9\x9c!\xcc\x0f#\x91\x06\x83x\xa0\x06@\xe8\xf2B\xdd\xd3D\xeev\x8f{\xa6\u07bc\u007fSd1\xd5a\x82\xe0:\x16\xaaN/\x9f\xe64E\xe0?\x95s\x93\x173\xd3\xd01\x93\x8b\xc0\xf2\x04)9\xd2\xc3o̓$\x16\xd2C\xe7H\xaa\x1c\xb7\xe1?\x9d\xa8\xf9\xff\xd8\xf0\x10\xa6.ӡ\x98\xea8\x05\x92\xc1@s\xab\"\xa2\x05\xe6&\x1f\xa7\xcb$\xbdׂ\xd5d\xcc\xcc\b\x1e\x9e\xf4\x94\f\xcd\xc6\xe0%^\xf2o?9\xe8\x1f\xf4Y\xce5\xd2a\x1f搢\\v\x97O\x97\x92\xd6\x06\xbc\x9e\x8b\x83vzx\xac@\f\xe3\xbbt\x9c\xa4S\x9c\x00\x87\xe9\xe1 v2\xc4h\x177\x12X{p\xa0s\xefǚ\x1eG\xfd\x81\x99\x86\xc3TL'1\v\a\x03\x9aU0\xdeZ\xb2\x0fA\xcbS\xfc\x13'\xc9qz\xc8s\x8c$\xe5\x04\xa3,)\xccR.\xfe0G\x8f\xa2\x19\x04\xc1aqҵ\n\xc9\x01M\xf6\xe0?;G\xf6⤝\n\x86v\xb2J*\x99q\xea\x1d\xf6;<ǈ\x13\xd1\xef\xb6\x16'\x94\xc7\xe4I\xb7\xdd\xeb\xc6v\xae\x99\xa4\xc7G\xfdt`gryrtt||r\x12\xc8N˲gi:T\xc0{\xed\xfe~\x92\xf2lF8\xf1\xbf\xd4\xd0`\x94\xaa\x15\xa7\xcd\x13u'\x84\x88M\xf6\f\x9dǔ\n\xf3[\x8f+\x93\xf3\xd6,\x16*GNt:\xe6\x9a\x01DD\x98\x8fl\x9d\xe4\xf0\xb0\x9fhRQ\x01\xbe\xe6i\xe6\xa2,\xb3\x04\xf4\xe2\x83\x03=l\x949\x99O'C\xacy\xb2w\xd4NzV&\x1f\x9e\xbd~o\xef\xa8cgs\x81\u007f\xd4;<NS;\u007f\x02\n\"\x13\x12\x03\xb1\x18Z%,\xc1\xbe\u007f\x9ct\xf4\x02E\xf9$\xda\xd5D?\xea\x1c\x1c\xab\xe96ʒ1\x9f:\x9d\x93\xceɑ\xa6\x99ح¥ʑT\xca\x06\x9auFY1[N\xf3B\xebe\xa9\xeeR\xde\xef\xc7x\x13\x9a2z\xaa\xa5q|\x17\xbf˙\xb4N\xd281yK\xadi\xa8\xd6\xc5\xec\x1b\xe2\x83]Q8\x19\x1c\xe8\x81\xc7E\\\t\xbfv\x9b\xa7&Ӹ\x87\x03\xdb;N\xbbj\x80\xf8\xaa\x1e\x1f\x98\n\x98,i2\x18\xec\xf3\x1c5\xd0I,\xc6R\xb5;\x89\x87\xa9%\xd8\xd34=\xd6샹zƋE\xfd\xe4\x98\xe5X\x03\x14\x0fD͔\xe5Z\xc3#\xf4\x99\xb6^c'\xf1$^Ƃh\x13I\xcfA\xa2\xe89I\xe3\xfe\xcdd>\x18Hjƽ\x13\x9d3\x9d\x93D?>\xd8S\xd3\xc2\b\x96~\xbb\xaf\xd8s2\x9c\xe3\xd8&I\xdcN\xd4`\x88\xfdWb\xd6\xe2^;5s\xcep\xf91\x1f)MD6\xdf\x04s,\xb5\xfa\f\x1a\x8e\xd6q\xa6\xf92\xd62c\xbfsx\xa2y\xa7\x10\x82{\x98\xeaZǽ\xfd\x83Ξ\xce\xd3\xe2/>n\x1fuu\xf281\xed\f\xf6\xe3\xfdC\x85\x81%\x14\xd3\xe3\xde\xc1\x91\xc9(nҡ\u052c\x05g\xa9\xb1(\xb2t<F\x89\x12\xb7\x0f\xba\xddD'\x0f\xefh\x1d\x13\xa4\x13\xff\xa9d[\xac\xa6\x8a\xa8\xd6t>\x8c\x0f\xcc\"e\xcbZA?\xbd*\xd8b\xd6\xca\x19k\x01\x1a\xeb\xe9\xe7K\x04\xb1APy\\$\xef\x1f\x1ew\xf5r?\xa3\x85.\x11)z\x95\x9e\xa5\xb4.\xb6\xf9\xba\b\xbb\xc6\x19\x8d\xb3P>\a\x89\xe2\xe4Y>\x8ag\xb9T#\xf6\xf6\x15=m\xe9#\xf8%Q\x80\x8cJ)\xa6JW\xd3\xf9\xfe&\x8dg$S\xc4\xf6iO\xa7\x1aEάĘZ\x8c\xf2[\xbd3\u052a\x82\xbd\xbahΣd3\x17c\\Jؽ}f0\\#~\x97U^ǻ\xb3\f]\xe6\xf5\x90k\xe7jX.{\x1d#\x02\x9c-6\xa3ẖ\xb8\x1b\xc7\x12\aǜ\x9f\xcb\xc6\xea7p\xb2t\x03\x87\x947\xada\xd8\x12w\xd3dv\n\x85\xa5,\n vD\x81\x1d\x01`g\xe8\xd9\vD\xb6c\x86\x13\x8d\xaf2\xc3\xf1J\x8fk\x86\xb3N=\xc9\xc6q#--\x85\xfc;\xd4ީ\x1eB\xe8|\x14;T\b\x19m\\\x1a\x96#\xe3\x18h}ے:\xdb\r\xf7\xd4vz\xd3wY\xac\xff!,\xd6W,\xd6\xd7,\xd6\xd7,斆\x13\xe3\xcf\xd5\t,Հ\xa3r8\xf3\xbd\xd1\xe6a\x95W\x97>\x13\x80\x1f\xe1\x9cQ~\xa3\r\xfb\xa6\xd1\x00\x13\xda\r\xd8Ϡf#\x00\a` \xc7\xf6\xc3\x1c\xdb\xf78\xb6\xbf\x9ac\xfb\xebr\xacuNj\x8fK\x9f\xd9G;\xed\xb6\xe6\xc7\x1d\xd5\xfb\xff\x06\xcfb\xa5Le\xdb -\x8c*\x98v\x1d|襨\xc4f\xf7\x83\xb1Y͵\xf2\x04\xd9\xc5Fr-\x9dV\xd8\xfe\xb7-\x88C\xf2q\xbd.\xef\x0e+\xb8\xb1>\x04\x0e\x1a\x02\a\r\x89\x83\x86\x15\x9c\xcek\xc0\xeci\xdd0\x81\x8a\xbc:4\xbc:$^\x1d\x02\xaf\x0e9\x97\xd7w\x04\x80\x1d\xd1\xe2\x8eo\u007f%\xc2\xc3\xc3\x16'\xf1\x17\x91\xd8:9h\xef\x1f5Uү\xe4\xa8C~\xfd\x03\xbeZ\xed\xe3\xe3\xe3=\xbb\xaa\xc3\xed\xa2\xf7\xab\xb8\x9dWZ\x97ۡ_\x1f\xc4ݲB\x1c4\x8c[\x18\xad\xc5\xed\x06\x9f\xad\xb8{}lVr\xbb\xbauB\x18\x94\x03V!\xa2\xd1\xf1\x97\xf5\xe2,\xe0\x92\xb0\xf5\xdb<\x9d./\xb0l>\xad\x17\xea R\x03x9\x1cn\x06CT\b\x80\xf9)\xcfg\xfc%\x9d\x13a\x99\x95|\rNdR\x19G\xd3Tn\xa1s\x99\xb4P\xcd\xc8w\x84\xac\xc0}ڻ\xcdd\xf5\x8ab\xa3\xfc\xfd\xea2\xc5\xca\"\xb9S\xc2\xeb\x81\xe5\xc6w,\x141\u007fD\xad\x0eK\xa7\xf5PP\x0f\xa5rz\"$\xc8E\xf6\xfe\xfd\x90\x9c\x13\xd6\x14\xd8\x1a\xd3 \xab\x87[\xb7J`(O^\x05e\xfe\xce\xcelh\x15c\xef\x00l\xcd\xc7pvs\x01^\xc5y\x13\x8d0`C!Y\xdd\x19\\'V6U\x12(p\x11ty\xa5|\xf1\xaa\xdc\xea飋\xc1\xf0Y\xbe|U\x86%\u0382\xcd\xcay\xebg\xb4\x02\xe4\x97}\xb1\xae\xe6\xcf{\xe8\xc2C;?\x97\xdf\xf8\v\xa2\x8a7#\xf9\r\x1fg\x96\xe3\x1b\xc1\x03\nd\xf4\xc4\xe1\x02\x9da\xe3\xfd\xb6\xd0\xf3Z!\xe38\x8d~\xc7\xc2\xf1\x90\\\x91\xaf\xa9(@\x8e\x15eG#/\xef\xc5\xcaO\xec\x8ay\xff%S\xc1\x85\"\xbc\x91\xa57\xd8uU\x12]c\xbd\xbbj\xb0\xec37\x88\x0fw\\F\xb0\fR\x99\x1f\xb5\aC}Q3X\x1a#U\xf0\xa7@\x06%\x89\xb6,\xadh\"'\x1eR\x1f\xfem\xbd}\x9bĳ\xf8\xed[t\x8b\xecFqQ\xf5\xff\U00097a26\n\xd6\xe0F\xcf\x18\x83\x15\xcbl\r\x03\x16F\xfe]\x19c\xc1\xc6\xd4zW\x17\x8ac\x1c\xe4n=.\x96\xc3\xear\x96u\xe7\xf8F\\\xfb\xb8\\\x8a+\xc7#0\xaa\xae\xe4p]\U000e3c56?%T\x84\xff\xfaz\\f\xb1Yx\x0e\x8d\xd9\\\xf9\b\xdc\x10\xcffS\xd7Ӏc\xa7X\xed&\x9bq\x01\xf9\xe0\xb3\xeej\xd9^\x9d$aq0\xe1w\xdd\xf7\xd4H\xcf\xeb\xa5\x0fI\xf2}\xe0ŴC\x9f\x05\xe8S\x11\x1c\x18H?\x03\xfa\x19\xfd\xf7\x17urj\x00\x8e\x19\x9b\xac0z@pK\xdbM<\xd8\xec\x04\xfe\\2j\xafAX\x83y\xb4nq2\x10Q\xd9j\xa0\xe0%\xfe\xbej\xb8\xb7\xfb\xa0z\xe0eY\x15T>\x1e\xabƲ?\x8c\x8b\"M\xfe\xe4\xe1\xa4iV\xe7^7E\xd5Q\xbdA\xde\xf3\xeb\xcf\xfe\xf9\xfb\xff.v\x9e]\xc3\xe6[^\xad\xcd,\xafp\xdc\xed\x8e\x14\xa3س\xef\xb2bf\xcf\xc3`\f@\xf2s\xd5\xcf\xc738uE<(\x98\xa0\xba\xa0i<\x10Vż\xb1\x9a\xfe\x9e\xf9\"\xb5\x10T\xfe\x17\x8c\xd3^U\xd9\xca>[\x85\xb45\xf0r\x1c\u007fJ5\xf2t\xc5PrAYG\xdc)\u009d\xf5m\xc1\xd4\x12\x8b\xc7\xe7k\x05x\x13\xd6.f\xcbaZ\xc2\xd8\xe0B)˧\xe8S\xdf\r\xe8\x1e\xf2\u007f\xaa\x82\x84\xee\x95s\xfc\x93\x12\x8eg\x81G\xd58\x1b\x97\u007f\x92\xba\n\x99\x95\x04\xc6>\xe9\xe2\x92\xc2\xea\xf3ʢL\t\xb5\xed0?\x121Y\xea>\x1b'\xf9=ȹW\xf9h\"\xa4\\r\x81\xed\xd93\x15X\x16\xca(\xf7\xb6\x18\x04Ɩ\x87\xba?\xa6\xaf\xeb\x8c2\xf5.<L\xabF{\"\xb1\xf9Ȓ\x8cw\x80(r\t\xa5\xae\xce6\x9f,\n\xe3ǟ-\x1a\xf2&\xd3\xc5u\x11o\x91\xadܷmG\xfa\xb6\x95\xd4@0\xe8\x12|<\x93\xfem\tAIR9\x05,uO@\b\xbc\xb0\xc6\xf89Z(\x92c\x1f\x00\xc7l\xe0\xc6C/\xba\x85a-\x9fCU\xfd\x90\xbbVSQOɃ\xb2BB\xe5\aZ\x0f@Ԝ\x1c~\x11\x1e\xc2\xc1\xf87|XE~\xd7A\xfc\x96\xe4\xcf\xc6\xe3t\xfa͛\xd7\xdf\xfd\xc1\xc47\xed>\x0e\xe99\xbcu\bo\xb5\xbf\x01\xd9E\xd7\xd2qR\xe67K\xfb\x99\xa7b\xbe\x8b12\xaca\xe6+\xb1F'\xf5R\xffO4Hʑ\xfa\xdf~\xfa\x96&\xbc\xed,\xcfiOT\xfb\x80\x16K\xb4Y\xfb\x1d\xea\n\xed\x997J\u052b[Z\xb4\xc2\x13\xc2!\xe2\xcfU\xf4\x16\xeaU:\x9d\xf9B\xba\x97\xc2%9\x8f\xeeT\xbc\x84\n\x94\xf9\x05\xd6ܜ\xf0\xcc\nW\xa7\xd6\xc9>Z2\"\xd4X鈬\x87Kɐ\xac\x87ʇ\x8e\x95\xea\x01xlğ\xab\xc6j\x9a\x8e\xf2\xbbt\xd5;\x8f\xd4:\xb2\xb6E\b\xedJ\x95\xae\xef\x1br\xd0\x13&\xa66dY\xd9(\xb16\xd2`͉\f;\xe4\x80\x03O\x88\xe1\xe62UO\xa89ui\x96\xc0?_\x8a\xba\xcesk\u007f\xe3O\x06\x05]\xbe\xe4\xed\xdfX\x14\x03%\xa9\xc3\xfdi\xca\xc4\xf9\x04\\\xadA\xff\x95y$\x85\a8V\xc2\"\x9b\xf1oc\x04\x8069\xd9X\xc7\xf8\xbe\n\xc3f\xfe\xac\xbd\xfc\x9a\b\xa2*4\xa6\x82l\x02i\xbe\vt\xcb\xd1O\xe5f+l\xfa\x88\";\xa0\xa81_0cH\xc0\x92\xe1n\x8el\xf4\xf1\xf9\x94\x02\xdcp\xdd\xfbh:]\xbe\xdbٹ\xf2L\x1e%~~\x9c\x16\xecؤh\x94\v;\xe71=4\x91\x01\u007fv<+?TQl\xb4\x0e\xc5\xf4\x18\x98\x01\xe7##\x83\xaf}\b\xd5\x18\x03\xcaH\xe7\xc6^\xc4`\xf0\x18\xb1\x8e\x83\xa3\x90\xc1\x92s\xa6\x1c\x1d\xcd\xd6Ԍ\x19-jU\x9f\"T\x8c\x95\v\xc3Rp\xa1}\x8cS\xa3\xdaw\xd0\xf4:\xba\n\x83OJ\x99\x84\xe4\xd1\xc6#\xee͑\x15cU\x90\xbd\xa1\x8c\xcb\x11k5\x97\xaa=Hy\xd4\fa\xd7v\xd1s\xa6뙓\x15`\xc6\x10#6\x02\x13n5\xbb\x04\xd9\xf2\xff\x18\x8er\u0604\x1eT\x8f\x1c\xb7r\xeb\xe3Ǉ\xc0\xc2q3\f\x83X\x8d;!\xb4V3\xa1\x8b\xb9?\xa0\xc1\xb6\xd5_S\xbdE5m\x10g~1\xcb\xda͊\xda\x19\x1as;\xd95\x98[\xf0I\xbe\x9b\xa64\x89\xa9\x15\xcaf-\xea|hLVV\xcd6\xdc(\xc7\xfcL\x81\x9dL\x94\x9c\xe4=q\xb7{\\E1\v<\xbd\x9a\x1e{Gg\xed+e,m\xac\x17/\xbc\xf2\xf8\x02[4\xb33tHU\xe1\xc7\xdf%\x04\xd2\xd7eqL\xac_^iM\xc9)@Y@\xdd@\x86w\xb4\x14\xda\xeb\x9a.\x95S\xc2(\x87\x8a\x90\x99\xb2\xab\xb1e\xba\xe9qQ\xf8(\xf0!\xe4kk\xabƃ\x9e\xf9$S*r\x96\xbb\xc2\xc6\x12\xd6\x01\xbb\xaa\xba\xc8f\xd55E\x81P\xe4e\xaa\xbfZ)\x9f\x8f\xcaN\xc8ݐݛZ<\xb4\x95\xcb\x1c\xae\xaa\x90J\xa1<N\xc7\n\x94\a\xd9\xd0!&\xa5\xacw\xcaYu\xd8)!\xbbG\x9d\xbaAk2P\xaaj;p\xc2\xd9\x0e\x1cp\xca\xe3M\xa1\x12\xff9G\xf1\xed\xd0q\xa9Ԇ2\x8e\x94\fx\xebI\x9c\xbf\xfcE\x92\xa3\xfa\b\xb4\xea$\xdc\xd6\xe2\x1f\xfd\xec\x13\x03\x9d\x87&\xccG8~\xe6t\x04W\xac\x82\x14d\xa3\xb57Ug\x91\xf2\xe9\xbc\xe1\x914\x16\x00\x88\x82\xecc\x8a\")\xa5;|]d\xbd!z\x1d\x87\x0f.\xe8,S\x87\x1c\"\x1e\xf0\x91B\xbd#\xa2k\x1fD+\xfbv\xd5qNnۍ\xfa\xf9H \x15\xb3\v\b&Ż6 \xea\xbeҹ\x95\xf6̍\xc6Q\x0e\x17\xe2\xc6\x11\xf2\r2\xc87\xf5\x95\xdcU\x11\xee\x0e\xa2`N\x04\xc0\xf41N\xac\xf0\xe6\xd2\x1a'V\xaaq;\n\x9b\xa6\x93\xc4\b\x8e:(TVɁ\x04\f\x17\x15\xa5\x00p\x97\xf2\xeb\x8aE\xbb\xdb\xf6Ъ>\xb6ϒ/\x85\xc8\a\xbb\xf6\x0e\xb6DBe\xdcҊ\x8aB\xd9\xea\xd0:\x87*\x15\x03\xb2jX\x01\x98źN8ӀDzKv{dQc*\xc5\xc9\x06\x8e\xc5\xfd\x00\xa5U\x12\x13*\xaciF\x03(U\xa8\xea\xe6\xf8\x1c2'j\xe8@7rg\x96?\x1fV\x11l4\xb1O\xf2\\\xff6\xceU\x90\nPR\x02\x96vh\xabE\xb5Zno\xb0\f\xb2\xeb\x10\xfe\xc6\xca\xec\xaa\xd5\x05\x16&N\x03BT\xed\xb2\xaa\x0e\xf9\xc0%lVv\x03r\xdd\x1b\\\x1f\xefN\xe0\xa3\xddǲ\xb5\x02\u007f\x1d\xfcܳ~\xd0%\x9f&KM\xd2a\xbc4\xa1.\x90l_B\x9aUh>\xc5\xd8\xd1n9\x99\xcc\ue304#w;\x02\xc0@0:I\xd3\xce\xf86\x81\xab\xb8;;V\xe2\xf7b\xc1\xfd6iF_\x82R?\xce\xef덒[\xde\xf2V\xab\xb7\x85\x02\xaf^\xf2\xd8\"\xba\x8a\xae\x1a.\x0fA%\xf0\x87mi\x88\xd6=g\xeb\x8el\xd5EA%\a\x9c;\x80\xbe/-\xabqu\xa6a\xa0\x9d\xfa]\xd0\xc0T\x17웻+n0~\x10b֕D\x1f7\xef\x96_\x83c\xe8\x0e\x11m\x86\xab\xef0\xb7\xcaw\xd1ᢥ\x97\x96\x9d\xd6ܡw\xb2͙iՑ\xea* \xfa \xb0\xea\x9cp\x15\x10\xb5NT\xac\"\xab@\x8c\x1dN\xf6\x16\x8fU\x00\x1e\xed:\xf7|R\xb6\x89\xdcJ\xdeJp\xc1}\x9cټ\u007f\xe0\x16\xb1̖\xf6\a\xddЖ}$\xbbT\xd9um\x17\xc7UW\xb7\xff-\xefe\xf3xD\x99\xff\xbe\xc1Y\"h5 U\xc4];\xfcD\x19\xce\x16\x97\xb6\x8al\xb9\xa2\x89\x12݃\x922_\xe9\x88y\x10\x97\xab^ðt\xbb2$Z\xa3\x19XJi\xea\x85qi\x86\x97Բ*2۩\xa5Q\ncˣSeAU\xb8\\O\xe6\xd1\xc1<\x9dJ\xd7Ye\xd1\xfa\xdc\x1b\xa1\xcf##\xb8\x99>\x00\x8b\x8ay\xc0r\xea\xafC\xbc,_\xfbL\xba\xbb\x02\x04\xba]A\x90G\x93t\xffS_\xac<\xeak\x81\xa0\xf5\xab\xf2\xcdI\x8b\xed\xee\x02\xef\x02<ێ\xff\xe8\xa4U\xfd\xf2\xa4D̹R\xcd?\xe3;\xd5ckeI\x05\x1a\xc1&\xae\xe2\xccUg*\xe1\xaa\xcc<\x80\xd4c\v۰\x9eMWy\x12\xf9c\x96\x8d\xd2\x06I2i\x13\x10\xbf\x1a~T\xbb\xec\xd1\x1f\xcbHj\x16\xffy6\xa3(\xb1\xf9\x848[\xeb\xf1ͥ}\x88\xaa;v\xab\xe2-\x18łS\xe4Vt%w\xcfЫ\xe7\x893S\npE\xed\x14X1_֙1\xf6\x9c\t\xde\x0f\xf8\xa3\xe7\xc3\xff/OT\x1e\xcdB\xb4\xf6AI\xf5\xea\xf0XG&\x1f\x91O\x1e\xe5YY\xe9\xfd{\x80\xfe\xe6>M\xc7\xccA\xce\f\xbe\xa5wxv\xfb\xde{F\xb1\xc6%|\x03\x9e\xd0v\xa0\xb3+\xfe\xeb\x11\x02a\xb9\xd4\xf8\xfeBP\x182\xfc\xfbɺ\xf9\x04c\xf6\xf2\xbb\x8c\x10\xe3\x13k\x11\xaf\x90q6A\xb30\xb9\xe9\xf5\x9e\xb5\xb9\x1d\x1f\xa0\tDu\xe9'\xba\x16\xfay$]\xff\xe2\xa7\x03A>\x13\x81\v\xdbȫ\x01\a\xf7\x91\xba\xa9\xea\xb4ތ\x06\xf5\x19;A\x0fߏV\xfd\x85Ⱥ\x1f\xd4\xe3\x95\x17\xa5\xb7\xa2B\xd5]\xdfm(S\n\xaf\x8aZh\\.\xbb4\xfc\xfdE\xe0\x94\n{V\xaf\x01u[p\x82\xa2\x98ξ\xf3mh\x0f\u05fe\xd5\xd7j\xbd\xe8\u007f\xfc{,\xec\x01M3\xf3\x04+$G؋,\xdd/\xf7\x1eG\xe51\xd7Z\x0f\xa8\x18:+\x85\x0eCd\xadq\n\xca\x1f)}\xfc\xc1*\x91\xcbbt\x02\x1d\t0\x1c6\xa99\x8e\x9dxm4\xb7\xab\x9e\xafU\xbf[\xdbh\xaa\x13\xb24\xe1\u007f\xe4/\xad6\x99\xdb\b\xa30\xc80\xd1\xe7\f\x15\x9f\xd6+\xc7n\x9dG\\\x9c\xf0P\xbeVJ\xef\xc0\x93\xa6\xea\x97D요\x19\x98\x86z\xf6\xb3n'6xwPK\xc7\t\xb3o\xf0\xae8/\x11\xf8\xddy:>|\xcb\x1a\x17{`\xd8}O\xfc\xa7\nBu\x9a\xac\xf7(!ԕDڸ*\a\xa4\xec\xdcw\xcdW[\xa1Sa\xb3\x85Џ\xb8\xf8\xad|W_tΊ\xa3\xdfU\xaci8\xb5\xaa[ϓ!\xcf9\x92\xaejV\x11j5\xa5\x8cu\xef\xcf!\x96i_\xbf\xdb\xe84\xb7\xa3]#@<\x0f(\x16\\AI\x83\xd3fĬ>\xe6\r<\x14\x028\x8a\xaf\xb5\xd9\xd0]\x02 \x96\x88\x89\x18\xe3\xe9\xa0X\xfek.I\x9aQ\xec?)\xecy\xf2\xc1\x11\xbe\xfc\xf9 \xc6\u05ec\xd5(^\x8d%\x8dO)\xef\x0e\x03u\xf3\x88Y\x82\xbc&\x90\x8f\xa78\"\b\x19\xf8\xc7CQǠ\x17\x80{\x01\xc0\xbdFh)\xb6b\x01q\xa6\xb3\b\x82\x91~L\xc7To\xbe\x87\xcfS\b\x97\xb2s\x8eQ\x88\x18\x82\xbe\xf5\x9f\xf5\x1f\"d\xf1,\xb1皾͘y\x1f\x13zK\xb4\xf8\xff끥\xfd6O\xe9\xf20F\xe54u\xa1\xafw1O\x82\u007f\xf3\xb9:\xd8Ŵ\xd0U\x8a&\x19.@\xb96[\x93r\x95\x80+l\xe1͜\x92 <\x82ܓ\xac\xf8:\x1bgb 0W\xbb40\xafP\x91Y\xcd\xf9\xb7\xab\t+\xf4-\xda\\\xea\xfb,Yr\xc5\xec\vXV0\x00\xfeh\xa9B8\xc4\xee\x05\x92H\x16\x92\b\xc0\x9f3+C\xf5\x06\xffZ\xc1EKQ\x81(M;lT\xaf8\xdej\xfc\xdc\x1b@\xa7\xfaW\x93\xd1\xe4\x14\xffU)\xeaJ\x037.\xc1]\xbdS\a<\xbf\xf0\xab\x9cM\xd8lb\xbaﲋ@\xad?L\xe3\xe9\x1b\xfa\xac\xbb\xf9z\xbe{0\xc1\x9d\xa0\x979\x98\x82.\xab?\x8bY:ac\xfb\xc0\x99\xb35\x00/\xfee7[D\x9f'E\x9a\x80ؾ\xb78E0n\xc7#\xf0\x19\x0f}>c\xb1^%\x18\\\t\xee\xa3]Q\xb7\xc5ǜ\xf4\x8b\x0e\x8dz\x03r\x15R\xe2\xa7\x1a\xa0\xba\x04b\xdePw\xa8\x00\x8c\x05g\\C\x05\x00R\xf7%\x82!\x91\x00 \x15\xe0i*zP\xcc^\x8e\xb3\x11.\x1f_c\xf6￫|\xf2~\xf7ӊR\xa3\xfc\xfd\xaa\"\xf9J\x18Ei\x89\xd2\vYB)V\x9cc\x04L\xe7\xc8Z\x9aF\xf9\xbc\xb0ou\x91\xeb\x96t\xeak\vX\xf6\xc7\\\xf0\x98)\x852.\xbd\x13\x82\xe7\"\x9fO\xfbi\xe0\xb6\fV{ۛ_\xef\ufdcf\xf7 r\xde\xdf\xd3\xde\u007fg3\x19\xbam\x1c\xdfe\xd7x\x99T\x14\x9b\xbe\xbc\xc6'\xac\x9fG\xbb\x1d!\xccۊ'\xf3\xb9\xeb\u070fa sW\x9b\xb6\f\x18\x17iՂ\xbd\xaf\x92\xc5?7WNTS\x18[\xc9\xecJ \xd5ђ\x81L\xd4P\x98lTG\xbb\xf9\x80l!\xae\x04J\xd9 C\xe9\x8a\x05X\x92\xb3\xb6c\r\xa5\xc6\bRѭ\x16}I\a\x82&\xeb\x95M%\xb4 \xe4B<]\xe1\xb2\x0fJ\xbe\xa5\x92\xf5KX\x03;\x91\xd8}\x9b_v\xb8\xbb&\xdd\xfelx\xad\xb7\x86\xf9\xf5Z-\xe5\xd7u\a\xc1\xba|ǭ\xf2'\xfe\xf5\x1e\x95\xf5u>\x15Ӂ\xec2\x03\xfc]\xaf\xb5\xdaiMߺ\xd20\xc0\xf13\xc7g\xe1 \xa4\x9dCwDG\x17\xf6eH\x05d\xbc\nȮ\x05ew\x11 \x8b\x03\xa1\x8c,\x00#@\x96N\x00b\xf1\xdbtV\tҴ,8=]L\xf2\xb1`\xaaz\xeb \x00+\x9f&\xd98\x1e\xae\x83\xa1,Z\x87S\x06\xbd\x80\x9fF5\f\xc0PSkb,XS0gt\xe5\xef\xf3d\x93}\xf0\x91\x9fO\x97\x9d\xf6:\x9dP\xad6Z\xd8\x0e,g\x06@\x05\xf4\xee\x87B\xefVC\xef}0\xf8^%\xfc\xfe\a\xc3\xef{\xb3Ȣ\xfc%\x84\t\x19\x1c\x1dA\\\x01\x19\x9bL\xcc#\xf8\xd9\xed\xc7\xedn\x1f\u007f&\x87ݣ\xee1\xfe<\xd9?<\xea%\xf8\xf3\xb8\u007fp\xb8\xdfß\xe9\xde\xd1Q\xbf\x8b?\x8f\x06G\x18\xbe\x00\x03E\xf4\x92.\xa5v\x8ezi\u007fP\xa3\xa0\x98\x0e\x1e\xdd\x00\x1eq\xda?J\x8f]\x94\x06\x83^\xef\xe8\xd8\xc5\xee\xe48\x19\x1c\xc7.\xa2\x83\xc1\xc9\xf1ɡ\x8bs\xff\xa0׆\xa0\x1b6\xfa\xfd\xfd\x93\xfeɾۓ\xc1Q\xef0\xf1:\xd5?\x82\xff\xdc\xfe%\xbd\xa4w\x9c\xf0\xaeb\xc3i\x12\xa7\ae\xbd\xee\xc9n\xef\x9d\xec\xf5\x8eN\xb0\xc2A\xf7`?\xdeß\x87\xbdC\r\xa6/\x00\x11\x05\x0e\xf7\x8eN\xf6N$\xfeq\xf7\x80Z\xef\x1d\xf4\a\x87\xb2+i\xd2;\xe9\xcb\x02\x87\xc9^\x87\n$'\xa9\xac\x96\x1e\xf5bY-=\xea\xf7d\xb7\x8f\xf7\xf7\xfa\xb2@\x9c\xec\x9f\xec+r\x1ev$\xdc\xf4\xe8\xe4P\xc2\x15c\xd49\"$ッ\x8e\x84\xd0O\x0f\x13I\xe4$\x15\xf8\x1e\x96u\xbb\xaf\xba\xdd9\xee\xca\n\x87\xbd\x18*\x10\xc9\xfaqJH\v\xf4{)Q =<8hS\xd9Ar\x9c\xec\xf5\xe5\xcf8\x95\xe8\r\x92\xa4\x1dS\xaf\xf6:\xf1\xde\x01\xe1t\xb4\xdf\xdf?\"\xb8q'99\x91$:JO \xee\a\x1488\xec\xf5:\xb2ᓸ\u007f\xac\xc75\x91\x9c\x1f\x8b6z\x92\xf4\xf0\x1f\x95\xc5pg\x92\xb2=\xdd\xed\x13\xf8\xaf\x16]Ys\xf9\xb79\x04[\x1c\xa6\xeb\xc8VU\x16\x85\xeb\xe5U@2P\x89\xf7\x1b@{\x9f\x82+\xed\x8eY\xca\x03Pg7Ӵ\xb8ɇ\xc9:`ua\xa1&\xb4\x0e\xb8\x92\x10\x80L\x8aL\xe5[\x04\rX\x95\xad\x87\xe1\xdd][\x9a\xcd\xddu+\x9e\x06ţ9\xe4\x9a\xf6\x1d+\xe6\x14D\r\xba:\xfa)N\xb2yQ\xf2\x92\xa9\x19Ma?!T\xe8\x95\x05c\x80X\xcc\xe2\xa9Pѯ\x87e\xae\x9eȣ\xba\xc0Y(\x94\xfd\x1f\x06\x03\xa1\xa3\x8blh#\x1d'\x1bWL\xc0\x95@]T\u007f\x0e̓\xdd\x15S\xe2\xb6D'\xeeH\xe0I\xdc\xc0_\xbb\"\x1d\x94\xab\x01\xa6\x89j\xa8\xaf\xfc\xf8-\xf8\x97j\x83\x83\xa9\x1á\xbev\xc4\xd2ϋ:\xd6(tR\x01q\xd9!\xa9߱Ju\xa0T\xc7*\xd5q\rR\xa2\xc9\x17\xe7\xac\x1f\xaf\xe3\x05\xc4\"nC\xf3\xaf\xdbM\f,\xdc\x01\x9b\xd3K\xf3\x93\xa5Fm\xc1\xbe\x9d\x88\n\xee\xae]\x92\x92\x14\xfc\xb6\xa9\xd56\xb5ںV[\xc3_\xb7$%\xfd\x03\xc9\xf7\xb1{\x81\xadH\x82\xc9ԧ0^\xac\xe2S\x18\xac*\x98\x18vv\x80\xe9\x9d\xc8\x00\xe98@\xf0\xfb;\xd9A;\xbf\xad\xf3K\xc9\xc3\x1a\xa1\x0f\x02\xd2v\x80p\xd2\xfd!\xfdi7\xdb5j\xd3\xf3)`\xa4Aę\xf4[\x93\xde\xe4\x92\xc0*\xf4\x83Io2)`\x95\xb9\xd0\xc9M=ۭ\x02_\xc9D\xc2L$\xb4l\x9c\x8c9\xdf\t\x98\xef\xfb7\x90\x13\x8e\xd5\xd7f\x15\xb7\x9b\b5\x9f\n\xa0\xcel\x15\bX\xef\xd5\x01!\xbb\xff\x9b#\xc4\xea\xab\xd6<\x92n\x84\x90E\xeb\xcd\xf11\xd5\xf5\xf5|g\xf06\u0086\r\xeb渤\xd6\xf0G\x0e\x8fl\x84G_\xc0\x9f\xe6Y\x12vH\xa0\xe2\x9d\xd7W/\x80b\xaa\xac^\xfc\x1aѳ\xa8+\x12\x00\xe4:+\xe0\x8a\xa5\x0e\xc1\x05\xd6;\xa7ߗl\xf1i\x88\t>m\xb2\xa5\a\x13\xa2\xab\x90\xbf\x05I/˜\xc0\x9b\x81\xfb\x8ajY\xc4~\xd9\xebչ\xc0\xed\xa9^7w\xa3N\xba{\xc8T\x11ت\xaf\xd0q\x04,(\x05\xbb3\xa5\xe94B\x18A\xa1o\xb5\xa5%\x9fza\xbd\xc9,p\xca\xcb\u007f\x87I\xb4\xf9\xaeQ\xfen\u007f\x98\x17iR\v\x94{\x859\xb24\u0603w\xc9i\x9e]\xf6Bd\xd0#u^2\x1e\b\xcc\xfc\x82/!Y\x06Ԏ\x8b\xac\xb0\n|\x01)\x12\b\xe6\xee\xe6\x93t\\\xf3\xcb\xfc0Q\x96vY.\xd4\a,ɻЛ\x8f\x13\x88o\xc7\xcb`\x92\n\xe3M\xdbc\xab\xc0+\x99([Se\x02\x88\xa9\x92\f7]:\x84\x9e*\xcf1\x1c\xe5\xe3|\x96\x8fm\x1c_\xcbD\x1e\xcb.8\xfe\xebĵ\xa3cV\x91N\x0e\xd1\xceX\"!\t\x06X\x89\xef\xff#M\xb0\xe0\x01\xcfD\xb4s8\x10\xa9\xfcE\xfa>KQ\x15F\x95\xbc+&\xc6\x1eh\xe6\xf8\xa7MV\xc1P\x8d\xae\xaa!\x8bvW\xd6\xd8\xe35\x0e\xad\xa6\x0e͆JN\xb4\xd6T\x88\xa5\xb0\xb5\nz!\xe7\xa23\xe7\xd4\uf7f0\xae\x94\xa6\x1a\x1a.B\xf8\xb5\xc0Ӹt\x96\xcaOV0\x96b\x19?\x96v\xb9\xa5%l \xc92%zs\xaa5M\xef\xd2i\xe1 \xaa\xa7R\xa0\x1e\xa6\x97W#\xb0\xd6\xee(\x8dW\x8b$(\x15\x16I\f\xcc\n\x82˖\x1c\x88A\x82khHp\xfc2\x04\xa7OV\xd0VZ(\xbb\xed\x14o\xb3\xf2\xb6NA\xf9\x1d\xa7|\x87\x95W\x03\x8a\x1fK\xbb\xe0\x92\x95\xb3\x94\x03\xcau\xd0Xr4\xd8\nN\x99\x0e\x0eˎ\xb32\xa5\xb1K\xf5\xfeM>M*7\xb7X\"p\xbf\xab\xa0\v\xf7x\xb3X\xae\xb1\x05\x1ew\xc8\xcb\x02\xcdh\xe6\x97\x10\xfd\xbbƝe\xe0:\x01i\xe8Ek\x02\xea8l\xa9\v\b\x91&\xbe;\xf0/\xee.ş6\xac\xf0\xf5\x14nH\x16\x10\x8f\bOs\xfa\xf3\xe9]j\x97\xa7\x9fm8\xbb\xf0rg\xf0s\x86\xb9ԒJ\xc0<li&[\xa2\xba,\xdf@n\xf8z\xbe&\x9a\xeev\x91\x0e\a\xcdh\xa0.XY\x044\xf7\xd1\atՂ\nK\xea\x81\x06E\f\xcc3\xcd}\xfb,`\x14()\xb8\x86U`\xfd\x9aΨ\x99\xfbrBS\x98\x9aw\x10q\xfb\x14\f\x05\xe6\xbbs\n\xf6\x02\xfd=i\x83\xe5~\xaat\x1dm\t\xd0)\xd2\x10\x10\xb1\xc7\x17\x93\x8e_\xa7\xe3\xd5\xe94\xe4i@ٝd\xc9<<L\xa0\x99#-\xa0)\\~!\x8bG\f\xec@ߝ\xf0\x05g\xc1>\x82#&\xa1\xfb)rOi6\x8alG\xb9S\x8f\xa3\x17J\xcfk\xb0\xdd\xe5$\xd8\f\xf1\xe1TH\x83I\x1b\xacF\xe2o\xc7o\xef\xff\x15+\x1b\x81\x9ft\xdc\xfd'MN#>q^_\xc8\x19K\xf3\xd2\xc9|#'\xeb\xd4ُb\xe6\xe3nF\x11\xa4\x91כon\xa6֞o\xba\xcev\x0f۴4x\xc2B\x13j\x8b\xed\x1eV\xd5[=F\xf1\x8d\xb1\xd0#\xb29\x16TU\xdfX`C\xbb9-\xfe\xb4\xedo\x19F\u007f\xc6\x16\xd8\xc7\xc5\xcd\xe0K\xaa\xd0B\xae\xf3\x92CN=\x9fU\xa1\xc0\xc2:A\xa1\x8e\xbc\xe3\xdd\xc0\x13\xd3\x1e4X\x1a\xd3@.\xbc\x8b\xaaOڭ%\x88\x80\xbd\xd6Rn\x9d\xf1\xcd\x13J\x0e#\xae\x17\xa7\"A(E:ay\x1a\x8d\x94\xdct\v\xee\x95\x17\x14\biW8\xd0\xce\x04w\x90\x93i\xfeN>\xab\x0e.\xf3\x13p@$\x84\xde+\xfa\xe8\xe0\aI\xae\xcb.\xffػz<Af\x902\x05\xd4@\xfc\xa8\xf3d\\C\x99\x1e\x10\a\x8b\xc7\x13\a\vτ-\x9b\xb5c,*\\|\xa1\xb0x<\xa1\xb0!.\x1617\xc7g\xe2\xd0;\xb2Gg\xb1\x0e.\x81\xbc\xd0D\\\xb1\xab`\xf3թXo\xac\xcb3\xbc\x1c\xbc\xd2\x0ePiS\xf2\x95\xbb!`M\xd5\x1d\x8ch\x0fd\xf0\xaa\xdbDm\x80:좴\x1e1\xd5M.u\xa9\x8be\xf1{O\xfc\xa6\x93\xcc.\x96\xa3^^-\r\xa9\x88#\v\x95\xdb8\xd9E*\x83o\xc2\xeaڳ\x03\x97~\r\x15\xd0U\x17~\x95M\xfbôQ/\xb2\xf7\x81\xe2\u07bdS\xe3oGCx\x83\x8e\xe4\n:\b\xb5r.D\x1aA\xa0\uf5ac\xbe\xc5̔\xce\x1cp^j\x1cJg%5g\r\x9dĠp\xcekחS\xba/\x11\xeb\xeb&\x18x9\xae\xb1Q\x8e\x9ekb\xec\xe3\b\x9d\x86\x86M\x1aզyQ\xb0h2\x80\x9eoi&\xe5\xff\xb7\xe9\f\xf3Św\x80\v_p\xe5\xd9\xddCۭ\xd2\xc9w\xf1\xe77\xe6\xe7\xcfv\xa1o\xb4\xe2\xfe\xb3S\\\x17\xf9Y\x17q\n\xdb@vC\x85MCz'\xd9T\x82b\x94\x8f\x93\xea\xae/C}\xaf\x83\x01\xd9\xe6\xe2x\xbc׆\xb8\xd5S\xb0/\x8bZ\xa1|\x8fX\xea\x04qi\x0e\xea\x16t\xe0V\xa3}\f\xedi\x96z\xb1\u07b5\xf2\x9d\xfe\x14b\xd35M7\x1cɊQtG\xf0;{\xabE\x89Qh\xff\xe5T\x0f\x11\xbf6\x9bfh\xb3\xd9M\xf2{\xb0\xd3Va\xbd\b\r\x82-+D\xc6\x1e\x90\x1f\xc6K\x94\u007f\x1a\xc8\x0e\xf6\x94\x93\x98\x8f\x00\x1b\x18\x8b\xf2,\xbd\xb4G\xf3ɟݟ\xddp\x87J\xfa\xe3t\x87Y\xb1\xd9\xea\x02r\xbapEt\x01v\xea\xa2\xde\b\x8a\"B\x92\xf7t\xaf\xd1\fL\vUd\x16\x8f\xeb{mv4\xf3,\xea\x1c\xb79&\xf1\"+\xaa\xaf\x89\x88\x02\xf5kC\xed\xebҐ<\x84\xef\xb5\xd4H\xe8\xe5\x0f{\xcef^J\xf4o\xa1I\xf8\xabb\xc6\xe8\x87+\xf2\xc6\r\x16\xb1\xbe\xe4\xb1\x18\xa64\xb1\xeeK\xb50\xbcE\xd7EX4\xc9GqF\xbe\x8c\fx*\xae/Ś\x8f\xb7\xc1\x86eA?i\x15\n\xf4\xacF6-[\xb0\xbb^\xcc{\xaa\xf7ʦ \xa8{1\xef%\xd9]\x96\xa4\x1c\xb4\xc4Z\xe75\x9a\xaa6xj`N\x01k\x10\x18(\x9f\xd6\x1a\x18\xa3\xa8\xae\x9ahJtL\xbd\xaf\xa4??\xf9IN\xfb0\xa6\x1b\x06\xc1\u00831\xb8\xa9u-@a\xa0\xd7\x1a\x86ń$lV5Ca\x14k\xf9$\xeeg\xb3\xa5Ȇ\xc3>\u058c\xf2\xa0߲\x9d9P\x9b\"\xb3\xde(\x83\xa1\xa2\xa1\x18`\u007f3\x9e\xfa\x03\xe0Bp\x02\xcc\xe6\x12\xecZ\xd1\xca%\x14\xa7R\x90D\xd7@\x8c\x89\x98J\x15d(\xa5\xc1f\x04\xa8\xe8}iש\xd6\x1b(\t\u05femJ\xe0\xe5W\xc9x\xc0f?\xe1eX\xfc\t\x1b\x06\xd1)\x8f\xb1h6)j]\xc2\t\x95,\xaa\xa8\x04\xbf5\x95d\x14;E \x87\x87\x140\x02P\xd25\xc8rF\x101\x04\xab\xaf\xbc\xfc\x9bO\x96\xc8 \xf0\xd5V\x0fjߊ-s<\x9d\xbd}\v\xda4\xd58\xb3\x9fD\x9b\x12\xe7~\x015\xee\xba\v8\x17\x82Ӡ֨\xaa\x87\x0f\x9e\x9d\x0e\x00,\xceUTC\xcaG\xd9R\x13Ki\xa2\x98\xc1\xf7\xca\xc9'\xd8\xc8L*\x8fZŧ\xd4u#{d\xe1\xf2\xa6\xa9\x1a\x95\xaaj\xda\xedTq\x9f\xcd\xfa7Q=\x9ff\xf8.\x85\xf9~\xe9\x83;\xbcZ/\x9f\xcd\xf2Q\xed\x94\xf9\x84\xb1\x9d\x15Ylj\v\xc3_\xce\\\x97G\x8c\xca8\x1cK\xb8\xc8\n\x89\xafA \xc1\x8e\xa6\x11\xac#{A\x95\x16P\xa9\xddX\x17\x84\x1e3\xafB\xfc.XASZU\xa85͛\\\xab\"\xa0\x01\xde\xc3Eڏq\x92\xa8\x87\x98v\xdbk\xe1^\x81\x8a]ߪ\xbe=b\x00XBI\x00L\xadu\xd4IGz\x9a@\xf6n<\x06\xdb\x15d\x8e\xb2$\x19\xa65\a\x86\x99\xfb\n\x12\x94\xa5[n \x90\xa4\x81\ru(\x1a\xf8\x04p\xc3=H\xfb\x1bS\x8cLo?;Ŝ\xc6z\xd34\xbe-\t\xc4E\x9c:\xcb'\x1f\x95Mw\x1f\x81Ow7f\xd4\xddM9u\xb7\xbe6Kl\xc1\xac\xbb\x1fƭ\xdb#\xe71l\xfbc\xb2\xeb\xee\x9a\xfc\xba\xfb!\f;L\a\xb3\xed8\xf6ו\x1c\xbb؞c\x9dZ|\xf4\xdb+8v\xb11\xc7.>\x02\xc7:\bTu ȱ\x9b\xe0\xc3x\xbb\xbdR\xc4\xeeu\xcbyV\xa8\x1c\x1b0\xac˟M\x97\x8b\xbfi\xff\xec2\xec7\x1f̰\xd3\xec\xfa\xe6\xe3r\xecV\f\xfb\x81\xfc\xba)\xbb>\xaa*\xf0\x81\xac\xba6.\x8fƧx|\xba\x01\xa7n˨[\xf1\xe9\x83庋Y\x1cl\xa7\x86\x86\xf5\xc8$\xcf\xd9V\xedF\xacF\x19ߕ\xd6\xe8x5`\xe7\xb8nykB\xac\x89\x95=!6lhm\xdc\x02\xcetє\xb5Л/z\x8b\xf9E\x8cQ\xcf\xf1\xb4w\xc1\xadP\x89\xeb\xfaT\x99\xea\xb12d\xef\bhր\x9e\xad=X\x8bM\xc6i\x11\xf2]\xe9\x9d¨\xa7\xe5\x81\xc7ɴK\x02\aer[\xe4L`\x91s\xe8\xc8#\x93\xa4f\x81N\x90sS$\xec\xb9&(<-\xef\xe0-RnZ\x93\x8eX\x98Mʱ,\xa1'\x14\xba\x9e\xb7\x00\x97=\xb2+[\x1c\xc8@M}\"#\xc1\xb8G\xa2І}3\u007f\x81\xf1\xab$\x916o\x95\xaa\xeaw\x13\n\xd0z\xed*k\\\xc0\x9a\xb9\xe2\xf8\xcb\"\xbd\xf1\xc8\xe2\f\x88\xae\xbf66zض8\x92\xd3\xd59>\x1a\xe0\xfa\x14Ѧ\xd2\xedppL\x9f\x96\xbdu\x03,.܃\xc1f\xb4lF\xef7\xc1DO\xb23vT\x10r\xfb\bO\x168\xbe|v\xee,\xac\x1c6I\xc7\xd1\v\x8c\x17\xb9\xb3\x94F_\xaf={\x0eCyx\xa5\xb6c\x02Z\x8d\xafʪ\xae\xa4\x8f\x11\x05\xdb\r\x93\xac\xcfQ5 w6\x18'&H\xb6\xc3DC\xe0\xb8p\xb0\xab\xb1q\xd2\xf9\x1d\x80\u07b4\xc4k\x8f\x89\xd8\x0f%>\xf0\x8c\xa3\x19\xf5\xae=\x03*x\x97\x81[\xa9\xa0\xa7\xdbFԁ_X\xe8L\x82@n\xc1\xd9{\xaf\xe04\x85\xe3-U\x90\xbe\xb8\x19\x9b\x85ѻVfb\xf4\x8c\x92Nw\xd1\xc1\v\x1a3\xc5Z'@\x88\xce\xd5\xf0f\x04\x1c\x19\x12\xa9D&\xfeE\xad\x8dJ\xe0\xf5\b\xfc\x0e\x151\xcd\xf5\xae=c\xf0T\xa0\xed[R-\xc2H\x14\xef\xb2\"\xebeC2f\xd7n\xc4~<\x1d\x9b\xdc\xfe|Z\x906\x89\x87\xeb7q6\xe5\x1a\xe5`ݖ5\x95=\xb8`y\xb7\xac\xbb\xef=\x90\xfe\xd1H\x99Ƣ\x0e\x0fit\xe85&S$\xfc\xe6W\x00\x92\xdb\"\xa4\xfa+\xacs\x99\\Y\x00\x83\xdd^\xac\x86\xfc\xec2\xbd\xbfR\x8f^\x12\xf42\xb4'\x83\x89\xd9\xf0\xf5f`\x15\xc0\u007f^\x8e\x8b\xabu\x01\xdeg\xc9\f\xf8\xe9P%ܤ\xb8cĔJΰFJ\x96L\xb2b2\x8c\x97\x8a?)FS\x1dp\xa8\x8d\xf3qZs\xa3\xa4\xd1(㙌>{\xb1#\xe4-\x9cp\xe5\xde\t\x8a\xadM\x8a\x19`(\x9fb\x94l\xbb\x9f\xb8a٥\xac3\x8bx\xc94\xbe\xff\xa5~\xdd8+ٚ,Wa\xb2\fc\xb2t0\xd1\x04\xaeF\xe5\xd7\x12T(\x97e>\x84]!\xearL\xaa\x06\xa5\x98\xdc2*\x95\xbb\x94\xc1Ԕ\u0092\xe83\x11f\x15\xcd\xe7˝g\xa9\xe1\xe1+n\x96\xd3\x05\xfeY\xb0\x02\xb4kl\xd4\xd6\xea\xc4/\xa1^p\x89͆\x9cZk_q\xa2\x86\xe4|\xb35~\x01S\xb5\xd9*^XSV3\nA\xea`gvC\x80\x83\xb8\xfe\xba\x1e\xaeK\v\xd7\xce*\\S\x89뽍\xaba%\x8dl\xc7F\xb6S\x82\xacY>\xd8\xe2\xaasqE\xf2V]\xeb\xc6\x1e.d\xad\xfe\rp~\xf2\x86;*+qV\xa6\xee\xf8\xd5\xd5k\x1e\xe5\xb6\f\xe8{\xaao\b\xca\xec\x86\xeb2[\xe3v\x9b.a\xad\xac\xbbN\xbf5N\xa2\xc0+\xf4\xe5*\xf6h]?l\xce\x131J\xd7\xd7\x18\xfa\xd8\xde\xe2\xf6U\xc0=[R\xca]\x8d\xa8\x81\x8cpn1F\xb0X\xc7)\xd6q\x8a)\x04\xe0\xd5n\x89\xedN\xfb\x82{\x15\x8f\xfb\xe9\xb0\xde(\x0f\xc4\xcf\t3\x9f\xacI\x16\xf4\xb8\xaa\xd18\x8f\x1c:\x99\xfe\xee\x94\xf7\xd7\xf4v\xa7\xbc\xb7\xac\xaf\xed\xb3\x0f\xeb\x1f\xb2,-\x11\x0ec*\xbfv\x92m\x9b\x11\x94J\xfc\xa0\xc6@\x8f\x1c\x9f#\xd9\xdd\xc5\xfa\xb2\xb7\x94\xeft\x95\ntx\x01\xdeM{\x9d(a0k0\xe2\xe1\xec\xbfӥˁX\x9bذa\xd8\xf12\xaas\xe1cD*\x8e\x88\xb4\xde\xd4\xf9\x94\xb7\x8at\xa8HtU\xc6\xd2z\xf0v\xea\x9a\x10\xcfe\xf3\xd0@\x15\x9f{u;\xacn\xe7\xaa\u1c834L\x95L5\x9b\x8e\xb8@\t2\xfe\x02\xcc\n#ڡ6\x9a\xd1\x02\f\xa2\xae\xee\xe3/ߑ\xe6\x83\xd9t\x9e\xaej\xe6W\xaf\x99%\xdc\xe9\b5\xf3\xeb\x16\xcd`\x99\x100\a\x16\xf2\xc7['\xb8\x10\\\xf0=\x15Z;\xe9\xfeV\xd6\b\xa3\x0e\xe9i\xf6\xb9ԣ\xc1w\x89\\\xe1\xcb\x02n\x95\xcd3\x8b\x06\xf2FT\xe6N\xb9\xea\xdb,\xe8\xc8HY\x8c\xa5\xbb\"e-n\x8a٤\x9dWKN\x82X\xf7\xf2\n\xb1a\xdc\xcc^ɠ\xcc(\x1b\x8b\u007f\xe2\x85=\xa5\xc3\xf3M\xe0 \xa4\xb0j\xcbү:\x90S\x90u\xdb/`\xc6M4\xc7]{\xc3;:\xfa\xc8\xc6u|L\x87,\x9f]5\x1a\xeb 4B?\ru\x00\xbac\x10\x03K*\xbfI\x1d4\xde\x02P%\x17\x18\xf5\xca1\x83˼r\x16\"\x19E\xba}\x0e\a\x105\xa4\xe7\x98\xef\b#BWd\xd8s\x9fh\x12\"k\x00o\x05%X\xbcd\xa2\xf0\x11\xc7(\x1f\xd0\xe2\xef\xbfی\x81鱻7\xc0\x12_\xe2=\xa7\xc02n\x01\xf6zf\x81?\xb7\x99\xcc\xe83\xc1\t\x1e^\xa9R4\xad\x1b\xfc\xd8\xf2\xb5\x81} \xa8\xb1o\xbd\xd926\x93Z/O\x96\x81]\xb8S\xe1\xde\x18(\x00um}\xa0h\x1b:o>\t\xe4\xa0~WR\x8bt\xbfq\x12Ȓ\xfa]8\xc7m\xc8`\x1a\x90\x9b\\j\xc2Q\xed'!1X\xa6\x88<0\x8b\xa5~\xf7D\x0fA\xb0\xfc\x1b\xf3\x16\xcaxٗ\xab\xbbTaeI\xb0\xbaRz>\xd0\xca/w\xd2\xe6X\xb3Te\xb50\xa9\xea\xd4\"\x18\x9d\xe6\xa3:\xcb\xffE\x14x\xf2\xec\x9f\xf5\xf1\xefEC\xed\xc1T\x1e\x86\x12[\x98\xb2\xbfʲ\xe9\xef\xf7\xe1\xb2\xe0f\xc0\xa8l\xbc]\xb4\xb8\xa4I][p\x9aR\xc24\xa5\x14\xe7\nXn=\xfa\x06\x1a\xde[\x9d$\aҍ\x12\xee\xd2S%\xccbj@\xcb\xd8̩\xee\xf1\x9a]\xdf\xe57\xf9\x1db9\xfcjpW\xe0!I\x1fЬ\xdar\x13\xa9\xb3\xce>\xa9Х\xdar\x17\xa7\xb34O\x1a\u007f\xf9f\xd0\xecu9\x05q\xbb\xf3\xec\xde\x1b\\\xc1\x8cK\xcc\xfa\xe7\xd8\xcdb\xc8(_C\x97Z\x1e\xe2\x1a\xec\xa2ߴ\xb2\x97.\xbe\x91\xdf?N\r\x82WI\x02\x82\x19跧AkU\x92\xa0\xb4\x8aa\xd6g\"\xb6B\xc0\xa2\x8c\\W\xc2Z\xd2f\xb5\x18\xe5\x13\xc7\xce3˰'\xb1\xb8\xbc\xa2#\xfbO\\y\x15XD\xc2\x02\xcc\x1c\x8db&\xf3.\xfe\xa5\xe8S<\xeb\xdf\xd4\x11V\xd3j\xaf\xa9uL&6\x1bt6LǘK\xfdKڽ͕\x11\xac\xf0\x13\xa52\x1eAnB\xe79m퍳\r\x01\xfc\x9bւMh\xd3Bf\x1dE\xaf}\xe0\xa4\x17l\xa8\xfe\xde\x1cXT\xa1\xf9d\x11=\u007f\x1eu\xa2ߣ'\xcb+\xe7\x8c\x03\vZ\x87\x1c\x84\xddr+\xec\x96\n\xfa\xf2\xe3b\xa7\x89\x1eB\x11\xf8\x01\\Ԁ\xdb\x19\xf0\x11\x03\xae_fg\xd5\xf8\x1b\xfe|\xaf\xe7\xa7Ա\xb4VVi\xb2]\xc0f\xe0=\xc9@h\x1a\xbf<k\x02\xb6Ρ\xbb\xfa(\x82\xe1\xf2T\xc2*7\xc7 .\xadl|\x97Nņ\x1f\xeb\xab\xcf\xfa\x02\xbc\x86`}\x93\xd4i\x04\xea\x83\x1bSQ\x18\xdd\xd2 \xe9\x10LGV\x9e\xad\xa3\xd1:\xfb\xfc\xa5\xa6\al\x86\x96\x9a\x1e\x9d\xcd\xe8\xb1l;+\x86\x84UnwB\\4=\xb0\xbe\xfa\xac/\x81\x1eX\xdf$\x85\xe8\xb1\x04z,%=\x90\x85\x10LGV^I\x0f5WQ\xd7\x10\x9a*\x88\x86\x05\x81A\xe1\xb0 HB<\b\xedu\x81\x05\x88a1a\t\xd5.%\xeb\x9aUF\x81w6\x00a\xb1\xc3\xd9\xdd\xe2Tͧ\x8cI\xcf܁\xa4\xe1o\x9bR\x8b\x8e\xc5w\x1c\x05\xb9\xec\x13\xdfX\x19\x1d\x95\xd1qf\x8eí\x9cMm\xfe܌7-5\x04\xcaR\x98/\xcb\xc4\r5Md0CT\x8f\x83\x97\x86N\xcb0\x9d\x16\x8a\xbbچ-\x97\x1d\xab\x98K'\\\xfc\x97\x01:Q\x86C'\x97\x8b9\xfb\xda|\xbb\x19\xcfZ\n\x18\x94u\xe9$\xb1\t\xd1i\x85\\Ƙ9\xe1\x9b.\x15\xfbVg\xe0\x1c\xfc\x9c\xf1s\xd0l\xaf\xbbb\xc0\xb61\x8c\x19\x9f\xad6*\xe7N\xebb)Xڥ:n\xa9N\xd0\xf1\xa5Х\xa6iOl\a\x94>\x82J\x8a\xd0?ry\xc4\xe8\xbe\tgG\xb0:J\xd2X\xa8M\xe3bW\xaaod \x03U*\xbd\xb7ӊ@\xb9\xfb@\xb91$\x8e\xef\x8b\xd4I\x06\x98\xe3\xb4p\xa1\xa6\xc1\xd2ŽS\xba\xac3?i%\x00$Vm\x8c\xc7\xe4xo\x13\xfe\xb9G]\x95\xfe\xa5T\xfa\xf7\xbeFr\x8d\x8a\xea\xaf1Uį+훰\x97\xde\xc4w\x19Q\xec\xc1Ik\xc1&\xa6\xda\xed\x8c(Pw\xe2F\xda\x17\x17\xa0D\xad\x19\xe9\x04\xefڂW x*\xa5\xb3C\x87R\xa8\xc1\xfb\xb6\u007f\xe3\xd8\xc6Du\f\x1fD}\x9b\xd8\aQ\x93FKE\rW]\x9f\x04O\xd4'V\x10\x9c\xf3s\x05\x8e\x1f\xa7;\xa7X\x93\xf2\x03, \x85w\x8cAq\xa5\xdc~h\xed\x11\xea\xa0A\xe9\xcc\xeb\xbc$K\x93nwN\xf8N\xed-\n\xe9d\x89\xe9\x1d\x9e\xceE1Y\xaf\u007f?\a\x00\xbf\x8b\xd2\xee\xc6\f\xec\x17\x93\xd5\x06\x93*\x13\f\x8d>\xd7\tO\x95/\x1f}\xde³\x97\xa7ʻ\x8f>m\xe1ى\xa8\x9e,\xac\x14\x88z\xb6\f\x18x\x82\xd4wMsՈ\x97\x9a\x8eJ\xcc\xfa\xe5\x04r\x0e\x81\x94Y\xe9\xdc2\xb44\xa4ѭ?\x84'\x86r\xde\xe0G\x13\xed\x8f\xc1K\x01X\xc5093\x8a\x00\x80]\xbc\x9c-sO#fq\x91്\xcd\x05\x01f\x93R\b\xf3\x89U\xbf\x94䈼E\xf0r\x12\x05\xfa\x1e\x04^f\x96[il\xf3\x8cw|,\x9a\xba\x8f<K\x1ebc\x90\xca\u0e77\xe0^&!\x94\xf5\xab\xc9珚\xa4ꨨ\xbd\x96ml\xfb\x11UB\xe6CFU2\xbf\xc3vx\x88\x8a\x1d\xb3\x8c^\xcar$\xcd0҉\xb67\x00A[\x13\xfd\x14\xdb~[p\xc9\xe4\xa5%\xb7\"\xd7*\xf4\xaf\x10DR\xf6C\xaa\xec\x13\xd9\xdfF)\x13\x96\xd9e\x80\x1cA\xb3\xcc\x1a\xb6\x16\xa8\xdbTr\x90\x83j\x1a\x19\xd3`\x86T\xa3\x03BnK\xa7ou=\xfbڜth@\x9e\xa7,\xd1L\xb5zF]\bjg\\\x99x\x9f\xe7\xa3Je\x02\nT+\x13P\xc2W&\xf0\xf3\xfe&M\x87V\x01Lq\rɼ\x801\x04\u007f\xf9\xc3\xebאrџ\xe6\xc3R(IoH\xe2G\xe6\xaboO\xa5\x91\xf9&ŵG\xf3\x02\x01s\xb4_?\xa8\x13A\\~W7\xd1\xce\xe6\xebr\xbd\xd7w\xb2\xe4\x8d\x00yl[\x97˾\xc9\xee\x98\xec\xe8*\xd8\x1e\x89\xaaa\xa0\xb1!\xb4\xf5TVޱ\xdal\x8a\xccN8\xd3LY\xd7\xdb-\x14}\x93\xd7\xd9C\x1f\xf5hA\x1flb\xc2WjO\xc1\x0e9y\x06\xec1\x8bFI@`\x85\x87hh\"\xb04m\r\xb5X6\xab\v\xef\x12\x1e\xd2\x12u\x87l\x8boulG+VC\xb3\xbdq]6J\x11\x803Ǟ\xb5bG/6\xdd\xcaiʢ-\x03\xa49\x81\x1c\x17\x81Kx\xf5E٠\x9b\vu\bE\x00\x95[eK\x80\x8b=\xb2\xd8ƪ\x96\x97%-/C-/\xcb\xf8\xc9iy鷬\x97\xcfɔ\xc4c:\x88\xe7Ù#z}\xc9K3\xc5\xf8?\x80֤\xc7\x19\x93\xaaq:5?\xab/\x17V\xee6\xb4\xec\xb0\x14\x17\xb5|s\xe7\x15\x8c\xc3\xdcKl\xc0pLs\xb6X\xe1m\xb9\xc2$\xd7ao\x83 \x95\xcdUړ'\a\xfd\xd3Z\x9e\x11Ph-]g\x1d5U\xc2\v\xa9\xa9\x8f\xa6\x0e\x86p\xfe\xd8\xea\xa0Vךk\x1d`\x86\xd7\x1d\x87\xe8\xf2\x13\x98\x03\xbc\xf5+\xf1\xee\xf2\x8e\xee\xa3\f\xb6;\xc8\xfb\xf3b\xbdc\x1f\x9b\x91pms\x1fVi\xae\x05\xb3\x98\xfe\x1d\xc6\a\x9eW4\xacwe\x82\xd5uLQ\x8aE\xa2\xd6\xff\xb7\xd0\xc9/\xd3\xe1,\xaeC\xb4\x93V\xbb\xddm\xa8e\xc1\x17\xb3֔\xa1W\x1c\f1\xddUk\xde\xe0H\xba\xc1Y\xca{\xefLa\xab\xafF\xcbr\x85\xb6\\\xf3\x9d\xf04\x13\xe6\xc9R\xe1k\x8d\xe1\xc4#\x93\xe6\xa8\xe2&\x1b\xc0\x89\xa5\xf2k\x85w\xf2\xa4\x10\x13\x04\xea\x06\x893\xe1\x02d[2\x18\x05\xc3\xe9N\xc8\x03\xa7\xea\x96\x1bD\xdb\xeaW[=#=\vQ\xf5_\xfa)\xa8\x84\xe9G\b\xb1\xee_\x9aʗ<\xda\xf0\x15'\xec̻\xed^.50»lY\xbe.\x03\xd9\xd5q\xa5\xa9\x8c\xef\r%!\xcev\xf4<:h\xb7\x1bޣى\n{,O5\xad\x017\x19\xf6\x83]\xa5\xdax\xa3[9\xc2\x1b\x8d\xb2m\x000\xdd\xc0\xc0\xe5\xb6|\f1\x843/V\xf1\x03\xba\x97\xb6Ȁ\tf\xe8&m>v|,&\x1dV\x15\xf4\x05ǀ\xd7\x11\xc0:\x0e\xb0N\bXDX\x80\xd6\xdb&\xe3Ѥ\xc3n\xc0N\xdad2\x9atBw^\x87\xb2\xeaPV\x1d\xf2\xaaCYu\x18\xac\xea\xcfe9\xac\xce\xfb\xee\x87\xd0\fn\x03\xa9\x1a\u058cP\xc3\xc4\xec\xfc\x1b\xcem\x1c-\xd5\n\xdb\xf0r\xf1\xd9\xd4\xdatG\xbdD\x97\u007f\xbfRGĮ\xdc\xfev<\xc8\xc6\xd9l\xd9,\xdf\xc8B\xb1\xa6\xd4\xc8\xe0h\xca=LV\xfd#l\xa1X\x8b#\xba\xc5\xfbDUۓ5xv\v\x9a\xe6\xf7\xf3Q/\x9d\xba\xfef\xa1mkG\x8b\xc8<\xf2\xebj\xefadE\xab_y'\xf3\x9b\xb5\xfd\x95u\xd4n\x83\\0O\x8ae\xa3\n\a\xaa\x1b\xd3k˫\x18Z?\xa6\x93T\xfd\x85G\x87҇\xdcZ\xedoy\xd9B\xb7\xbf\xb4\xda_nҾg\x01!ޯ:\x9f\xb2\xb5\xa0\xec\xaeY>\x18r\xd2\xeaouR3\x8c\x97\xf9|\xc6\xcfi(\xa5Eq\xc4*B&\xe9\xf4a6\xe6\xde3P\xc8\nm\r\x0f\x98\x84\x88\xc8 \x96\x9c\x98\xabc\x8a\x15u\xab\xa8\xa7\xf5\xcd\x1b\x88\a\\\xdf\xd9\xc9Ē8nP\xdd\xd6\x04\x9e\xe8\x8a\xde\x106o\t\x9b\x1fE\x165g݂V\xc7&P\x91\x91\xd4\xe9\xd0\xea BӔ\x8a\xd6\xc3!p\n\xa4R3\u008f\x8b\xf9Hu\x10\xbf\xbf\x1d'x\x17\x0e\x86\x0f\xf7\xb6\xe3\x86\tR\xa32\xa1\xf4-\xbe'\x00A\x965\xa3w\xaa\v\x88\x1c\x01\xd4W\xc9T\x9b&閶\x06D\xd12\xfa\xb1\x1b\x06T\xfc\x9dU\x9cUx\xe7V\x80*;p\x19y6\xcd\x16\x82ė\uf0afN4\x01h\x94\xf8\x8bH\xab\xc7j\x105EX\xc1[hh\x11\xb2\x98\x16\xf9t\xf6W\xec;G\x8dA\x85\x02F\xb7\xb3\x03\xe4p\xdf!\x1aN]#|\x19_\xb1\xf1\xbb\xecq5\xca?\xc4Q\xd8\\(\x06\xe0\xed\xd8=\xf5T\xce\xc4y\xc4 8q%\xe2\x16\xea\xbaѺ\x19\x0e\xc0\xde|\xf5l%\xf0\xa1\xb2/\xc0:u;h\xe3D\xbe\xfe\u007f\nL\xf0,\xba\xe5\xf7\xd96\xe12\xbc\x05\xb2\x19\x9bQD\x03x\xe9\xaeI\x88\x0f.\x92w,\x1a\x17%'Ȇ\xcd\xe8.Ҍ\tI\t\xa4Ųi\x8c\x12\x85\xac{'zs\xeb\xf8\xb2!2\x8aZ\xf0\x04\x14\xcf De}\x96\xafUth\xedT`\xd5t=J\xa9\x9cwN\x8e\x0e\x12cǐ\xe2![\xechR:\n\xe1it\x17ve\xe3\xcc1@\xda\xc54\x88'\xc7ea\xe1b0\xb1\x0e0%\x1ah׃+=b\xf8?\t\xe0\x834\x9d؎#\xf4\xecX\x93?`H3\xeepc5k\xe8 $f\xf4\xcc\xe0\xc1\xc0kۋ)\xf0N\x17ȼ\x8bm2N\rv\x1a\xae\x8eȃo+f\xa4~\x96\x89\x92\x98D\x97U\xef\xb9UM(>v=\x15:\xe5T\x85\x8fsr)\xf5T\x96\xb22\x1f\x84\xaaT\x06LF\xab+\x01F\u007fm`\x8d\xea;q\xaep{\x85\xfd\x05U\x06\xc5S\xd9[c\xca\xd4hJ*UK4&ͨ\x95z=nY4݉\xe2\x96=\x18\xb4A\xea\xb9\xc5z~\xb1\x92\a\xe9\xc0?j\xa9\x87՚0\x95R\xbfP\xf2S\xa8#M-\x00Qܙ\xe5\xa2i\xcb\xdf&\xeb\x00\x8f\xf1D\x80\xb6ү\xa9\xaa\xc2\u007f\x8cϴ\x14\xb4\x05\xbe\x8b\xa0OGS\xd2J\x82V\r\xf8\x9enEH\xaa\xc9\a\xb8\x8fq$\x80\x01\xb5x\x14\xd4\f\xe9\xb7\f`\xa4\xaa\x9b F\f\xe0\xe3\xe1x\xc1\x14\xc1\xed\xd0\xd4\x108\xa6\x1c\xac\x8f\xec\x868\xbeR\xf5\xb6C\x90\xb3y\x14Y\x00\x17\xdc\xc8\xd2\x0fJ\x8d\x95\x18\xf6=\xec\x1c\xe4\f\\\xa5\x8a\x87 \x17\x01\xd0\xfe\xc88\xa0\x95\nW\n\x9a\x8f̊\bjrK!\xb4\xbe~\xbabK1\x99\x0f\v\xa1\xfb\xe2\xed*\xe7\x88SW\xfbm\x1e'Ұ\x01\xb7i\x85\x04\\v]\xf3!\x94i\xd1\xcbsx\x8dh\x03\xb4<\xeea\xc9>\xac\xecP\b#\xca.u\xf2R%\xc3\xcb+\x90<\x10T\u0604\x97H0N\x06ĵH0\x00\xcbһNT_tAg耙=\x81\xb7\x9c\xb3\x9bt\x16\xbb\xab( s\xab\x1b\xbd\x01\xd9M\xe5\xe1\x1f\xfb\x9a7b3Y\xc0\x1bYl\xfd6\x94\xbd\xc4쥟\x1d|)i\xfb6p\xa8'\xa4kV|\r\xbbഞ\x8c\x1b\x95\x98c\x8dW\x1f\r\xfd\x8a\x1b\xebO\x18\xe5\xce*\x03\x85\xea\xfb}\xec^X\x02\x18\xb1\xf3%=w\x13\xc0\x85e\xe8\xdbw\xc8\xca\xf0 I\xc8\x06\xffQ\x8fbtXP\x8d\xad\xae\xa5͈5}\xbb\x04}\xe6+_\xb5\xfa\x95\xf5%\x18\x05;\x18\xf0\x1a\xafu\xc4\xc3ɍ\xd0\x16\x06\xd3LE\xfdj\x9d\xa0O\xfc\xdb/3\x01j\xac\"\xd0\xc9\xdd?6\xff\x1d˥\xb2\x17\xb3\xa9\xb4\xb7\x87ʪ\\P\x01p\x00\x85\xba\xba\xd7\x06E \xbe#\x8bH\v,\x88\xc0\xbd\xf0\xfbX\xecx\xe0ŖP0\x9a8jj{\x8f\xf6\x06\xf9;\x91\xedcHQ\x02_(\xf8Rr\x10!e\x1c\x8a\x12YT\xc7\xfeGO\xa1\xdb'\r1\x87Z\xed\xf6\x81wC\x10\xa2H\x86.\t\x02i\xb9B\x88\xc0N\xe9\x0f\xe82\xc1˃\x81y\xf2\xe08\xc9\xc3N\xcbE\x81\x824rsM3\xfa\rM\x16\xb9\xe8\xba \x9b\xa0\x8b\xb2ep\x1e\x8a\xea\x19\xde6\x8b`\x1b0:\x8b\xc4~\xc0\xba\xb6\xa5\x80^r%\x1d\xa8\x9b\xb7\xec8\x84\x11ޞ\xcf[vD@ڜ\xd2խ\xa2ŞL/1y\x89\xc9K\xfb\x84y\x88{ç\xb0\x97\x89`\x06:\xcfc [\x0e\x87\x19Sx\x94\xfdT\x8c\xd3Њ\xbd3\x14\xc2b\xd7\xf0\x00ؠ\x84\xf0\xb4\x1e}/`P\xad\x94\xa5\x97\x82\xe8\x13Nu`\x92\xa2u\x8f~u *\xd6L\xfd\xde\xd1\xc9\xf6Cz\xec\xe4y\xe4\x89\x12A\r\xb4\xa8(\xa0\xf0R\xf2\xd6>$\x82x\x9b^\xcdж\xe0\x96\x91D\xce\x16\u05ec\x04\xf3\x1a\x0e6\x9eq\x972K\x95\xd1q22\xc74\x80\x8d4*\xf6\x8c\xc4)Ȑ\x97\xf6v.\xa7~\xe2\xc6U\xfcn\xb8tȩ\x97xm$\x87Т+{K\xb3\u05f9\x1f\xc0\x85\xc9\xcb~\u007f>\x9a\xa3\a\xaa\xdfH\xee]\xa7\xf9\xa8\x05\x02Z\xf0\v\xad\xedpf%\x85\x9a\x94\x06\x8d\xf2\xeeW\xf5\x1bU\x95:\xef=\xdc\x1a_\xa4\x9e\x87\xdc\xdfZ\xe0&\r\x9eՒ\x86\x917\xd6\xdd\xf8\xad\xb9o\x0f\x8f\x00^\xc7\fa\x94\xa3\xed>\x17\v\x8f;\x1c\x98\xba\xact8\x91Ӕ\xa8縒ʿ\xe78\xc00\x82j\x9dp!\xcb*KY\x85\x9aZ\x96Tq\xe9 o^\xc0\xb1\xbbw\x03\x88V\xb1OB\x12\xd6\xddtr\xa9\xaf\x96\x8d\xcduo\xac\xa97\x84\x12\x8c{\u0603\x8d\x04\x9aU+\xd4\xe6\xcdbMՊ\x02\xb3n\xb3\x8f\x19\xc9q\x93\xae2\x15a\xbb\x1e+\x00\xbc\xe3\xb6\xdeQ\x1aU\xb2\f\xaf\x84\xe1\xe4!\xeav\x80\xe9-\xdbu@\x01\xe0\x1d\xb0\x95\xa1\x8d;\xc0T\xb1\xcdqr'\x1b\x03\xb6\xee\xc0j\x1dm\xf3\xd6m%Y\x03\x82\xa9\x9c\x0f\xf0\xa8\xf2<\xaa)\xb0\xb5\xe8s\x91t\ua7e4\x96!f\xd4\xc5\xcd1\x93u͉\x8e\x02\xb5n\xe3J;\xdd\xe2\x1c\x1bj\xea3l\tf\xddf\x95*\xb9y\xb3X\x93\x1b\n0\xc1\xdd\xcb.\xc01rCk\xac\x8b3Z\x11\xb4\x06\x1bp\xc4 \xab\xb8*2\xdd3\n(\xc9rCR\xae&/Jܳ\xb4\xe0:\xc1\xb4n\xd4\xf8F\xc9\xcb\xcbR\xb1\b-\x87u\u007fv\xe9Y\x06@KDc\xe3\x02c3\xdbA.\xa5Z\x0e\x1a`/\x9f\xa2!H\xfe\xaeC\b\xf5w\xf2Pu\xc42\x8c\xa6\xbe(1\xb5\x8f\x1a4|Y\xf1}\xfc}}\xc1\xeb^\xbe\xbb\xba\xd4\xc8\\5|/\a\xbaߨ\x0fO\xe3q\x82\xf7\xea\x9fZ\xd2ܻ|\xa9\x91\xf6\x1e\x9f\xe9\x96m5\x82\xf7\xf8\xd2\xd2\xfcpw\xf1\x8ev\x17xn\x00\xbb\x8bw\xaeZ\xc4;\xe4\x81x\xa8\x007\n\x82\x83A0\x9b\x96w\xce\vwӘھ\xb4\xf0x抎\x0f\xd4\xee\xa5Q^KZ\xb5\xedZ\x04\xaa\xb1\xa6\xa5\xc0\x80ˮB[;8fn\xae\xb5\xc1\xbb7\x8a}3\xba1\xca|Ӵ!\xf6\u007fe\xbb\xbd\xb1\xb7\xdbs\xd4\xd8L\x9e\x82g\xecQ\x8e\xda\xee\x9c\xfb\x0f\x9c\xf5>\xcb\x1aF\xbdE\xb3R\xb7\xddy\xe2\xdd=Z'r\x1dC_\xac\x16c\xbc\xb2Rk\xb0T\xd9\x11\x95P\x06D\xdf^\xb6\x80\xe8\xf3*\x05\x84\x12\xae\xac\xcb\xdazW)QU\xea\x03\x0f\n\x9eñ2\xdb9\xf3\r빥\aT\xd4\xda\xd9\xd1\xecJ䷳$O\xdaY\x0fk\x8fz\xf9\xbe\x81\xc4\x0e)\xf5\xb4]\xd0r\x10\x9d\xe9\xde7\xc2\xe5\x97X~i\x95\a'97%\xe5'Ԁ\xdaB\x94\x14\"\xa8j\xd3\xe0\xf6S\xee\xe2,.c\x03\xad\xb4\fK\xb5\xe0TXM'\xdd\x06\x8d\xdd\x0e}\xf1aST\xb4G\xef\xa1\xec=\xda\xc6Mڊ\x93\xbfC\xe2\v\x9dk\x1a\xe4+\x1eeU>\xe9gzE\xbd\xd5i\x04\x97\xcd|\xb26\x88v\bBٻr\xe6&\xb5\x11\xc9B\xee{\xf4zC>z\x83;Gt\x17t\xb6\x94\x0f\xb4\xd8\xe39\xc72\xf0\xa5\xca2%ݷ\x90\xfa\xb9]\xb02x\x05;\v\xbeL\x13\xf5\xa7t\xbe\xe0\xd7|\xad\n\xb0\xfb\xfe\xfa8\xa2\xac\xf8\\ \x89\xbc\x85t\xa8\xbey\x86\xd5\xca\x1f\xdf\xc9ӏ\x89\xe8x\xe6\xee\x19\xbc\x13\x10=e\x81\x9b\xd1^\x98\xe0\x89\x84}Ǫ/ԔD\b.);Z\xea\x9bo\x89Qb,\xac\x94\xa5*\x9f\xa4\x13\xb1\xf5yʞ\x93S2Z\xc1\xed:x\x18bʑ\tH\xb6\xfe\x97\xbfD\xf51\xce\fJP\uab6b\x97\xe9;mͨ\xdf\xe4\x9e\xfe\xb1AlF\xdd\x12\x10)\xcfx\xca)wL\\e\t҄\xeb3\x84P\x1a\x00\xfd MB|\xaa(z\xe6^\xe4J\xca\xe6\xb6{\x84\x00\xd4sΨ\xaaF\x85\xdao?\x0e\t˩\x91\xf0\xa7yIS\xa2\xc9H\xd1(\xd3\xcd;&\xfe\x82\xfb\xdcP1\xadsC\v\xb5miw\xb9\xc9\xd2i<\xed\xdf,\xb90N\xb8\x14\xb6\x98\x9a.\xa8\xb7\x9bFw2FPCY\xbc\xb4\xef\xeeƙ\xd9\xc7\x1c\xb6\xe8\xe6IN\xc9٦S\xeb\xfe\xb1\nA\xd0]\xfb\xa8\x96\x19\xddJX\x84(ɣ\xd1\xfd\x89D\x8a\xae\xd64\xfd\v\n\x95\xac\xfa@U\xe4\xd7!\x0e\x8a7xw*\xee\x11\xe4\xda\xef\nݻx\x12\xd9\x1d\xacBc,\xc0:Clv\x85\x00\x1dv\x8c;j\xf17\xb7\xad|ۂ\xc9Soҝ\x8b\xf5\xf0\bG\x97\xb1\xda\xc0+z\xaa\ruo\xcboA\xe5\x94\xc3W%ം\xb5\x05\xec\xb8\xd7\x12Ku\x9dhe5\x9e\xb9\xf7W\x91\x8c\x92=Μ;C\xd1\x13\xba\x97Рjt\r\b3\x00[\xc3\x04\x82\xaa\x17\"\xf5\x8b\xe5\xcfR\x18\xea!\x81MI`H\b1غ\xed\xaa\xdflS#\xba\xb6\n\x00b\x02\xa8\xd3uB\xf8\xf1\xee\xaalL\xa7}G\xbf\v_\xe5\xf4\x9cQ3\xb1\n0.\xbd;z\xd0\xeei\xa4\xd0\xf0\xaf݁`\xd3\xdd+\xbb\xc7\x17\x87\xaf\xf1\xc5(\xd8\xe1Xÿ\xb0\xf7\xe0\xca\x17@\xcf\x15/\xb4h\x9cGt-\x9fn7E\xe5\xa3ִ\xd8\x1d\xddSi\u058c\u0605R)\x832\xb5\xcem#~\xb0\xa6\x19\"\x02\xe3\t\xa0̶\xba@\x93\xb2\x0f\xdb\xddy\xe1\xb7]\xd6m\x8f\x93d\x8bVuu\xb3\xade\x00\xd7\xc1\x80\r\xc2\xe6\xed\xab\xca\xfa\x1d\xb4\x01\xb6\xaam;\x99?F\b\xcfy\xffa\x81\xe8j\xf8lܼԇ\x12J\xcaÜt\xee\xe3\xa7\xd3l3IOs\x8d$=\x02\xaf\x94\xf5x\xe9\x03\xe0SCk\xb5\x90إ\xee\xbc\xdb\xdfګ\xc1\xc2B\x03\v6\xa3\xa5\x9f\x18]\x05\xafs[\x98\xe6\xd3$EK\x19\xfe\xb0`P'\x14\xfd4\x9bi\xd2\xed\xb5&\xe9t4\x9f\xa5uJkJ`LőT`E\x15P\xa7(\xa2\x82\xceP\x10\x17r\xa3\xb2\x1a\x19u\xef@\x92\x99ۥ(\t\xfcܨT\xb2b\xd9ƧJ\xeb\x1d>\xf9`8h\x90x\x9b<\u05c8\x16\xf8M\x18R.\x0f\x8f\xc3v՝\xf2]uI[\x99jk\xe7\\5\x80\x17\xa1e#\xba\xd5\xcco\xb5d+\x0e\x1c\x1f\x94\xe7ҥ\xabڹ\xca!\xb2\xc4:\xe2\xf6\x03$K?\bM\xe3\xc1\xc6-\x85\xe9\xffH\xa7y3\xa2\xd7An\x81\xf9\x8c\xbc\xf4:\u9fd0\xc7^'U\x06v\xc6߭\xbb\xed\x03^\xdeY\xc1.\xef\xca\x02]b;\x96Т\x96\x15I\xb6qj#j\x1a\x9f6\x04\xa6\xfa|)D\xf7\xa2u\x9dΠ\xd5\xdf\u007f\xaf\x1a\x97u\xbb\xa3\x06o\x8b\xfeX\xee\xd25\xa0\xcdzD\xb3\xa7\xa2K\x9a\x89\xd6\xec\xd0\xe3\xbaa\xael\xeaq}*W\x0f\xd3|\xb6Uc\xa2\x9e\x1e \x04\xb1\xbaA7#\xb0:3f\x94\xce\x05ĲE\bղq!\xe4Ǯ(Vc\x8a6\x88\x1cg\x9b\xac\x96^[6S\xe8\r\xbd&;m\xbe\x8e\x17\xf8\x9e\a_ō\x8a\x8a\x92?\xa5ɼ\x9f^\xccG\x8d\xa6\xbf+\x197\xd6{s \x90\xb9\x8c1\x12\x89\xf8\xd13[\b\xf0\xf8\x80vF\xa1\xc7R|f\xfa-\x12\xd5\x1d?J\xae>_\xf0\x17\x01|\xe5\"\xdf19G\x03\xa2\xbd\xe7\x12\xaa\x13c\\\xe4\xec\x9c#=\x9c\xa3%@\x87N\x83\xdeU\x87ٖ}\bC\x91=\t\x00*\xf1%+˃\xb7\x9c)\x04Vh\xf5\xf3q?\x9eA\x17\xd4j\xffД\xac\x86EJ\xf9\x84\x99\x14\xbdݤ\x81o\x81\xac%$\xfej\xa7U\xc2\xf1\x13\xa5\x03\x85\xf8Z\xeb \x16c\x17\xd9\xf0&\x9f\xa7\xb3Y\xba\x19[\x8fd\x02WC\n\xf3\xec\x93\xf8\xbd\xadU\x13\xe9\xe7\xd7a\x9aJ\x1dŰ\x15i$\x86\xbb\xb2\x9d\x9d\x06)\x0er\x17I\x1a\x82s\x0f+zAQi\b\x95\x9c?\xc3TÞ7\x82'8\x95h-\xdbtxZ\a\xb8\xbb\x8a\xb3\x1a\xfcN\x9f\xc39˶5\x94\xf7\xd95\xeeU7\xa1\xf6\xc2P\x9bH\xbf0\x03aS\x1a\x14,\xf01\xd0\x15\xff\xbfG\xe6d\xd0S\xda\xde\x00,\xdb\xe4\xa8\x18\x95\xbd6\xb7Xj\x1at\xd6\x19\x9a\xa2\xe3\xcf|\x91V>8V\xe5.\xfdE\xcb\xf0\x02J\xa2\x8f/\xf1\x8bT\xc1\xf6U\xb9^\x89p\xe4\xa3\xdfb\x0fF\xc4j\x10.\xa3\xaao\x02F\xde$\xf0\x9dg\"\x14\xed\xe8V\x00\xce\x00\xf0\xad{\xb0-\xc0\xed(x\xb7\x0e\xbc[\a\x9e\x13y\x9f\xdb+\xba(z\xf6\xa0\xbd0)\x1e<\xae\xca1\x1a\x16\xc4\xd1\x16\xb5\x9f\xc1/|q`\xd9ŉ\xbb\x9f\x8bQk\xd0\xc8\xe5\xebr1\xb5\xb2\v\x03~Vɣ\xe9b\x12\x8f\x93\x0f\x97\b\xb7\xf2\r\xc5\xf8\xcf\x14\x04\x8d\xd0\n\x85Um\x86y\x06\x94\xa4Ud\xbd\n\xe7\xe6\xf6\xecz\x94\xf7\xfd\x80;t\u007f/\x14\xc2\xd3r]Q\xcbwn\xf9.f\xf9\xf54\xae\xf6\xf3\xa8K\x85\xcd\xc3=\xa1\xddH\xc1\xed[\x8a1eڌ\xa4\xf3\x19\x15l\x0e\xffN\xf9!\x00U\xa5=\xfb\xecf\x9a\x167\xf9\x10\xdf3\t\xf0c\xbb(V\xb6k\xf4 \x9c\x1cw\x87 \xed\x12\x9c\xbd\fT\x16\xaa\x9e\xb8l0M\u007f\x9b\xa7\xe3>\xf8t\xea\x88\xf9\"\xb9n\x11\xbc\xcak\xa9\x1a=\xf4\xc1\t$ [%\xbf\x15#R\xe9\x98̴\x8c\x8fmQ\x1c\xd4!\xd7\xcd\xe4\xfbT\xc8_\x86no\xa0o7\xf7\xce\xd6&\x17\xa1\x17\xdcF\xea>BZD/L\xc0?\x8c9\x15=7!\xff\\YǺ\x0f\xe7\xbeYAQ\xdcU\x8f\xf0@\r\xeeR5P蝹u\xe9F\xf9\xad\x9fN\xee\rh\xbe\xacy\xf9ZiZ\x02\x17\uf34d\x1e^\x8a\xec(yg\xcaL\xb5Đ\xd6\x06[\xb3\xfdO\xc4oĈ\xe1\"_d\xe3\vѼ~\xaa\xa23>\xd4d;\xb5\xf6\xe4\xd3\xc0\x9e\\7emX\f\x02j\xcam\x8e\x00\xd1D7\xc7(Tz\av\x152RVl\x8e\v\x11_\aORCa\xef\xab\xe5\xb5 ~\x1a\x82X\x87\xac\x8a\xc11\xfc\x1a.\xdfץ|1\x9d{\xa0=\xfaֽ\xe6\xfc\xb7\xcd\x15`Y\xdb\xdc\x016\xe0\x9e<Y\x8f\x1fB\x99\x0f\xeeb`\x0eG\xab\x9e]B\xf8+uR\x88\x87\xb0\xf2:M\xe0\x80\xbb\xe0\xa7\xd5(\xc1u#t\x84$!4\b\x843\xb7d\xc9o\xc7\xc3l\x8c\xe7\xfd\xa2\x82\xf5\x9e\xdf\x1c\x06}\xe2\x1cװ\x9b\v\xe7Ԅ\xf5\x02\x80\xe4\v\x9e\xca{'\xed\x85}\xce^\xacsQ\xc1=\xc5Wˡ\xf1\x12C\xb8\xec\x800L֓\xd2p\x92\xa5\x88M\x98\xe0\xc1\xdb;Em\xcb՜\x8c4 \xf1\xe0Y})K\xad\xf2w\xa8\xf5\xb4\xacC!7\xd6\x11\x18\x03\x1a\xa2\xba>\x81t\xae\x84Iw\x01\xec\xfe\x85XU\x02\u05cb=W\x10V\r~R\\\xc2\x1b`\x00+\x8b\xd1bz\xeb\xb9U@\xc0\xf2j\x8c\x04\xb4\xde\x05\x8c\xbbǻ\x80\xe1\x8c}\xf9\xc0\xe3\x80(\x9c\xad\x1b)\xef\x1a\xeb\xd0\xf4\xae\x82\x94\x153\n'ݩ\x9c-\xabH\xber\xd0պ\x15\x1c\x10s\xc9\"\t_\r\xb9dqѤ\x88\xc1\xab\x1f6\xb7W\xdd\xee\xf0\x8e[u\x9bpl\xd6\xe4C\x1e(\xf3\xca0\x80\xecX\xa0\xd0\xcffƘ\xab,\x1f\xf3\x88T\xb7\xe2,-\xfa\x1a\x8d\xe9\xd36\xcf;\xec\xab`\fئx|\xe4\x93\xe9U\xcd˩\xc3\x11\x98\xe6\xf9\x8c[\xcdhnA*\xc4\x1cw\xe0Crx\xbdd\xed\x06\x8c\xbe\xee\x94b\x81\xea\xf9m\xbe\x15\xa7\xb2\x13<\x94\xdd\xe2\xd2T\x13\x11\u05f7\x82\x8dosH\xc6\xddE\xdbJ\xb1\xf6\x13\xa6\x17\xf0\xd6\xf2gx\xf2\xf8r\x001t\x88Ff\xf7n=\xe0lM\xad\x87\xbbr\x11\t;u\xad\x02ώ\xb5E\xef/\xb2\x9e\xa0ߵ}\xf0Y~i\xfe\x96__\x03\x93\f\xf6n*vm\xf7\x141[\u007f\xdb7l\x94{\x15g\xf3\x84c\n\xfd2~\xbbn\xedg\xb6[ъ\xa8\x05+\xed\xf4\xac,b\xf1\a\x90胰\xda-\xc7j\x1b\xe2>x\x83\x0e(\xbf\x01\a\x9f\x83|:\x92\bݓ\x0f\xa2\x1b\xfa\x03\x9b\xec\xdbǹ\xb6GJ\x8aC/PY\\OD\xe1\xcb}\xfdۏ|\xaf\xcf9M\xc2\x16\x03^\x83\xde~\xb0נ\x9d\xb7\xab\xda^\xe3:!\x9c\xb3W\xde$\xec\x0f\xe7\xc5,\x9dV\xca4Y\xe6qĚ\x18F!\xc0\xb3|^|OW\x9di8o\x17\xe2\xff\x97\x9b\xca3\u05ff\xcb:\x97\xa4}E\xd0\xd5\x01\xed\xa7V\xeaN\xb5\xc1I\xd2\xe3\x17\r\xc4R\xc8\xf5\x95k\xaf¯\xa1\n\x81\xe3*\xdd$\xa7\x14\x9e/\x83\x199\x15\xfb\x83\x98\xdd\x15\xe7\x85\x1a\xb6\x81\x98!c\xa5Zp\xdd}\xc6C\xf0\x12\xcd0\x1d\xccB}\xfaN\xa4\xd3\xfa,FZ>R\xf2\n\xfd\x04\x19\xaa\x14zB\x04p\xe4\x9f\xc2t\a\xd2$\x10\xe9\xe1\f\xfd\xd4b\x02\xf83\xe0e1\xb1\x89`\xac\x13\x97m\x19G\x93\xbc.\u007f)\x87\u007f\x10ϓ~?U\v\xd8\xd9'\x1eu\xebP\xa8.\xd7\xe5\xcfU\xfa3\xb5R\x9fF\x9d\x86\x06\xc0\"h?\xa6Ĥp\x12r\xa7\x04\xbf\x9b\x8c^֠\x00e.tV\x89$\x95#ײ`l!Ruus\xb9\x8a\x01tūl\xd5v\\\xa50\xf9\x88b=\xd4\xeeJ\xe9*+\xad\x10\xb0@\xedJ\xe9\x8a\xde1lѪ\xf3\x06ٴ\x98\xfd=\x1e\xde:s]*\x10\xeb\x8a>1Q\x94\x03cLG\x16(\x97\x87+7\xc6R\xf87\t?\xdcm\xf1\xd7\x17\\\xc8cf3\xc2G{3\fui\xea\xc8m\\ӳxW[S\xe4\xe6\xc6~\xefa\u06dd\r\xddd\v\x16:\xceKS\x86[<\x99\x88\xb9\x84\xcfI\x02\xf5L7\x1c\bV)\x85W\xd9i\xa03\x11!\x02\x85m\xbeR\xf4\x1de\t\xf9\xe2:\x8fZ\a\xe0\xb1\xc6P\x8eF\x10\"\xf6\f\xb3\x91\x90\x8c}/\xd1\xf3HV\xc1:\xe8\x1cA\xeeo\b\xe2\xb9\xcbin\x83\xa5\xab\x90\x02\u007f\x16\x82>ʁ:vS\xbb\xba\x9f\x16\xc1\xfcU\xd1\xc71X\xb1ja\xfd\x93\xe9P~\xe0a.\xf5\xa6\xfd|\x9c\xb0\x19\xbf\b\xaeRf\x16\x1bT\x16g\x1f\xa8\x06\xad9\xed\x03O\x92\xe4C*\x86\x95\x18\xe9\xf5\xa73\xeb\xb4\xfd\x82kS\xea\x99\xe9\x1b\x1c\f6\u007f\x9dw\xf6\x95L\x81\xd7G\xb3\x89$d3\xba\xcb\xd9\xef\x10\x93@\x91\x91\xa2;Ys[\x96p,\x10\x9a\x80i\xa8\x05/\f01\xb7\x13\x11\xbeh\xc5.\x89\x89\xb9\x9d\b\x82$@s\xc2\xd0\x16:\xa4\x8f\x89\x9cFS\xf6\xcc\xceG\xa5Nd4\xa8\x83\x827\xe0\xc3}\xf4\x9f\x8fJ*\xe6#g\xfa\x13ł8\xe4\x13\xbf\xac\xec\x16\x93ˮ%<\xa2\xeeZ\xa41s\x12\xa5\x8a\xa1\xae\x164@vkʊ\xcaH\x80@H}\x82\xef\x18\x17B\x02\xfc5:\x15\xb4\x92^J\xc4\t>1\x8a\xe6<\x95\x80\r8\rG\x84\xe2\xb9?\x98dV,\xcb{\xb0I\x93\xe1U7\x8bi\x1c\xe2Q+\x16\x03:%躜\xc5cn\t\x82\x91\x97\xc0p}KJFzR\xc6\x05>{)\xc0p\x06\x1d'4\xd6e\xbc\x02+\vІ\xc69\x9fTc2\t`\xa2Y7\xc0\xe7>\"\x93\xb3\x922\x1a\x91\t\"2*U5\\\x96\xae\xf0]\xa1j\x05]\x12noX\xdcd\xafT\xa9z\x1a\xb9\xef\xbc\xf0R\x88ӱD\xd3ށ\x8a9)6\xab<Q\xd0\xceI\xa1\x88\xbeN\"N\x00'\x8d\x8e\x8bO],\xc5^,\xbc\x80Sy8ȁ\x1ds\xd5\x1b1\xa3J\xe2\xfe\xd5lc\xf4\x9aE\xe4\xdaŽ^P\x05\v\xee\x9fi\x17\x06C\xe6\x99\b\x15;\x8e\xf2\"\xbc\xad^Q\x17'\x95\xac\x9c\xa4\xe9d\x93\xba_\x8a\xf2i\xf1\xd1\xf6\xe9M\x8a\xfd\x02X\xc93\xdd\xdf\u007f7\x87i\u007f\xd2\ue75d0?\x03\xfc\xbc\x8d\xba\xc0,\x1d\xa6\xb34\xb0{\xfaw\xde\xc3#/\xfe\xb1\x1bxC\x9a\a\x8e\xc3Gܺ{-\xaeܷC\x8d56\xed\xa3xR\xfd\xfe\x0eb=\xd5\xcd\x16\xfb\xd66\x8d\xf2\x1bl\x8eR\xad\xf7\xbdB5\x8cW\xbb\x98\x84R\xc0ہ]oC;7\xa8\xc3%ֶ\x90wm!иKS\xda\x1aJ\x18\xe4\xd6\x05>\xf0\\\x18S\x9f\x9f\xebz\x1c\x9f\xa0\v\x84\xe2\xb7y<\xcd\x06\xcb\r\xbc \x94\x9d\xbeW\x18]\x01\xdc4\xed\xcf\xe8\x14\x87ڂU\xec^\xdeŘ\xa6\x10 \x97\xac\xe4\x1aL1\xcc\xfa)\xbc\xfa\x97\xb4\xed\t)&\xf2M\xf4\xb4\xa2\x9fOŸ\xcf\xf5\x91H&ēh\xa5\x05W\xa3\xe9ǲamkh\x84uk\xaa\x14x\x8f\x95\xe5-\xa7\x15\u070fp~\xafH\xee\xfb\xb1\xc0}\x96\x86\xaa\xfb\xef\xe9\x9a\x00\x04/|\xa8\x81\xd7u.\xc7x\a\xcf\xd2\x1eu\x93;\xe7l\xcc=\xbf\xe4H\x05\x01\xeb>\x17˚\x90\xab\xf7\x82\"B\xe5\x11L\x00\x04\xf3#\xed(,'\xf9\xa4\xeeh\xab\x92\xc2\bq\xe5\xde]\xa3\xb7{N\x1d\x03x\x1e\x8a\xccg\x04\xa1F4o\xd2Q\xac\xd3~\xf5H\x9eyͫ\xb0\x93%\xa3ú\xa4\x98f\x95~\x06\x145\x90K\x1c\x93\xd8\x1d\xb1\xa2\xf0\xae\x89ك=\x99\x81\xdb\xd5\xf3{5!\x1b\xd5\xd3\x16<\xf4\xfdY\xd3v\x9dɪ\xe6\xf6G\x9f{\xdeT\"F\xac\x9ax[\xcc2\xcayo\x1c@\xfc\xab\x82\xc7U\xe1\xcfu\x17OU\x17\x15\xd3<\xf1\xa4\xc5\x16\xdc\xfd\xb0\x16?IN\xa9\xe6'Kxد\xce\x19\x02\x02{\xf1\u007f\xfaY\xcat\x84\x17\x8c\x8d@\xe6+\xa4\xe9\xc1\xea\xf5\x90\x9c?O\xa9\x96X\x04\xb111\x84B!\x9fe\xe3\xb9c؞\x8a\xea\xd0tC!0u\xf3_ \x92\r\x85\xaa\xb7\xcd*\xc07\xb9~P;\x87\xaf\xb9\xfb\xcaP\x8c\x9e>g\x9f\x03\x8f\x02,\xf1\a\xf4$x\xbe!\x96\x1fPG)+\x1b\xab\xac\x06\x1c\xd8\xd9\xd2\xe6\xa1\xc41\x94#\x0f\x87\x82?\xcb\x15\x0eCN:`E~Zгc\xfc\xbd\xa4\xbbqs\xe0\xba|.\x94\"\xcd5\xcf`HO\xf1\x89\x05\x97\x01sp\xd6'\x19\xd4\x1d\fD\x06\xf4\x89;\xa0\xa5\x94\xbf\b_~\xacwK2\xd7#z\xe6y\xcc\x0e\xb8\xcb^\xdaI\xe8\xb0\xeaγI\xe6tm\xdf^'`\x93\xa2&\xdb.\xdck\xbf\xd3t\xc8\x15\x15\xee\x90\n\xc1\xb0\xbe\xb9\x98\xad\xe7N0\rlgGQچϝ\x83\x02\xed\xd1n\xe2$&\xe8\xa7۽t\xb9\x92\xce\vN\xe7\xc5\x1fB\xe7\x85K祤\xf3ң\xf3R\xd3\x01\x9c\x8f/\xb7\xa3\xb3\xbe\x85\xc5FZ\x11\xdai\xc0\xa1\xe9\"H\xe8\x85M\xe8`,fR\xffˮ7\x92\x90\x14?\xc5P\xf0\xbb\x90\x1ft\x85\vS\x12\x165\xc0\xce`Q\x03,?C\x12\x95\x86\u007f\x81αW\xe0zzI\x18^5usO5|yZm/\xa7\xba\x01t\xdaDk\x03\xf8fRj\x87݈\xc6HL\u007f]\xf1\xdcv\xdb\xfe\x81>\xbd\x90{\x14\xa3\xe1G |\x8a\xb9D\x03\xcb/~{\xbbg1\xbe?\xc6\xc9\xf7\x98O8+^kjܛR\x80\xab\x038p\x98f\xee\xcb\x1d(Ge\x12\xdcG\xdf\xe6\x8a6\xbc\xbd5\xb4[\x1d\xb4\x8c]ML\xbe\xae\xba83\xc1\xe7\xa4d>$\xc8\xcaVm\f$~\xac\x94\x89\x1d\xe48Ha\xd9\xdci\xb0\x80\xb4/\xca\xe7\x1f\x13\xf7\xf9\xc7e$А\xff\x17]\t\x18\x93F\xa9\x1fe\xd1\xc3W\xf9\x18\xfc\xc1μ\x1ezf\x01\x0f\x83E0\xb4\xe9\xda7\xb70\xfc\xb7\xe8\x04\xbb\xc9\x05\xbbv\x16\x06\xae\xb1\x06\xa9D\aѹ\x19{\x10\xd3\xf0=M\xb0\xa1\x14\x15\xa8\xbcM6\xf4[}\x89At\xe9\xff\xe4\xbcP\xf4\x81\xd1`\x9f\x1bp\x9b\x9a\x81[<B\u009aO\xd4K-#\xe0\b\xe0B)Q\x94p\xea\x16[\x8d\x9a\x99\xc7[8\x9b\x82\xaa\xc6є\x04\xb4\xb0R\xa48\xf3c\xcdU\xd2K\x8a\x90m\x1em\x89\xf2\xe6\xcd\x16\x81YG0\xace\x02\xc3\xf9\x1d\xb6\x82\xf5\x8b;\x92\x97IqW\xaf51bU\xba\x98=\x13\xe95\xf5\xectf\x95\xf9\xbft\x99Y\xdcە&\xc24٥\xb7\x89\xba\xd2u\x9ak_W\xf2\xf6\xb5Hz;\x8d\x93,\xc6\xd7d*\xee\uece8s\xdc6\x95Z\xf1\xfbl4\x9f\xdd\xc4\xc3J\x93\x9c.U\xef\xe7\xf9TL;\x81\x83\xf3\x96\tM\xd5,\x17^F>u\xf1\xd8\xc5\xc8\xd3K\xb7h\xc7/*\xb6o\b\x11\xf1\xee\xe7E}\xd1\x01\xa3\xadI+\x84.\x84i\xfd\xa5Un\x89\xe5\x96V9L\xeb\xc3\v\xa4\x11\\̃\x00v\xb5|:\xbb\x81W^\x93\x9b\xac\x8f\x9e\x13\x97m\xb0O/a)\xea\xe3\xef>Z\xac\x01\x8dS\xb9\xdc\xf5\xe9V5\x02A\xb1\x00הR\x0e\x05\xee\xeb\xe2j\xd6\xef\x83 0%\xaf\xc7\xf9(\x1f\x9bB\x02\x1b\x9e\x9d\xfe6\xcf\xc8\a\xf7\f\x85L_\xa1\x1fC\x9f\x040x@\xf5\xb9\xf8\xffg\xa6W}R\xf0\\8\xf1\x10\x14\xc0\x9a\x9a\xf3\xb8\xa2v\x19ZP\xa1C\xbb\x97[\xdd\xcb\x02\xa3\xffɤz\xe1\xf4\u007fWRDPǵ\xca_\x92\x02$\x03\\\xcd\xe023\x84*\xa2\xd3x\x99\xb3\xb4r\xc4`_\xb9\xfa\tu\xc0\x19\x14\xf0\"\x05>\x91\x15\xa0\xf3\xa8\xdb\x06_)\n\x12\xca\xe1\xfdc\x91\xd4=h\x83\x14F\xdej\x03G\xc05f\xf5\xf0[\xf3n+\x1bߥ\xf6+\x94rnF\xbb\xb0\xcdͻV\xf7\xe0\x14\x04\xf1\"\xba\xd5\x1d~\u07b5z\xcc\nO\xac\xa7\x01vd0z%W\xc1^\xda\xe1a,8\xa5>)g\xb1\xd2B\x0e\xa3MʹǴ\x05̆z\x1a\xc22ip\x8d\xa1\xe8\xf3\x89\xd6W\xf3LOǾ\xcf0\xf5E[\xa9{\x80a\x17\x89P\xf4\x814O\xd5\xd4\xebK\x9a\xc8Y\t\\\xfb̓\x10\x06\x13Q\x9e\xca\xee\n\xbd@\xe0\x8eU\xfb\x12\xdc3\xece;\x00B3\xa2\xcb)\x92\x1f\xb7\b\xad\xcb\x0e\x9f%\x10\x18\xdeZ\xcd\xf5\x8c\xa9\x9a*A\x808\u007fKoaPո\v\x93\x80\x8c\xa3*th\x81\xc9!\x19m\xbc\x97\x98b\x9d\xf2b},g\xa4\xaf\xd9p\x16&\ae\xb0\xf7\x9eh\x15\r\u052c\xdfB瀚\xd6\x06\r\xae\xfd/6l\x9f\v\x9a-\"\xe0\xa8\xda\xda\xc1\xba%\xb7v\x16(\"\xc5\x1f#\x0fW`\xe6f*\x97\xf1\x97`S\x12\x02\xd0\xd24pm\x1f\xf6\xc8\xd1V\xc5\u008eE\xca\xe5 n\xbaaIp&\xce\xd3\xc8\x13\x8e\xc3\xf1u\xbb\xe1ʷW\"\x03\x04\xc9X\t\x93\x82|ܗ\xc3\xea\\\xe1D\x1d\x97\xae3\x13\x0eJ\xa8\xdbe\xabN]\x17\x04Ɯ\x81w\x85I\xbbQ\xb9\x14\xb1\x97\xb1\xc3\\l_lZ\b!\xdc!\xcd\xcc\xc6\x1eT\xb2\xe10\x1d\xcaG\x187Y\xb7\xba\x14<\xce\x12ͷC\xa5\xf4\x84kF\x05\x9f=ж\\\x1et\x970M\xc7\xe0\x12\u052f\x00h\xac\x1ec\xbd\xe9.\xf4\xa6\x9b\x1a\xe8\x1a\xff\xe0\xaf@A\x83\x81\x11E\xd4\xf0\xe9)?i\xaf\x1eb\xe8_p\x1c\x89\xe1\xdc\xf5_K\xa9\xcbh\xf7\xe4\xb8\x19\xed\x1dӮJ\x92\f3\xba'\xad\x83f\xb4\u007f \x90\xbf2*A'ݫR\t\x80,\xf8\xc6\xf8\x95\x00\xa7\x14\x02D\xe1\xdfD\x1bhc\\\x11X\x10\x97M\x9cm|]\xc4\xfcF\x99\xce\x00u\x9fb\t\u007f\x89Ev\x10\xac\x0e#P\xbdt\xe2\xf8M伂1\x1ck\x1fN\xe35\x96L\xa2\xe5\xe3/W+$\xa4\x9a\xa0!d8\xdbl\x13l^\xd66\xe6\x06΅[c\xf5\xd1W3>\xb1\xac\x96\xff\xc4u\xcc\xc7)@)\u007f\xc9\xfa[\x11\xaf\xb1j\x89R\xe5Sv\x88\xb7h\xec9\x8bb\xd7\xdbz:8\x83\xe8\x8a^D\apuC(\xc3\xc5-\xb8\xd3\x00hϣ\xdd\xce>\xa4\xde\xc4\xf7q\x96A\xaa(\xf9<\xea\x82/\xb1\xc9\\Ȓ\xfc\xa7\xac\x9fc\xe9\xfbt\xba\u007fܰ\xb0sE\x9e,\xa4#\xbaʮ+\xe6A\x0f\xf1ԼW\xc2,\xfc\xbb\x9dC!\xde\x0ea\xf17l/\xd2\x0f\x84\xa4<<\x88\xae\x180\x89\xf5J`]\x0f\x98\x90ǝc\v\x16\xebm\x15<\x00\xd7Y\x01N\x0f\xe5\aL\x10IJ\x82`.S\xd8\xc9\xc6\bIdU\xc9Bε\x0eu\x1eQɫb:̪uZ\a\x8d \xc3Co\xf4,\xa9+4LJ#$ \xacJ\x1fD\x06\xd6\x107\xa1&p\xe6\xe3\x90\n\xccA\xe9\x9e\xf6\x0e\bS\x04\x0fD\x16l^\xf8`=R\x9a\xac\xcb\bO\xe6\xf6۰mK\xde#\xb8\x9d\xa8sD\x9fѕKh\xaff\xe7Ī\xd9m{5\xd9X8\xb5w\xa2\x83c\xab\xf6\xfe\x9eW\xdb\x1d\xa8\xa0\x86m\xf3d\xdd!\x9a'\xb4z\xf9x\\\xfd\x14\rKT\xeb\x17k\x9a\xceV[\xce\xe0\xbc\xcerͲ\xec\x84\x0e$\xfa`\x0eZv\xe8\xf8\xf0+\x19\xa4\xdc\xec\xe3\x805&v\xdcsK\xf1\xfe\xaaa\a\u07b6\x94\xed\xaf@\xd9\xeewJ\xcf\\1L9k\x8b\x83z\xca_\xac=|\fcӆ֤e\xa7\xa9\xfb\x82\xe3\xf8\xef\xa09V\x8fo_\x8fo\x99\xee\x88ʽ3\x84\x86#\x9c\x81G{̈́\x9b\x9eP7\xed7\x945\xd2\x1eEo\xb8\x9dA\x05\xa0ς\xe3\xef\r\xf7\"\xa0\xba.W\xe9\xa44Hj\xc9\xd9J\x94.;^#\xda\xe0ё\x1e7\x11\u007f\xb0\xb0\xa1\xady\xe1\xcdCW\xe4 Z\x01D?@w\xbe\x84\xa1\tP\xa8]J\"i\xfeY\xac\xb4\xfc,\xaa\x8c>+z\xf4\xb1Uݲv\xff<E\xd7ǈgx\x86\x1a͜\xf5\xfd\x03o5\x01\v-\\\xa8\x88\xc7\xd7\xf3a\\\xed@\xc2)\xbb\xd1\n\xf3,\xda;\x94+ʮ#t0\xeb\xecc\xcaރ*\xd9K\x95\x9c\xbe\xfd;I]M\x12A& H3ڥ_KG\x14\xb9}\xf8\xd83\xc3i\xaf\x12\x97?o\xb6TaY^\x84ϑQ:\xed\xc7\xf2\xd5{\xe9\xe4P\x85\xb6\x9e\x15u\x14\xf1\xc3\xfc\xba\xaee\xbd9F݇Ut\x85&\xf6,\xea\x06L(\x8d\xad痾\t\xb9\v\xa60}%\f> \x9c\xec#N>E\xbb\u007f\xefYg\x1f\x87\xe1\xaft1\xa9\xeb\xc9\xe8-\xc9\x01{\xd6nt\xd2vf\xad\xee\xfcǞ\xae\xaa\xa1p\xeb\u007f\xde\x04\r\xe2\x15\xc8\xe3Sr\x12\xe3%\xe9*\an\xb3\x1b\xc7\x1d\a\x8bh\x8c\xfe\x06~\x12c2/\xbc\xb0Ƙ\xf7*\x9b\xf6\x87\xd2\xe3 \x80z\xdbǄ:\xabX\x12\x92\xd0\xec\x1cE\xb57\xa29\xe6蓮\xd6\x17\xf3!\xb0xo>\x18\xa4Su\xe1\xfbs\xf5\xfdN\xb4P\xaf\xd5\x1a\xf2\x1a\x80\xf6\xaf\x8b\xb9\x8eSFi\xdc*t\x1c\x1d\xf7\xd6\xf14\u007f\a\ue7c3\x93HY \xa9\x8c;\xd7$\x1e\xcdZ\xa3\xe4>\xf3py\x9d\x8f_N\xd3\n\xabX1\x87\xa7\xe5\xb1S\x06f&\xdd\x1ao\xcbgU&o\xc5\xe5q\x00\xb8\x1b\x80\x98y;nQ\xb2\n\xefWb\xa8\xa6y\x96\x94\xe3.\vj\x8bӨ%S\x9c\xae\xa0KuC\xc3F\xa3\xa9\x1ez\xc9\xf2xA\x16\xdfH\xc86Y\x96J\xaa\xd3\v.|\xc0E\xbf\x9f\xd2%\xf0\x0e\xb0\xc1.\x9c\xc5\xe0\xe2!\x8b\xe3\\Z\xf2\x048\xc1y/i\xbd%m\xcd\x06j\xbd\xaeg~\xd7\xcfܷn6\t\xd8c\xb6\x0f \x05\xdf\xd7\xedZ4\xe1\xfb\xcb]\x8b8&\xe7\xbd\xe2\x9f\xf2M`\x13\xf6\xb1\x87B\x9a\xbf/9\xae\x8bK\x99^\x02\xa1E\xa2W\xd4+\xe8\xe7\x11O\x12ɳ\xdcZ\xa2*ڇ\xd5w]\t\x05g9\xba\x05\xd7v\n\xf6lp\xd3\xef\xca\x15lS\x8a.s\xc4\ar\xb3\xae\xba\xf9u\x1a\x8b~\xa6\xafr\xb1\xa9@\xd8,\xb0D\xeeZ\a\x06T\x18\x83\xe5\xb5ԇ\xfdjD\xa5:\x1c\xea\xf3\xa8\x14\x91\xf8\xa8G\x8bWU\x1b8\x12\xa8\x9dΦK\x16+\xb9i#]\x86\xaa\x06\x97\x1b \x1e\x8c\x1f\x81\xb6e\x108r\xb5\xd75M{\x01\x913\x8b5|~\x13\xaf\x85,\xcf*\xdbA\x93\x8b\x01\x88te\xdf6i\xcb\xe7\u007f5u\xad\x0e8\xa2vE\x0f\xbe\xcb\xc6\xe9\xc5l\x9a\x8d\xaf?z\x0f\x1c\x8c\x1bkrNY\xb7j\xdfq\x10\xaa\n\u007f1i\x0f\xd3G\xef)\x84`\xeaY\xe5!\xf4\xd8z\xcfC\xec\x9a\xce\xd6\xc3~3\xf2\xce\xf3ۅa)-\x00\x1e\xed\xab\xa8\xcf0{G\xe19B\xf4\xb7\x1b\x80\xa0K\xee\x10\x84\a\x81y\x0e2\x13\x13\x05\xec\xffiÀO}K\xc7\x02\xce/\x1a!\xdf6U\x03\xf3xC\xa3ڹ\xb4\x15Yx\xd1\f\x97+\xffQ[\xe1\xf5ɑx\u007f\xfc\x00b\xaa\x9b\x01\x01\\\xff]&\x98;R\x8e\xbb-\x17y\x0f\xa4\x13!\x8f\xfc\b\xdbxH\x1e\x9bP\xdd\xcd\xd8l\x15\xa3\xb1\x0e\x80W\x83I)\xab9X߆\xb9m#~\xb3\xdd\x05Up\xdf_\xe5b\xbf\x9e2#U\x83L\xb2\xa0\xf9\xb49Фo\xa7Ҙ\xfa\xb00\xb9\x8fx\xf9\x99\xbd\xd0\x19\xa5\xa26A\xa5\x93\xf4\xee\x0fV\xda\xd4\xc3\xe3棪o\xeaŵ\u009a\xabn\xfe\x8b$KK_S\x8bcU\xd7P\xe6*E\x8e\xda\x1e\xb3\x8d\xae\xadȝm+\xc8h[\f7\xc7?\x86\x16\a\xd0w\xce\xcb6\xe8AJ\xeb\xcd\xf2\xd6\xf3Bw\xe9\xf1'\x88\xec\x8f\x1eR{nT\xf6EFb\xd3\xcfRq\x15\x91\xbbB>k\xd8v\xb4b\xe6\xac`\x14\x0e\xf8C\xf8\x8e#i\xf6\xc7\xda`\x11\xe6A\xb6\u007fe\x1bbx\xf8\xa1\xbe\xbaWM\xbe#\xb6\xb3\x8cIncf6\x92\xa2\x92\x9bUc\xdaQ\xf8\x92\xfe\xbc\x97\xb1.\xb7dv\xbe U\x10\xadt\x02\xc8\xd7\xe5A#\x82|\x11\x1d4#\x80!\x81\xe7u\x83A \xad\x83\xe5\xf7t\x96\xfc\x9e\xd1\xda\x12\xe7ȉ\xd6v=d\x85\xb5\n\xf8^\xed\x15\xad\x1e8LˀPf\xda-\t\x87\xddp\xec\x0f\x8b3\xf7\xb8\xdd\xce\xdfa/\xcf\xd76S\x94\xd8b\xbc\xee8\xe9\xf6\xa5\x98\xf98\xb1\xfa'\xd7\x16\xd5K\xe6\xbc\u0378\xae\xd0A\x91M\x92\xf2Ҷk\x92(\x86\xf2\xae\xed\xdfA\n\njW5\xc6|\x9b\x81\x19ɦ\xf0B\xb0,9P\x93x,ά\xdc\x17\xca\x17\x9bB\xc1\xca_\xb2\xb0\xca\x1a\xed\xa5]\xe2\x05\xa0ڐ\xf8*?\x14\r\x8bv\x97\xe2?r\xfc&\x81\b\xb1p\x19I\xf7nPQ\xca\x02\xf7i\x1f\xf5\x13d[\xa1\x9d\x03j\xb9h\x95\x91\xa9\xcdOJ\x94\x8ePa\x93O\xd5B\x8b\x8fU\xcf/@\x15\xf9\x8eܪ`2\xa8\xa0\xb7\u007f\xb7J;\xb9\xac\x8a\xb4̬\x86\xadE\xa7\x0fXfQ\xe1\x10@LS\xd9!02ՙ\x03}5\xd5*B@\xd0\xe4\x13\xe9\x8eM\xf3.+\xb2\x9e\x9a\x96\x9eA3\x9e\xf6[\U0010caef\x8b<\x8f\xack\x1a\x0fn\xac\x89lRn\\_\xb5[\x83ړ4\x91~\xc2&b\x81\x98\x88ғn3J \xb8pg\xb5\r;\xe9\xa05\x9c\xa3\xdd\xf5\xb6hN\xf84QGw\xaa\xe1;\x9c\x16\x9b \x89\x97\x8c\xdfF#r-\x16\xc0\xd9\xcbi\xff\xdb\xf1,\x9d\x8a\x85\a\xee\a\x12\xb6\x8dz=\x817b\xec \x96\x12\x92N\xa3a-B\x16\\Q\xd1\n\xf2\x00\xf7c&\x1d\xe7\xc1r\xd0=\x17\x96\x9at]\v\xc2\x13\x01\x02\\>\xc9FB^\xae\xa2\xb5\xbb&\xcb]\xda\xd0p\x17\x06\xa3$\xfal\x8e:\x13rޘt\xe8w\xc3u\xb7N=[\xe5\xdc(\xc1\x97&\xde%=\xacm\x9f\xc5\xe8\x937|\xf1+\x11m[\x8e6D\x96 Ť\x8b\xb1\xb2\xcf\xe1-\x84\x8c\xa9*\x92::\xa9\x83IO\xea\xac\\\xc7/ס\xd7C\x86\x8e\xf60v\xca\xd65\xb1\xe1\x89G\x93\xa1\xa6e\xa3\xe4Q\x90*U5\x8b\xda哈L\x1c\nL\x82O\xab\xc0\x0f\x83sf\x0e\xbe\x18,UM\xd5(\x9a\xe6}\x04̤\"\x9fO\xfb\xec\x96o\xf9\xe4\xd3\x10\xec\x9a\xd6\xe4#\xcfw\xaefƕF'\xc2\xf8;\xea\xed\b\xdd\xe4H\xf8J?\x8c\xa4\x89\xe4,j\x98\x0e\xd30\xe8\xb2`\xc4r\a\x84!G]m\x9c\x85\x87*\xa9xBD\xb7\xac\xc0E\xab\x98/\xd8\xe7\x13\x98\xe4\xadv\xa7\x19\x99\x17\xf1*\xd7\u007fy.\x900g3jʡ+Q\x8eXk\x16O\xafS\x8c\xa8\x94%B\xed\x14\x8a\x88D\x96dz\v\x98\x89\xcb\xfe$\xa8\xdf)\xbc\x1d%\xcf#Ī\xc3m\x1dp#\x9b\xc83n\xf0\xa2c$\xd4\x03\xdbq\xc92\x1f\xed0\t\x8f\xd2T+\x8d\xd6 \x1bb\x94#\x8fP\fo\r\a\xfc\xe5ó\x82\x1fz\xa0V\xb7\xfa@~A\x83F\x93\xc1g&\x0e\x91\xbc\xf1\xc9\x12\xb3\x1b-\xa5\\*\xdb#j\xfct\xf9*\xfc\x18P\xf53\x88_\xe5i\x92\n\xf9'\xd7\u007f{{\t\xcd\xe7\x8f\u007fD\xa5\x86H6\xeaw?`\x84\x14\x98\xf0\x05\vX\xe8T@\x85\xbf\x96\xa7iS\xd3\x12k\x01\xd7\xd0\x1fr^\x85\xea͊\xadx\xb8\x13\xa5\xa3YjK\x0e\x8e\xe9\xe3\x9c?陣gMY\x80:\xe5\x8a\xc4ۑ?\xfc\xc1\x1d\xff\xb0\x83\x02\xd3\xe1*\xac\xe1Z\xc7\xc7\x19\xb1G\xc0~\xe5\b\x05\xba\xf8\xb0\xf6\xf8\x9a\xae\xffyC\xfcH&\xf9-\x96\x05\xcf,\xb9\x8e\xfc\xa5\x96\xb9\xa53/\xb1\xd8˕\xfa#z[\xf0W\xfa'\xabVzoIG$\xed\xd0]\x84w<\xbe\xde\xf2~\xa2T~\x8c\x0f$\xa5\x15ՍҴ\xb3h\xacz\x01\x10@M\xbbHjMe4/,\x83ZU3\xaaM\xa6i_,2\xd0s\xd7b\xa44\xad\xca\xfd\xb2Q\xc7,\xcd\x1b\x94i\x95\xa5w\x98%*\x13\xbd\xb3\x86m\xeb\x8cB\xcc\x10Bp5Ե\x99^¦\xe6\xcaѯ\xeb3\x8cW\x8a;n\u0602\xb2\x99\x87\nn\xc66g3\xa3\xa0y\xe5&\x9e3\x1d3\x8fh5\xad\x99\xf5\xa4f\xd6\xd4\x15+\xea\x83\x1f\xf2\x19\x98\xcb({\x8aN\x17\x98N\xbby\xd2e\xfd2o0]\xee\xf65\xa5\xce\xf16\x96\xab6\xb3~\xfb\x80̎5\x9f\xaa\x1d\x8b7`\xa1\x91\xb7\xa6\x90\xea\x8a3\x85X\xcbj*\xe1f\x94~\xafҜ\x19|E\x86\n\xf8R\xeb\xc7\x1d-\xfd^S3\xe7@\xb4\r\xc4~\t\xaa\t\xa2\x87l\xf3\xb0\xa7T\xd5D̖\x80ޞ\x95R\xf3\xc9F\xd4t\xbb\xa5p\x0ewD\xf3\xd5\xe6\x1d\xa1\xaa\xfa\xf6\xb2\x02\xf4\xb6|؞l4l\x9bu\x843\xff\x16\xb1h\xb9\x90\t\nT\x0e\xff\xed*\xb1\x1bD5\x90\xe7IWf\xfbg\x06JSj\xa4\x9dȩ\x8b\x92\xe0\xfbY\x8c;\xef\xf5\xf5\x14N\x8d\x04\x03OgܖO\x0e\\E\"\xec;\t\xfd\xd1[Y\xfd\x02ұ\xa2tb\x02VDtɪoΒ_\x94D\x1eF%\xf24j\x82\t\xdf\xc7\xdf\xc3ϥ\xfa\x99\x19\xff\xaeIn\x86\x80{}\x85\xf1\x80\xf6\xea\xf8\xa8\x18\x9a\xd8\xc5X\xa1\x19\xc8\xee\x8e_\xca)\xd1-\x85\xa3J\xec\aa\xa8\xdcc\x9e\x9b\xa1@9\xb4\xac\xa1\xf8\xa0\x16zt\x0e\x86\xd7\xe8s\xf8\xe74b\xa6\xb7\xc4rb+m\x8b\x06ډ\a\xad\xed\xd4Eҝ\xc3[E\x17\xb8g\xa8L\x16Z`\xeb!\xfbra]\x99I\x96\x81\"KV\x84\xbb\xf6\x14\xf0\x9e\x10\x02B[\x135\xf1ci\x19\xe6h\t\xa4˽\x11\xb7\xfb҈s\x97\xcb4\xf0\xc6ٵj\t\x0f\nMA<\x1bT\xa5\x1e\xd4b\x8d\x16\xb5'\xf0>Sp%\xbe2\x11\x1fK\xfb\b\xa6\x1f8_atP\x1ep\xc1\x9b\x01\xfe\xdf.}\xec\xd2W\x9b\xfd\x1f\xcb\x11\x8c\x8a6(\x97d\xcaB\xb5\xdb\xf1\xeb\xb55x\rZ\xe6 4>/o\xc0\xa5)\x9b\x94\xf0J'\xeb\x1b\x83$\xc6\xe3\x92iJu~\x1e홧\xa3\xecr\xf1\x10\xa3\x148\xa5ń\xa3t\xf8\x17Y\x1f\x8f\x19\n9y\xc5Lǀ\xfd\xf0;C\xd3\xe6\r\xcdXt$\b\xfe\x05!$Q\x17=\xab߉eQԐ\xb7\xb8\xd0v\bs\xb5s\x16ex\x027\x06s\xa1\xf3,Da#8\f\x06\xed\xb9F\xef\xf2\xe6\n\x1f\a\x19^\x82\x86\xb3\xc0Dq@\x9c\x9f\xaf\x80\xc1˷\xdd&E\xc2\xe7\x118\xfd\xb8\t:\xb8V}jW\xf5\x89f\xee\x8d\xefP\x1f_\x10;\xe8\xee:\xd8\xea\xf7\xbb\x1d\x1f\xd5]\aU\xbd\xa8\xe0\x88\xd1Tcao`\xd7p\xca\x1fs\xe3xu\x1aF\xc5\x14z{\xba8\x8d2'\x16\x13uVB\xc5\xc0J\x9a\xff\xc4\x00\xf7\xfcS*\xb9Cٍz\xf4\xcb>\x01\xa5\a\t\x00\f6\xb9\xac\xc0\x9d\x95\x81\xc8P\xc6\xdc\xc89\x97\x8d&>\xcd\xdf\x190\x99\x05\x86\x86#\x06\x960\xf9\xd8>\xe7\t\x9b\xd2w\x95\x94v\xc7\xf0\xaer\f\x05\xec./\xfdn\x15l\xb7t%l<\xbc\x06ם\v\xf9\xa0\xff)\xfc\xf3\xe2\x1c\x1a}\n\xff\xec\x00@\x91\xd8u\x03\xabX\xa4rn&\x86N\xb7\xa8\xc2<\\\xc1\x1a\xdf\xcc\x1a_\x13f&\xb3b\xf3\x8a\x84w\x81;\xc5n˕p\x1d\xa8\x16L>eQ\x80\xd1\xd4Pη\xcd,n\"\xef\xc8\xc9\xdc\xc5\xd3\f{*\xcb\xe6ߩ\xae?\xc1e\x9cӓ\xc1w\n\xf3CΝ\x9d n\x13ҥ\x04\x00n\xfaA\xfc\u03a2w\x8c\xdbW\xa0u.\xd1r\x05\x8e\\\x1c\x9f\xa8\xd5\tV\x93W\xaf\xfe^\xc76/\v\b\xb1\xd8UB\x9e>\xf10ц\xde\xd4\x1ch\x9d\xb9\xed\xee\x16\x13/҇\x04\xb4\xb3se\xc6\xee\x9d5'\xf9\xfb\x9a\xe1\x92=\u007fqe\xab\x80nOs(O\x84\xd63\x82\xdaˮ\xae,\xc1\xc5.\x10:ڱ~\x10\xb4\xe2\xa1+\xdf\xc3\xcb{V\x81\r\xeb\x1aG\x81\xb1sTJq\xa7\xc8+\x83m\xf7D\xb5\xb8\x17.\xde\t\x14o_\xad\x11\xf8\f\xafJy\x87\u007f~\xf3\x99-Yz%\xf5:\x81z\xed\xab\x92\xc3\xd6zOT\x8a\xc1\xb4\xd4:\xb0\x8dZ\x8cN\xec\xea\x98&\xf0\xedfW\x16\xeckmq\x19\x11\x9bQ\xff\xacz\x0fGw\xb4\xe1\xdc\xfc\xd0\xee\xa6\xfd@\xac\x9a\xe0\xe7Q\x8fӱ\xfcn:\xec\x8eb\x1a\x89\x1e\xc9\xf9\x1e}\xc5\xf6\x02\x02CQǂ;X\x02\b\xda\xe7\x0f\xee(\xbfC\xf9\x1d;?\xe0h\xe5)\\\xb4\x87'ӷ\xcekhkX\x9c#\xcdb\x8eVXg`Ɠ\xf9l\xf5\x03\x00:\r\x8fK\x06\xa5\x87\u07b6\x935\x82\x16AktY\x1e\r\xc22\x02\x16\vx%3t\x1c\xa7\xf6\xfa\x03\x81\xc0/\xf1\xc1\a\xfe\xd4<\x01H\x9a\xa2\xeek\x82\xaag\x02\x89\x06k\xbf\x05\xc0}\x93\x14\xc7R\x18};.\xb2$\x857Ө]\xb9\x17D\x90]\xc3U\xfa%U\f5ح\x12Vy\x96N\v|\x88\x85!n\tDeDd\vZ#\x10\xac\xae\xbc[e8~\x18\x86\x1c?<\xc5\x0f]\x03\xb5\xa6\xe3\x83\xfbN\x19\x9b\x0f\x99V\x9c\xab\x10\xd6\xfaq\x97O\xf3q\x9eUm\xc9\xd8#\xe6\x82\ufdec3$O\x87VˠR\x9a\x05Ed[o\x05\x1eE:D;\xb3\x02\xc6\xee;:\xf1\xe8\n1\x15\x8b.\xed\xcfpo\x06\xfb4\xcb̑\xb6bi0\x10\xbb\xf6\xb4\xd5\x03\x95\xd1zbR\x80\x8a\x9b\xb6\xd2I\x8b\x85\xfa*\xba*qXj_0\x15\x87\xa1\x8aӐ\rAc\xc31@%\xbb\x00\x8bF\xd1i-\xf1\xf9qzxfk\xeci\xab/\xa6'\xe0\x0fz\xaf\xa3D\x8bFE\xdd.ֵ\xabvݪ\xddr\xf7e\x1c\x8b\x85\x87Œc\x11\xa3\x1e\ued23\xb0X\xb8X,\xbbn\xd5n(6\xf5]\x87b\x90\xe0V\x1b\xac\fw]J\xc0=w\xc4\xf6\u007f\xc4m\x97ik\x9a^\v\xa6h\rI庒\xfaR\a\xaa6ʋOˋ;\xd7XU]\x9b\x9be\xaa\xe5\xf4\x01\x8fT\x17\xde&\x16\xdc\xf6{\xbb`\a3\x1dM\xcf\xcc1\xeb\xf0Q\x1f\x88\xb1\xbd\xed\x1dm\xad\xfa\xa0\xae\xd2z\xdagO\x00\\#\xb0j\xa8js\xbbj{\x1b>ݵh\xa0A<\xc9\xd0Z\xaa\x01\xc9\xf6\xa5b%\x93_\b&\xd9\xed\xb4]\xa4\x1f\x1a\xae\xddJ\t\x86|\x82\xd1\xedL\xd4\xf0\xe1iT\x9b\xca#\xa4\xa9\xf8=\xac\xb9\xf2+I\x87\xf1|\x1c/W\t\xb04\xb9N\xb7\x92^\xe0q&\xc3\xfe\x14L\xb9\xdfF\x9a!\n\xa5,\xad\xf8\xc7\xe3a\x87o\x11\x8a\xcfP\x90\xec\xb1\xeb\x9d͘Mɿ\x8ck\x19\xaf\x02\x84\x8fȨ\b\xfeC\xd9S\xc7`\x87\xbd\x15\xf4\x90\xdd\xe5\xc3\x06\xcc%R\xdao\x8eğ\x9d\x1dވ\x1eMeɽkbU\xa1֨\x1f\xe0\xf9\xf3*\xba*9{\xd7~j$\x1coI\xfdm\x1e'2\xf6=\x13(\xb0ut\xad\x8c\xde)q6.R\b\xfb\x8dѭ\xc2e\xa5I\x0e\xe36OZ\v\xbc-\xa7\xbe\xc0\x8cO\xe8\xf1\xf5\x11B\xb3\xc6\x03\xf7\xb6\x03\xf0Ƙ\x9et\xd8&\x98;\xffƲvCq\x87\xa1Աٝ\x88\xa5.1u\t'˭v\xc7S\xf8\xb0W\xaf \xb4h\xb8k+\x83\x05KD\xbd\xab\xcb\x01\xe8w\x95\xd07E\xa6ʌd\x90\x9a\xaca\xfcY\xbb\xdd`\x00\u0095\xb5m\x9d\t]Z\xa1\x19mѥ\x1d+\x04\x96\x81D\x8c\x13\xb4ԉꅈ\x18RP\x9b\x8a\x05{\xca\"\x06\x14\xd3ddֺ\xccx\x8e\xb7\av\xa8\xaa\xf6Ώ<\xe6\x06g\xc4 \xc2-\n~\x98]\x01\xa7\xd6٧9\x97Q3\xe6{\x91U\xb7\x0f\xb8\xe5K\x16R]ԛ!RF,\xa3\xa8z\xd1B\x9a\xd6R\x16$\xdd\xc9<n\xa9\x98^\x9e5\xc7\xde\tJ\xe317k\xe1\xdc\x02\x9dSN?m\xf8]4\x1a\xe6\xd0AV\xc4\xe8\x91Nw\xf1\xe6c\xc3\x00s\xb7\xf0b6\x1d\xd8\xd3\xde+\x01\x9a枥iB\x8f\x91>\x96\x0e\x89\xae>;՚!\x16\xf2#]\x13\xb0\xae\xffpi\xd53\xba\t7w\xba[F`\xb8\xe7`\xbc\xa7\xb6ŧ_\x02^*\x81\x93\xd8%\x95X\x86`\xbc@\x0eG\x1cK`\xbc@v_v]\x18\x0f\x968$O\xd2]pWܑǸK\xf8\xe2T\x94G\x84/\"8\x13\\\x12\x8d\xc5DH,\xb6\xc4Y\xe7\x1e\xfa\x19\xb6\x92\x01AÜ/\x17\x18\x88\xba\x19'\x96\ri\xc2\xf8\x80\x98\x18JU\xf01\x03\x05\x97c\xad\xab\fl-p\xf1\xf8\x19\xca\xd6\a\x14\x0e\xb4\x1a\xb6dl\xa51(\xac\xedU\x12R\xf9\x029\xcbF\xa9\x1b\xf0M\xa6})4\xa8\xa6\xfa~\x9b\xc4ˋ娗\xcb\xf0\x05\xb5\x8b\xf9X$AP\xb9\u05f9\xfa\xf5f\x9e\x16\xf2\xe7\xdf\xd3d\xac?\xde\xdç\xea\xf7\xd7\xd3L\xfe\xba\x80\xbb\xd5\xf0[\x1dHRC\xf3\x19\\\x92\xc8g\xb9\f\xb2H\x94\xb9Ng\x80\xcfiT\xae\"½\x99\xd6[\xb1\xe4\xcf\xfe\xf6\xe6\u0557\xccm\xb9\xbc~\x88 \x96\x1b@X\xfa\x00\xbe\x16\xabݯi<]\x1b\x8a\xaa\xe0\x83\xfa&\x17DY\x1b\x0e\x96\xf6\x81\xbcΆìH\xfbb\bև\xc5+\x85@\x8e\xe7x#lmhX>\x00(\x1f\xcfn\xd6\a\x03\xa5} \x17\x1b\xf6\xed\xa2\xac[o\x04o\xad\a\x05J\x86\xeb\xbf\xcf\xc7\xe9\x0f\x83A\x91Ϊ \xb5\xad\xaa\x18_\xf1\x87\xc1\x1aM˒N\xd3E\x05\xe3\xab)\xa3\xa7K\xab\xd0\xcc\xcf.\x93\xb5\xde\xf2\xebd>\xf4\xe5\x86\xc0\x97kî\x9c.e\r\xa8Jk\xb7R>\x93ʚ\xc0\x1ak\xc3_9\xc9ʚ\xe1\x157h\xad|\xfe\x957\x84u\xd6o\xa3tj\x96\xb6\x005ֆ\u007f\xb1\x05\xb1.6\xa4S\xe9\x84\x0e6\x00\xa5W\x81v\x8c 6\x10\xb9 \x9a\xa5\xc9-)\xd6\xddQ\x8cs\xf5\r\xad\x9f\xb5O\xe3\xe8\xd3^\xf4i\x1a}\xfa\xcd駯O?\xbd\x88>\xfd\xb5\xd6\fT\xc0£g\x9f&\xcf>]z\x0544\t\xa4\xe66,\x96Ђ4\x18g\xa1\xb6\x16\uf5fd\xde4\xbd\xcb F\xed\xd8)\xae\x95a֪.\x9e6\f\x98\x110\x81\\\xff\xffW<\x9e\xc7SZ\xd2\xd3\xdeT\xfd~\r\xb1j\xe1\xc7\xcb\xc94\x1bR\nf\xfc\xaf\xf98\xa5\xbfC\xfc~9\xbf\x9e\x173\xd4\x03\xd2\xc9,Ũ\xc8\xe2\xe3\x87\xfe,\x97?\xbf\xcf\xeft\xf2\x97i\x9f~˫P\x06\x9d\xb2~\x11\xae\xd5=c:P\x8b2\xb9V&\x90\x9a\x80\x15\xc93\nP\xd1z\xc2\xf2\xe46\x0foX\xab\xcbLr\xb3\"-\"\xfdf4X\xe3\x10\n\xee\xa2\xcav[\xfd\x9bx\xfaJ\xe8\xa1/g\xf5\f#S\xef\x1d9~\xaa\xb0=\xb2\x9b\xe8Z\xe0u\t\xd3\xeb\xef\xc0\xfeԌ\xea\x03F\x16½\xb8\x84#\r\xab!\xd1\b\x1c\x86_5\xa2ϣ\x81\xec۩\x15ځ\x8e\xa720\xc6\x04\xb6ן\xac\x8f\x92\xe7ѕ\xea(\xf7\xb8\xee\xc6o\xccQu\xae1\x88\xbe\x80\x0f\xfc²\xed\x10<\xff^?۔\x9dB|\x98\xb6\xb9\xa74:\x8d\xd8W\x02\x81m\xf5\xd77V\xdek\xeb\xeb\xc2\xfa\xfaN|\xe9'/Q\xc6Ȏ8\x82\xedVu\xa4){m\\ب\xab]O\xce\x15A\x9c\xbb\xbf\xdc\xd2\x02Ek\x93\x9a\xd8{DI#JZ\xdf@[\xe2\xdfO\xa3\x0e\\\xcbIZ\x10e\xa3ӵ\xc2\xf6\x90\xa0\x19\xa7\xf7\n+\x95\x9b\xe0\x18\x99պ\x9e\xb4\x96Mxn\x04\xff\x98\xc38U\x8cTQ\xd1\x1ad\xbf\x86\u007f.\xe0\x9f\xef\xdcaM\xb4\xcf\xe8\ak\xbcf\xf9\x85\x9a'\x15:\x91\xa4S\xe8\xf8\x8c\xe0\x9c\x85\xc4\xf5\xfb\x81Xr)l\xa2\x9c\xd1\xf5Z\xbb\x9bԘ\x10\xc3\"{N\x91=\xbfȾSd\xdf*R\x04\x1a\x82v\x02\xc2\xf9\xa7ԛ\u007f?\xa5u.\u007f\x1b\x01I\xbd\xb2\x96%\xf8\\!]]\x9bd\xa3[\xe7\xbb<\xbf\x9dO\xbcz\x94\xbc\xa2\xee:8\xfb\xe2:\fe\x03<lh\xe1\x15\xd9x5\x89O\x83o\xa8M,\xf6 i/\x93\x16\xed\x1c\xeb\x8d+K\ay\xb9.\xb8r\x10\xbd\xb5@\xf8]%\x80r\xcbd\x83\xfcb}\x90U`\xfa\xa7\xce\xcaX\x0f\xeb9\xf2\xaeg\xb2V\xab4;\xeb\x92\x1a<\x80\x19AIׂR\xac\x80\xf2\xcdƸ\xc8͵\r\xe6\xdbm\xc1\x90\x10\xfe\xfdw\xf1\xaf\r\xf1\xdd\xfa\x10\xf70Ⱥ\x1a\x01\xc1C?\fH2;H~\xb7\tH\x1amk\xf3oC\x1bm\xdce\xc9;\xa0\x13ؠ^o\x0eJ\xd9\x11l@\x93j@\x9c\xee/\u0381\xf0\x9fG\xb5\x1f_ׄ\xeeR{\xf9\xbaf\x81\xba\xd8\x18\xa7\x8b0\x9d\xfe\xb6\x11 9\x88\x05\x1a\xccJ\xc6\xf1~u/}{\xd4߷Ac\x94W\xa0\xb1Xc\xd6\xcb\x19\xffˊ\xa2L8,7\xa6\xbb\xb1\x9a\xc1dj\xb7m,\u007f]\x1f\xde~\b^\xbao\xc3\xfbǩ\xa9\x94\x8f\xa5Ӂڧ\xb5\xcafD~ն\x11\xf4\xbd\xa9\xb5\xf4XY\u007fO\xd3[\xbd\xd0\xe8\xe5$TD/\x14V\xe6k\xb3$h\xb1\xef\x17`\xa2\x9ce}\x97C\x18\x11 \x89\x96\xddV\xfe\x97\xaaѴ,\xe3\x1b'\x03&`w_\x8b\xcdҼ\xef\\$\x99,\xd2\x12\xc8\xef\xc6\xf7s\xd8\xfai\xb9\xe2\x80@\xa9\xa1e\x85\x95\xf9r\xf4\xe3HO}+\xe7\x827\xba\b\x12\b\xcd߆ս|`p\xcd\xdfV>p\x9a\xe6T+G\xf1a\x88e\xc6\xd8KT\xa1\x9e\xfd\xf3\u007f\x17O\xffw\xb2\xf3\xcc-\x13\x8b\x0e1\xf5\bw\xb7\x92\xc1\xcc\x0ef\"~v>Q\xe7\xe3\xf6$m\xcdg\xfd\xed\xf7\xb8\xe0\x91\x84=\xf6\xd2'\x05\xcct\u007ff\x1d\xe2Pk\x81-G\x04Y\xad\xb7P\x97E\x96\x89L\x04RA\xe1\xba(\xc2b\x01\x0e\xb2q<\x1c\x86\x11\xf8\x92\x01y\xf0\xe2\xe3\nXD..\xadt\xcf7\xdbKnL\x02\xb9\xedB,\b\xbc\x82\xe9\xf5\x1a\x8b\xc2C7\xd8h\xbdݺ\xe7e\x9b-BA%\xac\xbb\xa3\xa2\xaco\x8b\xdc#!\xf0R\xbd\xf6鯻\x9f\x8ev?M\xde(\xfbT\xeb\xd3\xef\xfeQ\v\xb1^\x860l#\x9a@\xe7ۋ\x1f$\x8a\x9f\xfb\x8d~/\xf4ݻ4:\xf5s\xf8\x91\x91S|\xd5(:\xbba\xd4!\xad1Q\x0f\xad\xf1\x18\x99\xa6\xc0\xe7\xb8\xfd\x06D$\xc1\x1f\xaa\x10`T\xf7\x8aX#\xa0\x95\x03\x94G\xac8>9\xbe\x13\x93\xc0,Al**\x8b\x80\x99Xt%d0\xcc\xf3)\x96\xa4\x80\xbcp\xab\x00\xfe \xc2\xec\x12T\x82\x16\x88\x1c\x8f2\x14L\xb5\xbd\xc7\xe3\x0f\xfc\xd0g!\xean\t\x81W\xd5\xcaA;h*`\xd6ٌ-\x9d\xe4\"\xc0x\x8cRZ\xd3x|\x9d\x06JJA\xe6\x94\x06\xa1\xe2\xd5\x18\xe1\x12\xf1\xb8\xb4=\x14:\x84 \xc0\xa1T%>\x02m\xcb@\x97\xd0\xd6:\x85\xb3iK\xfd紥\x942J\xb9\xb4\x95\xa5\x83\xb4\xbd\xa1'\xdd\xebQ\x16\xe6\xddL\x1e\xa3I\xc9\xdf\xf2N\xd60\x96\xb4\nj\x17\x18\x8b\xc0`\xec\x1d\xa6\a\x10\xf3M\xc2\xc1\xf8u\xfa\xf7S\xcc\xfeX\xa3T\n\xbbd\x98\xd81\xae=H@H>D\xf0\x1d&\xb7;<X288I\xbc\xdchl\xa8<'v\xe7\xe4\xa8m,\x94\"\xdf6\x13\xcaN\x19\xed\xbai\xf8\x916\x86&\xc1کk\xaa,7\x19\x17\x04a\xc3\x13\xe3\"\xcbn0\b\xb2\xea.ٱ\xedqЇ)\xfa3HWw\x10\xa0\\\xd9\x18\xd0^\xcbr\xe0\xe7\x10~I\xf9\xaa\xceR\x11צ\x16c@\xe2\xfc]\xaa\xb8\x1b\xd5K\xa7\x12\x15\te)\x19#\xe6\xcf\xf1\xe1~j\x85\x8fu\x0f\x92\xfc[\xa8\xb011\x97[%\xa3\t\x1a\xcc\xf2\xef \xc6\xf9\xab\xb8\xd0G\xe8`\x06?\x12\x88d杸bE\xd3\xe7KQ\xf9jmf\x8d\xa8\xc76\xfd\xa9D\xa3\x8cQ\x18\x95p'-8'\x83\xdd\xe0Q\x85\xd9z\x05_\xae\xe2̠\xc48j\x84\x81;\xc7\x15r\xea\xba\f\xe1\x18\x02¼\xe1[\x8d\x10\xfe\x0eP`i\xfa-F\xfeH\xd2e\x89\xefN\xb3\x86\xfbBD\x8f\x8d\xa8U+jW\xf8\xe4\x88Fưz\xa8\xa0\x9c\x1e\xba\xb057\xbc\n\x84&\x82\xffw\xa0\t;s\xb0\x85ýؒs\xa5\x03-:n\x81\xc2+\xe1K\x05,\xe7\xaa0T6(E\xa0\xbc\x16#!\x83\x92\xb5\x8a\x83\xe8]{.\x95M\xa43_\xf4v\\\xe9-5\xe15\xc57-\t\xce\x02\xb1\x95\x00gw\x85\x1cUG\x1dP[\t\x01E\x87\x0e\x87\x1d=\a\xcb\x06\xe9\xaf\x05\xf4\xe3Q\x94\xfa\x00\x8e:>\x8c\xaa\x15\xab\xf1V\xb4\xb5/\xac\xd9\xe4\x052\x14\xce\x1c\f\x13˥-\x96\xb4H\xcb\xf7\x98\x18\xd7\xf6b\x96N\xe8n\x81\xd8V4\xa3\x03\xf8\xa7\x83\xff\xee\xa5\xfbMX\xab\xe0\xd7A3:\x81\u007f:\xc7\xf0/\xa8_\xe2w\x1b?\xba\x1d\xfc\xda\xdf\xeb\xc2\x1f\\\xd4D\xdeQ\x173\x0f\xdb\xfbT\xe8छ\x1e6\xa3\xa3\xa3\xa3C\xf8\xbb\xd79\x10@\x0e\xe9\n\xa2\x87\x13Zz^\xa7\xb3\x9b<!\xd4.\x9d\x1d\x87h\x80\xfc\xec\xbb\xc9\a\xe1\xe4NI\xfa^\xdbN'\x9dۃ\xae\x92\x0fJJ\x97\xa4\xbb\xd0Ae\xf4`S\xe2^(\xf10X\xbdk\xa7\xa2J\xd0\t\xa49\xe5@\x96\xf9\x1dCseI\xaa\x83\x13\xf0\x12\x16\xad\x1a\xb4\xaf\xf5\xd9\x1f\x1f4uR\xfb\xe9\xafp\x96\xeb[w\xf5S\x12\xf2p\xf0`7\xack\u007fQY;qET\t\x94^\xf4i\xb2\x1a\x90\xd4&\x9eHgSe\xc0\xe25\x81\x81\xe6\x03&\xa6\r@\u007f\x1b}:Y\r\x9a\xefl\xca\x00\x9d~\xfaz\r\xcaY;\xd90(\xb8\x06\xb5\x12\x90mn\b\x03j}\xfa\xdd:\x18\xb9\xf7e\x1fʙ/\x1b\xebU\x1a\x13ZCL\xa9\xf3\xbb\x03\x0e\x97\xb2\x95\x05\xb3\xbe\xb6\xcfS<\xa6np-ݓSj\xb12/\xd1\x16\xb3t<k\x9a\xf7\xe3\xeey\xaa\xc1\xbb\x95\xe4\xa38SU\xac\xcbSX\xea\xaf\xe9\f\xd6\x06\xa1eϲ\xfemQ\x1f5\xfc2\x17\xb2\x8c{\xcb\xdc\x0f\x82^\x85O}-\xfa\xc9.\x97\x93\xb6\x11\xb4ib)\xb8\u0528%{95\xadg\x89.\t/\xa3\x11\xbc/\x11\v\x9b \xaf\x0e\x80\xfe\xd0(k\xb0J*)s\xea\xf6\x92IBXC:\xd9\xf7\xad\xab\x80\xad'\xa4\xf4\x85\xfbja\"a\xc6\xeb\xc3\xe4\xe2j\xb3F֓Z\xf6\xcd\xfaJxk\t/\xf7>|\x05ĵd\x98{\xa3\xbd\x02\xdeZ\xa2,x\xfb\xbf\\\x9ai\x96]GD\x19\xfe\xf6\x05\x94\x99l\x1fS<\x89V֑PH\xd4*!垒}\x90\xa02]o\x96\x10Wa\xf1\xd0\x10\xe3\xf1_\xff\xf9\xdf\xff\x01\xffK\xa7\xd3\\l\x82nf\xa3\xe1Gk\xa3\xddi\xc3\xff\xfe\xab\xdd\x0e\xfem\x1fu:\xff\xd5\xe9\xee\x1d\x1et\xf7\xba\x9d\xbd\x8e(\xdf\xd9\xef\x1e\xfeW\xd4\xfe#\b0\a\x0f\xae\x02\x95\x0f\xee\xa4ӹ\xff!\xff\xfb\u05ff\x9e=\x15\x93\x1a\x05\x15\xbc\x83\xe8\xc5E\x8a\xdc\xf0\t\xbc\x17\xec\x0f\xe7Iz*\xc4\xc90\x8f\x13J~\xfa\xec\xe1\xe1\x93\u007f\xfd+Jҁ\x10#Qm\x96͆i-zx\xf8\n9)\xeaͳa\x02'\x8a\xa2L\xebG\n\x15\xd8\xfa>\x1e\xa5\xa2\x88H\x12\xad\x88\x1f\x9f<O\xb2\xbb\xa8?\x8c\x8b\xe2\xbcv\x93\xc6I:\x8d\x90\x13k/D\xbb\xcfo:/\xa0\xf6\xab|>\x9e\x89ҔU\r\xfa\xf93Q\xe9\x93\xe7\xcf\x04\xdc\x17\x80\x1enߣ\x96D\xea\x01N\x9c\x03m\xf6\xf3$\x8dF\x10\x15\x01\x1b\x86\xa6\xf7\xa0\xe9\xfblv\x13\xb5@\xbd\xc2;\x06\x0f\x0f/gب\xf8u\x1a\xe9^@\n6@\xed\xef\x11\b]\x1dn\xc4S\xcb\by2M_<\x87\x06_HHϟ\xe1\xd7\xf3g\x90\xa3\xaaJ\xfa\x88\xf2\xba+2I\xfcRW\x01\xa2\x1a\x1b\x91\x1a\x02\xfbd\xdb\xf9\xbf\xc8fi\xf2\xe7\xce\xff\x83\xfd=w\xfe\xef\x1d\x1d\xfdg\xfe\xff\x0f\x9b\xff\xc8I\x1bLq\xaa@\x93\x05'\"\xce'\x91('\x0eMi='\xe5T\xfb=\x9a\xe5o\x01\x13=\xe9\xccL\xa1y\xf7\xc3|\x06\x0eҪ\xe6<\x9b\xedT\xdaL\xdf\xd5\xf3\xf4\x0f\x9a\x99\u007f\xcc\xffF\xf98\x9b\xe5ӏ*\x00V\xcc\xff\xeeѡ\xb7\xfe\x1fv\xda\xff\x99\xff\u007f\xee\xfc/\x9d\xe8\x17\xb3x6/\xd8D\x17\xfb\x89[\x90\x11\xe7\xb5b\xb6\x1c\xa6\xc5M\x9a\xcej\x18\xd0\xe3\xbc6\x13\x90\x9f\xf5\x8b\xa2\x16\xddL\xd3\xc1yMT\x13\x931\x9d\x89\x89\x02۞\x9b\xf8\x1e\x8c\xbf-,\xf1\xf0 \xe6\xe5s\x84\xe1ֆy\x87\xaf\xbffr\x873\x8a\xa7\xd7\xd9\xf84\xea\xb6'\x8b\xa8M\u007f\x8e>\x957\xca\xc4\f\x9c\x9db\x8cY\xe5p\xa0\xc8(\x86\x8f\xc0\x12/>Q\xfa}\x96\xc0\xeb\xd2\xfd\xb6\xacx\x93\x82ϐ\xd3h\xaf-\xc0\xa9\xdbi\xe3\xd9\xee \x1ee\xc3\xe5i\x14\xbd\x9cf\xf1\xb0\x19}\x93\x0e\xefR\xb1m\x03\x1f\xdf\xf1\xb8\xd8-\xd2i6`\xe5\x8b콐\x9a\x9d.\x01y0\xb8\xdf\xec9\xe8\xefJ\xfc\xdbQ\xe7\xc0j\xb2\x14D+^d\x85\x84b\xfa\x15\xf7\x8a|8W\xe7\xc1\xefw\xa5;k\x98x\x944\xcb'\xa7\xea\xc17y<џ\x9a\n\xbc\xad!l~\xa5\v\xd8!>\x8c\x16\xb5n\\\\\xfa\xf1\xf8.^\x85\ro\x1a\xc6D~\b\xc6y\x86c\xfd\"\xb4T\xdc\xc7\xd31\x04\x81Q\x8b\xc5_\xf3q\x92\x0f\xe3H\x90\xfa.\x85\a\x8c\xc0\x83\x96ҷZ\xbd{~\xd3}\xf1:\x1d\xe5ӥ\xa8\xd8}\xe1,\x0fإ\x1a\x1c/Ż\x93a>;\xaf\x8d\xd2Q\xeb\xe5p\x98\xf7\xd9r\xf1j>\x9d\x8a-\xfdp\x19Ő\x13\xc3\n\xd6[\xce\xd2B\xad \x12\x9bu`\u007f\x93\xc6\x13\n\xeaT\xb0\x16\xbe\x83{\x849%o\x01\xf4bɁ}\x01\xa8E}\x8d\xf4,\xbeM\xc7\xd1`\x9a\x8f\xa2bY\x88Uk\x8b\x16^cϋ&\xfc\xfe\x1a\"'\xb1\xf6d^\x14\vрy\xd5\xf0\x81\xb3j/\xd4\x00\xca?\xc5H\x00y\xf1}\x0e\xbe\x04\xde\xdc\bf\x9f\xc4B\x95\x17\u007f\xf31\x90\xfd.ΆqO\x88\bxR\x98\xf6\xe6\xd7\xd1H\fsK0\x13\xd6\x13\xf5\xfb\xd3l2\xe3\x12䝨D\xa9\xb5\xa8\x98\xf6\xb9\x14\x1a\xcd\x17\xadwR\xf6\b\x10XhS\x18\xc9^\xeb\xae\xfb\xc1P,y\xf8\xa1\xc0Բ\xee\xc3)Y\xff\xdf\x15\xff\xf5g\xe9\xff\xfb\a{]w\xfd\xef\x1e\xfcg\xff\xff\x87\xfc\x0f,\xa9\xaf~\xf8\xdb\xf7o\xa2\xf3\bֽ\xb3\xe8\xd93\xb1\x16\xb5#u\xd15\x1f\xe0\xfc\xff\x04\n~\xfb\xfd\x9b\xaf~\xfa\xf9\xe5w\x10\x83\x00W\x96OX\x94\xb2xrs\x91B\xf0\xb9z:L\xc1]\x81c\x8c\x94\xa9`\xdc}9\x9bM\xb3\x9eX \xea\x9fi\xd9\xf2Y\xa3UL\x86٬\xfeY\xf3\xb3\xc6\xd9'\x0f\f6x\x8e\x12\xeb\xaeX\x11\xff\n\xad\x14u\xcb=#\x81ų\x91\xbc?WM|%\x93\xbfX\xbe\x02Y\x03V\x82\xfag(\xcf>k躈4wΈ\xee\xbb\xe2a:\xd3w\xae\u007fR\xb3\xf2U>\x14\xd3\xe4Gʬs\xff\xf9xQL\xb9k\x87\x87\xf2\n%\x1d\x1a8\xcblO\x82\x847x\x1e\x94%/3\xee\xe7\n\xb2q\x95g]\xa2\xa8\u007f\xb2W\x82l\xd9\xddg\xec^>\x94\x86;\x16\x8c\xb0(b?kF\x9fA\x1e/\x9b\x0e\xc1\xbd\x84\xd0\xdd\xc8'\x1cd7\xec\xb6媾v\xebT\xbe\xac}ʭ\xc0\x80\n88\x14\xa9\fjx\xe9\x10\x06\x03\x1dZ\xcc\xd6p\xa24\xa3wG\x19\xcd\xe0\x1d\xb8r4\x03\xf1\xceq\xe9H\x1e:0\xd8\"\x85\f\x19\xc7ૣ\xb8|\xf7\ue287\x9c\xb4=~]\xbb\xbc\x81lY\xb7\xc1ʡ=\x95\xd4iZ\x99R\xe5\x12\x84\xa0{/\u007f\x87o\xbb\x88\xd2Eu\x99o0\xc1.$Vu\xa1\xe7\xa4B;\xfb\f<\xb3\u007ff\xe7\x16\xb3i~+\xba\x03gq\xcd@\x9fO\xed^\x10A[_g\x8b4\xf9r>\x8d\xe9ABJ\xf1$\xe5\xachz\xee\xb5#\xbc\xc2\xfc\xad\xbcht\xaaeD\xd3+7\x8a\x17_\x8aَ\xbe\xebD\xdb(u\x9aAh_\x88Mȩy\xf2\xd0`כ\x9f\xa1\xe4\xb1\x1dt7\x82aB%ƭ>L\\\xee\x1e\xfd\xba\xa5\x86\\\xcft\xf9\xcb津\x9c\x83\xfeP\xb7^\xc2|\xfb\xd5\x19q\xe4\xca\xd3\xe8\xda\xee\x13\x9c\xee\xd0\xe9ɩ\x81\"hLa\xa3\xe9Ř<\x1e\xfb\xef\xd7_8\x04\xd1L\x04\xa8\x04{\x89H\xb6\x88\x0f\xacN\xa2h\x03\xb9\x02\xf3\x85{\xaa\x10\xac\xf4\xad{-,\xaa[^\xaf\x05\xb4\x9f\xd2\xdf\xe6i!\xe6\xfa\xb3\xb7פz\xbf\x95\xaa\xc2\xdbx\x92\x89y\r\xafL\xd8!\xde4\xfd͝X\xf2\xf5\n\xc6.\x81w.\xff\xab\xa0r\x02\xdbb\x92\x8f\x8b\xf4\x8dPb\x1c\x1f\x9ba\x81J\x9d)\x15\xa7\xd6܄\xa2JD8r\xd5+\xf7%a\xf7\xaf\x87p\x19-\x83\x02\x8b\x1b\x82n\xf8\xf5J$P\xca\x02\xba\x86\xc5\x10o\x18\xeenR\x15\x10Dg\xc1r\xba\x03\x97\xb78\xc4\xe9\xecK\xb1\xadK\x93\xffN\xf1\x16\x9fؚ\xde\x06\xd0{\xf0R\xc4*/6kik\x98_\xd75\xcc@M\xccS3'N\x12(\xb6F\x05\x9f3\x1d\xef\xa8b\x85x'\x18\xe33s\xdbXI\x10G\v\b\xf6\x90)\x02\xb7)^\x88\xbfUjD\xeb\xb3\xcaE\x1a\x8a\x97r\x94d\xdb\x04\xc9+\n\xc2`_\x05\x02\xab\xa0f$\x90\xf45\x94\xb3O\xfeg\xe8\u007f\xb4\xff\xf9\xb8m\xac\xd2\xff\xbbݶ\xa3\xff\xb7\xf7\x8e\xba\xff\xd1\xff\xff(\xfd\xff\xaf?|\xff\xe5\x0f\u07fd|\xfb\xe5W?\xbf\xbd\x10S\ufadf\xde^\xbcy\xf9\xe6o\x17b\n0\xf9\x9f\xa4wo\xc9\xfc\xf2\x96\xcc/\x9f\x89\r\x00\xd4\xff\xe5\xf5w\xdf\xccf\x93\xaf\xe3\xbeX\x1e\xa4\xd6f?҅\xe5\x85=\x91\x92\x15\xd4\x1aӐ\xcf\xdbˊ\xbf\xec\x83\xc9\xee\x172\x95\xd4k\xaf\x8b\xc5h\xd8m\x01\x907o~\xacmS{o\xeb\xdaY\u007f\x9a\x17\xf9\xe0\xffc\xef\xdf\xfb۶\x91\xc5q\xf8\xfc\x9dW\xc1\xb0][\x8c(Y\x92-ۑ\xc2\xe4\x9bK/9\xa7M\xf24\xe9n\xfbq\xbd\xf9P\x12e\xb3\x91E\xad(\xc5V%\xedk\u007f\xe6\x02\x80\x00HJ\xb2\x9b^v\u007f\xed\x9e\x13\x8b\xb8\f\x80\xc1`03\x18\ff\x1a\x80{\xe7\xba\"Ģ\xb2\xc8\x15\xd5t6\x05\xad_\xc2؍\x80\xd4\xc5\x1c\xcaFk)\xb72\xaf\xfa\xd2EqՈ\r\x049Y\xa5P\xb2퇳\xfe\xa5S\x89\xec\x8d\xc9~IK\xafӃ\xc1~(\xe0\x8b\xa2}\x93\x81\xebb\xc5|:\xa2\x1bW\xc0\xc4\xfb\xe1h\xd4\v\xfb\x06/\a)\x01ߩ)Be\x16\xf4\xf8\xbe%s\xe8\xf1˳[\xd5W\xe4\xd2\"\x989\xc5\xdcx\xfd\xf6\x1dE\xdd\xf8\xea\x8bw\xae\xf4h\xffW=\x01\x85\xa4\u0085}\x87\xba\x87b\xb3\xd6\x1c\xd67\x9b\xfb\x17\xaa<bD_\x93ű\xb2\xff\x1c\xb0\x05\xe2A\r\xcd4\xa0\xc9b \xbf\x98O\x91\x0fnj\xd7\xd7\xd75\x14\xf5j\x00?\x1a\xa3ur \xb4\xd1u֏1\x8cz\xb0\xc0\x15\x16\x81\xbe\x8a\xa7\u0601S,\xa7Q\x94k\x92\xa7\xa0\x02Z\xe5\xf1\x9dh\xe7(7\x81\x02\xc1$\xa3ٳ\xb8\xbeW\x02\xe9\xbe\x05\x89\x87;\x1e0\x1e$\x96\xf5\xf9̈́<\xb4N\xe9\xcfr\xfe\xef\xdb\u05ef\xd0;\x0e\xff\xf2\x9d\xe7\x82\xe0\xfdY&\xd7/ \xab\be\xd6\xfd\xca>ޛ\x84\"\xf0g߳\x8d\x05\x80q>\xa0\xa8\u0604\xa5\xd3_)˳\x05[ǖl\x99<\xf1\x8cώY\x9e\xa1\x91\xd9#MF\xa3|:\xb0!o\xe3s\x83\xb25z\x1dog\x19Z\x9b\xeft\x92\x9fp\v_\x93d4\xfa\x8e\xc6c\xb0)\xf4\xb5\x98\x897\x8f#\xf1\xa4P\x86ZM\xecO'6I\u07b7\x13\xf1\xbf\x83\x03\xe7i\x9aί\"gv\x19!$\xe72L%x2\x17\xa71\xbe\x87\x8e\xb9S\x9e$\xbb>(c\xbd\b\xdd?`\t]\x87SX<\xce,\x91\xe0|\xfa\x01\xebe0\x8a\xa6\xc4P1z\xa6\rb\x12\xce.\x9dA\x02[\xd48\x999\x11\xa8N\xb3\xbaQf$\\>\xea<\xc5\x15\x8d\a\x94\xce\x11\xa1\n&\b\x87]\xa7\x8f\xae\xa5\x86\xf3 E\t\x03\xa5\xf9G\"\x8a\xd43c\xd5\xef0\v\xc6LP\xf0|\xee#,\xc1\xac\x93\xb8\xc2\xe9\a=\x04Q\x91\xbd\x94eʾ\xef\ar@^\x91\n\xb3\x05\x81\x96\xf0\x9f\t\xfdm\x8a\x9d\xb3\xbe\xf7\x97G\xde\xef\xfb\xdf$\x1c\xc7\xfd\xdf\xd6\xfdg\x9b\xfc\xdf<j\x1f\xe5\xec\xff\xcd\xe6_\xf2\xff\u007f\x96\xff\xcf\x1b\xa4\xa4[\xb8\xff\xc8\xf2\xec\xb4\xf3v\xde#H\xe8\xee'N\x00\x95\xfb\x8d\xfadз\xf7\v\x02^\xa8;\xf3\xed\xe4\x17$<\xfe\xb4j\xbf\x8b\x8b\x9f\xc0\x06\xbd5^\xd6S\xf3l\xfc\xf01\x95V\a\xadw\xf2_\xe2f\x85tT\xdap<\x1e&\xaaUQ\xf8W\xb4\xbb\xe5\xa4\xf7\"\x1aG\xd3p\n{\x8ey\xd2\xeb\xfc\xe32\x1a;\xd3\xf9\x18\x91\x80y\x93i2\x98\xb34\x83\x05|\xe9/\nu\x81t'\t\xed[RT\x18\\\xc5c\xd8\xf6\xa7!\xe8CNt\x15ƣ\xec\xe8\xf8?ّ\xeb\xae\xfc\u007f\x9a\f\xe3Q\x84\x0e8\u007f\x18\xff/\xf0\xff\x02\xb9\xf9/\xfe\xff{\xfc\xf7\x99\xb0\xee\xd4\x04!\xd4z\xf3\xd9\f\x16\x92\xb8\xbb\x1d\xa7\xb0\x1a\x16\xb0-\x80\\\xf7AsO\xba\x16\xa7S\xbdd4\x90NE \x91Ok\xd3p\x10\xcfa\x1fiIo&\xd4D.\xa6\xc9|<\x80}\xe4\xa2\x17\xe2Mr\xf1\u007f\xf5\xb6'\x1f\xdc\x1d%ӎ\xf3Y\x14E\xb6\xc3\xd6\x10O\xa34?\"\xe5%5\xe5\x0el\xf2\x9a\xc23\xa0\x01:\x8ck\xc5Po\xac\x85\xa3\xf8\x02`\xe3\x93\xcbѴ\xc0e\n\x8f\xb6AG\x92\xb8y/p\xf3\x1e\xf9\x1f\xc5ǲ\xd13N\xc6ц*\xf1p\x1a^Eﯧx\xec)ݪvA\x8c٫\xe66\xe4䝬r\xce^\x02m\xa6\xeb\x17@\x17\x1ep\xc9\xc7h:\x1c%\xd7\x1d'\x9c\xcf\x123\xad\x06\x03M\xfb\xd3DZ\xad\xcaҁ8z\x1f\xe2Y\rx\xecX\xf64\x99\x84\xfdx\xb6\x80\xa1\xb5R'\x02\x19\x03\x06&J_\xa5;\x96\xdc^j\xcb\x04\bė\xa2J`\xe34\xf3\xfe\xbb\noj\"\xf5\xa1D\x91\xe1y\x98aɢ\u007fEpEKh3\x86\xda;c\xa8\xbd\x13\x86\xda\x06\x86J\xf8\xff\x1f\xe8\xff\xdb89\xc9\xf1\xff\xf6\xf1_\xfe\xbf\xbf\x9b\xfc\xef\f\x92\xf1\xfe̙\xa7Q&\xfe\x90\x1fY\xea;i\xe2\\G\xe8Àv\xe0\x01\xd1\x16\x85xL\xae\xe2\x19\nTW\x0e)\x04\xb7\xf5\x01V\x87\n\x14\xa1\x04@\xbe\x17\x8ek\x9a<\xa2\x1c\x82wr|\xdb\n\xf1\xb6.u(\xb2\x02\xdcϑ\x818\x9d k@\xe7,\xce\xe7\u007f\xc7\x18\"\xcfg7,4\xa3\xe9\xec\xfd\xfb⢁\xb3\xaf\xe0\xad\x1c<\xf4ċI\xfb\xdd{Z\x8fB\x81\xa2\xac\x1f\x9d\f\xdc\xdb\xcb\xe4\xfa\rC|\t@*\x9e\xebă\xc0-\xde\xc1\xddǲ\xb1\xfa\x17\xa3p\x92F(r;ULxt\x10*?\xc0\f;\u007f\x98\xfe\xdfj\x1f\x1e\xe7\xf4\xffÿ\xf4\xff\xdf\xe5?e\a\xde@f\x9a%?+\xf5u<\x88\x8cRۏb\xa4\xfc\xb3\x93c\x99(\\'vR\x97\xbbY\xa0\xf6j\x91\x1d\xa3]u\u007f\a\xa9k_\x8b\xea\xc6\xd2@y7\xb8\xc0\xbe:\xfc\xc1\xaf\xb2\x8e\x88\\\xde\xfes\xc9\xf4\xef\xb3⼭]\xdf7\x8a\x8f\xb9Ϫr6\x1e\x92H\xcbG\x83ٚ/\xc4զ\x86\xa9\xacV4d\xe2\xc0s`\xd2\xc0\x0fDi\xbd\x8c:a\xdbǣ5=\aV\xd6E4\xcb:\x8dCЃ\xeb\xe15\xad\r\xb3\x80\xf9j\x12\xf0\xa3.\u07b7\xdaG^\xbd\xafg\b\xe4\x90;\xa9\x91Ao\xd5AN\tSև\xaa\xb9$R]\x8b\x10\x8d|\x1a\x0f\x1fݩ\xee\xf7\x92\xc1\xc2(\x85`e0\xad\xd2B\x02\xbcIk\xc9X\x1e0\x15\x9d\x00\x18\x04\xc9~\x82\x19\x8e\xfb|\x00\xf9\x0fP\x1c\x92\xeb\xba\xd9.K\xe9\xecH\x88\x87g\x93\x9b\xfdn1Ԍ̛\xfa*&D\xa5\xf3\x1el\xfe\x15\x9d\xa2&!\xde/\xc0\xa7H\xeb\xd3\xe8\n\x14\x83\x1c\x02ʖs\xd3<\x8d\xda\xc0_\f\x9f\xdfbo\xdfg\x8b\x97\x83\xcaN\xcc@;\u05cdF:n\xa3Q\xd9`\fGS+ʉuN)\x8e\x05\xff\x13,F:\x92~+%`\xcb\xfe\xdfn7l\xf9\xbf\xd5>\xfe\xeb\xfe\xef\xef\xf2ߣ\xfb/^?\u007f\xf7\xe3\x9b/\x1c\x9c|\x90@Ʉ>\n\xc7\x17\x81\x1b\x8d\x85\xb17\n\a\xc20\x0e\xbc>tЍ\x1eD\xeb\xc0\x9dφ\xb5SW\xcfB\xaf\x8fZ\xf4\xafy\xfc1p\u007f\xa8}\xff\xb4\xf6<\xb9\x9a\x84\xb3\xb8\x87g\x04\x825\x05\xee\xcb/\x02|/ߨ\x89\x1c<p?\xc6\xd15\x1al\xb5¤\u007f\a\x83\xe8c\u070fX\x19\xf7區\x1a\x85'\t\x9a\xf5F\x01\xa8A\xc4\x024\xb0\x15\rZAA\xd0\xe1/\x93iA\x99b\x9d\x86\x05\xf4\x83\x83q4\x1b\x8cC`\xac\xc9\f\xcdɓ\xfe`\f\xcc\xf7\xea@%\x1c\x1c֛\xf5&*>Y\x9a\xba\xec(\x9a\xa03\x8f\xc7\xcc\xe7К\x8d\xac\x0e]\x81\xa1ӏ\x0e8\x93\vnUU\f'\xa6Yrq1\x8a\xde\xe15)\x8b\xbfQhk\xba>\x15X\xbcN\xfb9\x06\xf8oa\xce\xf0m7\xed\xb7\xe9\xe8@P\xea}y\xbb\xa2N\x96\xaa\xd7\xc3\xca~\x1f\xf6\x18R8\xf6饛\x86yLlU\xc3'\xf3,@\xd3\bT\xd0~T\xd9w2H\xbe\xb3\xbfo\x1c#C\xdf\xe3\xf18\x9a~\xfd\xee[\xbc\x8e\xb2\xbf\xf7\xd9\xc3\xe3v\xa3\xbb\xbf\xf1\xac\xden\xbb\n\x15\xb5F\xb6\xc1?6\xe1\x1b\x8f\x19d:\x1cOW\xd9%V\xfc\xaf.56R\x05\xb3\x0e^\x83\x9cX\xc3\x1d\xaaÎU5L\xc8\x1aԌQ\x99\xc1.\xeb\xc7lZ\n\xb7\xbc\xd2d\x1a\x95ֺ\x8cgQ-\x85\x9d:\xea`9\xea\x99]_U\x8e>\xa2\xd7\xd3(Ng\x1a\b\xdd\xc4\xc9&R\xab6\xd7\xe2\x83?}\xa2Jl̂\x84\xa3)\u07bd\x1dI3\xee,\x99\xe8\xd9\xc2\xe6[\x13\x96Φ2\xe6\x99\xd9d\x05|Xog\xb9Y\xaf\x04A80\xf8\xb1\xd1/e̋\xc7\x18D\xa9\xa6\xd9\xf4v\x85\xbe\xd9\xe0\x9e\xb3*;\xf7\xe3+d\x88\xe1x\x96맢\\\xad\xb2\xbc6\xd2\xe8\xe6\xe1Y\xbd\xcdl\xbd\x97\xf1`\x10\x8ds\x04ElBw3̬\x8b\xe1hD\x96E\xbbN\b\xab+1V\x9df\x97?l\xe9S\xc1vԢiR+\x8ao\a\xe3O\xb9\x05=B9V\xac\xb1P\x9eNN\xe6\xa3\x11\xc3q\xa8u7oDa\xe6V\xdf ^\xba\x8f\xf7\xf0\xeaI\xda%\xeb\b5\xa0\xdfT\x85Q\x840\xe3S\xb5\x88\xf5\\<\xb6\xac\xf1\x19\xa9\xca\x17\x87\xdcE\x9c]\x1epϒY8\xe2\xa8\x17\xcaB#\xcf\"\xe5!7cBܝ\x15\a\xc8\"\xb6ͻ\x186\x93\x8b4;\u007f\xe6\x1b\xce\bO\x04\xc5\xd1N\xd2'\xd3x<\x1b:\xee\xdf\x06\x0e-\xba\xd4u*\xa3h\fMӗ\x87\xa5kԗw\xd4+\xac,\x8dQ\x9f\xb9N2\xee\x8f\xe2\xfe\a`f\xda\u0382\x1eV^\xd7\x14z\x01\x8d̉\x11\x8d\xda`Z\x1a^\x98\xb0\x04\xee\xf8\x83\xfe\xad\xe1\xdb&\x93h\xa0\xe1P\x1f/\xf7T\x1f.C\x9b>\xb6\xbc\x90\x1e\xcd\x06\x12\xba\\\xca\xee\xe3G\xb8\x98\x1f琍\x89\xb0\xd3\x0e6\xc0\xd0\xf9\xa3\xfb8\xe7\xf1d\x0e'\xcf\x0f\v\xaa\xe8\xa3\xc2\xd3\xefܠ6\f\xaeh\x90\x19\x17%\xab\x1fP\x06{R\x14\rL\xab\xff\x18\xcf\xec\x8bG\tP\xf0\x95[\xa7B\x97)\x00\"\xba[\xba\xbe\xeb9\xf8\xc81\x81\xa6\xf3\xfe\xd2\x06 gZ2p\xe5\xff\x90\xab\x81\x88\xccMD\xae\t\x1bt\x1e\xa4\x05\xca.\x80\xab$\x99]\xe2\xb3g\x9a\xab\xc9w\xe8\f@.\x05\xc6*\x14\x84\x9b\xdd_?`\x16\x04Y$0\xff\x87\x9f\xffk^\x0e\u007f\x94\xfd\x17Խ\x9c\xfd\xf7\xf0\xaf\xf3\x9f\xdfI\xff\xdb\xe5\f$s\x8a\x91\xe1\x12꼚t\xe7a\xfd\x1c\xe3/\xbf\xca\xff\x98\xf5oE\xe1\xf9\xfd\xd7?\xac\xfe\x86\x1d\xff\xa1ux\xd8\xfek\xfd\xff\x1e\xff\xd5%\x01\xbc盳\xf5A\x04\x82\xeeh9I\xe8\x1c\x95嘴Cڛr<Q\xf1}P\xcdit\xa5\x97J\xab\xab)|¥\x05\xffWoz]\xe1\x86\xd2\xe8\xb22\xda\x04i_\xd3'\xa4=\xb8\xdej\xa7\x0e\x87\xa7\xed֮\x92_j\xdb\xca$[K\xe4]-\n\x8a\xadK\xd0P\x8f\xc7!]\x00[\x8aJ\x9dFYQ\xa7\x0e\xea2\x1f\x98hś\xe5\xc5oޏ\xc2^4Z\xea\x11\xa68\xbe\x94\x16S\xca\xf4*9\x04\xacI\x97\xa6c\xf8-[\xa9\xb7EAī\x03s\x13\x0f\x9cϢ\x06\xfe\xaf\x9b\xe9_\xe4\x16\x95\x9fCm\xce>\x1b\x0e\x87]]\xef\x1f'\xa8\xf4o\x1e\xf22\x0fR#\x88\xd2\xfe\x03\xf6\xa3+\xbbw\x1bq\xa1\xa6\xa0\x8c̎\xbc.{\x92\xd18r\x18\xb1\x8a\n\xfd\x93<\x80\x9a\xd0\x15\xf1\x8d$]\xc3\xef\xdb\xe2!7\xf5e\xdd<\xf56\x03\xea\xf4\"P\x15\xa3\xa5\xb0GvܟZ\xed~\xcb-\x98:\xea{\xad\x8e\x88\xe4\x81\xeb͜xb\xadm \xd9A2[r\xa1#\xc0\xbe\xb0\x1d\xe0O\x1d95-\x81\xb0\x83\xd3hNl\xbb\x98\xb2\x92\x9b\x1a\xb4:H\xae;\x18\xe1\f&ؘ\x83c/G{\x02*w\xa9\x955C\x96\x80\x0eMcW\x9a3\x88%e\x00j\xa0\xa0N:\xd2\xfe\x02Mo\x1c\xb5\x9c,\t\x8cL#v\x8d\x8c\xb2eظ\x1c\xcc\xf4\xe3\x85\t\xa3\xab\xac*lT\xc9\xf7\xe2\xe6=\x86\x84X\x961S\x8bQ6$\n\x98N\x01\x85\x03\xba\x04o`\xb2\xe5u\v\xf8uY\xd3N\x9dTł\x1e\xdce5\x02\xefɯ\x14\x83~\x98\\hT\xd0\u007f\xadW\xe1x\x9c\xcc\xc8\"B\xc1Ƒ\x15/\x05\r6\xb3\xb9G\xb4\xe4ٚF\x8fd:\xda4U\x05\xed8\xf5,\xb1\x00\x11\xa2\x17\xc8cy\x1a\x8e\xed5\x81\xf8ٰ\x18t\xba̭\xccV\xbb\x80\x03h\x9d$Dl\xa1\x8fZ\xd67\x93DZ\x85\xfc\xee\xd03\xd6\xcd\xd6\xe6wZ\x1fF-2g,\xcb\x05\x00c\xd9\xee0\xb8\xed\x8dݱ\x8f\xa2\x1aE\xd5\xe9O\xa3h\xbc,\xc1\xcc\x16\xb2q\xe4Q\xfb\xd2\xe6bb\x13B7b\xb5J\x1e\xaa\x8d\x8f\xc8c3g<\xf5\x8a6N\x9b³m6\xbf\xcbgB\x82s\xca\xffO\x18n\xe2\x0f&\xe0fS\x11P\xf3\x18W\x901A\xfd\xf94\x851\b\xaer\a\xa4\x14mb\xbd\xc2M\x8c7\\썶y\x93\x9b\xb6\xc2P\r\xd7\u007f3\x87\xa4ݻ%\xe6\xdc߹B\xe7\x12\xf9\xf8r\xe32\x86Y\x12h\xba#\xe1\x88F\xe44\xb6\x1b\xb7\x1ePF\x83e\x8b`\xa7q\x96\x80\xe9\x16\xf6L,\xab\xc5{\x8c<\xe4\x17\xec1\x98\xfe~p\xb8\x1cƣQ\t\xb7Y\xd0F\x94:\xf4\xf6\x85_\xb2O!\x10.\xb1\x14\x91\xac\f\x96\x02\xe2\x03'k\xe2\x02\x00\x99D5\x8e9\x83\xf4ߟ\xc2xjx֜\xee\xb6C.\xe0\a\xb0\xce\xd2~an\xd6-\x89\x9fZ\xb3[\xd0Ö\xd5A$q\x910\b\x01\xect\n\x88n:\xcd\xd2^\xe0m\xe5M\x9d\xc0\xfc\f˲\v\x9b1^\x06S!\xbc\x18(\x94[\x94\x03\xc5պ\x11(\x16Xj\x12CN)\xd9Yx\xa9_\x80l%$\x18\xbf\xacC\\\x88\x1a\xa5\x91\x10+ָ\xb2\xce]\x14oi0wi\xb5۾\xfc\u007fط|̪m\xc9ߐ\xdd\u061c\xb9\rr\xa3,\xb3\xb6=w\x03\xecR\xec\xc6c`\ai\xb4\x15\xc1\xb2\x9c\x86cm\xe7\xb39\xb8\x8dc\xc5@s\xd85s\n3\x1ae\xc9\xe5p\x1a\xf9\xe4ڦ\xf4BH\x1a\xceF\xd1\x05\xb0\x98\xbc\xe6nӵ\xae\x8dj2\xc2Q\x03\xff\xa76\\\xe3,Y\xee\xdcX\xdfi\xe7d\xcb\xd6\x16Q\x97{fm,\xcdF\xae\x84SO\xaf1ދ\xd0\xfeH\x84\x96\xa2wC\xb5Z\xa2<\xb7\xbc\x02x$\xb4r\xa8h\x8a\x14Mc\x92 1¶y.\x8f\xe1\xaeK\xa0\xa8\xbe\x15\"\xc886>,BP\x11X\xb2\xb4\x88\x9b;\x8d\"\x85\xc5lK\x9b\xc8x|\t{\xc9,/ѓE\x89ϕ\xc5Dˢ\xba\xf7\xc2\x11\xb0\x1b\x1d\x15c<\x04\x1e\xa9i6y\x91\xc5\xf8d\xef\xd9\tWL\xaa\xe2\xa2ǥ%\x97\x06\x8e\xea-\xd3\xce\xd2\xd0\rG-K\xd2\xd3\v\x1e\x95\xceP\x1d\xb0\x85\al\x83\xac7G\xf9\xa2\xf3\xd1\x12\xcf?Yy\xa7\x808\xbc\xa5\xa8iȐ \x92h͔I\x9e\x02\xea(^\xaaz\x8e\x10\x9e\xbbW0Z\xa6\xe4S\x1c\xdeFӍ\x82\x93\x93\xf1:yV\xd9(\x12\xc5\v\x81\ted#\xb4V\x11\xb0?\x91\xfd\xff7\xba\x04\xb2\xd1\xfe\xdf<>i\xb5\xed\xf8o\xad\xe6\xc9\xe1_\xf6\xff\xdf\xe3?\xf4\a\x94aI\x03\n\u007f\xcb\xcbF9\x9e\xab$?\xe9\xfd\xec-9B\xf2t\x96\x06*C\x04\\t\xeb\xae\xd7\x15\xd9\xe8F)\xa1\x02K\x99rp\xb3\xa0\xe9s\b\xb3\x80 \xc8xf\xf1#\xf9\xa3Z\xe5\x06D\xbc\xfa7P\x8a\x8b\x9e\xc5\xe7]\x06{\xa6\xe5\x9d\a\x05i\xab\xd5r-\xca\x16e\xaf\x85\xb7\ng\xad}\x8c\xee\x98\rV\r\x11\x93\x83\xb3s\xd5w\xf8\xc6H\x03\x98O\x81#)Z0\xfc\xf2\xba\x02\x1e\xa6\xae}\x0e\xa3\x91\xc1\x1bD\xe9,\x1e\x93\xa6\xe5\xa7\xc9|ڏ\xbc\xa5\x049\x99&\x93h:#\xb82O+\u007f&\xf3\xcf\x03\xce\xcd\x12\xe4\x18\xb4\xd2k\xbf?\x02\xdej\x8d$\x1fY\x8b~\xa2\x9b\xcd\xf8\"\x1e.\xa8\x94\xb7^w\xe3a\x05\xf9s2\xc4\x00\n\xf3Qt?\b\\`a\x14\xe2c\xe0\xee\xedqj=\xbaAW\xb4\x94Q48\f0PS<\x8d*\xee\xe0\x10\xe6\xde,\xa4\xe6\u007f\x9d\xc5)\x02\xb1\xbc\x17\x8e(D\xda͌\xa1\xbc\x9f%o\xa9;\x01\ax\xab\xc3(g\tv\xa6.s\xfcW\xdf\u007f\xf3\xcd{\xf4Q\x0e\xdcW\xf3\xd1\xc8\xf5\xbf\u007f\xf5\xe2\x8b/_\xbe\xfa\xe2\x85H\xfd^u\xd5\u007f\xf6\xfa\xf57_<}%2\x9e%\tH$c\x17@|\xfb\xec\x8b\xef\x14\x10\f\xba\xeb\xfao\xdf}\xf7\xf2\xd5W\"\x91\xdbr\xfd\xd7\xcf\xfe\xf7\x8b\xe7\xefD\"\xf7\xc9\xf5\xbf\xfc\xfe\xd5\xf3w/_\xbfz\xff\xfc\x9b\xa7o\xdf\x06\xee\x19\xbf\xcf\xe0|)Fv\xeev\xb3h\xe5\xe9\x97\xda$@)5\x0fj\xacu\f\xd1%s\x83 0\xc1\xaf\x15(&\xa7\xdbR\x11L&\xff\xaa_\x86\xe9\xeb\xeb\xf1\x1bQ\xa4\"\xcbz\xdent\xd6-\xa03\xd57$x5@h\xf2\x1dL\x99\xfc\x06\xf2\xd1\xd0\xe8-g\x97S\xd0\xc70\x9c\"\x96\xa2x-k\x11\xd9l>\x9a\x19\vM\x1f\x8f\x06\x9d\u007f\x96\x0fh)@\xf1\xc2T\xe9k\xb9TDv\xd6}\uebb7L\xafc\x105\xf1W?\x04}\x06c\xb2uD\x1dEv]\xca\xfa\x98\x80\x18ܐ\x99&\t\xd2hȅB,\xa3\xa4+\x00\xe37\xc3v{\x82\x18%\b\x9dT\xa9\tw̄\x99u@\x11-\xe7\xf3\xc2U\xf9\x1a\xfd\xcaqjh_k\x14\xa9\x16\x88M\x92\xb2\xbb\x94\x1a\x18\xab\x9eƔ\x8e\xe2~\x14<ES\x85\xb62)5\xa3x\x10\xde\xe8&\x0ez\x1d\xa6\x95\xe1\x98W6\xed\x0f\xc1p\xac\xd6q\x05_8E\x9c\x1c\xfc\xf3\xec\xa7\xf4\xa7\xca\xf9\x03\t\xe1\xec\x9f\xf0\xf1S\xa5r\xf6O\xef\xfc\x81\xf7\x93w\xe0\x9d5ϕ\x1f\xf8\xc1O\xf0\xbf\xfa\x83'g?M\u007f\x1a\x9f\xaf\xe0\xebA\xe5I\xa7\xbe\xe2o\xef\xc1\x93\x9f\x1e\xfctppỮ\xa7\xd5I\xab\"I\xecN\xbe\xabx5\xf5L\xec>A\xd0\xdcۻO)g\x8d\xf3'g\xe7\x1d\xfa\x9d!\x0f\xa5G\x18\x93/\xefi1\xd7zϗ\xde`x\x12hvS\x8bJ\x84\xc1|2\xc0\xe0\xe5g=P\xc2*\xb2\x82O>\x93\xe7\xbeDY\xaa:\xa5]3\x1b-ȵ\xd2\x0f\x81~U?\x048\xb2\x1aau\xc1\x85\xe9\xfb\x1b\x1e\n\xfd\x16\xe3\x92;-\x96\x94\x1b-\x88ţ\xa8\xc2\x1f\xb5\x9aG\xc5\xcf4\x00U\xce:\xa7Jg\xe2C\xf6\x8f\xcaeݹ\x8a\xa6\x17fo\xe8w@\xa4\xc1\xfc\x8d3\x1bj\x84\xf9\x11d\xe0\bI\x80\xe5\xbe\xdc\x1b`\xcd+\x1c\x89\xfe?j\xed\xed餬\xf2a\xe2<O\x124 \xaekM\x115f\xf6L\xd4\xf4[^\xe9\xfc\xc9\x11^\xa4\x05\xb3%\xa1\x8b\xe9\x12ݦ\x19\xa3\vjW\x93\xd9Bn\x03\x81\x06zM}\xa3')\x02\xabŗo\u07ffx\xfd\xea\xdd\x17\xb0\xea\xdf?\xfb\xfe\xab\xaf~\xd4\v(\xf6\b|q)\x17T\xa7\xb9&DMp\xe1\xcaD\xd7\xd3\xfdq\xd7\xdaE\xb5u\xc5\xcb\xd6l:\xef\x91\xe3'\xf6Ɋ][QR\x1e\xcaP\x14\x0eS0\xd48B^\xcd\xcb_\x8c\\C\r\xf4D\xdb\xfb\xb2*4=R\"S\xa9\xf5\xf42\x1e\xce\xf4.}\x10\xfd\xc1)\xacg\x11\xb5\x8d\x15\xa1Z[\x8b\xbd\x91*\xf9\x84кx\xbd\xd8\xebRb=\x9dCS\xec\xdd*\xd4t\x99\xceC\xa7\xc1`\xaf9\x17\xf6\x02\x91\x91q:Q1K\x10 \xb2\x02\xb8\xa9\xc9z\xdd\xd9t\xb1\x145\xb2F\x84\xac\x88\x1fޚ\x82\xecV`G\x80\x95\xad\xc4\xe2\x86\x12\x8b3\xec\x14\xc9\xc6\xdc6(\xbfb\xa0:\x8e1\x18=\f\xe5\xbe\xd5?\r\x8f^yV`Ы=F<\x1c\x80\x9dg\x8e\x11\x84\x03\xcaSB/~d\xe4\xa3\xf5L\x8a)\xb4\x94\xc6}\x10 \xa0.Ml6+{{VB֤Np$h\bx8\xc4\xdc:\xa15 $\x1e\xb9\n\ue5cb\x93\x9e\x86e\x9a\x1am\xe9t3Htq\xf8\xf5\xb0\x00\x90\xc8\xc9\xc3\x11\x19\xaew\x87\xb9]\xea\xc2O`L\xacOp\xf3\xd2\x19\xb2H\x81Zd\x8cj\xe5Qqoo\xcfܖ9\x15\xd6\"\xf0\x8a\xcf\t\xe7.7*\xd8$\xe5w\xb9)\xde\xf3$\xbc+%-h\fI\xee\n\xa2\x03gW\xe7%\xebt\x9dIc>7\xe5q+\x12\x8f\x01\xf1~\xc1NE\x9aUR\xe9\bzQ\xa5\x1e\x88\xb2k\xa2&5M\x9a\\K@\xd6\xda\x06!~/\x99\xdfu\xf8\x8f/h\xb7\xb3\xcc踓\xfd\\\xaf\x91\u007f\x02\xce\r%&S\x89\n\x93\xeb\xcc\xe9\xe9\xdf5^G\xb3\x8a\xe9\xd9k\xaf\"U&\xaf\xab^\nQZv\xc5՞\"\xc2;\x95\\\xf9\x1b\xbca\xa0\x95\xb7\xf3\xf4M$\xd3\xf2\xe4\xddi[ϻ_q\xfb\n*F\xdd+\xbe\x9a\uf1ae\a2wF\"xm\xd3[\xba\x18A\x06eTP\x99h\x9fS\xb0Pb\x0f4\xd0>M\x13\xa7\xaa\x19s}|[\xe3\xf9l\xfa\x06S\x02\x02Z\xc7\xfbw\xa2\xd9ՊRėw\xa6`\x9c\xa3i\x02ꉥ\xeaC\x17\xdeM㫀\xe9C+W\x87\x84\xab\xd5*O\xc6D:Jv\xfc'\b\x8f+\xf8\xff\xcfY\x82\\\x039O_\xf2\xedF\x16\x85u\x90\xe2ڣ\x06\x15}\xb8xi\x89\xe5όO,vd\r\xbc\xf4y\xdd㮉\xca\x0e\x96a\x8e\bK\x1e6t\x86\"\xfa\x17KE\xa6\xd6\\\xfb/^\u007f\xfb\xc5M6\xaf\xc4/\x81H\xd2\xf0\"\x12\x9b'\xddk\xa5\xed\x8a>1\fc@\xb5\xfa\x11݇=üs\xce\x145\x03\xf1w\xed\xf7/\xa3\xfe\x87wɇh\xfct<\xf8\n\xa3\x11\xc3\x00\xb3\xe6\xd4$\xfa3,\xc3T\x85\xbfP\bqu]\x8fZ\xac\xb8o\u007f|\xf5\xee\xe9\x0f\xef\xbf\xf8\xee;\x90\xc1\x9f\xe2k^\xb0\x1eA\x95J\x00?\xa3Qt\x11\x8e\x1c\xd6k\x9ck\x8c\b=\x89\xfa\xf10\x06j\xf4\xd6\x00\x19\xe4\xf8\x83\xfa\f#vsk\x05\xf0_\xbe\xfa\xfb\xd3o^\xbex\xff\xfc\xeb\xa7\xdf=}\xfe\x0e\x14&n\x8ag\xdf\x117\xb7\xf0IB\xd54^`\x0e\xfb3d\x81\xebL\xbc\x15S\xcc\"\xa2=̵_\xb0\xa4\x90^y\xa6\x91\xb0\xae\xa2\xc1s!W\b\ndPX*\xbbt\xea\xf9R\xf80\xeb<1?\x85\xdaBz\x8c\xd79;\xf7%-I\xa9\xa2\x84\x9c\x98\t\xe2v$\n\xa2d\xc0\xac\xf1=\v\xe0\xea=4\x9d5\x98}d\x82\xcd\xf4\xb6\xf5\xdaח2,N\x85\fm)\x804\x95\x15\xfb\n\xdfS\x9a\x06\xf9\xa5\x863\xa7j\xf3\x15\xb2u\x97\xa6R\aE6\x02-\xa1k\xb6O~\x92\x19\xf0\xd8X\xc80\xe2\xd5\n\xc5ֵ]K҂\xb6x\x98\x86\xe9O\x15\xe8WJ6\x85\x8b\x80\xf76\xae\x02\x9c\x13֢\xdd\x00l\x17\x1b`\x03=o\x85\x1b \\}\x1a9\xb9[8\x8383v\x178dņ^p\xe8\x15\\\xd3[;C\"=&\xde\xd7z\x85\x84\t\\\x92\xd2\xfd\xe6-z\xc6W\a?!~\x00\xdb\x02\b\ufb42\x8b\xe3\xf8ezA'\x84(Q\xb2\t୷\x8a\xeb\x00c\xe8\xb2\xd9\t\xf6\x95:o\x90\xd2\xec$l\xd4\xfa\xde\xf6\"J\xfb\xc1\xf2\"\x9au\xac\x05\xe0Gc\xd8@\xa7xX֡g\u0600\x04\x87\xf1\xc5<KY\x93\xd6P\xd8PE\xdf\x0f\x8d\x158\xf1s\xcd+\xdd\xe2\x86XrtSgk\x12\"\xac\xd5<:>m\x1d\xb6\xda-o\x99\xabXϺ\x18\xf0\x1d\xceOљ5M\x89\xa3p\xa8o\x99\xef\xdf3hF\xd1\xfb\xf7\xcc~$\xec\\v\xa5\xa41\xce\xf7Pp\xba\xa6h7\xa6%\xfb\xbb\x97\xcf\xff\xef\xed\xd7O\xff\xf1\xfe\xd5\xeb\xf7\xcf_\u007f\xfb\xe6黜\xb4\x93/\xb2Z\x95\xd66\xadc\xde\xd2z\x1d\xd2\x14\xbb\xd6\x1b\x058z$J\x97\xda(!#I6\xa8\xa0:G\xe6\x1b\xf9\x9e&m}\x16\x14\xe0\xa7\xd1\x0067g\x1a\r#\xd0<\xf1\t\x85\x04\xf7:Q\xc9\xe5\xf5)\xbe\x02\x1d\x1e\xe7\xf0\x8b^\x9c\xc1\xbf9\x1d\x06\x1a\x92qvI\a\xb1\x93d\xc4\x0ecn?\x9c\x0e\xe2q8r}~\x97\xb0\xe3\xfe\x12M\x13\u05ff\x8a\xc7\x1d\x85\x1f\xff*\xbcѾ&ӈ^\x0e\xea\xb0Q\"\x1b8)wF{\x1e>\x86\xf7E\b\x94\xac\xd0\xf1\x81\xd7\xfb\xd9\aa\x99\xfa\x00\xfcݨ\x03)k\xb6\xacqߙ\x1c\x82\xe5\x9a?\x05o\x12\xcfb\x90\xd6\xcfϻ\x8d\x86\xb4\xd3u-\x8b\x83=\x11\x94M\x92\x03\x80\x11\xcf\xc0i\xf8\xf2tD\nǼ\x02\xf6\x82\xcd\xc9B\xc3x\x048\xcdF\x98\xcaR\xf7Su\xb6\xbe\xf6\xd6\x12\xf0\xec-\xf4\xaa\"|5\xa8e\x8e\x83\"|\n(\x85\u007f\xaf=c\xbe\xebj\xb1\x10\xb3tMw\x1eW\x14\xfe\x18\xa7\xc1\xe0\x10\xda\x19\xe1\v;z}O\x84\xaa\xaa\xb8\xe9ǋ\x0e\xfc\xbf\v)\xb3ٴ\xc2QY\\_\xa0\x1b~\xcb\f\xee\x87\xc8\xe1\x0fOY\xf7)L\x17\b\xbb\xd6s\x8a߉g,\x99\xe6\xb1\xd0ju\xbf\xa4\x8c}\x06\x80\xa5=O=RD\xc7\nAI\xdd3,L\x8a\xf3\xfd)\xb40ʹE\xf3K\xbcZ\x97A\xa5\xb9\x9bF\x17\x80\xc8h*\xa1U\x90\x03L+K~\xf7\x11\x8b\xac=!jѻ@\xa2\x14͎|\xa8s\xb5rS\x8c\xd6\xee\xb2\x01T,\xb58\xed\xa3+\xc2w\xe8\x9e\\\x91\xd3n\x12\\FP\x82\xe8\bUl\x98\x8fS\xfa+sP\x8d\x13O\x17\x82\xac3C\x15\x1d\x19\x9a\xf9̧ǻ\x18\x97{\x1b_\x8cC|\x90\xb2\xdcj\"\xb4\xfb\x8c\xe2\x91\x15\xc9V\xf89\x95P\x18\x89;\x8e[\xb5\xe0\x92Q\x94\\:\xd2\xe7\xc9\x1cX\x8f\\\a\xf6R\x17\xe3\xaa\x18]\xe7NI\xd9_\xb6*HTk\x9dO1\xa8y\xd4\x1dp-\xe1[Ef5|vf\x9c\xd0kPX\xd2:\x10MI\xeb\xb0\xf1\xcap,@\xf4\x9eT\xc1\xd0m\x88\\\x990p\x13\xf0\xd7Y\xe3\xbc~C\xa4\xba\xd0R\x16\xda\xe6\x05\x12\x97<\rR[\xd2\"K\xdbۃ\x0f2\xcd\xcaN\xdd\xd0\x1b:\v'\xb3\x1c\xe1\xcb͌r'\xbdL棁\xd3\xc3\xf3-\xac\xcf؍\xc2\x01\x96q\xab\xb2ͪK@T\xc2\x02q\xc1\xfd\x13\xea\xc6\xe3\xe0\x90\xadm\xdc\xe9\x16\f\xe3\x91\xf8݄߫\x95\xf6\xf1H\x1b\xab\xca0\xa0\xd5\xecbE\x18\xe6\xfd\r\xf64\xe8}ʡ\xfc\x93\xb1s\xe3\x90Q'\xa5\x97~Dq~\xbb\x17&\x9f\xf6\\\x90@|\xa1]\xf0\"\x03`/\x12\xbc\xe2o\x1b\xde1'\xd0Y\xf8\x95n\xfdJ3\x06N0\xd6\xec\xe4\x00\xbb\x1d\xf2L\xf8C\x0fb\x99u\x06\xaa\u0380\xc6\x05|\x81+\x857T)\xbc\xd9Ri\xa0c\bks\xce\x194\x87;\xeb\xf9\xba\x80q\xe4\x86Ń\x15B\xb1`\x9e\x9cX\x11\x8c\x87zC1\xb4\xea|\x0f\xb2\xe2\xc9\x12\xfc\a\xa6\xa4N\x97&*g\r\x8dן\x8b\xfa\x8b\xad\xf5\x17\xaa\xbe\xb6\x1f\xf8\r\x05\x00Pp\x01\xfb\xee|\x10\x95\x83:\x93\xb0\x00\x975\xed\xb7\xaf~7\x8d\xf4s\xbb\xcf\xdc깤\x04F\x86\x8d.b\xcb\x11=k*\x88\x01\x13\xe8\x95\xd3b6\xdd51\xab\x1e:-\x129\xf2\x8cN=N\xb6T\xcfuyj\xc7\xe7\xda\xfa\xd4u\xcd.m%a\x96B\xe8ĵ\x98\xc4\x04-\x97\xe7\xdb\"\xca\xc0ԓ\xde\xd3!\x10\xa4\xcaU&\xe4/6c\xb3\xb4\x17\b\x8b,\u007fѶ+?\xa4.Y\xb6\rd{\x9c(B\xa4\xe1-3Ш:\x01\xc2h\xe42\xf5\x89\xda\xe4\xc8\x1b\x86\x9f\xaa\xeb\xe0\xbf\xddB䔴\xeb\x93n[\xd0z\xb6c\xd2$\x10ߢ\xb2$Sd9\xde2\xfb\x9do\x0409\x80\xa5\xa3\x83\xae@\x02N\xffZ\xcaoj\xa6\xeb\x97I\xf2Ap\xcd\x1c$\xd8\xfe\xa6\xa0\x8e\x12\x0e\xe8w}\xa8\xf6\xe9\xd1\xd0?\xc3\f {\x9e\x15\x8d\xc0i*L\xf2\x9d\x8f)_\xcc\xcb{%|`\"\x05\xfba \xa3p\x91\xccg\xb8V\xf9\x17w\x142\xc5'\xab\x04Լ\xf8\x8d\x97 \xb2\x95\xc5\xc5xb\xd6z\x86\xf6{\xb5\xd2f\xcc\xc2C8\x9c\xf1sم\x88\xd0\x01n\xc5G\x1c4\xba\xb7$B)\x9a\x8b#V)\xc5P\xb3\xfa\b\xce\xe2j\xf5ܘ\xca\xfc(\xa5\xa5IK\x12\xab?\x8f\xfc\x1c\xafb\x91P\xeb}\xf7\x16\x02\x96V,\xd0~\xcb=Z\x1dO\r+Z\xeeޞ\x91{_\xaf\xa9\xb6m\x1e\x8a\u070f\xfbt\xcd\b\x04\xae\x8f\x913\x88\x87C\xba\x92\xa3$\x10%\x9d\xe0\x96\xad\x01\x03!\xe4cJ\xbb\xb8\xd6\\\xd5\xed\x02\xd4(\xf7V=\xde<\xa8x\xae\xb5\xd5[D\x13\xd0\x02A\x1b*Q\x0f\xfc\x90\x88f\xef\x16}a\xa2\x04\x96i\x90\xf5\x9bo\xe3\xb1\xd05UJx#\x8c\xfb\xe9\xcb1o\xbc\x14\x05\xb6\xa0\xe6\xde\xde\x00\xe4\x1b;\xd5\xcb*\xb2\xb9%W3\xbc\xa1\x9a\x8fs\xed\xda5\xe5\xe9\x80L6N\xfby\x8c\xc9\xf8{\xdeL\nv\x9e\u009d\x8amǲ\x8c\xda2M\xd5'О\x1e\x94\xaa\x1b\xa3T~\v\xea̾\xc9/\xd3J;S\xec\x87\x14\xb3@~\xae\xe5\xb9\x05\xdbʢ\x02\xcbH\xa6\x05\xafV\x9a\xfe\xab\xf6\x15V\x99\xd9\xcb\xe4\xceֆ\x0f\xa8\xab\"\x8c'\xc2\xee\xd0\xf9 \x8fj\x9e\x882\x9d\x8d\x86\x88\rz\xa0\xc9~q\xfc\xc2'&WSC\xb6\xaf\xc6/D\xf4i\x10\xb82\xdb\xf5\x96\x06Р@?-\x9eΊQOXS\xd5\x1e\xa1\xcf\u05f9\\\xeb}\xd4(\xf0Ո!Ч#K\xc0\xba\x9d\xae\xcdN\xe4A\xac\xb3\xee\x93cN\xe0\xb2\xe2f\xf7?\x9b\u007f1\x8f\xa6=\xc4&\n\x82\x85\xff\x10\xa5em\xf0\x02\x02uI\x99\xa3\xa4\xc0\xb7\x00\xce*\x96\xd7E4C\x13\xde\x1cԋ\xb7\x98nXA|~\xa9\x95#OS\xca?(&-\xb9Ծ\x1c\xc3vGA\xad\x01\x86\xb4J\xfc\x1d\x95\x13i$\xf1\xfcfè\xcd\x01\xb8\xb7W\x17\xa6\x14\xaa\xbfΤ\xef@\xa7}\xbdC\xab\x15\xde6\xd1D\xde@[\x19\xaa\xe8\xd7\xe2\xb3\xd5n(\xf3\x8fp\xc5\xf8\x88F\xf5ۛwֶ\x01M\xcc\xd7\xe6S\xec/\xe3\x1b4\r\xa4\xf5\xe7xyF\xb7\x86\x9a9\xfa\xe6\xc7\xf3\u07ff\x8cеP\xf2\x13\xf1]ǣC\xe0|Wͣ\xe0\xcc\xfd,\xea\xf7N\x1e\x1e\xbb\xbe\xfb٠\u007f:<i\xe0\xaf^+<\xe2_\x0f[\xa7'\xed\x10\u007f\x9d4\x8f\xfbG\x0f\xa9\\+\x1a\x9c\xb6\xa8\\/::>\xc5_as\xd0h\x0f\xf0Wt\xd2\xefE\f\xef4\f\a\xf4+<==\xedS\x8d\x87\x83~kp\x88\xbf\x8e\x8f\x1eF=\x82wxz\x12\x86\x87.:2\xd2e9\xa9\x1b\xd8\xfdm5\x1a\r\xecq\xfb\xe4\xb0q<Ě\xed\xe6Q\xff䘡\x1d\xb7O\t\xee\xc9\xe1\xe9\xe1\xc3#J\xeb=\xec\x9fP\x9fN\x8fz\xc7\xc7m\xea\xc9I?l\xf3\x18\x87\xd1\xc9\x11ՍZ\xc3v\x8bF1\x1c\x0eOZ\x9c\xd6\x1f\f\x1aTnp\xd4k6\t\xca :=\x15iё\xf8\xd5\u007f\xd8l7\t\xf2\xc3\x10\xaf3P\x0fz\x8d\xa3\x16\x8d\xac}ڀ\xce\xd0\x18\x9b\x8d\xd3V\xcf=/\x1dY\x13G\xd6\x1a\xb6\x8e\x0eid\x87\xfdV\xbfMp\x8f\xc2\xc3\x13\xc6q\xfb\xb8\xdd\xe2Y9\xee\x1d\xf7N\xfa\xd4V\xeba\xfb\x84j\x9c\x1e\x03\xb2#\x9e\x8b^\xbfM\xbfz\xa7\x83\x87m\xc2\xc5\xe00j\x1cQZ\xbf\x1f\x0eZ!\xff:=jҬ\xf4\x9b\xed\x16\x8f1\x1c\x1c\x9e\xb6\x9a\x04/l6\x9a\xdc\xdai\xf3\xa4yB=8lFM\x82r8h\x9e6\xa9W\x87\xad\x064h\x8f\x8c\xec\xa9q\xff!\x8e\xea\xa8u88\x1a\xf2X\x8eO\x8f\x1b<#\xa7C\xc6L\xd8\xea\x9d\x1c\xd2X\x06\x83~\xafMs\xd8o\x87\x87-\xaaq2\x80i\xa5\x19yx|\xd8k1~\xfb\xadc\x9e\xa5\xa3\x87\xcd\xc1!\xf5\f0\xd7>\n\xcbi\b\xe3m\xf3k\xc0\xc1\xb2\xdd8\xec\xc0\f\x03\x1d\xe0,\xc1\xbf\x1d\xa4\xe9\xc3a\xf3\b\xbf\x1a\x1d\xa4\x8d\xc3\xe3&\xf4䨉_\xb0g\xf5#\xc0\xd8Q\xe3!~\xb5\xa0\xd1>~!\x94\xe1Q\xfb$:ůS\xcck\x02\x1a[\xf8\xd5D(\x0f[\x830¯6\x95<\xe9G=\xfc:\xea\xe0\x8ah\x85\x0f\x87\xf8E\xed\xb5\x8e\x0f\xfb0\xa2C\xaaw<\f\x1b\xad#\xfc\u009e\x9d\x9e\xf4\x0f\x81r\xe0\xeb\x04\xeb5\x06\xa7G}\xfcB(\xad\xd3^\xbb\r_-\x82\xd2\f\x8f\x86'G\xf8u\x8cy'@zC\xfcB\x98\xedV8\xe8?\xc4/\x84y\xd2\u007fx\xc2y8\x86\xb0\xdd;\xed\r\xf0\va\xf6\x9b@\xfaM\xd7\xe2\x19tq\xef\xfa2\x8aF8\xa3\xbdv\xef8\xa4\xd9;m\x9f\x9e\x9c\x10\r\x9d\x9c\xb6\x87G\x87<Sm@\n\xcd\xcf1\x10=\xaf\xc5v\xefa\xc8\x14{\xd8o\x1c2\xfd\xf5\xda\xcd\xc3\rs\xd6O\x12j\xad\x1d=\x1c\bjPu3\xc8'\xbd\xfea\x8fy\xd0\xc3\xd3SA\xe3M\xc0(s\x9e\xa3\x93\xa3\x87LS\x8d\x87\xc3^ۦӫ\xf98\x1ec#\x8dF\xbf\xcf˷\xd18>\xee\x1d2;8\x15Kz8\x94\xb9\x87\x87\x8d\xc6C\x1a\xfaÇ\xf2W\xbf?\x1c\xcar\x92\t\xc0ʇ\xff1\xbcӡ\x84|t\xca\f\xa9w\xd8\x0e9\xadw(s\x8f{\xd0r\x8f\xea\x0e{\x872W\xc2\xebE\xf8?\x86\x8c\xfd\xe2_\xfd\x87á\xe8\x9fh\rԇc\xfe\x15\x86X\x92\x98Y\x84\xa3\x93#\xe2\xdcc\xf8O\xf6\xb97\xe4r\x8d\x06\x8e\x93Gt|,G\x89\xe3t\xcfwݤ@\xbe\x1e$W\xa8T\x14\xeeTY\xb6v\xbe\x1d_E\xf2)o\xe1\t9`\xd5O\xcf\t\xf4\x8fժ)\xd4\xect\xc6 I\x14\b`!P:\x96}\x16\xa6Q\xf0m8\xbb\xac\x0fGI2\xad\xd01\x04\x80\x8d<\x14!\xde\xc5x\xf8~Ќ\x0e\x05Չ65-\x87\f\x14tN\xa2\xb5@\x009\xa1\xe2=h6\x1a\xd5f\xbbjuCs\x1d\x90&[\xa1+ҁ8jo \xf05\xbb\xc5\xc6\n\xa9N\xeb-Oc4\xf1[\x8d\xb7x\xac\x1f\x03\xad\u007f\a\xadvU4P\xad:\xd5\n\xd5\xe8')\xbb!<\x10Y\x0f\x9a̓\x87\xc7\r\xafڂ!\xb4\xedR\a'\x94q\x92KorFS*ͤ\x02-o:\f[\x9f\x9f\xaa\x9c\x00\u007f\xd1\xf9X5G\xb1FÂ=q\xda\x10\x1e\xd4O\xdbJ\x9dB\x1f\x85\xa2iٌ:io`\xcfi\x94\xe6Ew\xaa\x06\x15\xadw%j$\x96Br\xc6\f\xdb\xe80\xfb\xe55\x19t\x82<\xc1\xfd\x92\x8c#΄\xe9;n\x14\x9d\xae^%\xe3\xd9e\n\x1c\xe9\u007f\xf1:\x96\xfb%n\x19\xee\xb7\xe1\x14}\xc2&S\xfa\xbd\x80\u007f\xffw>\xa6\u007fG\x98>\xbf@7\xaeh\x02\xff\xbe\xc6[X\xee\xab\xe4#\xfc\xfb\"\xeaKv\a\\\x0e\xaf\x0fѽŎ;\x88@\x06\x00^\x92F\xf8V]\xda9=\x86m\xe8\xc1\xe1q\xbb\xdej\x03I\xfb\x1c\x80\x1bM\x00\x05VM%\x8d\x0fpT\u07ff{\xfe%\b\xfd?\x92!\xfa\x00\xea\x82\b\x0e \xd6k_\xb4\xb5\x88\xb0\xefE-mn&\x0f=\x83I8\xca\x01mԷ\x80$c\x1b\xe3\xf7L\x82\xff\x16?+\xdey\x06\xfc:\x8a>ذOv\x00\xcc%p\xba\xd1ث\xc0\rp\xba\fh\xbb\x8d\x9b\x00ip\x8e\x9d\xcbd\xaea\xf2\x10\xb8\xf7\x83\xe3\x9d\xfbE\xecN\xefW\x1e\xda\xdda5\xdbx\xcc\x03:a\x06\xf0\xb8\x01\x8c\xe5\xee\x10\xf3\xe0v\xc3ڷT/\xad\x98\x9dc(\x19\xb4掴\xf7\x96\xcbW\xbc\xaa\x9b\xba\x19\xc4\x1c\xb8;A\xd3Vf\xc6A\xf0\x8b\x9c\x1f\x8d3\x04Z\xbd\xb9\x83\x06LU\xe5dE\xd4\xdec~\xe6k\xed)\x06\xc8Me\x14\x1a\x14u\U000f03bcQ\x94\xaa\xb8\u007f\xeb9\u007f\x03\xa6\x87ӢW79\x9e>\xcaY\x82\x83\xb4n\xb0U~\x1aT;\xf0\xff^\x87\xee\xaaI\x83U\x14\x8f\xcc\xfd\xdf\xe7ѐ\xef\x03\xac\xf6/\xe7\xc8_\xd9I\x01c\x04D\x034T\xa8\xa1\x05\x81\xe0\x01\xe8]$K\a\x92\xddV\bb\x95J\x8b9\xaa5\x81+\xe1V/\xa0ee\x1b*\r\xed%:\xc3\xc9 \xe7X\x91]\x87\xb9H\xae\x82`.vij\xb7i\xa7~\r\v2\xcdwGRt.C\x92SA\x8d\xd1(N\xb5\\q\xb3S\x142d\x9f\xb5\x85U\xe2\xd6\u007f*\xa46\xfe\xcc\xd8\x13\x99,&\x01M\x13\x8e\x0et\x1cy\x0f\xf4\xaf[\t\x1c\xf5o\x92~8*\x15;8\xfb\xff\xd3\xc2\xc7o(y\xfc\x96bǟS\xe6\xf8Kี\xc0\xf1\t\xa5\x8dO(j\xfc\xf7\xcb\x19\xbf\x9b\x90\x81\v\xe9S\uf1b9\r&\xcb*ޔ\x8a\xf7\xb0\xa2\r\x8fZ3wX^\xd5&\xbc\x02a\xa5HR)ٳ\x8d\r\xfb\x16\xa2\xc5o#\xb0\xfd\xd6\xd8l\xfe\x19P\xf7\x9bHe\xbb\xf4\xf1\x8f\xc5\xf3\x1f&}\x89\xb87E\x92\x17g\t\x1e\xf5\u007f\xdf>{\x97q\x18q\xdf%\xec\xa5\xef\x17l\xa9\x83\x9f\x90J\xf7\x8f1\xf1qЌ\x9a-\xc5\xc9\x16\a\xf8Yu߹\xea\n\x88*\xf6\xd0(\xf5\xb0\xea>+(tl\x14:\xae\xba\xdf\x16\x14:4\n\x1dV\xdd\xff\xb3\n=j\xee\xed-\x1e7\xb2b\xc0`a\xb8Ѡ\xd2\xf2̒A\x10\xa8b.CQ\x95t\xdc\x16#\v\xcdo\xcdF\xeb\xe8\xff\xbe\xfd\xeaݛ[a\xadqs\xd4\xd0\xfe\xd3\x06d\xe5T\xdd7y\f4\x1e>l7\x9bǭ\x93\x93\x13\x03azz\xe1$4N\x0eO\x8e\x9a\xa7\xad#\xa3\x96L\xac\xba_\x15T9:m[\xadPJ\xe1\xd44,\xc8\b\U000f77dc\xe2{ңdZ\u007f\x13\x8e\xf0\xa2\x90yIZ˰\xfd\bؖ\x8d\a\xcf\xc6\r\x1f\xf3P\xbakz\x1bl8\x97\x0e\b\x96\xcc<\xe3{#\xf4q.<W\xf8k\xb52\nj'S\xc2f<\x1f\xe3\xe3d|\x1bW\x9c\xdc_D\xe3h\x1aΒ\xa9L\x95\x8e1ٕ\x1d\xf4gH&\xc2M\x8bT#\x82\xcf\xde_Z7\x95\xc31\x1b\xf9\xfd\x9f}\xd1}\x11\x9b\t\xbd\xe5\xe2GZe\xben**\xf3M\xc5\f\x1a\x85\xa3@@\xaa\x87\xe82\xa8\xf5\xea\xebtd\x95\xf7\x8d\xcfj\xf3\x9co\x8d\xfc\x1c4\xbb??*\x1fT\xf7g\xbb\x1b\xaa\xc9J\xf3\xa0\xbc\xde\x03\n|V\xd6\xfd\"Ĝ\x1b\xc7x\x01\xff\x11\xce.\x18M\xbb\x14\xa7ҙ\xc9\xf0e\xc0\xd8q\x86\x90*Z\x86ty\xbbI\xef\x8b>\xfbժ,\xa1\x8d\x8c\xa8\xb2\xe2\xadV\xea\x88Nye\x98e\xac\x80\x00\xd6U\a\xada\x19R\xa7\xab\x16\x84\xf2T\xb3\xe8.\xb0\x91\xf0\xa0\x85w4yě\xe7\xbd\x00\x9cI\t\x8ds\xafRo{%\xf4\x9ek8h1\x8b\xf8$m\x17\x14@\xba,\xebO\xb5\xba\xd6'\x9f\x1d\xe9\xb0\x1fj\xbf\xa7\xafm\\\x8b\xaf,=\xfd9\xbc\xc9\xdd\x10\xa4\xd4\xecr\x13G\xbd\x11A{\x96\x99\xfbM\xa7\xe8\xfe\x1a\x9e,}\xff\xdd7\xec\x12$>\xa4\x8b \x9dDQ\x06\xffւ\x19h\x1a\x8a\xf2(DO)`\x9d\x91\xac\"\xbf\x8d\xc8\n\xaa4]\xe5\x96E飨\x9c\xe2\xa7\xf2\x84\xec_s\xbc\xf5\xef\xad}\xf1\xb3\xa3\xd5\xf9\xbc\x1e\x02\"*\xcb\xf9t\xd4\xd1\a\xe7\xe3_\x8c\xec\xd6q\u007fN\x931\xe8\x97\xf3~?Jӎ\b4C\x1fu\n\"BNz~\x84\xdd\xe1\\\xfa\xa9孡i\xce\xd6\x1a\xc6H8\t^QH.*.\xe5:\xf8\xf8\x1d\xfa\xb5\x8a.\xa07\xab\xde%\xcf@\x83\x00\xad\xfae\x9c\xfe\xf9)9r\b\u007fnmj\xf8l0\xc3S]\xf7\xff}/\xeec\xb3\x93+á\xec\x8e]^z\xff\x91?`\x90\xfd\\\xad\x8c\x8d\x8e(\xad\xa2*땬K\x0e\xd9\xcc\xcbq\x89\xcet\n\xb6ԁ\xa21\xf2\xee\xd6ܘ\xf5\xab\xa7\xeaΫ8\U00094d07U6\xb84g\xd0\xfe/\xc2+U\xc0Eѝ\x19\x97\x17\x81Ty\x9eq\xc1Hޝ\xc5\xe8\x9e0\x95\xfc \xbc[r~=\xc8Ɓ\x8d\f\xb8\x91A\u0588\xc8\x11Mh\x97\x97r\r(\x8fr\x04\x14\xc8zz\b \x8a\xb4\xe5\x12\xbfp}\x97j\xf9\x04\xd2=\xefj\x91\x84r\x9d\x9c\x10S\x1f\x9cMν\x14\xfe\t\xf0\x17\xde+\xc0\xc3be.\"bX{;\xb1!~)\"*`E\"\xa7H|\xb2)\x8c\xa7\x98~\x1a\xd7SAV\x92\x8fRt\xf4\x9b\xc9\xeb\"#.\x11\x90d\x17\x12@]\xd6/\xbd\xe9Z\xf0\x00\x86\x9b\xb9FX\x86\x14\xf1\f\x86\x0fdN\xa5\xf1\xc8}4\xe4\vj\xf8}\x1eX\xdf \xfd\xf5\x92\x1bXl\xe8Tn\xe5\xd5)Gx\x13\xc8'?\xb4\x16:\xb2\x15\xfbޏ\xc6pL\xd7e\xd5@\x81\xdb2w\x97\xe3m\xc9\x11۽eߒh8\xe3\f^\xd37\\\x15\x89\x12\xb3\x1e5V+\xfc\xfbX/\".T\xa1\x15\x8a\xbd\xaf\xb3\x87H\xe4MᥖV4!\xea\xb9\x1d\xc0~\xbez\x9d}`E\x14\xf6\xc0\xc5@\xe1\xeeZ+Ǹ̍\x1a\x92\xa9C\xf0\x97\xfb(\xa3\x03\xd9\t\x1b\xba\xa3\x96\x06]\xfd,\x1a\x9a\xe6\xbb\x1b\xe4\xf3\x83\xb2(I\x83\xf8#\f\xb6\xe4*v\x06\xc7\xf5\xcah\x9a/_?\xbf\x8cG\x83\x8a쌂\aP\xe8El\x04\x89r\b\x86n\x8a\xf1fqv+\x86\x03+\x98\x8ds\x14\x0eh\x9f.A\x99\x93a\xcdۦ\xa2\x9fp>\xecfp\xe7\xa5\xfb\r\u07ba\x94P\x88\x88\xf1\x9f\xaa;\xb9qw (z<\xc0\xddu\x04\xfa\x84c\xcfe\xc4\x04$\x10E\x15\x05\x05\xeeF\n\x82'h\x05\xe21L(\x86\xbe\"\xe0\"\xbfh\x94\x85\x14b\xcd\xe8\x96NmY\xb7\xd6\xc3`PAc\fEݰ\xe0\x11c!\x14\x8da\a\xb5\xc9`K߶\xadb\xfbm\xaf\xed\xbd\xcb\x11\xe6z\xad\xf7\xcf\xe4\x9ft\xa9\xe6\xed\fÐ#\xadu\vr\xbf\x18\x0fؼ\x837\x81\r\xae*a\xfae\x8c\xb4k0Ҭ\xad\xc7\xf9&t\uea9a\xa5.\x15v\x96;\x04\x12\xf2-:\x04\x8aֺ`||\xd3!\xdfz\xad\xa8\xe1\xfc|i\xab\xb5\xa8\x02\xafޒZ\xd7EMS\u007fJ\xaae\xc4!\x82\x00\x19\\\xbe\x9c\xce\vJ\xe7VE\x11\xdbY{\xe6\xbd3F\xa9\xbcqUѶq¸\x88\u007fK\xd7|w\x11\xbdn⼇\x9e\x95U$|Yb\xd3\x16\x01\xecL\x9c\xa3ѳ>\xefp\rfqb̴\xd5\xca\x05V\x1a\x8fED\x99!\x9a\xeb\xb0\x0f\xdf\xe3\t\x9c(\xcf_\xcaU5K\x16\xd63K\xd50\x0e\xfe\x85L6A\xf1v\x1a\x03\xa6\x14p\xcb\x17`.\x9b\xe0\x03x:u\xa3\x9f]\xfbڻ\xa4qu\xeb]\xf8\x9e\x02\xf5\xb1\xe9<\xe0\x9c\xec\x029,\x83\xae8.\xb47\x869ml\x9a\xe3\xad\x0e\xe8`\xael\U000cf0d6\xb7\xa4cI\xfcg\xb5\x9a\xaf3ٛS\xb2\x1e\x9fe?3;\xd3:\x9b\x11\xf6kH7]\xed/\x1c#5\x9f\x9f&a6*\xc0\xb1\xa8G~\xb4Av\xf2P)\xc0\x8fg\x1eE0N\xd9<\xf5\x0e\xba\x1cd\x88ĜD\x8c@\v\xf1Φ\xc4~fDdr\xe9\u007f`\x97YB\t\xb5\xaeA\xe5#ήގ\xaaa\x1c\x13\x1d\xb4\xba\xa2I!xS\x9c\x88\x8e*L\x80:\xf8\xcfZ\xc5\xfe\x13\x15J\xc3\x03\x98\xd2Y\xfe\xf21\x88\x02u\x0e\xfb\xfb*\x19D\x82\x9d\x88=ٳ\"\xf2\xa8\xe8C\x121\xf6\\\xc3L\xc8\x01\xe4ZJ\xc4ed\x8d\xa9'\x1c2\xd5+\x15\xd75#\xde\xed\x84\x14\x8d\xe3\x15\xb6\xc8L\xb8D\xa4\xe1\xe7\xc4JE\x1e\x02h\xb2\x17Op\x8d\xd9h\x9b\xc0Be\xec\x06)Qef\xf2SB\xab\xab\xae\xdc\v\x94\xff\x90\x1c\a\x1d*jR\xb5&+\x10\xac\xad\x12\x85\x12\xbb\xa8\x9c\"\x13\xa2>\x99\xb7\xdeq\x8b\x90\xf6\x95\xdbl\x11?\x94\xec\x0f?l\xde\x1c\xf0\xab\x17Mg\x97ߡ\xcaYo\xee\x14\x04\xabx3I\xa61\f\x925N\xb6\xf3e\t\xb0k̒\x89\xd83&\xc0\x8cF\xe9\x9bhJ\v\x98\x8a\x1aI\xab\xd5I[\x9da\x10yx\xf2B7|a\x89Tۙ\x8c\x92\xb4\xb8E\xf1\xec;\xb0\xf23\xceJ7HU.~\xadVG\xb7\xdf\ne\x17\x94R\xb0%\xda\xda{\x19DD]OV\x97L5c\x9b\x19\x90\xcbh\xa04 W\xd1\xc5\xccm\x979i\r\xb9\xbe\x15\x16\xccQOxZ\x9ai ;\a\xcc\x1d\xf9\xbb\x11i\x8cم|\x9b.p\xe5\xe3t\xee]\x82\x98i\xf1\x1c\x11\x17\xdaҁ\xcf\xf5mWҭn\r\xdf7\xe2\x9f\t\xeeY0w\xc6\xe03\xeeoM\xe3\x1d&\xe7A\xa5YU\vS\x04\x0e\xa2o\xfdҏV\\\x95=hyESB\x1c\xbc\xd6\xe4r,\xb7\x96mt\xea|\x87pK\xe0\xef\a\xc6\rn\x12\xbf=sF\xc3\xf9,\xe1P\x96\xdcY$\x1e\"ߏ\x17u\xfc]\xf1D\x90\x13]^\xf1\x04\x93\xa8\xd8\x1c\x04D\xef\x1b\xb1\b\xbfdW*\xb5\x00\xf9[;=\xb8Q\x87\x137kO\x9dNi\xec@\x81\xe2\xef\\\xbe\xb6\xe0\x03\x9bӬV6\xbe5\xb4\x1c\xe4\xf9\xd9\xedfj\xb5\x127\xba\xf0\x15AܝT\xf7un\x1a\x10\xf3dֽ\x10\x1e\xb0\x1a\r\ty\x8e\xfb\xc5I]\x05\x10\xea\xe2O<\xf9\xaa\xb8U1\xfb\xbe[\x15p\xaa\xae'\x8eӷ\xd4p\x1aPPv\xce\xe4s\x1f\x89\f\x90I=\x1d\x8d*\xee\x03z\x80\x85t)q_\xfd#K\x9b\x8aae\xecJ\xb0\x9e37{%V,\x02\x93՞g\x11YeU\xd5_\xa8 \u007fz\xe2a\x0f9\xdf\xdaT\xa7\x1e\xb3\xf8y\x0fćx\x80\xfeB\x8a\xe7W\x8c\xfd@,6|^\x97XE\xd1|\xf0\v\xe5\xee\x93f\a\x1d\x92\n\xd0o\xb0\xaa̓w\xd5S\xbe\xee]\xfb/\xfb\xeayFl\r\x11a \u05fdu\x01'3\xc3Y\xab\x9dhY\x10A\xc1\x88\u007f\xba=\x86Bi\xf8\x84\xbb\x04@Ȏ\x86\x90\xdf\x18&\xba\xdbGcX\xeb\xe1\x14*\xe5\xf1\x14\xece\xef\x99\xccy\xe7X\vG\x8d\xbb\xc5H\xd0Ĺ\x1fKļ\x1f\xefx\x1e|'Q\x8e^\x14\xfd\xaf\x15\xe6T\x05\xdeh\x82\xf2\x8d'\xf3\xddX\x88\x895D\xe9\xdbJ\x85w\x91\xf7Jd7~\x96\xfdO*\xb8\x95\x99\xa7v\x12\xe3|ѓΝ$\xb8_\xcf\xcd\xca\x04\xbeO\x11\x1c\xa6\x9c\xb7\xdd)V\xccn\xc1bl\xe6\xf6\xc0$䝙[\xc9&x\xb7`2\xc8`\xcd~\x18\"\xb0^\xd2\xean\xb1\xfc\x84үk.@A\xf1 YYb1\xfa\x9a \xb9uJEbnْ\x89\x19\x0f\x1b\x84\xe2;\b\x99\xdcP\xa9\x94I\"\xb6\xd8ʧ\xe15n\x04z텔\x060\xf3+\x10\x11H\xb0\xd8QD\xf0\x15\xc8\f\x0fZ\xfc\xc3R\xe9\x9e\xcb\xec.\xd6[\xdc\xf6n\xf2\xfb\xc6I߉l\f!ܔ\x803\x82E1XIĿ\xa98,\x1e\xd8\xff3\xc8\xc2\xf2e\x14\x80#\x88\x02IIc\xbfHS˭\x123\xcb\fy\x81\x99\x10{;yyA\xf2\xf2'\x10\x96w\xf5~!\x01\xab\xfe\x16){P&\u007f\x89\xec\x121\xac\xb0\x8a_(\x9c\xf1\xab\\9\xe9[\xf8\bC\x1b\xf6\v\x04\xd9S8\xec\\\xe6rO\x1c\xf1,o\xeaP-W\xc6$'\x10\x19\xb4\xae\xd5\x04b'\xdfB,2\x88\x95e\x9b;\xa5\xa9jk\xee\xba\x10i\x8b\x18\x88\x18\x9b\xceG\x06?\xcfS\xdc\\\xa9[Y\x0f\xeb\xfdd\xb2\xa8\xc8\x10\xbe\x9c$\xccي$es:\b\xaf\x90F%N\x89T3^\x1e\x0f\xbc\xa5\x04\x82Y\xeb\x1d\t\xe2Yt\x19~\x8c\x93\xa9\x8c?\xf95\xd0\xf6\x887\xde\xee\xaeEoaX\xe5\xd7\xe5\xe5\x1b\x9e\xf8\xbb\x9b7⒓\xd8\xdbp\x18\x05\xe2uI\xf6\xa3\xf8\x06O\xfeQ6\xe1S1\x11\xb9\x94]\x80\xd9\rOO\xd2d[\xf6\x0f\xa3T\xfdr\x9b\xe6H\xfb\xddEO/\xe5C\xee\x14\x9202\x1a\xfe\x0f\xb8S\xa5~\xea\xe9\xef\a)\u007f+\x85\x05r]\xd1\x0e\xb6F\xderT/wm\xb9J\xe6i\x84\x1a\xab\xe9ނ\x82\xb6\x1a\xad\x14\xf5\xe8\xfe\x80\x86\x84\x11[\xe6\x19\x81t\x9a[p\xb4\x82\xc9Z\xdc\xdf\x11\xac\x04\xf2^X\xca'p\x1e7\xf6\xf6\xb4\x93\x00;p\xee\xde^\x85ωy\xb6e\xf6\x93\xa2DY\x87\xe4\x03#\x10?\xfb5k͈\x9a\xe2l\x90:Rkv\xef\x11T`\xb3\x17\xf8\x10\x87\xac\xa4\x00\xe8ΕyX\xc2_T+\xef7\xf1\xf8\xbf\x9b/Jg\x18\xc2\x13S\x1c\x96\xad\x15\xb9\x9d\xe9C\xe3\xf8\x9d\x1b\xf2V+=\x89\xdd\xc9s)\x81A\x93\x95\\>\x1e\xa6i\xbdT\a\xea\xc2]\xa8\xbb\x9d\x82\xe6\xb3\x1c\x01\xdd\xdfNA\xb4\x8cv$\"\x83|\xf6\xf6h\f֓\x15\xae1s\xaeA\x01\x05\x136I&\x15\xaf[:\x91yZ\xf0\x1b\xbe|\xa7a\x10\xa1{\xae\x93/\x83\"̆\xe9\x82\u0558\x9b\x9c\r\xc5כ'f\xad\xe44Ɵ`z[\x90)T\xc0<׀,\xf4i\xbd\v\xc3~=\x1d\x987\xe06\x14\xfb\x94\x8c\xdaV?\xeb\x9f\x17\xed\xe5VT՟\xff\u007f\xf3h\xbap\u0099\xaa\xe4\xae\xf3\x80\xea\xf3xwX\u07ff\xd4\xc1AUw\xfd\xb9\xaet\u007f^1\xc9<\x9d\x81x\x9fLg\x14\x02|)\xdeO\xc3\x15\xd6qY\x05\x06\xb93\x19ES\f\x80\x05I\t\xed\x12\xae\xcf\x04\x90m\xc3\x11Λ?\x8f\rJ?;\xef\x165\x87ݭ\xb8\xa3\x18ļ\xc8 \b~q\x8c\xdfE\xc4u\x8b\xbfL?q#\x80\x97\x9e\xbd\xf64\xef\x83R\xf6\xda\xecƏ\xd17\xa1V\x13\xa4\xa7\x17\xc2W\x19\xad\xf8[E\x14\x0f-\x15\rJ0\xb6\xb7\xa4\x1d\x10\xaa\ro\xfcB\x03\b\xf6\xf6\xb2\xd4\x1c\xa1\xb7\xa1\x8cEB\xff.\xc8\x13J\xafP\xe7/w<`\xb6\xd7\xc5;r\xd5ܾ~\xdeYO\xbd\xfd\xfa\x05$\x05\x88\xa7\xe3\xfe\xa5~\x89\x89Y\xaexf\x18s6<Y\xda\xe5\"\x9aw\x80\xbb\xf7Y\xb3\xd1h\x1cu]\x99g;\x18\x8a\xc8ʼWe\xee\x99@\t\xb3g\x11\xd0TTኾQ`\x18O\xd3\x19\xb9\a\xa8F\x931\xb9\xe7\x06\xd6\ue8f3R\x15^\xde`\xbe\xd1X\xc4\xdf7Z\xc8{\xaf\xc9\xdan\x16\xbfY#\xb0[=<%\x1f\\\x0f\x9ara\x15t\xb3\xbcG\x849\xad;k\xedZ\x8d\bo؋F\x81Q\x1b\xe8[LU\xfal\xf1.\xbc\xa0G\x04]\xa0J@>\n'T\xa5\x10\x89\x9ax\vj\xf7\xeb\xd9e4\xc5M;\r\x8aP\xcb\x175\nJgO\xac\x8b\x9b\x8e\xb9\xdd\xde~\xacy\x14\xe4\xca\x00\x8f\xe8\x9as\x8ar\x80dBKu\xb3u\x94\x9fq\x9e\xb3\xc2qPx\xf9\x1eP\xf3\x8759\xab\x16w\xffב\xccNҍM\xb0\xf9\xc1-G\x05D\xb2\v\x85\b\x9aݭ\x17\xa3\xfc8w[\x17\xa8\xe7\xe5D\x91lG\xfd\xfc\xbe\xf9>\xe0\xe7\x15SL\xd17C\xdc*\xcbs+\xf8\x14\xc5tV\xb4\x01\xcecz\xbc\x94\x97\x84;N$M\xebr\xe9G\xf6/\xff\xc8O\xcf\xd3\xdf\x17\x1ca^X\xc2gɤ\bv\xcaq\x04\xf0\x85\rm\x1f\x91-\xceǹ6\x01V\xab\xdd \xfc\xdfN\"c6̂\x18[\x15!Q\xee\x029\xdf9\x93\x05\x15\xdc\xdfR\xf4by#\x94m\xd5ș\x84\x98\x93\x8cG\v'\x19G\xf2\xd5\t\xb2\xfd\xad\xb3\xa5\xc5˧h\xab\xeeJ\x02\xd2\x1b\xd5*\xf2\x1b\vś\xbc<H\xd5Fm>\xfd#R\xe7yd\x98uv\x13c\xbfF\xd5\xf7E\x04\xc2\xd7(\xbf\xf9j\x99\xb7?\v\xdc\xe9\xba\xd6͗\xd2}\x8e\xb3\xb2\xefBg\f\xe5bwC\xceuf\x04=\x81\xb9\x85\x05rQ\x04R;f\v\xf8\xb9\xb3'\x8b\x8e\x11+\xc0\xf0k4\x8e\xbcnq\x13\x83\x02\xff\xb8\x03F\xaf:\xbc\x88\x912\x88xv\xf0\xfd\xe3\xd5\x13\xa6\xac\xa1\xb0¨f[*\xa1ivq\xf2\xeder-\xef\xc4\xe2o\x99\xfeu<P\xd7j\xf1\xb7L\xe7\x97\x1ad\xcewڛPC\x13\x8f\xc3\f\x8df\xf6\xba(Ēx\xef\xe8\xc6_\xa8\xdc\xc1\x0f\xd9\xcf\x1f}=ʔR\xf7\xaango\xdcK']\xb7\x9a\x15]\xe7\x85~`aA$\xfa\xa1p\xc3wh\fǪ\fo|\x912\xaa\x03\xf3\x04\x81\xa0>N\x06\x11N\x8e\f\x88\xf4\xcf\xca$\x9c]\xaeҏ\x17\xab)\xc8ѫ~<\xed\x03+\xfe\xfc\xc0\xb8\xabn\x933\xd3\b\xc2\xff!\x88īD?\xacV\x11>a\x14M\u007fȲ\u007fT\xd9?\xaa\xec\x1f)\xfb砡\xbd\xb0#]x1\xc2L\x94\xce\xde`b7\xcf\xe8\xb6=3e?\xdd%*k\xcf\b\xfd\\\xad\x9e\xab\xab\xa5\x82\xf5\xe9#eW\xeb\x1f\x02\xe9\xf6\x1b\x8f\x81\x13\xccxC\xf8\xc1ӊ\x90\xcaϖ\xd6\rϬ\xc9g\xf8\xe8\x1au\x9d\x1f\xf8\xaa\x91m\xa8~\xa3?\xaaf>\xe1'N\xa4Ч\xfc&\x86\x89\x8a\xd8\x1e\xc51\x9d1\xc0M\xc5\xee\x83H\xf8\xc1\xe3+0v\xcd\xc0\x80\xef\xd9\xf9\xb5ZW\xe2Mk\t\xaf\xdf\xd8%W+\xab\xaf\xba\x16h\x17\x06\x99\xcf(\xdbe%\x93\xdf\xf8:_\xad\xc4/\f\x00@\xb2\x18v]d\xd6o\x1e\t\xaf\xf7\x1f\xf6\xf6T\xb1\xfa\xcdc9Υ\xd5Y\f\x05#\xf2j\n\x86\xf7\xa8$\x8f`yO\xe2\x0e\xfc\x12r\xa0j\x9b\xf2T\xeb\xd0\xe5jUH\xff\xb5\xdaZ\x16\xa3\x96\x1f5\xbc\xac\x17\"\xc26\xf9\xdb\x13 \x95u.\xcdų,2w\xfa/ *\xfa5I\xae+\xaa\x97\xd2!\x9c\xc0@\x0fk\x82\xee\xfc\x96W-+\xbd\x10\xa5\x17U\xf1\xb7!\xaa\xfd\x88\u0558\x94\xb4\xddF\xac$}\xbf\xb16$~E3Wca\xd7X\x985h!\aˌ\xe9сb'\x83\xabF\xa5qC.\x93A2^\x87{\xa2\u007fȕ(\x06\xe9u\xe4\x0fy7_0^\xbe\x9c@\xff\xfa\x12\xe7\x1d\xf9\xc3O\xd0\n\xd5\xf9٧\x98y\x1a\xffe/\a\x9d\xfb\xacV\xb2\xd2#=\xb9.S9ȖL添\xd6\xcc\xcc\xd8XB\xbf\xd5#~6x\xc9s\f\xd8\xe2\xa1c\xda!ufd\x14\x12X\xa4\x02\x16\xb2͂V\xa6颢)\xecn\xa9\x8b\xab$G\xb9\x16\xf8~\x82\xbe\x9b\x8bWn\x84O\x89x\a\xad\xc3\u007f|\xde\xfe\xe5\x17Y\x8b\u007f\xe80E\xf3\u05cf\xfc\xf5\xa3o\x13\x8d\xf5-\x9e\xa5\xfc\xa1#\xfe\xa2\xc0~\t[y\xc7\x16\x04\xa5\x8c\xc1r\xe6\xa6g\x9b㱺\x82\x9bi+\xbaĐ\u007f\x04\x8a3(\x84c\n\xf2Ei\xe34}%mK\rjS\xf3$\xc8\x145\x8f\x19Լ\xedSQ.w\xea[,\xfb\x93\xd1om\xc1\n\x92\xb5\xad\x18\x93,\x90\xaa \xcb,x\xa3z\xc7NТ\x94$\xf5}Ԧ\xccI!I\x1a%\u007f,,\xf9\xe3\xaf%^\xbd\xa77\x82\x84\x89'~C&\x93\xcdB-\x17\xd2eڛ\xf7d7qeV֗\x8d\xebM\x17q\xb9\xa6x2\x11\xd4\xc8-} MS\xeb\x01~\xbb\xfa\x81\x14\x8fP{\xb5\x10&l\x1e\x8e~\f\xb62Sc\x16\xbd\x8e\xf1\xc9\xedf\xc33e_)\xe7\x1a\xd8\xf5E\xc3\xf6z\xb6\x19>\xd7\x12#\xcb\\\x87tw\x1b\xb3k\x8d\xaa\xd9S\x8d\x11\x15!\x98\fڂ\x83nS[\xa0\x84\xa1\xb2$3\x97Ҳn\x99\xbd\xd42{\xb4\x9b\xf0\xf9\xafq\nW\xda1\xa8\xab\xad\x1e^W a\x14L\xb08+s\v\xfa'\xb3\x84\x0f\x021\x85<\x17\x11\xeaM\x11\x1f\xe1,\xf9\x02\x9co\xe8S\x9dbCÖC@dj\xe6)`\tG\x14\xfa~\xe4\xe9\x06Lyָ\xc5x\xaf\x030\xea\x1b5\xefp\\\x89\xf7\v)\x94\xd9;ґ\xf0\xb1w+\t\xe6\xf5\n\x0fg^\bRz#\\;+\xf9\x86\xbd=\xba\xb1\xf8\xe2\xf5\xf3\xef\xbf\xfd\xe2ջ\xf7o^\xbf}\xf9\xee\xe5\xebW\uf7ff~\xf5\xee\xe9\xcbWo=1\x92K\xb1\x99\xe4\x11\xb1\xa3\xe7\x04\xbe\x8a\xfe&o\xbb\xa0\xe4\x1d]g~\x0eo\xfc\xe5ݣAM~m8\xa8\xdd\x06\xfa\r\x99\xd0\xf2#\xe5\xf4\xa2;y\x85\xe6\v\xc3Ix\x9b\x91\xc6<*)\x11\"\x94\xa3\xf0H\xf6\x90\xacؐ\xcfP\xe9W\x19\xfb\x99\x8f\xdc\xe2;\x92d\xf7\xd4\xf9\xbb\xa1\x02\x97\xbd\xa4\xbe\xd6\\^\xc7!\xa4\x85#:r\x95O\xdc\x04\xcacB\xbc\xa0%̓d\x0f\x97W։\x13\x8c5띮Vo\f|\x81\x87\x8b\xdd,\b\x003+\x8an\xd1-x\xecwi\x96\xac\x06\xaer\xa7q٥\xf9\x1a\xcd\x13[X7\x17\xd2\x1b\xe4\x14W\xe6\b6\r\xda\xff\x05\xa9\xcd\x05\xac:\x17\x14\x86\xab\xea\x87*e\x9d\xe0\x93\x14q\x8c\xa2\x8f\x9a僑%\x1eh\xdaF\xbe\xd5\x11K\x04#\"-\x83\x160܇~@\x90E\xf5\x92\x00\x93o\x84\xd9]\x8b\xaa`E\xfd\xc1\xc1P\xac\x91@\x86X\xe9\x90w\x8e\xa1:\xad\xbb\xd2<\x9c^F\xa3\x8f\xf1\xf8B\x18\xa7\xe5\xa7f\xa5~\xcf\x1d3\xb3-3-\x90\x98\x04x)\xbd\r\x98\x1e͔\"w\x04\x86\xcf\xc7\xc1l:'\x9dJ$o\x8a[&\xcd\xe9H\xc7\x15:\xa6.\xdfQv<\xb2\xe5\xe0\x1a\xa3\xb8\xd0\xcfA˼\x9b\xa9\xf8\xd7\xf3*v\xbf\x9d#\xbdl\x1am\xf9\xfe\xb9\xf6\xa9v\xc7:)/\xeaY\xb99PDP\xe0\xf6q\x9fx!\xc3'X\xee\x10\xeah=%\xacU\x96d\x05#\xcf-\xff*\x1ewT\xb4\x03\xff*\xbc\xe9\xa8p\t\xac٧\x9d\xb3,_\xe5\x9d\xfb\x04\xab\xe8\x00\a\x03d\xc7,8\xa6P\xf2Q\x90}5\xd4U~\xe9>\xab^\x946J\xd9\xd9\xe1M\xa0\x83\xec\x9aG\x19[\x90\x81\x06$9\x80\xc0\xec̲\xa0\x1b\xf2\xecl\x9d\xd5k\x1a\xf5\x9a\xf9z\xd8?U\x8f\x03\xd5\t\x9c\xa3\xddQ\x8f\x04\xa3\xb9\r\xb3\xbf~\xce\xe2|\x17J\xe0\x9e\x05\xf9\x99v\x93\tI\xa2\xbe\xcbE\\o+唃\x00Rq\xfd,\x84\xc6\xe6\xb2\u135bQ\vMB\x1e\u05ec\xc3.\xd5|da7\xd6\xf9\xf2\x80c\xb3|\xf3<\x8b|\xb2\xdea肜w\x95\x81\xe4\xbb\xd2\x05\fH>\v\xfd)/\xad\x81Ȝ\xaa\vk\xe2C\x98\xfdė.s\v\xe7K\xf94\xb5\x95X\x14\f\xb3\xfe\xbd̕\x8fig5\xa5\x1b\x8f\xfdV\xb5حބ\xb3\xcb/A\x05J\xa6\v\x9dLe\xf6\xdb\xd94\xf9\x10\x15\x16\x90O\x8a\xeb\xa9\xcc\x00\x96bP\x9d\xfa\xa9\x9f\x12\x00\xba\xd0\xd3i\xf9\xba_\xa9?\x81}\x056\xba\xce\x12\x0f\x8b덦O\x8e\xf8\x9d\x86\xcfWX)\tm\x0f\x9d\xc6Z\x80\xe9\x90D\xef\xe3\xa3\xfa\xfc\x13\xf4-&\x93N\xc1\xeb\x91\xdaA\x05ِ\x8d\x8b@Zf>\x9db\x9a\xf2j\"G\x196J\xea\a\x1f|M\r\bY\xcb7\x1e\xf0\x16\x98\xb8\xe9\x9c!]\xd3\x1d\xacs\u007f\xa1\u007f\xadI\x8e\xa0\xb5\x92\x01\x11\x96HZ\x11\xb9ԅUvQ\xd5?\x1a\xa2\x8cY\xd3.\xa3\rb\xeb3\x8eE\x11F\x91q\xa2\x89ʶPa\xbc\xd1Eu\x80M@\x91\xc5#\xec\xabG\x1d^P\xc2c\xec\x98G\xbd[\xb0p-\xbc\xd6\xf0\xec\xe6\x11b\xc1#Th\xa9Z!\xcb\r\x0e\x8f.\x10E\x1eᩴ\f\xb4\x830kA\x05\xcbը\x11\xbe\xe0!H\x8fL[]̬\x96\x97!\xaa\xec\xd2P4:\xb9B\x16\x17\xb8x\x8b\xca}\x82\x99\x1d3\x13\xaf\xcf\xd3h\xf5dds\x19\x91<\xc1\xfc\x8e\x99\xdf5\x9d\xa2\xf4VV+l\xe6Q\xc3[.xPX\xbd\xb6\xc8w\x98\x97\xcfڂd\xb6\x8d@h\xd4e@`M\xae3\x1aF\xb4\xf8\x88 \xa2a,\xeec\xc5\xf3R\xeb\xa9y\xe7\xb1to\xcb\a\xd8]\xadt\xfd\x8c7\xc0X\x94\x80\x1f2\xfb#\xde-(\xbfͤ\x0e\xe0\x82۹\x95\x95\xa9\x83\xcc\x16D\xf8\b<\xf4M\x03\xabu<\xf7\x85\x0e\fT4\xe4z\x84\x9e\xa6\x15\xf3~\xac(ŗ\x86\x06\xe2\x0eU\x8e\x11W\xc49\x13:z\x95\xca\xe6b\xa9\x16\xa8\x83\x96\xab)\xc0\r\xa8\xcbȴb<\xb0\x15a\x99QrawH\xe5B\xef\xab0\xc9Yf\xa7P}E\x1e,\x03x\x8dFOt-\xb0\xc3j\x12O0\xf1my\xab\x10\u007f\x17\x16\xd5\xfa\x8a7\x14\x9f\x02~\xe2\xde\x1c\xef\xb8!t\x978\xbe\xb7\xa1\x14\x83v\xc56\xb1\xbddM\xbf\xf1\xa9mQ\x9bj\x8a;^\xb2\xfbRC\x05l\xa9\xadu\xf3*0C\xe5\xb2!H\xec\xa10\xe3\xf9)\xa6\b\xfc\xca\na\xb9\xe8c&\xcb\x1c\x14\x91?P\xbf\xf4\xddLOȚ\xa2T-\xa2\xacn\xe9\xb4J\x01\xefIz?\x03\x8d\xbbv\xa4ߢ\xd2Ec\xd0\xfax\xf6ᜐ!?\xee\xeb\xbcP\xcf\xe8\xe8U\x8cRzF\xa7\xa8\v\x90.\x89X\xdd\x05㑨\x06\x8a\x9b\xcd\xda,n\xb0ca5WJ\xcb+\xec\xd9Z-.\xbc\x82\xabIE\xd9\xf2\xd2\xe8\x90\xd6u\xf6}\xdf`\xdd6\xd5\x06\xda\xef5\xc1\u007f'\x04\xb0,\xf82'\xb03!\xff.\x80)\xe5T\xf1w}Ki\xba\xfe\r\xc7_ݔ\xbd\x9b)UV\x91ϱ\x91\xe9\xab@\xe2\xe4+r\x8aO+(\xd1\xcd\fy\xae\xb8A\xe7\xf9KC\xf0\xcc\xe4G)W\xf2=\xe8-2q\xe9.6\xe4\xb2\xf2\xd21v\x16\xf8\xfeM\xa5\xe0\x8d\x02u\x06\\\xbf\x01E\xb2\xbe(/\xb3@9\v\xcbh\xf7\xda\xf4\r=K\xc6y\x95SW\xd1\xe7\xd1늞\xd5\xc54\xef\xedY\t\x95\u0087\xde\x16\xf7Y\xbe\xcb\xc2B\x8aj\xb7\xa5\x87\xb7\x88\xf3\r\x04A\xf9w\xa3\b\x9a\xce_E\x12D\x05D\x0f\x86r!)\x85\xf5\x8bOE\x13!\fjW\x9ahl!\x8a\x06\x95jn)U\x95%\xff\x83\xe9\xe7Y\xb8AC\xc7ܻ\xd1N\x0f\xdf'-\xa7\x1c\x92\x1dEnPN?\x17ᄢ}\xd4\x1bm\x9bh\xe4\xad6\tEi\xf9\x12E\"}\xedo\xbdf]\xf0\x8c\x93hXX\x19\xf8C\xee\xfa\xfc\xd55\xaf;\xdb*\xb21P\xb6\x16\xc9a1\xed\xd2\x19\xd6x&\x9f\xeb\x17\x81\x1c\xec\xe4J\xb1:\x9d\xf9\xe5I\x0f\a<\x16\xc6V\xea7g\xcd\xf3j\xc0\xaf\x93Ulp |_\x006\xe6\x83H\x91\rW[\xfb0a\xf6^\xa9\x8b\xa2;vW\x1e\xd9cWE\xc8\x0e\x01\xd7\bTk\x94#E\xb4Z\xde\xd5\a\x95fM\xc7|v\x05\\\xc2\xfe3kH\xe6\xf8\xe5W\xc5r\x0f%{\xa0(\xfe\x83\x88\xf1\xd6\xd0.r\xbf\x157\xad12\xef]n\xf1h\xe3|ftH\xac\xaa'\xb2c\a\xb9\xf6:2ˊ\x92a\xbf\x89r\x15\x82\x18\u007f\x13\x9c5\xfd\x06\xfc\x0f8ԣƓZ\xb3\xd3T\x1f\x82qf\x13\x9b\xf9\x05\xe26\xec=hu\x1aRfv\x19Zŭ\xf2\x0f\x11\xf4\xc2w=\n\xbd\xf1+T\xb6\xads\xb2\xa3\x02\xaaSpn.\x8a9u\xb1\xbe:%\xd1_\xe8\xab7\xdaQ\u007f\xf1\xeeU\xcd(d-k-6\xd4\xe2\xed\xacj\xa1\xba\x92͏\xb7\xb6\xa2P\x9adb\x87\xe9)o\xa8tb\xd7\x1b\x03\x95t\xf9\xf1\xb1\xc94\x99%\xa8&\xc9I\xe5\xf8\x1eR\xab\xce\xda\x05\x01{\\\xa8\xc0ڗĥqF\x90\xb8\x97!\xae\x1a\x98c\xcctr\x9b\v\x15\xd9<c\x91G\xab\x83^\xfa3o\x8d\x99\x1e\xc4ꪘ\xac\x16hN\xbb\x99\xe3oׄz&?σ\xb2\f\xb4~\x95\xe5U\xab\xebB\xae\xbd\xa4\x80\u06dd\x86\xaf\xa6\xaaӴ\x95f\x13f\x81\xbe\xc9GT9~M\xa0\x1f\xd9=:\xf7\x96e\xbd\xc8\x15\xd5z\x15\xaf\xb5\x80\xe96\x80ۊ8OAb\xd9 \xe3`\xf6݄\x1c\x948?\x89\xcad\xdb\xdc\rq\xf9\xbfM@\xfe\x03e\xe3m'.\u007f\x80\xfa\xf9\xa7CQ>\xfcY!V\xb2pM\x9b\xa4\x9f\xabhv\x99\fLQ\xc3\xe5\xdd\xcf\xed\xb8|\x85\xda\xd56\xdcB\xa8\xfa\xb6[,\x0f\xab\x9d\xf5\x8c\x1b<\x97\xc1\xa3|\xf7\x02V>\xc1\xbe\x9b\x91\xd8\n>\x152+\x91[\x8b\xb0\x85.oՀA}\xf9&\xf8\x81\x9d\xb5\xdcO\xb6\\\x9b\xfc\x03M\xd4ٛz\x04]6\x98Ű\xd43e\x9a[g\x14\x8aA\x17\xec\xdc9\xe4n\x03\xc8\b3\x00\xb2\xc9[\xa6)˵\x14\xd9\xf0s\xb5*\x8bg\xc4\xfd\xf0\xddވ,+\x95z\xb3\xd5\xf6LX\xa5\xb6\xedu6\x11\x99\xf5zy\a\x83\xf7\xad\xcd@}rr~3Jf\x9b\x8cAY\xa9;\x9a\x84\x18\xc0\x04\x9b\xf9\xb4\xb6¼\x95\xa8\xe4\xec\x1a\u007f٧\xd7\xf0\x03Up\x0e\rz\x84\x1b\xe6V\xa5\xdfT\xdf\xff@\xfd\x91}\xbe\xc9\xda\xc06m\xfeبX\xfe:\xf5\xe7\xf7\xd3m\xf8nf\x16\xc2o\xbbz\xa3t\x85\xfeV\xa5&+;-*\n\xa91\xf4\xf1ɠ>\xed\b\x9c\xae\u007f3eC\xb2\xcc[\xae\xdaoa\xf9\xc4\x1b\xd6+\xe5\xdfm\xa5^\x11\xe8ۮ\x83\xdf\xde\xfc_f(\xdb\xe0\x1f\xc2Uԍ[\xf4\x8c\x9d\xc8ȧ\xfc!\xefT\xbf\xa7\xaa\x15ND\xcfB\xfc\x9b_&\x94\xaeݼ\xa5\xef\xfa'>\xc6\xdezeW\x1a\x04\xf9a_1H\xf6\x1a\xe5\x0fO\x1c\x86\x93\xd3\x06\xecVx\xbdU\x163\x9aח\xe4\rz\u007f\xc9K\x94\xe8\x1c\x81\x15Û]*6U\xc5\xc5mZ\\h-.n\xd3\xe2B\xb4\xb8\x93\x0f\x84\x98靤u\xe6\xe6_1\x9d\x00\xe7\xfe\xb52\x14N\xa5\x0e\xf3̊\xe5w\xce}\x01\x12u/g\xb3I\xe7\xe0\xe0\xfa\xfa\xba~}XO\xa6\x17\a\xadF\xa3q\x80A\xbb\xd56P\xec\x9e\xfd\xeame\x9c\xb2\xbc\xaav\a\xf6\x812\xfc\xab?\xca\xf89\xb2q!3\xbf\x97\xdf\xf9\xdeu7\xf6=Xʟ\x1d\xf9CzY\x9f\x9d\xfb\xd0\\'\x13\xc0>\x12\x8f\xdb\b\xae0l\xa0\x9f\x85\xfd\x11\xab\x17\xd6\xf2k:\xf5f\xa3\x83\x0e\xb2\xec\x98^\xd5\x0e\x8c\x0e\xd0I\xbbX\xe9\xfc\xf6?\xad\xeeL\xb3\xe1<\x92fI\xd76\xb8@\x8a\xbe\xa8[\xb9\x84\xbaְ\x89O\b2\x92\xcc\xc2$\xa5u\xde_\xc6\xe4 FmG߁\x05/\xe1\xc6\xed\xf0\x91\xe2\xa2C\xb8H\xe63\\x\xfc\x8b+\n\xf6\xa9\xfb\xe8)\xf6\xdd\x1f\x81\x84\\\xe1\xd2́\xb6I\x1520\xa6(\xc5ش\x01\xea\xfe{T\xfe\x9c.͈\xf9\xb7&$\xafi\xee\x12(&\xaf}H\x9c\x14\x04\xc3ź\x8e\xf5з,\x8e\xf78\x99\u007f8\xfb\xb4[\xee\xab,\x8aFd\x87%ޤ\xe7\x16\xefIF\uab77\xa6OBt\x16\xd5\xc8k\xcf\xd6\rtX\xe4\\\x10\x03\xf4{\x1b/+0i\x05r@\xf8º\xfa\x81t\xb4\xde\xfd\x96\x94ᴰEs\x91E\xee\xee\xdb\xf0\x1b\xe8,9\x01\xe7\xce:ˡ\xe9\xc4{\a\r\xe6/\xb7\x8a-v-\xb1A\xbeHf\xe9\x8eƭO\xa1e\x15\xb2\x8f\x9b\xffZEˊ\x8b\xfck\xf4.\x8a?\xa1\x82NXJ\x18b\xafFʗm=*V\xd7\xdc\xeb\xcbx\x86~S\xe32\xafƍ@\xb6Y}̫I\xd2z\xc6h\xa0\xd0|;-\xc6Oc\x02\xfd\x95ΰ\u007f\xa4\xbd\U0005365e\xf57.\b\xffV\x1a2\x81ʮ\xdb\xd4۫$\xc1\x18\x8b\xf9\xddI\xe6|ҋ,\xe5/\x04\x85\x17\x17\xd3肸&\x87qj\x9a\x17\xe9\x8aT\xe8\xfae\x92|\xe0\x9dY\xbc\x85+\fyrP\x1c`F^\x0f\xef\xb4\x1b\xfe\x90}+\xd5\tmd]\x81.\xbezg\x8696\xde\xc5(\n.\x9c\xbbR\x87\xf7\xe8\x9at\u007f\xae\xd9h\x94_\x8e\xe3\xfb\x9bьcH\xc9Ke^Y\x84>\x8f}5\xa9t\xee%\x13$b\xfc\xf5H\xc5\x11\xa4O\aDb'\x19\xf2\x1b\xd1\x1dǭR\xea\xbax\n\xf8\x15\x05\xfd\x12\x91\n{\xad!\xd0>F\x96(ʁS\xc1VIS`\a\x10QFH\xf0\xa08\r6\\,y\xa1\x8e\xa9\xb3zoU\x0e\xd6\x06N8\x8a\xb4\xd2\xd2>\xc1\x95>^\xfc\x104|\xf8\xf3\xa3\xf0?!O\xa3@+.\"\x8e7\xfc\xc2\x11\xc0L`v\xe1\xbd\x16\x04^\r`\xf39\xe02\xc25\x05\x1b\xc3䅑\fk\xb3h\x04\x82\x88o:\b\xcc_\xe0\x9f\x1fa\x96M$q\xa1\xa2\xeaV\x14\x06\xf9\xba\x89Q{G\xb6\xa0\xee\x83\xe5\xf9\x82\xca\xda-\xf8q\xe9\x8bX\xf6*\x0e\x87x\xf9X_\xc6\xea\xca\x1al\xcc\x05Dv\u007f\xc3c\x05\x06\xa1m')\x8d\x02\x8a\xe6\x16O1\x83\xc6ZS\xbb\a\x8c\xca-\xf7\x95yjt\x14rJ\tK%1\xa2\xf8\xe5\x14l\x0f\xe4\xefQ4\x9bE>\xdf]\x04\x8c\x8b\x1f\x81\xf8\x9by\xf8\x89\x92\x81qӏ\xae\xdb\xd7\xdfpVE\x14Qo7]E\xcf\xc24\n\x84/\xbf\x80\x98\xa5\x1b\x01؟hO:\xa9\xe7\xa6=\x8cp\x8c\xc1Y+\xde\x01\x06\xc5\xec\xd80ԣ\xf9\x99\x9f]\xbe1\x95g\xb4\a\xf0:E\xa5\xd4\x03n\xef\xb4Ċ^\u0093Q\xec\xf6\xf6Dkd\xa5P\xd7\x12\xf6\xf6Xx\x8bS\xfa+\xac\x05\xcbb\x92\xe1\xc8\xec24\xc3K\xf8\xe2$e \xf7ErǪ\x92]\x92\xa0\xe02H+\x85Oݸ\x12\xac\xa7\x14z,\xeb\xae9&\r\xc5K\xc8~\n\x9fM1\x93\x9cVQ\r\x88x>4\\\xf5k\xb5:\xa3[\x87*A0$\xe8MC\x04\x0f\xe3\x04\xf1\x01SJA\xf3*\xdeㆸ\x89\xd18\xaf\x17c\auP\x11=g\xa033L\xaf\xdf\x00;\xa3\x15\xa4BD\x17u\xa1\xa8\xbaA\x9f\xb5\x8a\xfa\x943\xbcZ5<\x01\x9c\xd1!C\xe3{\x1b\xdfd\x901\t\xf8\xd1J(\xfelA\x11\xb93\xfc\x89\xf9D\xae`-\xc4\x1b\xe9\xf4\x84\x91\x06-<Y\x0eG|\x95+\x87+j`\xa9\x9e\xf2\xd3\xda\xe7\xa6M\"c~H\x81\xf12{\x93\f\x00UD\xa2y,ެV\xfc\xbc\xc0\x83\x02\x046\xbd\xaa\x81e@'\xfbnI\\\xa0?Vv\x0e\xe4\xcb\xd1\xe6o\xee:\x82D\x98|$\xad\x98_b\xbe\x9f\x14\xa4\xe1M\xdd\f\x17\x1d\v]\xa6'\x9aF\xac\xec\x87&f\x1b=\xcex\x81Q-\xbd_\xf1\xb9\xb8ݢ\x8d^[\xaa\x1f\xe5-\x16\x9d\x0f\xc5\x1f\x91\x9b\xa9j\x88\x1e\xedV\x8cV\x83\xd9\xe7\xda\x1f̯&\xb6\xfcH\x8bp)K\x99$\xed\xeb\xcdur\x1d |\xa0\xb5z\xbdq\xc69Z\xeb5\x92\v\xba\xa0\xe1ո\x8cQp|E5\x9b\x146\x88 ftR\xb2\x9a\x05Ȓ\x05M?\x16\xb4/R\x01\xea*\x17\x13\x15\xed-\xd3\x1f%\xe1\xa0`/\xa7\xea\x06\xdf.\x98\x8a\\\xa1\xb5^\x13qiO\x87\x91\xa9JS7\x05\x8f\xe7.o\xe2\xf4\x9f\x94\x9f\x88}b\x9d\x13\t\xea\xbfD\xd3\xe4K\xbc\x13\x99SG풨\xf4K\xb3uC\x17A\xb4|\x1b\n_\x80$*\xb9Q\xda\xf0f\x9b\xbdv\xeaǦO!\\\xc7\x1c\xc5\x15\x94\x19T\xc6G\x8b\n\xddH'd\x96\x1e\x86I\xa9\x17\x18[\x16\xcd6W}\x83\x8d\b\x97\xaeW\n\x9f\x9cL\xf1\xb0mP&\xc1\xd1y%E\xb9\xe5\xc2\xf7\x83\x1b\x14넸\x1f\xfb\r\x1fy$\x904\xe2\x89m\xbc\x18hv'\xf9\xaeN\xb1\xba_̧\xa1xIcc\xfe6\x1b/\xd7\xf9\xb5R`&p\x14\vW[\x9e\xd93z,\x8f\x18ԣ{:(\xd7[\xe7\xdb\x02\xda\xc0]\xf3\r\a\xba\xfcu\x8d\x19\xb0\xe4K\u007f\u007fN\xf9\xb6P\x18-¿\xe2$\xbb픂\xd1\xf4\xe7S|\x8fH9\xd3X\xa5\xbaz\xa1\x97J4\xb1Ji/\x1c\xea\xf0\x1a\x05\xb5\x1b\x8cks2\v\xa7\xf8w\x94\xb1\xbbv\u05eb\xd2P\xa4\xf7\x1d\x12צ\xdc(\x1e\xee4\xba]\xb3ayy\xe1Ȏ\xe4h\x000\x9fX\x16\"\xb3\x06\xefQ\xbe\x8a.\xc5\xec\xd0#z\xb1\xa9\xc9\x0f6\xed:\xf2\x1d%CД\xe9v\x150\xbeJ%VO\x97n\x95\f\x1b~܉5\xa7\xa0\x02!Y\x9c\xe4HYYs\x05\x81ϝgPm\xb76ε[Ǽ%\xd9\x10\x1f\x17\xe1\x9d\xfdϦɄ\x9do\xb0\xe3\xf2+wJ\xbb\x15y\xcaN\xd4T\xcf\\\xe9\xb3\x06\xe4\xb7MH6F\x8b\xfb\xcd\xff\xfc\xf5\xdfo\xf2_:\x01Q\xe3rv5\xfa\r\xdbh\xe0\x1b[\x8d\xc6\xff4\x1aE\u007f[\x87G\xc7\xed\xffi\xb6\x0e\x8fۭ\xc3V\xf3\xb0\t囍\x93\xe3\xffq\x1a\xbf\a\x02\xe6\xf8R\x0ft\xe5W\x0f\xd2\x1a\xdc\u007f\xc8\u007f\x8f\xd2\xfe4\x9e\xcc\x1e\xdf;x\xf0\xe0\x9e\xf3\xc0y\x9eL\x16tp\xedT\xfa\x9eӂ\xa9\xa8\xc1?GΗ\xd1(\xbeq\xbe\x1a\x83P\x86ž\x81\xf5=N\xa3\x81\x83\xdcf\nK6r\xbe}\xf9\xce\x19q2\x948\xb8\x97\xb1\x87i\x92\xcc|y\x1a\xeb9\xcb{\xf7\x1c\xe7\x00\x9b\xba\xbaJ\xc6\xff\xfb\x16\v;N<t\xe4V\x12\xddL\x92\xe9,u\x82\xc0\xd9\xe7Mr\xdfs\x9c\xabd0\x1fEu\x95)\x01V<\x01\xef\xe9\xb7/D!\x86(L)\n,\xb3E\x82*\xbb\xb6\xef\xec\xed\x89\xf4zx5\xf0\xc4\xef\x8a쫀\xfc\fd2\x90\xbc\x9c\x8bQ\xd2\vG\x1at\x1cY\xfd\xed\x84\xe2f\x1a=Z\xdf#\xb6\v\xa3V\xec\r\xc6\xed8\xee\x1cj\xa5\xb3i\f\x1b\u007f\x17\xa1Sl\xf3)\xb4z\x13\xe1\x98\xce\xf6\xaf\xa3އx\xb6\xef;\xfb\xdf&\xbf\xe0\x9f\xab\x14\xff}\xbd\u007f\x8e=\xf9;(S\x89V\x83\xba\xe28\xbe\x13\x8e\xf1)\v\x14>\x00\xcarM\xf8\x90I\xce\x14p\x92:\x1f\xa2\x05LXo\x81\xb3\x15O\xc9t\x96Շ~=Oӧ\x19\x14\x00\xf0\x8f\xcb\bω\x9cY\x82\xd9\xce\xf3\xb7o\xf5f\x12\xb4\\\xcb\xf7\xa6\x10\x10\xe1\xea\x01\xc2{\xe0|?\x8bG\xf1l\xa1\x86\x8f0X\x96\x97\xe1\xf9Һ\xf3r\xe8\x8c\x13g\x16^p_\xe2Թ\x88?Fc\x9fA\x84\u038b\x97\u007f\xc7D\xae7\xa8;\xafI\xba\nG\xa3\x05 \x80\x02\xb5\xa0,\xdc\x0f\xc7N/r&@\x99P\x88\xea\xe2\xa0T\xcb\xd2Ӯ\x02\r\xf9T\x91\xa7\x82q\x1f\x8d\x00_%AS\xb1g\xab\x95\xb3?\x88?\xee{T\x0315\xbeG?QP\x19;\xf1X@\x8cFg\xe3s\x00\x85_\xf0\x8b\x8a\x88\xfd,\x1a\xc1\xd7\xda@\xcfS:\xa7\x85\xbe\xa3_\x1f\xecs\x80ׁ(\x9e\xd2b\xe2\xc75\vF\x13\x8f\xd3\ng\xe2\fQ\xfd\xa6\xcf\u007f[\xbeS\xaf\xd7\x1f\x1c\xc8ᡏ\x93\x90\xa5\xa0\b\x85Ǜ3\xe6\x85H\xecď\xc6\xf0O\xb5*\a'Z\xd5}\x0eU%\xbc\xcfwO\x1f\x16\x17\xce\r\xed%]\xecA\xd3,h\x06t\xe2\x9b^F\xd1\f\t\xe02\x19\rht\xff\x0fHq8\xc59\x87.\xfe\xfd\xdbo\x98@\xb3\xd1ҩ\b\xd5\n\x9c\x8a\xb5\x82\xb4iS3\xbbO\xed\xc02Y\xe2Rw:\xce\xfe,\xba\x99\x1d\xf4\xd3t\u007f\xcdcC\xb4\xa9Y.|kp\xff2\n\a\xfbx\x1b\xdb\a\xe8\x9e9\x81u\xee\r\x10\x03\xfe\xa6clL\xc0\xc1W<\xcf\x18\xffs\xeaT\n3\njT\xd8\xc7E\xa0F\x1b\x1a+Ҙ\xf4\x18X\x1a\xae\x02F\x82\xf36\x1e\xf7#\xe0f\xe9\f\xfe\xe9\x81\x14\xe7\xfc\x83\xf8B\xea\\\x86\x1f#\xd41\xe3\xf1\x05,\x8et\x0eM]ǳ\xcb\fvm\x10\x8d\u0085XDב\\vi\x04\x13\x86?\x98\x19 q\xe0\xf3\xaa\x14(\xf9 \x8d.\xae\x8a鍢\xe8\nȕp4\xb9\f}\xbc\b\x1e\x8f|'\xf6\xa9r\xaa\xcf\v-ddcb\xec\xfb\xaa\xf4\xbf\xff\xcd\xd5\x1f\x800\xe0e\x95\xcf\xf9z\xf7~M[a\xf4v\x1e@i\xd4\x1bM\xa7\xea\xc4\aT\x12F\x03UU\xa1_\xa0\x804\xb5T\x9aNͩ4kԀ\xe7\x1cp\x9bP\xa1\x025j\x04\x0e\x9a\xe4\\\x05\x80y(@\xb1Y_=\x9d\xf7R~\xad\xac\x91g\x8cu\xb2\x13\xbf\x1eV\xf6Uھ\x87o\x9c}\x93\\G\xd3\xe7!F\xab\xd6\xdb \x9e@-\xc1V\x03Ä\x01\x89\xef*}\"\x83\xd9煅{\xd5\xfd\x8cÞ\xf1K\xcc\x02\xbb\x0e\xaf\b\xf1\xf6\xe8w0\x89\x15\x91\xee8\xfb\xffO\x80E\x98\x92\xdaR\a\x13iB u\t\x1fY\xf9\xc6ߖb\x82:X\xe8\x17,\xb1\xd6K\xf0\x14@\xaaY\x90P\x98+\\\xa1\xd2U\x9c.Ϭ\xd3,*G\x93\xe39\u007f\xc3\xe9ܵ\x89}(\xbb\xad\xcf\xf0\xe5\v$\xc1\xca\xff\x8eX\x8a0\x11\xdc\x13\x85l\xe4\xc2\xe44)km\xb06\xcc\xcb1\xb6wdy\x01\"\x8f\x939\xfc\xb56b\x9b\x81SXH\aT;\x94V`\xdb\x15\x1b֢`\x891\xa8J4\xca\xefN\xb8\x99K\x8e\x93\x11\xd5D\xfd\x8c\xb9\xdbXM\xec=\xf5\xfee8}:\xab4\xe8\xd9=`\xe4\x82$\x89> \x9b\xa3\x874=\xb5\x87\xa1\xa9\x136\x029\x90lo\x80mA\x91\xded\xa2Ș^9\xad\",\x91\x87\xae\x14g\x93ɹ\x03\xba\xa7\x93)\x9fj\x9b\x98\b\x04ge\xa1nii\x86kb\xfem\x04|\x8f\xdct'\xa3\x88\xf7\x14]\x00\bgN\x02ܲh\xebO\xd3\x1cZծ\x98m\xdeb \x12\xd1gڄ\x8c\xbd\xd5\xca\xd8\u05f7l\xech\xa3N\x11\xb0p6\x15\xe1\x85\v\xfav\x15M/\xa2\n\x88\xb7E\xdb5\xa0\xdfy\xe4\x14l\xd8\xfa\xa4\x88@:\xd09}\x93\x16\x99\xe60\xa1\x98\xa7\xd6\t\xb2\x19h\x97\xe4\x15s\x12d*\x96\x97B\xccZ\x1f1\x14\xc8\r\xf9;\x8d\xea\xc3^\x9a\x8c\xe63\x94_.\xa2\x1a\xbf\xa5\xe7\x90q(b\xd9NJ\u007f\x05\b\x99$8Y:\xf5'(\xca:7\x1d|\xf8\x97@}\x13\rA\x91Xd\t\xef\x80\uee43lꨐ\\\xa0\xb2ߐ\x88\xe2ɡ'\xf5\x9bj`\x01K\xea\v-\r\xe0\x193\x9cl\x1c-nK\x0e\x1d!9\xc3ir\xa5\x8d\x92\xb7\x0f\x94nB\xb2\xed\xe5GK\xefy\xe3\x894\x1fA9\xf1\xe0F\x0e]\x9aAXka\xf8\xa8\xb40\xd0}\xe7\x89H\xeb\xf0\xdf3\xa8\n̔~\vB9W\xdd>p\x9e\xcd\xe3Ѭ\x96Qd*\xb5\x0e\xf9\x8dH\xa6vi\x97\xed8M\x90#\xb5\xff\x00\xc4;\x18ט\xa2\n\xe1\\\xf2f\f\xe2\xdc`\x1a^sE>\x9dtN\x8a*r&VT\xe2\x06O\x18\xb9;;m\xa3\x8e\xaa\x84\x98\x05\x05\xaa\xff\x01\xdaJ\x19+\xe1 \x9ec\xf7\x1a~\xbe<gJJcu\x8c\xfdb\xb9n2\xc38\xe1N\xc3\xee\xe0w\x98\x81\xd3\xc1\xd3O\x85\xfbɔޖq\x9a\xbe]\x18\x96\n\xf6ǩ4\xeau\xc1B\xf90\xd1\xd9\xff\ft\xfe}_+\xfd\xd9\xf4\xa2\x87\x04\xf0\xd9tzq\xd1\xebQ\xe1A<\xe5\xe7\xdf\r\xe0P\xb8\ts9J\xfa\x1f\xae\xe34\xf2\x9d\x1a~bd\x8ch\xaaR\xa9~:\x89\xa2\x81ձ\xack\xa9\x03\x1c\x11\xe4\xbc~2\x1ePq\xdah\x11c6ʞ\xa23\x11(\xb3\xd7X\x034vPt\xb8\x01\xb9\xc1:̓#\xa3\x83\xaf\x85(+0L4\xc0\\k\x02\x98j\x99\x88\xa5\x1a_\xb2\f\x92u\t\x96(\xac\x8cy\x8a\vC{\xa8\x98\xd1\xf8\v\x1b\x03\x9dV\xf4\xd07\xc0|\x0f\x8ag\xe8\xe0\x03\f\xce/5\x12\xbdP\x83\x15\xb4\xcb3 oN\xc3,\xa4\xac\x8a\xc3D@U\xd4W)\x13\x89\x15\xfe\xc4\x17\xa4\x86b\xff\x05\x17b,%\x13\xa8\xd9n\xfcm\xdf\xc2R\x9f\xbcj\x1d|{*F_\xe5\x85 \xf6\xe1,_>+~\x99L\xe3_\x12@\xa9\xac0\x91\xfe\x95ξd\x8f\xfbTAtB\x15\xd0x\r\x115 \r\x96\xfc\x1cM\n\x16\xf3\x10\x16\x87J\"Y\x06\xbf\x954\xa1\xc5,6\x16\x14,\x97k_\x96U\xf12}\xb5\xf0=\x8dK|Ŗ\r\xc5\x13f\x97\xb8\xab\xc2Ч\xf1 \"\x94\xf5\x04\x1fI;P\xc3\x06J6\a\x04ō\xcbl\xe5\xf3\xed\xb3\xd1G\xf1Qҁ\a\x03f\xa3b\xd2\xe4\xdc0\a\xe5\x17`\xd5v\x81\xb6\x02\x1c$*r\xf4\xa2!\x1a\x06\xc2\x11\xa86\x83\x85\x04Hp\x80\xba\x80\x99\xce({>KPڣ\xb9s\xd8Ax\xc0\x9c\x1a\x15)|X\x9bD9\xd1T\xcf\xc1\x82P_\xc1\x03\xca\x00\xc1\x89\xae,\x90ա.r\x0e\xc4b\x8caN3g\a\x82\x92\xed\xce\xc2S\x1cA\xdc\xd36l\xf4\xca\x03la\xaeڐ}\xda\xe9\xc8_\x0f\xe7PK\xa7\xedL8͢\xc2\v\xf2\x8cRza\xcd-5\xdaO\xb2\x10\x02kPv\x96\x19\xd9%u\xf9ۗ\\\x17\xea\xca\x15\x97\xd4\xf9\xd7\xda\xd3ڽ\x8a\a\xd0\\Rg\xbeZMľ\x02?\b\x80\x1c\x90\x94\xaf\x96\xaa*\xaf\x8e\x84\"\u007f\xfb*\x95\x96X\x82A\xafE\xd2Z\xe1\x84\xecs\x16\xea\x1c1%B\xd7y\x16\x810\x13QC\"\x9d\x84k\xb2O\x80t\x86\x91\xd2%\xd8{\x9a(\xa7{\xf1\xefO\x13\xb2\x0f\xec\x03E^L\x81\x85\xf7©R8\xb3w[\xa8\r5\x0fF\x1f\xef\xdbz\xa0\xde]X@\xaf\x12\xd3>&E\u007fR \r~G$\ft\xabj\x93̇\xea\uef4c\x9fdJp%ᾁ\x86\v\xda\x15\xaa\xb4\xf0+\xa9\xab\xad\x04\xd5ݖQ\x934(#\x05\x984M'\xfc5\xd3\xd1f9I\x0f\x92:\xed,F^\x02\x9dD\xa9\x1f\xd4\xea\xa4.\xf6\x05l\xab2|\x90\xd4Y\xbf>\xc0\xad\xc53\xdb\x16\xb5\x86\a\xa2\xdb\xf7Tv\xb7\x92\x19\x15\x00K\x15\x1d\x81\x0eJ\xb6]\xedS\x89\xaf?#b\xba\xf0\xe7\x91# \u0087.\x05\vŎ\xb4F\xdb\"\x10\x83֣\xe1\xefg\xc4\x1f\xf5\x10\xb5\xcf!|\xd0 Q\b\x94\x03\xbcg@\x15\xa4@YD\x1b?c\x9d\f\xf7\x00\x9e\xa6I\xe0\x1c\x00\xe9\xd8X߳ ̈́\xad4[\xd2{{:i Z\xc8P\x82\xe7%\a01^\x06n\xedU2*\xe7\xbf\xea\xbe\xe8hȒ\xba\x9f\xe3\xb1oa\xc9I\xdd\x14\xd9\x1f3\\ɞMvF\x8b4gl\xd3\xccm³_[\x14\x99\xb8\xce\x1bq\x14N\xe5XԹ(|\x98\xaa\a\xacM6\x1d\xe2\xd3sh8\xd5>\xc55\x0e\xf9\xb8zVQ\xb4\xedh\xeaJ16\x14c-\xc0\xc6K\xc1\xc9Eh\"\xde\xe4P\x8aM\x85\xd88\x88?ƃ9\x14\xe0W\x9c\x9c\u007f\x806\x87\xb6e\xdc\b\xaf\xa7\xf1l\x16\x8d%,\x10\xa8\xd1p9\x84}\x01\x9f\xec\u0083\a\xd8##\x90\xa9L\xb4\n\xa1:\xbb\xce0B*1\x90k\xae\xfd\xbb\xae|d\\\x17\x92|\xd5J#o(\xa1b\xa4\x97\xe1 \xb9\xd6gL\xe0\xcc\xd8V<\xdfXZEҋ\xaf\xe5\x8b\r\xa5bo\x11d\b\x9a\xdc\x18e9\x8e \xee\x04T\xa4\xa0D\xf6\xfa\x99Pn\x8c\xcc\xe4\xe6-\r\xa1#\x86\xa2g\xaa\x1b\x17\xaf\xa7\xf1\x05n\xcc\xfb\xb8\x05\xed\x17\x16\x81L\xd6\b*hH\x82\xf5vxܐ\xfc\xeaA\f#\xe0\\\x1a\xc2 \xba\xf0\xb8\xe6HVP\xdb\"\xf6ީ\xee\xfb\r\xcf\x1c\x04]\xab\xf9N\xe8+\x80\x19\xa1Q\x10\uf811?~ܔ\xf8\xd1\x16\xb8\xbd\x89\x11\x0fdK\x80b}\xb1\xc9\xfa`\xcam\xb1\xe0\x96\xf3G\xab\xbeY\xfdwEt\xed\xa0U4s\x1a\xea\x92\xfa\xe5u\xd8\xef\xc3Z|\xe2\xec+\xc4\x1c\x0e*\x18\xfa\x130\x81\xd6w\xa3\xae\xd2)\x14\x97\xd5sՆ\xd9\xc9\x1fA\x01w4\xcc\xcf\x19\x00GlA\xbe2T\xc6&g\xf6%\xcah4d\tm\xcam\x0eSR\x87\x9f\xbf\x87u<D_\xb2Ș\b\xd3TR\x97\v\a\x0f\x11\x00\xe3>!\x9cV\x96T\xfb\xf6\x1bN\xc39\x9a\xdcPC\x9c\x88\xf3@\xc8m\x11\x9d\xac5^\x8ep\x90\x0f(x\x04KY\x04\x92\xba\xb4\tx\x02r\x13 \x83:\x192\x8e}\xd0=\xf7=\xafd;\x10\fzW\xf6\x17\x0e~\x9e\xa73f\x80I\xa6\xe4\x85\x0e\xeai#\xd6\xf4$G+\xe2\x87;3BE\x06\x06+\x8c}\xb4\x90e$\x8d\bG\x82\xc7G\nq\x13xE\xd1Մ\x15\xd7LEOE~\x1eL\xf6;@X\x999\x17'\xd2<:\x8bg\xd0Պ\x97)#\x9b\xce)C\x1a\x17\x9e\x01\x8a\x11d\x06\xdb+q\x98\x88w+\xb3\xbeKn\xaaN\xa5\x1e!5 \x00$\xc1\x9b\xab\x11\x06\t\x81\x12\x9d\xb4\u007f\x19]\x85i\xed*\xeeO\x934\x19ҋ\xad\x1d\x80겾\x1a\xb8\xa8[\xd40\xe1\xf1\xbehE\xb7Rg\xe2\xa6Z\x97)\xe8g3값;a\xe9\xc0\x18\xb0\x14\x1d6\r\xc5Q\x9b<~\xed\xdc\xcb\xce\x14\xa0(\x1d(\xec\xd7e\xc3H\xd3=~\x1bqڙOG\x95τ\xaa\xf7\x19\xc0\x00\xea\xe3\x8e\xe4\xf4<\xb1a\x05\x1b\xf7\xbb)ɢ\x96F\xa1mc\x90\xddz0\xcdme\x17\xd3I\xa5d\xf3\xd2\xf8\t\xce\xcd>\x054\xd8\xf7-\x19\xb1\x9f\x00cN\xd1e\x14ڐ\\!\xf5\xf3e\x12\xb1\x85Ԧ\xaaXm\xaa\vv\x9e^i)\xf7@P\xad\xe5\x0e\x97j\xc2_\x8e\xafs\xc4\xe4)4\x01#\xadIƫ\x94,\xefA\xcb\xde\x1b|G\xb2yB\x82\xa1\xddil\x9d\x999\x83\xf6\x85*\xc6_\x86r\x17\xe7P\vL\xa8\x02+qpC\xac\b\u07fd\xd4P\x87\\\xeaB\x1f0&\xe8}\x99\n#Zǁ}\x14d\x12)\xb6\x80\x90$v\xd0}ٛ\u007f\xff{pcb/\x03G3G{?\xf2p\x9c\xbdp\xda\xe7\xe9R[\xe8ڳ'U\x93A\xa6~.ǒ8\xf2\x05\xa4\xbe\xca;z>\x9f0Z˶\xed|\tFXG\xfc\xb5\xb2\xed\xb1\n\xfaD~\x8f\x03\x14\xc6\xc3bΟ\xdf7K\xa0\xf1ut\x84\xa7\xaa4\xd6\x1er\tb\x0f\xc2[\x80K!\u007fóG\xb6 \xf7F\xb0\x1d\xa2=n>I\xb2\xe3\xea\xfe%\xde~5Z\xd2\xd5\x1a\xaf\x9c\xb2\x8d\xed\xf2\x9e\xa1ˡ\x90\xcb\xc7\x1a\x81)\xcd\x18J\x12\x91a\xad%\x14\xf5x\xd0y\xf1\xc3˫\xf0\"z'\xf9\\\xfd[\xc53\x9f\x8d\xe6\xd3\xca$\xbe\x89F<yA˿\n?D\xdcz\xd0\xf4\xf9\x87\x18TP?T\\k[\x8f\xb8\x1f\x9e\xc9\xd5\xe5\x9e}ap\xe2<\x03\xcc\xf6\xa2\x82}.\xc7\n\xfb|Z\x91\xd93\xe4I\x05\xb1H\x1e\x01\x8aArI\xadVJS@\\\xf71\x0f\x04V\xd80\xfbE\xfb\xa5\xa6\x9b!\xff06\xcfjr\xde\xe5d\x04\xd2\xd7\xfaP\x92l\bE}\x0f\xf2\xf2\xfb\xae\x94F\xd6Ҵ\xc8\xeeMI/\xb2\xc5T\xc1\xa3q=\xab}\xc6\xd9\xcf\xef4,\x8d\x91\x05F\x1c\xcb\x118_ȞH\x13\xfb\x1ev\x95\x92a'\xfb\xd9\xcb6z馕\x93,\x03\xc7\x02\x16fg\xf9؞\x98t1\xbd\xf7\xee\xe1\xed\x89{\x8f\x0e\xa4\xaf\xdc_n\xa3\u007f\xfd\xf7\xd7\u007f\u007f\xfd\xf7_\xf0\xdf\xff?\x00\x00\xff\xffw\xc4\xfd\xa2\x00L\x05\x00")
+var appAssets = vfsutil.OpenBaked("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\xbdyw\xdbF\xb28:\x7f\xebS\x949w\x1c@\x04!\x80\x94d\x9b\x12\xed\x93x\xb9\x93sm'\xcf\xce\xcbKF\xd1\xd3\x01\x81&\t\t\x04\x10\x00\x94H;\xfa\xee\xbfS\xbd7\x16\x92r\x96\xbb\xfcn&\x13\x11\xddյuuu\xf5>\rJ\xe2.\xaae\xf2\xb7?\xef\x1f\xcf\xf7\xf0\x9f\xbfy^\xebߓ\xe3\xe3\xe3\xbf\xf9\xc3\xd1\xe9\xc9p4\xf4G\xfe\xdf<\xdf\xf7F\xc7\x7f\x03O \xf83\xffY\x95UP\xfc\xcd\xfbݴjB\x89\xe4\xff\xea\xff|\xfe|tx\x00\x10\xa7a\xb2\x8a\xc8\x18\x96\xab\xb5{]\x1e\x1c\x1e\xdd\xdf\x1f\x9c?z\xf5\xdd\xcb\x1f~\xfe\xfe5\xa0\x81<?8\x17\x7fH\x10=?8\xaf\xe2*!\xcf?\x7f\x86\x8a,\xf3$\xa8\b\xf4hR\x0f\xdc\xfb\xfb\xf3#\xfa\xfb\xf9\xc1yYm\x12\x02\xd5&'\x93^E\xd6\xd5QX\x96\xbd\xe7\aG\x87\xb0\xa8\xaa||t\xb4$\x1bRܑ\xa9\x1bf\xcb#R\xc4\xe1Q\x95eI\x89pG\x05)Iut\x00\x00\xb7C׃\xdf`\x88\xc6\xe1\x0fO1\xe9m\x1c\x92\xb4$cH\xb3\x94\x80\x95\xaf\xa6I\x1cB\x94-\x838\xb5\x0f\x0e\x8f\x0e\x0e\x90a\a\xa6Y\xb4q \x8ao\x1d(\xf3 u \xc8\xf3\x84T\x0ed\xd3k\x12V\x0eĳ\"X\x12\xe7`\xe1;\xb0\x18:\xb0\x189\xb08v`q\xe2\xc0\xe2ԁ܁i\x92\x857\xbf\xae\xb2\x8a8\x90\x17\xc49\b\x1c\b\xa6\xd3\u0081 ,\xb2t\xb3t \x88\xa2\x82\x94\xa5\x03\xd3x\xee@\x18#h\x98E\xc49\x88H\xe2@4K\x1d K\a\xe2\xe5܁8-\x1d\xb8\x99F\x0e\xfc\xea@\xe9@\x19,s\xe7\xa0\\\x06I\xe2@Y\x15\xf1\r\xa1\x7f\xb3t\xee@\xb9\x9a\xe2\x7fr\a\xaaʁ۠p\x0e\xa6\x0e\xac\x1c\x88\x1d\bIZ\x91\xc29\x88\x90D\xe5@\x149\x90%\x0e\xac\x12\a\x92\xd89\x98\xc5$\x89J\x94v\x96\x15K\a\x92`\x8a\xdc$dN\xd2\xc89\xa8\x82i\x82|\x06y\x15g\xa9\x03\x15SV5˲ʁjA\x82ȁ\xaa\xc0\x9f\x0eT\x91s\x10\x14U\x1cb\x91\xa0\x8c#Z2\xbd\rJ\a\"R\x05qR\xa2\x88S\x12!\xdd\xf9\xaa \x0e\xcc\xe2\xb9D\x8eHI\xe1\x00b\xa5\x7f\xe7E\xb6ʝ\x83%IW\x0e\xa4\xc1\xad\x03٪\xcaW\x95\x03\xc5j\xbaq\xa0$!c\xab\\-\x97A\xb1q\x0e\xaaxI\x1cX\x06ō\x03\xc1*\x8a3\an\xe3\x88d\xf0\xf9\x000y\x1e\xa7c\xf0\xce\x0e\x00\xf2 \x8a\xe2tο\xa6Y\x11\x91\x82\x7f̲\xb4\x1a\x94\xf1'2\x06\xdf\xf3\xfe!\x92\xc6\x10\xa7\vR\xc4\x15&\xdc\x12\x144H\x06A\x12\xcf\xd31\xa0\xa3L├\x1dܣ\xed\xfe\xf3\x87woO \x8a\xcb<\t6\x83\"K\bP[\x85YV@\x96D\xa4\x80i\x91ݕ\xa4(\xe1\xf0\xa8\xa15\xa9-C=Le\a\x1dj\x02MM\\1Tj\xceĘ\xd9(\xf2\x87uH\xb3\x90\xe1\xc1\x82\xc4\xf3E5\x06\x1f\xb3\x98i\xf0̲\x1a\xd0\xd6\xc9Z\x10f\xebf\xfe+\x85\xa26_\xb6A\x8c\xa7d\x96a\x15kI\xc1\x8c\x1a\xe3\xaf2\xefW\x96DQ\x85YZ\x11T\xf3W_\x9d\xe9\x9f\x025\xb5E\n\xc9jk\x10fI\x12\xe4\xd8\xc2\xc5/U\x95\x832\x0fBQ\xbf\xb4J^\xa7\x11d3^\r\x87GJ\vX\xb7\x83Y\xb0\x8c\x93\xcd\x18~$E\x14\xa4A\xdd\fN\xf35&M\x83\xf0\x06\x8d2\x8d\xc6\xf0\xf7W/\xf1\x7f\x88}\xe1+Dw\\\x9d\xd3,\x89jXF\x1e\xc3\xc2\xecp0ͪ*[\xa2\x8da\xf2\xfd\xc1b\xa4\xb00\xeb\x1b\xf2\x1c\x97\x14EV\xc0\xe7:\ao\u07bc\xf4^~\x83eݻ\xa0H\xe3t\xde\x06\xf3\xe6\xeb7\x1e\x85\x89\xd3Y\xd6\x02\xf0\xf2\xcd\xcb7/)\x00\xba\xa3\x16\x00\xef\xcd\xd37o$\x80\xbb\\%U<N\xab\xc5 \\\xc4IdeQdo\xa5\xcb\f\x15>\xeb\xadn\xc8u\xc1kK\xea\"_C\x99%q\xa4\xab\xd7-WaHʲI\xe4՛7ޫ\xa7\b\x93\x17\x9cs\x86\xaf\b\xa2xU\x8e\xe1\x84Q\xa9\xb7\x01\xb4\xae$+\xc6\xf0\xf7\x97o^}\xf3\xfa\xa5\xa8(\xb4\xe3b\x19$\xe0\x0f\xf3\xf5\xd1p\x98\xaf\xe1]\x96\x06a\xe6\xbc\xcb\xd2\fM\x8a\xc0\xa3x\x99gE\x15\xa4\x95i\x11\x03\x81r8\xc3\xff\xd52\xe3e0'c\x18ܑ\xe9M\\\r\n\x92\x93\xa0\x8a\xd3\xf9\x00\xdb`P\f\xe6\xc81I+\xab\xcar\a\xfe~||\f^\xbe濐\x11G \xae}\x1d\x1f\xe7k\xbb\x83\xd82\xfb\xf4WQ*\xff\"B\xd9_C\xe7O \xa2:\x1c\xf0\xb9Y\x8a\x0e\xc9'KtS\x00\xd9-)fIv7\x86`Ueh״˯\xfb\x05\xdf\xef2k\x8c\xa0D\x97ĺ~\x83\x8e\xf04>(\xbc\x12I\x9c\xa2\xacz\xe38==Ջ{\xa2\xa1\xb9\xe1\xaa(HZ5\x1b\xe4\xd3o0\xbc\xd5q\b\xf7\xd2 4\x90L\xdf\xc5Q\xb5P}\xad\xe8\x90P\x8f;\x14\xe7\xc1\x80\xe9\x929\x00\x8c\xb8\xe0s\xbbW\x89\xb2\xaa\"\x91\xceO\xb8*Jl\xb0y\x163E\xdd\x1f\x9c\x1f\xd1\x0e\xef\xf9\xc1\xf9\x11\x8fb\xb1\x97x~\xf0\xf93\x90uE\xd2\b0\xee=b\x89\xe7G\x18?>?\x10\xb1\xf2\xff\xc4\x7f\xa6\xab8A\x87\xfdg\x8e\x01w\x8c\xff\x86\xc7'^}\xfc\xf7\xc4\xf7\xffw\xfc\xf7\x17\x8e\xff\x98\xf1\x97,̥\xb6\xa0\x0f\n\v\x92dAD\x93q\b\x15\xa7\xf4'\x1b$~\xfe\f\x11\x99ũ\x1a\xfd\xdd\xdf\x7fÍ\n>\x7f\x06\xf7}\xb0$p\x7f\xef\xba.62\xde¢\xf8\x16\xc2$(\xcbI\x8f\a\x0f<\x04\xe8=?\x008_\xf8\xcf;p\x9c\x1f-|\n\x82\x18h[\x9e\xf4\xa4?\xf1\xd0\xfdA\x9e\x951\x06Ԕ\uf80ao\xc9\x19\xc5\xca\v\xc5Ѥ\x872\xa4\xa4\xe8=??\x8a\xe2[\xcc\xe4?\xc4\x1f\xea=\x9f\xbf\xcf*2\x86\x1f\x16q\ty0'\x10\x97\xd4m/\x03:2H6\\1$\x82,\r\x89\xce)\xa0UU\xa5{~\xc40\x1d\x18\xc3d\xa9\xc2\x1e\xb8T\x1deX\xc4y\xa5\x0f\x92\xaf\x83ۀ\xa5\xf6\x9e\x1f\xdc\x06\x05dyU\xc2DF\xf5\xe5\x18\xfc\x91\x03GG\xf0Â@\xbaZNI\x81\xd1/z\xf8\x12\xaa\f\xa2\"\xb8CX\x92\xce\xd1\xf7\x0e=\t̒\x10\x98\x04\xe1\x82b\xd3}\xb4\x82\xc3J\xad\x16qx\x93\x92\xb2<\x00\x101\xd7H\xc1\xb0$\xc4U-\bP\xa5B\x18\x17aBh\x0fQ\xa4\xa4@N)\xfcK\xfa\t\xb4'A\x8c`y\xae\xebۈ8\xab\x02Դ\x86\x17Sp\x80\x93\xcdf%\xa9\xe8(\xa7`c\x1e\x81\xce\x1fC\x88\x1d\xcc]\\\x12\a\x06\xf8\x99\xad\xd0\xcf\xcbT\xd5I}\xf5w\xcf\xf3\xbe\xa2\xd8\xff^̧\x90\x15\xf0\xf7\xa2\x98ϧ\xf4gP\x14\xc1\x06e\xa0]\x1aJZ\xe6\x84D\x82\xd0\ad\xb8\x84\x1c\x8d\x94\x84Y\x1a\x1d\x00TE\x10'c8e\x1c\x7f\x8dC\x9cy\x92\xdd!\x10\xf6\xca\xc1\x1c\xe5/\x17A\x84]}U\xac\b\x85\xfb\xff\x16\xa4Z\x90\x02\xab\xa7 )\x8e\x11\x03\x0et\x00\xb0\xb8\v\u0090$\xed\u0ad2\xc0\"(\xa2\xbb\xa0 @\xe1HA\x15\x842b;\xc2V6\x86\xaf\xb8a\x7f%\x15\xf9\xf2\xe3G\x06\x80X\x82\xb2\x8c\xe7)\xfe\xc2\xda\xe2\xb0\a\x00\x9f\xbeM#\xb2\x1eÐ<\x93\x05?\rbL\x04+\"\xb3`\x95TԨ\x86ܻy\x1eV[\x95\xe5c\xf8\xea\xc4\xfb\a'\x97\xe5\xb2\xedɦ\x87\xa5\xf2\x00c\tj\x8c\xb3\x8a\x97\xc0\x02oɬ\xdaZ\xe2\xfe\x8c\x9a~\x15\x14sR\xc1\x04\xa2,\\-IZ\xb9sR\xbdN\b\xfe\xfcf\xf3mdI\xb1mV\x80\x7f\xc2\x04Rr\a\x1fٗ\x85-\xc8v1\xcfb\x18\xed3\x8c\ah#\xab5P\xcd\xe1\xf1&*\xfc\xe5\xff\xb4\x7f\xa2\x91{;t\xafK\xf1\xfd\x97\xf7\xffO\x86\xc7ޓa\xad\xff\xf7\x8eG\xa3\xff\xed\xff\xff\x8a\xfeߚ\xadR\xeaV-6\xc4\x17\x9f\x10\x8d\xae\xa8߰\xc2*+p\xce3\xcbqF\x8c\x94\f\x0e]\xe0\x86\xff¡u\x01\x16\xb6\xbc\x1b\xb2\x818m\x81\xc6\x7f\xbf\xa3ӭ.\v\x17\xbeg \x1b\x8a\xdf͋\xacʰ\xfbs\xe0\x86l\x1c\xad\x10\xc0m\x90\xac\xc8X\xc3yqC6\x97\x8e\x06A\xd2Ւ\x148{4\x86Y\x90\x94D\xe6\xddӑ\x19\xfdE\xff\xdeC\x18T\xe1\x02,\xa2\x183\x19\x80\x89F\x89\x15\xbe?`\xff\xd7uC\xfb\x8c\x97Y\xbe\xb1\U00092b22\x8c~\v\x9c\xa8\x89\x18&0\xf0\x1dH\x11\xa1\x02qY\xef\xeb\x00-\x00\x13\xb8\xb8dD\xee\x16qB\xc0\xea\xf7c8\x87\xd4f\xf9n\xbe*\x17:\x85\x8b\xf8\x92KT\x90jU\xa4\f쬋\xc1\x8fI\x1c\x926\x0ey\xe9\xaf1M\xc9\xee\x96\b\xefblc\x14j\xc3\xff.\xc8\xd1d\xea\xc9qD\xd2*\xae6VT#\x15\xb5!\xa9\x16qi\xd5\x001\xad\x15\xb6X\x91\x06l\xb1\"m\xb0\xf4wVX\xb7u\xf8MN\xb2\x19\xdc\xc2d2\x81\x9e(у\x17p\vc\x89AR\x91\xe5n\xb9!\xb4\xd1*\xc84N#ޥ8Pf\xab\"\xc4)jR-\xb2\xba\x12Z(\xa0\xadP\v\x87\t/\xe3\xe2\xf2\xc4\xc6\x12\x88\x82bN;\xbd\xd2>3\x99\x92\x19ܦ\xe0\x85\xe8)Ǭ\xcdla\x9a\x05\x8cֺ\xc6\xdf\x1a\x1eM ]%\t<~\f\x8f\xe2\xf2}\xf0\xdeZ\xdbm\b>\xc5\xf9[J\xb5Yќ\x9d\xb6Re\x9e\xc4UE\x8af!\x980\xc2m\xa5\xc4\xe4\xafU\xd6J\x95nU\xc4K\xcbv\v\x92'AH\xac\xa3_\xca\xfe\xd1܁\x1e\xf4Z\xb9.\x82tN\xae\xe2\xb4\"sR|\f\x83\x84(\x15`E\xdc\xc0\x04|\xa3=\xae\xe1\x10n\xe0\x1f\xe0\xdbp\x03\x87\x13\xf0=\xa3\xf9ݴQ\xc1y\x10\xf42m\xedC\xe4]\x91[\x9cl\x12\x9f\x82\t\tʲE2\xe3\xee\x13L\xe8\xa4=\xc1\xa8\xda1<\xcc'\xaet\a\x12a%u\x87\x12\xcf\xc0J\x10\xf4\"\xbet\xb3Ԇ\x84\x1b\x1a6\xb8-f&X\xe4\xd6$u%9\xa1N̑\x9c}\xb3\xc1X\x94\xc7^\xccQ\xb0\xa2T$7C\x87(ĴR\\w\x93EMq\x13M\\\x86\x14\xe3>Z\xc4v \x16l\xa2\\\x8d\xa6p\x0eC[\b@\x8d9q\xb3T/\x91(Z@3A\xd9\x1fO\xe4\xa4K]\xeb\xccCZ\x1eӾJ\xa6\xb1\xf2w3+\xb1m7\xcc\xd20\xa8\xacz\x99\x18\xfa\xe0\xdbv\x93\x00\x97\xad \xcb\xec\x960\xf1\x04\xd0=\xffK9\xe6\xe0\xb6F\x96v\x0f5<%בމ\xe2\xa8I\x80\xf1\xc4{{GE\x1bvN\xeb\xae\xcd\xd6qQ1\xa8\xae\xf2\x82\x84q\x89\xbes\xed@.t\xcb\v\xe70\x00k\r/\xc0\x87>\xbc\v\xaa\x85;K\xb2\xac\xb0\xe8\xcf$\x9b[k\x91\x9egw\x16\x8e@;\x01m8b\x90o\xdf\xfb\x9e\r\x03\xc8\xedZ\xd2\x18|{\v\xa3\xd8ͽb#\x9a\x16\xefׇ^oKa\x9cB\xce-\xea[\xeb]=Mt\x93\xa0\xac\xbe\xe5\xa6\xd0s{\xb6\x033\x98@\f\xcf'\xe0a'C\x81\xca\xd5\x14\x17xӹ\x15\xdb0\x06K+\xce\xdbp\x0fKV\xcd\xe0 \x86\xe7\xe0\xd9P\xb1\xb0\xa0\x81\r\x06\x13\x18\xa1e\xf6a$j\x97\x8bV\xb9\x05\xb9%EI,۽\xce\xe2\xd4\xea9=\x1b\xfa0\xeb\x96\xf6\xfb\x82\xcc\xe2\xb5\x159\x10\xeb\xc2\xde\xc0Ĭ+\xfa\x11LK\xeb)\f\x10\xf4\x10F&ma\x86%\xfa\xdb1j\x03\x9e\xc2\vIQ\xf5\x05Z\x99\b\x8e\xe0F\xb6\x02\x18\xef\x82>ԠEdXn\x96\xd3,\x19C\xd4\xdd\r\x92\xa0$\x18\xe6.sk&\xf0r\x9c\x92`\xa5\b\xf2\xac\n\xceY}z0\x86\n+ק\xc6=\x86\x99U\xd9g;ȉ\x8ax\x00A\x1f\x060\xb3\xf0\xbf\xfb\xa0\x9f%$\xac\x1e\x82\xde=\x81C\xb0*8\a\xf7\x04+\xc6\x1a\xc2!Th\x9bCJ\x18\xff˒vR\x97\xe1_U#_u\x16ɳdc\x91\x1a\xf8\x16n\xa5\xf1U\x0e\x90\x9d\f\x95\xb1\x86\x83c@ER,aVZ\x15\x1c2{\xfe\xfe[8\x82a\xab\uf822\x91u\xde\xc0$y\x19:\xe0{L\x8b\x03\xe1\xe5[\xb1\xb0ɱn\x96\xca_\x8b\x8a\xd54Ux77\xe8j\xe2\xd0\n4w\x8bͳ<;\xd8\xde)\xe60\x01\xf7\xf8d+\x98/0\x02\x04*\"\x02(q,\x03Gp̵\x0e$)\x89L\xb5\x86J\x95\xb6\xf8\x19`\x05\xf8p\x04\x81}\xa6\v,\x04R\x9a\xd0tч\x00\x0e\x9b\xca\x1dT\x12-b\xa5\xba.1I#\fG\x90\xef4\n\\\x0eT\xc1$*\xe1QiS9|\xf7\x89\xe7\x9f<ݗU4\x1e\xfc\xbfe\x95\xb4w\xa7\x9f\xc8\xd4N\x0e\xb2U\x1a6\xcd\x00\xdb *k\xe8>\xc1\x96\xf8\xc4=9\x1d\x9ep\x1a\x15u6\xe70l\xc9GUL\xc0wOx\x1e*\xa5\x82> \x18/\xe5\x9et\x96\x1b\xba\xc3F\xc1g#Z\xb4\x01y\xda\x02\xfa\xf4x\xf4\xe4\xa4UT\x8c\x1a^\x06iH\x12\x19\xcaF#\x97\x06\x13nYe\xf9\xf7E\x96\as:\x91i\xd9gf~\x8e\x8e2\xadDg\xddޤ\x10\xe2#\x1d(I\x02\xd8\n\bL$\"\aJ\xa3\x13\xc5j&.\x1b]\xbd\xc6\xf26\x05/\xcf\xf4\x8ah\x1dURt\xafx\x94$\xe6\x105\xaa\"\x80R\xb1\xafH\xc1Ny\x02\x1e\x9b\r\xa87\xb8\xb3\x83\xb6p]\x14\xbd\x90\xe0\x17\xf1\xe5%Lt\xb4\xf5A\x04\xd7 \xfft\xb3\x99\x1ejW\x8b\xf8\x93\n\xf3?\tƥ\xc8\x12\x90h\xcdߜ\xe6\x91\xea\xf5P\x87\xbe\xaeDM\xe1\xc2[\xe0\xff\x88\xef\xf21\xe9\x84\x0fN\xf5\\Q\x82b32\xb8\b\x17X~\x93\x93K5T1dPe\xeea\x16\xa7A\x92\x98\xcc\xea\x04\xf8\xc0M\x0f\xab\xef\xdbB]\xa1\xcd6\v\xa8\x8a -1<\xb2\x96BG\xa8\x8f\x02\xf5q\x01K7p`\xe9N\xe1ҁ\xc2\xe7I!&E\x98t\xb3\x86\x89\x81\xe4=\x86\xa4I\xfc\x89X\x85g;p\xf3\xa9\x96\xff*\xab\xac\xc2C\\\x98\xbb\xe9,\xad\xa7\xbe̖\xd38%V\xe1;\x80e\a7\x9fl\x1b~\xfb\r\xb8\xf8\xe8\xec\n\xef»\x84C(\xfc\v\xff\x12\xce\xf1/\xfb\xf6.\xfcKU\xf7\x1c\f\xa7\xb1\x84\xea(\x84\x99t\xb3\xae}\x7fҿQ\x81\x008\xc2t\xe9\x92\x0e\xb64\xeb\x06\xc7\x02Ի\aU\x90\x0e-\x8a\x15ٽ\xf0.1\xeaв\x06\x947T\x01\xb2\x86.G\x17\xf6\x15\x99\x17\x84\xf0\x96K\x89P\xed\xd0E6\xa6}\x9c\x88qg\xc0\xc3h\nB\xc3PZ97k\xaaV#\xf3\x86\xdc\xc1\x04S\r\x0eo>!d\ay\xe0\xbb\n;\x8d\x05\xeb1p`*T\xcb\r-\xa0\xea\x85)\xfe\xe9C@5\v\xd3\v\xffr+2U\xedAkXN\x03\x88\x16\xea\x81\b\xde\xd0\x02nDQ`\\\x1cMT\xfcL\x19Q\t\xf7;\xa7;\x1a\xb6\x87\xb2: iP\n\xfd\tΟPa\xcfx\xaa\xaf\xa5\xfa\x97g:\x9d\xa0\x8d\x0eN\xdb\x14y\x86\xb5ˆ\xbat\x98+\xa8\U00012604sI=\xc9\x15\xce\xeeE#W+\xfd\x83Ȃq-\xa7\x8d\xec*\xd5\x00\u07b3y3\xbd6\xa70\x81)\x8ep1T\xea\a6\x1d\n\x1c\x81\x85i\x81-\x8cC2(P\xabѧ̲֬\xc8!L\xb9\xeew\xf2\xf3\x92\x8e]\xfe\x1cv\xa89-\x83\xb5%\x06yK\f\xe5\x1c\x9dͭ\x03\x82\x97\xb8\x9a\xda6\aV̧V\xe1\xc0\\㚓\xe4\xdd\xe6\a\r\xa0\r\xf3\x87&\x02ڴ\v\x98@\xa1\xb5\xe69L`\xae}Sݴ!,\xe6ӫ\x05Y7\xe6\x8bo1\x1e;\x85\x17\xd0\xf3z\xd074rk\xbbU\xf6\x91\x8d\xbc\xfdS\xe9\xb6PGÓ\x93F~\x17\xd5<\xa0cAlԸ\xeby>u`Q\xca\xc9)l\xd9(\x94\xe7\x00\xca\xe29\x80\"x\x0e,}\a\x96C\xdc\f\xbc\xe4ӼK\xecp\x8e\xac\x8b`\xf0\xe9\xb2o\xffbY\xee\xa1\xfd\x8b}\x14\xbbdMBN@\xf3\x00K\xad\x93_\x0eq\xeeٿ\x18^\xbat\x82\x96N\x0e\bwP\xde\xc5t\xc1d\xe9\x1b\x9d\x03\x84AI\xa0\xb7(\x93\xdeX$\xa9L\xa5\xc1E\x99XT\xc67I\x16T\xd6r\x88>\xde\x013\t\x11\x1f\xe1\xf6\xb0z\xc6PdpΕC\x92\x1c\x14\xf3\xe9v\x0e\xd0\xdate\xf3&,8i\xcf\U000bbcc6\x97v\v7\xf7\x9a\xb3D\x0f\x8b\x15\xc3:l\xace\xfc*\xe9\xec%\xaf\b99\x89\xdca\xae[\xb0\xcat\xe7\xfc\xef\x94\x13Ad\xac\x90>7\xcfR\xdcp\x11\x14_W\x96g\xb3\x05\x8d\xbf\xf7T\x05\xa9rb\\\x87 \xc7Z\rR\xcb2\x11\xf9\x9ad\x05\xf4ek\xc2\x7f\xe7\r\xe8\xa1\x06=\x87\xbelk\xc2\r\x99\xd0bV\b\xff7Eh\xee\xe4\xe48\xb2\x9d\xe3'\x1d\x1c\xaby/ߑ3N5>\x15\xccȁ\x13\x83~\v̉\x03O$\x8c\xa8W\xa4Hm\xf2۴B\x9fě\xb2 $\xb3\xe6F\xd6TϚjYFo\xaa\xfb\xc1N\xafT&uW\x87Na\x19\xa7\xa2\xc3G\x8fS\xc0\xd1\x04\xa8ۙ\xcb_S\xfe\xcb\xc6\xe3\x12k\t\x1d\xac%>\a\"l\xf8\x01\xfa\xf3e\x9c:\xb0\xa0GQp\xde\xdd\xc2\"}X\xc6)6\xc0\xa12Em\xfa\r\aR\x89\x984\x8a\xe0\xc8(4f)8u$\tH\xf5 \xa2\x02{\xe9e\xb0\xb6a\x81\xf4\xe60@\t\x8f \x82>~\x9d\xc3\x14^\xc0)\xf6W\xf6\x99\xb2\x8f\xb9Y\n;\xb5B\x94\x1ar8\x8a\xaf\x80\x01\xccE\x0e\x9f\xaf\x00X`lz\xca{@nv\xba8X\xd4k\xa9\xa9ht\x85\x8e\x8c맳\xb2\x92\xa0\xd1/\x15\xca\t\xac7\x9f\xac\x82\xeb`n&\xcfy\xf2\xd4L\x16>\x00k\x9c\x0f\x1e֛O\x94\x8c\xe5\x1e\xfb\xc3\xe3\x93\xd3c\x8c\xdbq\xa4?:yr\xf2\xe4ԇC\x98\xe3\xa7\xff\xd4;\xc6!\xfc!\xd7\xea\bK]\xfdd;\xb0\xa9#\x1a\xfa\xc3\xd3'\xc3g\x02\xd1\x13\xff\xc4?\x19\x0e\x05\"\xef\xc9\xd0o\xe0\xf9\xd9v\xe0S\x1d\x8f\xe7?\x1b\x8dF\x12\x8f\xef?\xf3\x9fI4\xcfN\xbc\x91w\xec\xd7\xf0\xfc\xcb>\xab\xa9\x19e\xf3\xfdS8\x84\r\f\xc0?u\xe0\xc4ù\x1f\x1atll\a\x86\xec\x13s?\xb5ϳ\t\xed\x15\xb2\x0exx%Z\x89\x8d\xf3\xb7\xaew\xec\x1d\xa3\xe5\x16\xd8\xc3\f\xddgCх㔓EE\xf0\x10\xf6\b|\xd7æ5t\x8f;\xe9\xe9}D(\xe8b\xb5̈́'`\xfd_h\n\x1c\n\xe7\x18\n\x97\x87\xb3\x87̙\xff\xa3'\xc6 tǜ5É.\x179\x1a\xb7O\xdb\xeb\x16\xda\x1aV\xfds\x87\t\xff\xb3\x89\x80\x86M\xd8*\xf8,\x05\xfd.\xd5t\t\xfdF\x87Ѻp\xbb(\x13\xd4N\x1d\xa9\x04\xb9\xb5\xe4\xc2'\xf3.\vx\x0e\xa3S\x0f}\x02\xaee\x9czZ\xd3_\xc09.~,\xb0\xeb\x18\x896,\x8a\x9d\x03\x96\xe2\xe2.q\xf9\xc8ZR\xdf\xe3c8\xbd\x80#h\x96\xf0\x9fjE\x86\xf5\xdc\xe1\xf1\x16\x84\xd6\xf0\u0603\x01,l\x03\xb1\x84\xd6=\x88\x12\u0590\x96\xc3j\xf5K\xb3\x0f\xa9}\xea\xe5шX\xb4\xc7Rie\xc0?\x94\n:uC\xab\t\xce\xe5JE\t\xcf\xe5B\x05\xaf\xbe\x84\xbbp\x01\x92h |)\x94\x06\x87\tm1\xd8Z\x12\x94\x1e\xf5[\xa2\x1d&\xf8\x03\x064\x95\xa3\xa4q(6\xfb\x04\x06\x92i.-\x8f\xfdoo\xad\x05N\x97\x0eq\xd6\x03?\xf8\x1fl\xf0C\xaf\xbdQ/Bj\x9b\xa1fF5\xe3\xd6\x00\xda\x10\xfc\xb3\x89\xa0ոC\x98@\xb8\x97q\x87\tu|\xed\\qW\x96\xf0\xa1\x13\xae4\xd0\xceGMSS\xf3;ĲrF\x9b\x1a@\xd8*?G\xa6\x8f\xf4L\xf9\xdfj\x00m\b\xde6\x11\x98\xf2\xf1o\x1c\xc5\x06\xfb\x8c\x99\xd0\x7f\x17\xf3\x06R\xb4W\xecb,\xb4\r\x1c\x18\x1d\x81\x8fn\x1c\xfb\xaf\r\x9d\xd9?B\x97\xce\xfa\x0f\xf4\xe2S\f0<n\xb3\xbc\x97C\xdc\xe8\xc2\xd7|\xca\x05\xbf\x7fb\x10\x1b\x13BL\xca \xc4\xcf\f\xe2\x93\t\xf1I\x83\xf8W\x9b9\xf2N\f\x7f\x8e\xdc!\xf6\n\xc7h\xbf\xd8\xe1\xf8\xee\xc9\xe8\x89?zz\xc2\xfb#\xf7\xf8\xd9ӓ\x91\x8f\x9d\xee'6@\x10%\a\xee\xb3\xd3g\xc3S\xec\xb80\x06\xf2ݧON=\xdf{J\vbWr쟜\x9c6\xcba\xd7rz<:\xe6\x04ݡw\xec\rO\x9e\xf1b\xd8\xf3<\x19\x0eO\x90\x9f\x8e\xde\x0e\x05\xc7\xc6\xd1n\x1c\xbc\xe5hSYS\a\x02\xb9d\xfe\xfd\xb7p\b\xfeS1\xc0\xa7sG\xb8\xf4\x12@\x1f\xa6\xb4\xaf\xeejNB\xbb\xeb\x1a\xc15<\aw\xe8\x9d>}6\xf2F\xc7\xf0\x02p\x03\r\xfb\xff\x98\xcd\x1c\x1cc}?C\x16\x9e\xb8O\x9e>\xf1FO\xda\xf0\x8b\xb0\xa2\x15\xbf\xe7=}zr*\xbaG\xec\xaf\u05f8g\xe0\bF\xe8\x94\x04Z^\x15\x8c^\x17\r\xac\xbbz\xa0\xa09\xe5\xe1\tV\xbdUP\xff\xe7a\b\x83ޑF\v\x18\xe6\x8cYl \x96\x9f\x90\x93\x82q\x82\xc1\x02\f\x00s\xdb\xeb\xad$\xb8L\x8b3/tW\x81\\\x8d\x92[\xf7VS\xb6\xff\x92e;F\x99\xefŎ=3\x9c`\xa0[\xa9\xf1_Ya\x89\x1f5\xe1E)\xb9\x9e\"s$\x1aY\x14O\x85\xc7[\x17\xbb\x9at\xbfN\x92\xdfAZ/\xfd\x10\xeaAU\x15|W\x8c\xb1}CB#\xc0\xfbU\xa2֩\xb8\xfbc\xbbr\xbe\xae\xaa\"\x9e\xae*c{\xce};\x86\xf7\x1fw\xe0x\xff\x91bq\xe9iS>\xdaO\xb20H\xba\xf1\xbe\xcc\xd2\x12\x0f\xa4\xd61\x97\xa42Y\x13\xe2\xed\xc4\xf4\xfe\xe36\\\x9d,\xee\xc4\xff\x86\x7fh\xd8\xc5腖ܱ\xe7\f\x83\x99\xb5\xd8\vho\xab\x02\x16\x18via\xbd\x9b\xc3\xf7\x1f\xff<\x1e\xb7Tq\a\xdf[4n\xca\"'\x96ܴt\x7f]\x05I<\xdb\xe8V\xc9[\f\xdfR\xcag\x8c^h\b\xe1\x85f\xaa0\x96\x1f0\x96\xdbcy\xd9\xda\x16\xd9\x06\n\xa5I\x18\x1b\t0n\xd2S\x86\ac#a{å>\x90D\x1fx\xb5\xc3g]H\x1c\xd7| \xf3\xd7\xeb\xdc\xeaY/\xc6\xff\xffo\xbf\xfcR\xf6m\x9c\xa9\x8dFnA\xe8\x95\x04\xbc\\\x1f\x10\x02\xf3\x7f\xfb7\xbb\xe7@o\u07b3\xf7\xa2l4+N^\x16\xe00B\x94\x9aAŠ\x16\u009a\v\xbc\x88\xf7\"\xbe\xe4f\xb6\xa5Yq\"B\xb9\xadD\x9c\xbd\xadw;\x1b\xad\xb6\x86\x7f\xc4N[6AL\xf7\xd9\xda\xee2ȭ6\x9d\xbdW戍?\x158\xf4\xf5\xeeڎ\xecv\x93\xab\x89\x0e\xe3\xba\xc6\xf7\xaa\xc2\xc6Z\x112U\xf0\xe9\xd9NS3\xd8\x14\xf8\xad4\x8b\xea\xd6\xc0\x86`8n\xc0L\x97\xa2y\x1b\x97\x95\x1c>\xd2j\x81\x17\x10\xbaA\x14qF\xc6\x10r\xa7\xa1Sc\xc3=\x13\x15r\xef@\x88qx\xe8\xe2\xf1\xcc\x1f\x83DL\x05;\x10\xdeb:Ε\xa9̱\x18\xc00\xcej̢Lj\xa3\xa2\x9a\xf0\x12\xe0\x8f\n\xe2V\xa4\xac\xac\xf0\xd6\xd6K\x01\xa3\xa5\xef\xc7\x0eo\xa1\x8f{\xad\xa1\x0f\x86\x10\x02U8\xb55\xb6&\x10\xder\xb7mJ\x87\x12\xf0\x1d\xf6\xca\xf6\x8ci\xe1P.\xcct\xf0!w\x80\xe3}$\xb8\xfb[\xe3\xe5K9\xe1S\xfa\xdbM\x8c\x1eA\xd5}\x04\x1e\x95\x89\xb3\"\xae\xe4Y\vY\x90¶\x05\x194\x83\x9b\x83<\x17\xa3i\xb4\xe6\x10(x\x8b\xcf\xd1p\x95\xa42\x105\x99\xeb\xc6\xdc\xe1h~O\xf7\xb8E@\xbd?\xec`}\xdd\xc1\xb6Ѽ8Ax\xa1Լ\xb3G3ą\xb1\xa9\xd8\xed\xf5\x9e\x9b,\x1amL\x96\x10@\xb5:\x8fHB*&\xf3\x05\xd6\xf2ek]\x88\xc2\x1d\x15\xcdJ\x8a:يᏭ\xd0&\xf7\xaa\n%S\xeb=\xeaI\xb0\xb7WU\xd5e\x81qCA\xdb+,\n\xaa\xe0}\x16\x11\v\x7f\bEp΄V\xae\xae0\xf3\xeaj\f\xf8w\x9f\xe6?\x8b\x13<\xce\xf2\xe5\x03\x9aw\xb8Ո\x94\\\xf9\x12\xcf\xd9>\xc4ˬ\xa8^f\xcb<(\x82*+\xacP\xfe\x14\xe4\xb0)>\x92\x15ʧ\x98mP\x80ԏ\xbaA\x19\x92\x14\xcfڷwy\xfa\xb4\x82\xccU8\xac\x00\x17$\x03W(\x0fר\x1f?\x86\xa9L\xd8O\x98,\xb5p@\xab\x0e\x83\xe0\xd5by\xb5*\x9a\xcd*K?PWY\xb3\xe7\xbb\"\xc8\xf1\x90\xf6D\xb7M\x9e\x8f\x9a\xe0\xf9\xaa\x10\xf7\x98\xcc-\xbd\xc6}bo9m\xce\n/!\x7f\xb8\xff&\x1bEg+\x16F_k\x8bY\xfau\x14i\f\xcb\f\x8eZ?\x90Ȣ\x80\xacuK\x9d\xbe\xa3M\xa5\x06ż\xc4]6<n\x10\x9aW\x00\xf5]|Bɼ\xe9c)\xea\xcbU\xab\xdfcK]\xa6\xc1\x1e\x98}7\n\x80Hye0\xdc\xfa\xdeGQT\xd4%;t\xa8\r\xe8y\xe5\x04Q\xd4V3J\xeb0i\xa9&\x98H\xe3\x11\xd8Dޕvn\x87\x1b\xa6\nTY\bл\xba\xc2\xf0\xb3O]\x12ۺ\x89\xbf\xe4!\x1f<١\xe6\xdeš\fvv\x94\x02\xaa5e<(d\x1b\xcdJІ\x17\xcc&`,u\xb0\xbd\x81\xe0e\rr2\b\xb5\x85\xbb\x98\x85\xd1\xc8\x03\xb8\xd7|\x7f\b\xee|\xa1\xb0\xbc՟\xc15\x9c\xc3\xf2\f\xae\xfb}\xbbyn\x97\xe2\x95e.\xae/\x8d\x1d\xab4\x97#r\xa8\x89\x9d\x01=\xa6z\x06\xb1\x8e\x8eo}`\xf5N\v\xe1yU\xc9,\x0f\x9ec\a\xae\xed3\xc3`\xee\x1f:\x8bER\xe5v\xe3,\xed\x9c<\x93\x10\xe6\xfc\x19+\xdf1\x8b&\xa1\xdaX\xa0\xdb\xcabm\xde\u03818r\x00\xaf%\xecdB\x00\x1a\xc5\xebı\xc5Tw\x84\xa4\xa5\xda>\xfc.\xc8\x1d\x10\xad-\x1a\xb9bk\xaa\xd5\xc3C\xeb\x15\x0ecI\x1a\xe1a!\xdc֮\xed\x0f\xa5\x14^\a%w\x11\xdc\x12b\\\xee\x8f##\r\x19G\xb3\x8d\x97\xc4LGV\x9a'\xf5(\x87B\xd0m\xc7\f\xb8.)|\xa9\xce\xed\x89jǂ\x9c\x84\f\x14\xf1Sxc38d9\xea`\x1b-)q5̆\xf75R\x16\xae\x1c)Jm@\xd4\xd1Kr\xb4Dj\x11\x84\x96\xb7G*\x14;\xdbY\x88\xf0\xdc\xc3F\xa3\xb6\xd0j\x0f\xd6Åκ\xd9E>\xa0\"\fˠ\xbe\x84y\\a\x9b\x9a%\xaa\x93\x9aur\xbbَFԢ\nu\xad\x00\xa1CEmۈb\xa8÷ɢ\x9a\xbb\x90\xa5\xb5\xd6H\xdb@\x13\xbfP\a\xde\x02\xe3\x06!\xbdR\xe49đT\x05\x1e<\x10\xa7\rxo\xa8\x81\xaa\x16\xc2{!\xb4\xb5ҝe\xc5kt\xc0\x929zMA͒\x14qn\x1b<\xbe\xa6\x9af\xd2D\xd8!\xd4Kp*$\xd2\x0e\xf6\x19\f\n\x0f\xc9\x7f\x1by\xe24EPT\rB:\x1c\xb2\xf5\xa8\x8a\xc3\x1b\xa92[U\x17\xa6;\xd8qু\x9e+MNc\xe9\xacȪ0\xd0n\xad\x89G\x93\xc9\xf6\xba\xa0~\x10\xd7\x0f9:\x18`\xa4\x15lp\xc1(Z\xb1Ko\x1czb\x03[\x97U\xd9\xecP\x85P\xa0>\xc5dLx\xa5\xac\x9b\xfe|Т\xf6\x8b\xc1 \xbdԕg\xaa@\xd7=j\x91\x1d\xf9\xab\xe3j\x8a\x02\xa6?\xfeVw\xbf\xe2\x1fV{$\x8d\xb6\xd6]\x1b&mڦ\xbd\x9et\xc6[\xea\x8c\xf1k\b\x81\xc2=\x1a\fhˡ\x97)\xc9\xd1\x1e\x0f+\x15\vWW\xfb\x1a\x89\xec\xd8H\xc4O\x8a\xd3\xfa\x14\xb1*\xfdpd\xd5\xcadY\xd5\xc8\r\xda\x03\x0f'\xf1\x8f\x8b\xff\xb1k\xfc\xe0!\a\xab\x85O~i\x96\xf8\x1f\xb3C\xbckJK\xa4\u008e\xc1\x93I\xf7\xb8\xba\xdb\x1dN\xf7\xfbJG*\x95\x8a\x82+\x85\xc2t_\xd4}\xd4X\xb58\x9a\xc3uQkv\xe2Ғ\xfbz\x8bl\xf8\xdd\xee脺x\xab\x1e&b]\xd0(\xa0fN\xdd\x01\x84\xaa-#\xebU\xa3\xd6\xccl\x9e.:\x05-\x8b\xb6\x02\f\xdf\xdd8j\xc9~ͻX\x04@\x9eD\x8bjv\x17\x88cGga`~հ\xba\xb3V\xb0\x0eC\x14\xc0B\xa5\xaa\xbd\nH\xfc\xed\x98\x16\xc3\x19\xe2թD\xe9p\nm\x9aP\xf1G\xab4\x9a ]b\x98\x12\xec\xbae\x05\xfd(\xce\xc8Ѓ\xdf\x0eԧJ\x02\x9cz\xee\xf5p\xc8/\xa0\xbb\a/\xb4\xd9\xf3\xb3\x1aS\xb6\xd2YC\x87\xcd\x01\x81\xac\xa9\xb3\xe5\x88G\xebJ\r6\x99⪬\x88\xf2bh\u07bc\xadaE\xe0I\x9eWA\x855sg\xe1\x89z\x9f\xdb)-\xf9늈y3\xdeET\x9aO\xe7hh\xdd\xde\xe1q\\߭\x16\x84\xeb\x90u\x03\x02\xe4\xf9\x04sy'U\xf9\xee,Y\x95\x18\xb4U\xbe+\x8cEz\x00Q\x9e\xb2R\xf9nJ\xd6U}\xb7\x97\xde\xe0(\xa7\x14\xa1\x85\xb7\x1d\xa4ٝ\x1ax2\xb8\xe70\xd46ucz\\\xbe\x89Ӹ\"\f\xc0\b6\u0084\x04\xc5\x0f\xf1\x92d\xabʒ\xf8\xf1\xbf\xd9J\x1c-\x17\xa6\xa4g\xe1N2R5\n\xa2\U000b90d0\x85\xef\x0fj(h\x9d\xde\x06\x89\xea\xb2j\x1bo\xdb \xfd\xb3z&\xbd\xa2\xdf$mw_\xcfd*NYG\xe5\xf1{>Z\xad\x01\xe7\x15\xe8\xa8\xe7\xdbt\x86*\xdct\x9a\a\xeaYT\xb5J\x95\x93B\x12\xedt\xf3mt\xa1\x19\x82\x1bGr\x9eHX\x01n\xbe\xab<j\n\xca(`\xac\x90P\xde\xea\xf6\xd2P#\b\xe6\xe5\xaep\xfcv\x84\xe5B_Yi\x8d\x03\x8bj\xa5\xf2m\x13\xbdf\x96\xbc\xb5\x8a\x16P\xd7\xf62[\x95\xe4\xfb,N+\v/u\x0f\xe2\x14gcd[Gŗ\xb7\xb8\xe5Y\xe6\xba\xd9]J\x8a\x8f?\xfe;\xbf\xc6\x0f;o\x99\xa9L\xbc\xbc\x9d\xbbaA\x82\x8a|\xfc\xf1\xdf)\x01\xa5l\xc4Jo\xf6E\xe3l\xc0\xa9H\f\xf1\b\x1e\xaf\xa6\xab\xf9\xf1\xb1\xf7tD\xb7E>~\f\xd6]\x9cFٝ[\x86E\x96$?!\x1bF\xca\xcfF\xf3aBD#\x97\uf0d1\xf7\x11\xe2}\xc16\x8e\xf4H\x1aY\xbd\xf2v\u07b3\xd9j\x86\xd5\x13\xf7\x1b\xe2`=\x98\x96Y\xb2\xaa\x88ʭ\xb2\xbc\xe7\x80'\xbf\x132\xc3a\xbd~\xde\x05\xe5\f\xab%\x93\xf2»\xbc\xf0.q<\xfd1,\bI_\xfe\xf0NI\nДs\x02\x8f\xac\xb0Z\xba3\x14\r\x7f\xa80\x03\x10\xa1\x18o7\xdao\xab\xdatePݻ\xb8*D\\\xbc\x1d\xf5\xa7\xb3Z\xd6Ff\xfd\xdci\xb2:\x920\xc1K\xbe;аL\x85\x88\xff\x15\x06@\xff\xbaˠ*\xe2\xb5<ާ\x8c\xb1\xa607N\xf9e(Rln\xe1\x17\x1c\xd3ڑ\xd4/\xeb\xfe\x19\xef!5lyN\xaaop\xb7X\x9c\xce_R6?\xe0&)\xfbLo:\x17J@<\xb3\x80\xf7\xe0a]\xc3@\xc3\xc3d\xc4[1\x1d%\xb1\x80\xae\xb2\xbc\x05\xf8\x87,\x87\xd6\xf3\xa2i\x96\xb5\xde\xcfFO\xbf\xbe^\xe3;\f\x16\x7f)\x85W\a\xda\x19\rJ\xd1-\xd2\x1cz\xf2\x16\x87\t*\x85\x15\x11\x13\f\x03\xa8\x9d\xdbd\xe5\xcf\xe9\xb0\x0e^\xc0\x05\xf0(\x97~_\u0098\xa6\xe0%\xee4\xbd\x9ds\xca\xe1\a\xbc\xa8ˢ?\x85\xcd\t\x12\x98\xe6ҋ\xbc\x98\x18\xf0\xa2\x99f\xe1>\xc0\x9a\xb4\x1a\x10\xaf\xf7V\xcaW)^\xd6\xc4\xe4t\x00?\x04\x03\xa8\xa0\x18=%N\xea\xfaR'\x9a2\x1cX{JW1\xaao\xad\x00/b\xffҁ\x88/\x8da\xfbZ\xfbp\x0ekmT\x1aaK\x88\x11?\xe2\x89}Ah}\xa6\x03\xac=Nh\xed\v\x02\xeb\xb3\xfa\xf9\xb38\xc4\xe8\x11\xf9\xb7\xd6x\x9f\xc8\xda\xd3\\\x99\xe2\x90\xc3\xf0\x8b\x96\xd6\xca\xf1(\x9e\x05HH\xe2\xc4Z\x8b#bF\xc7\xc0\x80\xb7\xebT^\xe4\x00\x9f\xf5\xa2\xd8[u\x17\xc4ˉ\x83BV\a\xad=\a\xb40\xd1\xc1\x1d!Ky\x05\x8a\xc4P\x10\x8a\xc0\xaa])F\xd1\xe9}\xa4Q\x87\x9c\x00\xff\xb2\xe19\f\xe1\x85b\x06o\xaa\xe1\x18\x94u]Mc\x96\xe6\x80q2\x17&\x8c3x\xd1~h\x17ƍtv\xc6PT\x00{\t\x87\xaeV\xb4\xe9\xc0(i\xa8DVa\x9c\x1a\bxA\x81\xa7\x86\xc0<\b-q\xf0Z\xa2\n\xd0+^*\xba4oѓ%\x18\xfb\xd6\xda0\x17lB\xe2\x85\x1f\xca\x1d?\xa9\x808\x98S\xae\xf4\xd9\xceM\x03+-d\xc9m\x00zq&\x97^|\xbd\xef\x1c\xaf2_e\xf9ؼ\xe8\x06'V+\xf6\x99Ɉ\xb4\xaf\x16N\xa8\xa2\xbf\x88\x11ZRR\xe2h\xd6\x0f%M/\xac\xee\xa0\xcfqh\xd0\xd6\xda\xd6k\xde2\r\x81\xe2j\xa3Č\xfbK\x84\xa4%\x85L\x02\xcdC\x84\xd4\xd8\xfb\"\x06\xb4\U00082a89\xf2!\xcc\xe0\xd4g\xa9\xb3!o\x06\x91ť\xab`\r\xf1\a,!\x9b\xf3\xb2\v\xe9\x1bzz\xf6\xc1\x98Y\xb1\xed\xe8\xd1\x1d\xeb\x88\x15\xde\xf6^\xb0F\xe6=v\x8a\x0fPQ\x98\xe5\x9bvr\xedb\xd4]]\x8b\xbb7\xa8\xb4\xb1\xb0\xad;\xf9\xc0nl\xa5I\x0e\xf7\x8e\x82'ɑ[\xb4@9Уm\xa6'~\xd0\xe6\x81_\x1a\x8f\xf8Iͺ\xb7\x93\x13Ԥ\x15IÍ֭\x97\xeb\xb1\xf3\x7f\xf2\x12\xc4h-\xcfo\x88[\x10}\xdb\xd0D\xb4\x86Ǐ9N\x00ڵ\x8f[ۉ,\xa1]\xb6\xb8Ɖvv\xf0F\xb6\x83{1Y\x1a\x928\xd9\a\x15µcR\x15\xb7M1\xd8FX\f(lA\x1a?\xf6!\xf4\xb9\x8a\n&\xf5\x18\x8fG\xb4\xf8HE\x80\xe3x\x06\x87\x17\x9a\f\xc4o\f\xcap\xf2\xa0Uӵ\xeb&)\x96#X\x9a\xfa\xc6\xe5\xd6\x02\xb7\x99,\xe1\x88\x11:\xa4\x18U\\G\nv`\xc4?\xb1i\x0e\x1e\xfc\xf2\xf5\xb3\x8c\x02`\xa4\x01\x9c\xb4\xe4?\xd1\xf2\xf9Q:)\x86\x10\x80\xaaZ\xa5\x1eQ\x84\xb6\xc1\x13ϥ\xa1\x9c&\xa7J6\v\xe1\xa1>\xca\x14\xb8'\x06\x82!\"Phy\x8534\xfb\xd4h٬M\x8e\x03\xf7kcuk\xab\xa5{X\xc4\xce\xf6\xd5\xea\x10\x1b\x94\xd9M\x00V\xcfq\xeb\x97|\fڬb\x0f\xc6\xf8\xc5\x15\xd2f\xe8ab\xdf\xc6\x1b7{\xb3mnA\x04\x93u\x17\xb8%\xda\xe3\xe2`\xb3X\xc1Ą\xe4h\xe8@\x8e\xff\xa47[\xe0\xde\x0e\x1d\x8c\xfa2\n\xc5~\xf9\xb5[\xc6\xdb\x1a<ϊ\xad\x95\xb5\xb6\xed\xb3]\xedZ\x05\xcf_.\xdb\x05ߖ\x82\x7f\xf9F\x97\x9b\xbd\x83\xf9\x81\x98T\xc4\x06ȕq\x83\xd7_\xf1ߞv\xbb\x89\f\x019Fv\x83\xaf\xad.Q\x15\xeeL\x84z\xf4o\x17ؽ>\x8b\xd8\xef_cӖK2\x00+\xb6\xea\xdbZq\xd7x\bQU\u07b5v\xf7H̊\xe9\x85(\x17\xb2\f\xff\xba\xb4\xdbFlm5*\xf6\x0fE#w\x1a\xe3\v\x93\x9c\v\a\xf0x\x9d\x037\x9a\x12U\xfd_\\_Z+\xfc\xcf>V\x80\xedGt\xa5I6\x17\xb4w\x8e&\xb8\xe5`qEF\xdaFN\xa7\xfa\x93l\x8ewqn\x1fR4Qc\a\xc0\xd0s\xf0\x9a \x02\x97\xb4\x89\x87\a\x9c\x1c=\xc3`\xb1s\x13yvǩ\xe0\xfe\x03\x9cW\\\xa3\xfb\xc6yImșd\xf3T\x9bʸJ\xb29w\xbe\xd0&5\xd4H\xad))T\xb4}fJ\xad\x0f\xe8t)\xbbCC\x13\xb3\xe4\b\vXf\x9e\xed\xb4\x8e\xfc\xf7\xe6\xa1\x11R+&\xb6u\xfe5&p\xc1\x87#\xba\xb84\xd6m(8\xeeb,6r\xb1Ę\xe7\x15\xe7j\x9a}%\xbd\xbc躭\xeb\xa5~\x15\x9d\x14ړ\x0e}k\xa6I\xd7*ء\x95?Q\xd2`\x1d\xcbI9>K\x8fr\xf0\xb7 \xb2;+V\x9a\x94\x9b\xf8\xe8N<8\x87\xeb3\xb0ն>\xf4\x8e\xcf\xce\xe0\x06\xf7>\x9c\xc1\xcd``7q\xe1\x05\xf7\x1a\xbe\xc6\x14\xad\"\xc0\xd0\xd3\x1d\x7f\x06\x05\x1f)\x9c\xe3\xd5\xf8p\xd3\xef\xef&\xb1]\xa0\xfb\x03\x83.փw\xc6J\\\xc4\xd8@Vb\xd7a\r\x12\xab\x85\x82\xf1\xe6'\n1\x7f\b\xcf\xe1\xf6\f\xae\a\x03\xa3\xa0\xb0\x10\xfaW\\\xd4\xdeܟ(M\x96\xc2\xed=`\xa3O\x1ecl\xb1\xcfF-~ɓi\b,x9\xdb^ؗ\x8eff\x80\x8b\xfa\x91\x11\x8dK_\v8қ\x99es<\xfc\xae\xf2Vk\x84\x17`a/7\xf0\xc9\xc0\x1f\xea\xb12γ\xd2,=\a#R\f\x92\xcfL\xddI\xb5\x18[\x84xf\x84\x17\xf7fw\u058c\xfa\xf8\b#%bӋZn\xf0Jj\x1e\xc3!9vC\xbbV\x03_:֔]\x11-\x84\v\xc4\xe8(\r\x9c\x8d2\xdd\xe3ǭ}^\xae\xfa\n\x8eRF\x93k\xed\xfa\rs`\xb7\x15c\xda\xc08\xd0P>\x97(\a\xfb\xe2Tݠ\x03d\x9dg)Q\x8bm\xfbv\xcfyv\x97w\xf4Ϲ毯\xf2\xec\xee{\xea%9\x19\\l\xb9\x9b\xb6@\xe0\x99~\t\xf5\x05\x1d\xfb\xf4?\xa9g\x9f\xda\xdb\xfacT\x93`\xe4\xe1\x9d\xe1\xb2!hˀ\xa4\xb4t\xd1,\xbbc\"\xe8\xf7\xcf3\xedE\xa6;\xa8\xe0\xf8\r,\x92\x18\x16k\x10\xa8\xb1\x823(\xad\x95*\xcc拪U\x14\x16u\x84\x9eTZ\x89ɑ\x00\xd9a\xe3\x18\xdf\xe9\xb0{Z{\x87\x86\xe8\x9f6\xa9\xf7w\x7f\xaa\xbdK\xf7W\xa3\xcd\x11\xd7\vn\x99C\xdb\xea]PB9\xa2\xe3H\xdbj\x86g\t\xbf8\x90\xb34\x835\xddS\xa0\xddҵ\xde\xf1\x02\x00\xa3\x9d\x15\x11^\r-\a3t\\$'\xfcv\xfa6\n~aY\xf4\xf4\a.$#\xcco\xbf\x01\xfb\xc6M\xe9k1>ca\xd5ڶ\xe9@Ɇ\x7f\x18C\xd0\xcb\xf6\xf5\x93\x8a\xe4\xa5%\xd7II\xde`@̍p\x01\x84\xad\xd2\xd0^\xa0\xb1\xe4\x1b\x1dZA\x8aTM\xe6č\xfd\x85\xd2ASY\xb8f\xf8\x9fo\x82\x94\xefJ\xd3M\xef\x8f\\^Ѣr~\x12Y\x1d{8;0#q\xf1\xceњ\xe3v`-ũ\x9fh\xa7\x1e\x9bJ\xe4.\x82\xd2Zc\xf95\x1eD\xb1\xf5:\x8bk\x95\xa6ŠB}(\xf7\x05UF\xe1V\xe2\nrn\xf1<90ۊ\xb6\xac\xf2g\xac\xff\x88z\xd1w\xffR\xa8\xc2\xd8\xe4Z\x8d\xe54\xb5L\v\xc6\xea\xf8\x01O\xbco\x93\xb7K\x1c\xba\xa9\xc6\xe8\x87p\x8b\x04{\xf1ܔ\xae\xe3\xd9\t\x06\xaas\xaeo8X\xeb{\r\xd6\x17\xbe\x9a\xa4\xb5h\xe2\x80\xed$\xc0Xƒ\xe1\xac/\xab\x90S\xa2\x8d\xae/٪+\x12g.\xc5\x14\xa4\xc6\x1cF\xb7\xaa\xa9d9\x12\xc1\xcb\x00k͇c\xc5L\xe4\x94\xe4&\xfe\x87\xd4\vS\xe6\x1fX;H\xbc\xa3r\x1c\xc8Vx\xd6\xe9wUU\a\xf0\xc86\x90\xe3@\x97\x91\x11尊\xf9d\x18\xafW8\x97u-j^\x00\f\xc0\xb4\x01\xba\xf3\x85fm\xb3\x86\xba\x05\b\xee\xfb\x80\x97f\x19\xb2\xb7\xdbC\xad\x9a\xf5\x12\xb5zF-p\x8el\xee\xd9k3}\xa6-p\x8c\x96\xafز\xf71\x0fZ\x9b\x7f\xa0u\xd0E\xaa\xffkLD\x9b\xb4\xf9]ւ\x01QQ\xd03\xd2:\x1a\x18tb1\x17]\xba\xecL[\xd2c\xf8\xf1ա\xdfii\x1a\xce6\xa3\xdb\xcbꔕ\xfc\xc1\x8eI7:e\\\xbc\xb4\x84\xea\xc2\xc07ym\xc3Q\x9b\a\x14]3N\xbf\xfd\xae\x10\xb9#p4p\xee\b\xcf\xdb#\xd2_WAZ\xc5\t11\vF$\xbc\\Іϵ\x19\x1d~.װC\a~\x95\v\x11\xe6)\xa8jQ\x90r\x91%\x919\x17*\xe3&\x9c\xbc\xfb\xd5\xd6\xc0.n0~\xc5žh\xe46\x98\xbd\x81#\xf8\xd5\xdeb\x05;\xe2j\xf4\x14\xfciQ|\xa2`m\xcb\xf0\xe7}\U0003e196*\xe6B-H(\x1e\xf1\xae\xa2\xcbz\x1c\xab\xb2\xff\xdc\xd0u\xed\xf2\x8b\x1f\xb6\xcei\xf1\aTծ\xff{\xdb\xc5;\x1b,\xfd΅\xba\"\x8d]\f5\xd3\x15\x0e\xe5\x0f\x0f,w\x93\x16V`t!\x8a:'S\xd7\xff\x176\xbc\x86\xc9Q\xf6M\xb6\xdax\xde\xd6\xda>\x11\x8b\xee\x9b\xf4\xbbZ\xdb\xf6\xf1\x9f\f:\xc5V\x01\xdc<\x18\xf3\xdfl\xdb\x00\xbe\x84\x83W߰\xbd\x96\xb6i\x9d\x92\x8cdXҹY\xd7\xda-vTbϦ\xa8\xa2\xb8\x0e\xd3\\\x89k4A\xecZ\xf1\x8d\x9b\xf8\x0fj\f\x17\xc04\b\\2`\xbbP\xfbk\xf9\xa8\v\xb0\xed\xa8\xfd\xf5ź\xb9G\xb8\xbd\xce\xfe\xeb\x98\xf8C\xed\xb3aQ\x06\xce\xfd\xedS6\x9a\x9a\xb9s\xf2{\x1ahˢ\xadi\x81]\xd5\x7f\xb5\xaf\x82;|\xe1ՙɎn\x86[k\xf6\xeaw\xd5\xec\xbed\xf7\xafծz0\x90֩\xb5W\xa9z\x05\xdd\xd8b/\x01e~\xb36\xfb\xe6Vn\x0e\xa8&\xbcEʙ\x99/\xabC\xa64\xf4\xbd\xdeWߝ\x9d\x1e\xce8\t\xfc\xf6\x99ɷH7\xb4%\x12\xff\xe8]\x92\x06\xde\xdf7\x81\xbd\x8b\xc2\xfe\xf6#\x8ap\x94&>\x0e,`ZM\xe7v~\x15\x14\xe1\xb7iJ\x8a\x0fA\x14\xafJ\x15U\bZn\xacr\xb7\xe0\xf8nUm\xc1\x91\xad\xaa=p|\xc4\xd1\xce\xd7\xe9<!-(J\x99\xb9\x05\xc3\xeb4\xea*O\xd2hki\x9cP\xb6\xf2\"\xbb6\xefw\x91`4_\xbf\xc9K\xcb+\xc9|i>\x9e\x0e\"\x8d\xaf9\xf7\xde\xf5\x8c}F\x1a%\x8b\x9e\xff)q\xf3\x00IK$\xcd\xebQ\xb4H>o\xc5\xf1\xf1\xcdH\xb9\x98\x1d\xbb\xb84\xdfeG\x16y\xf3\xa2\xc7\xfag\xfc\x82s\xca,n\xb6\xc4e\u05cd\x99\xb6\xb1\x1b\x11:\x9f\xd9T\U000a09ce\xc8,\xc6+\x0f\xe4\xcdI\xec\xc8:\x92\xbc\x88/\x9b\xf7L0&\x99\x06.\xa0?[\x1b%\x11ށ\xfel\xd3H\x85\xcb\xe6\xf6\x00\xa4\xcf\xf1\t硓\x92Up֠o\fA\x94R\xc5\xdf\x16\xc4\rd܊x\xba\x00\x84\x17*\x85=q\xdd\xc3\xe5b\xf5\xa8\xbb\n\x8ax%\bC\xfbI>\x8a\"R~\xc6S\xadT\xbb\xfc\x8ch\xb12w\xa6\x99\xe0o\xf9\xba\xa9\x06\xf0\x1fdc\xee\xb1s\xe9\x85!ܨ\x00\xf7v\xf2'\x18\xe2\x94\xd0St\x0f\xef\x90ep\xb3n\xe9\x0f\x91/\xc3\x03a\x82\xbb\xf9\"Bܩ\x03l\xf6&\xa4\xd4\xf7pr\xbc\xac\xa0\xa3P\xedGZS\xfa\x17\x91\xd7\xca\xff\a\x91\xa2#\xcb\xfc⟫ڥ?\xf6֊\xa7*㭦\x0efu\x9aԷ2#+\xd8\xfdIWtթau\xb6{\xa3\x98ܪ\x17e{\x0f\xd7\t/+\xe8(T;\xab\xa4\x96\xde\xe5\xec\x7fjv\x12|T\xd1U\xe2\xe7\x96\x12\xfe\xd6\x12o\xc5.\x05t/\xb5\xb2\xdc\xe70\xc7\xf1\xb6g\xef\xc6\xf32\xc9\xf0z\xe8Vl\xddD\xfb\xd0\xfbWo\x1b\xf2\x8f\x15ɿ!\xb3\xac 5\xd4b)\x8c\xcf\xfd\x18Nҁ\\&ѩ\xd3<\xa8\xf0\n\x82\v\xc8\xe9g\xcf\xe99\x90\xe3\xf4;w\xbd\xf5\x9e\x05\xe1y\xdf\xf8c\xcf\x01KC\x17_\xdat=\xa6\xf7O\x8aCN\xaa\t\xc5aI\xe1ow\t\xf6\xf5\xac\"J\x17\x7f\xad\\\xffl\x91\x8b\xe2\xf8\x91\xe3\xf8B\xb9^\x06l\x9a\U0003b724\\4\x15<\xc0g\x03\xa7.\x1b\x9c\xc31\xbc\xd01\xd5Le,8ŇRu\xb8\x7f\x92b\x89\xf7;pU\xb1]k\xbeSC\x8f\x8baN\x1b\xa3?\xe0\xc0 \xad\xca\x06\xb3{\x89i\x98\xfd\xbe\x82\x8e\xf6\x13\xd4\xeb\x10\x94C\x8a\r\x83\x1c\xd6\x16\x12\xef\x10\x93\x9f\xe9./L\xae\x060\xbc\x84K7\xcc\xd20\xa88V\a$0\xb6\x15\xfb\x81\xba\xa9k\x05\x0f\xa7fڕX\x7f\x8ev\x04\xd1\x16\x86\xbe\xac\xaa\xeb\x88+\x8eE\x88\x81]\x85HSR\xf8\xd8=\x99\xa2=\x9a@\x1d\xee\xf1\xe3\xdd0}\x18\xaa~\x89\xeb\xacS?\xbc\xb3\x91\xae\xe4\xd7U\x10\xc1d'\x11\xe9Jz\xd8\xfa=\xe5\x16\xbcK\xc3\xe9\xa0\xe7c\xd7c\xf0\xf2\xd4\r\xe1@\xbc\xf2\x1c\xc8\xd1q\xf1y8\xd4\n\x12W\xacS\n\xfd\t\xf4\xfe\x1f<Wb\xa1\xcb\u009bdس\xda\xf4\x1d\xff\x11\xed\x10\x1c\x9e\xed\xf3l\xbf%\x9b\x16V\x1fjNM\xe7]K\x8dA\x9e\x18R\x87\xc2k:\xc0Ǿ\x14\xb7\x95.\xa4\x86I\xa1\xcfc\r\x7f\xbf\x7fV\x17\xf3%\x13\x93\xca\xd7GA\xbcK%\x00\xa6\xfb<\xdd7ҹZj\xd0\xe8\xd41տ\xdcC\tr;2\x13\x9bnU\xae\tK7\rc\x8d\x99\x17\x96\xb6\x8bWӆ\x9e!\xa5\xfd\xf8\x87q\xafߴҴ\"\xec\x1f\x93v6\xdb\f\xac\x0f\xd5\x16\xfb\xc2\xdc6\xf3J\f\xe6\x12ŝqh$\xef:\xab\xbf\xaf\xdb\xe1B\xa1HB\xb9|Ќώхr\tJ\xf7Y\xe4\xd8\xc8|%<6\xbe|\xa8\xbe}>\xde\xe6\xc3m\xa3շG\x04B\xad\xac\xdd\xc89tF\x84\xb7\x180h(}\v\x9e\xc5\x18\x1a\x9fʲr|H\x18W\xf8\xa9\xbd;2\xd1\xe7\x89h\x02\x97v\x8b6\x05\xb6m\x1a\xfd&(c\xa1F\xc1{c\x88\x8c]\x88\x8c\xd4w8J\xd1H\xda\x14\xc6\xfd\x19\x17\x1bUM\xe7\xf7\xf3\x98\xfa\xbc\r\xff\x8d*\xcfq\xc4ɗ\x03\xf8\xff-\xbd\xacOc+@\xc8\r\x85\xdcx\x8e\xfc?\xc5\x01z0\x87xh(\xb7\xf1D W\xd7\xc17\xe4S\x8c\xa1c@\xd9ĽG\x1b{G\x05\xc7mU\x98\xaf\xddr\x11ϴل|\xcd\xe3\x8aX-\x18\x03\xe4\x9b\x06\xdcF\xc2ig8\xf6瓷o\xedM\x18\x83s\xad\xcb\xfb\xcf\xe6\xf1\xa1\x1105R-\xfc\xddf\xa8\xc7\x0f1Ta \xb5I\xb5\x86\xd1J\x83\xf44\x93\x93\xa6d\xa8y\xb4\x87\x81\xb4\xab\xba\xa1XT\ah#\fM\xa0WYul\xb5\xab}\x84\xcc*ǻ\x7f\xa1\x8d\x98\x89\x1c\f\xe2\x16\xc1\xfe\xcb[\xfe\x17YU\xeb [\x98\xc6V\xab\xc0\v\xb3R|\xfeO7\x10i\x1d\x97-\x1a<\xee\xd0 \xfc\x03\xd2˝:\xdbb\x1eH\xf9\x01\x15\xbd\xb6\xb97ܿ\xc8Ɔ\xcbmƱ\xdcS\xb4\xff^\x06\xb2J\xa3\x84l\x8b1\x1af\xa1V\xcd\xd17iM\x06Mjm\x8c\x02\xb4>O&a\xd7\x17\xad\x15T\x8a\x97\xb1\xd1uz\a\xa2\x8d\x91N{\xff\x8d\xa7L4w@\x1e'\xd0\xebf\x02iG<\xaa\x9a.\vGc8\x02\xb9*\at*\x06&r*\xeePėf\x14\x85/g{\x18u\xd3\xfb\x1cd\xdd`q\xbf^\xdco-\xbe\x91\xc5Eu\x89\n3\xaa\xad^ۢ\xcdn\xabA\xda\x10Z^-\rP\x92C\x98\xe2\x9f>\x04\x18.\xe0\x97Ͼ\x86\xeckȾF\xeck\xb4u\xe6\xafi\x7fk\a\xe4\xedB\xda$\xd1˽\x9b\x1d^\xbd\xf5\xb0\x86\xea#\xc9\a\x95\x18>\x98\xc6\xf0\xc14F\x0f\xa61B\x1a[\xeb\xf5c\x92\xe5\xc4b\xb1{\xadj\xad\xdc7\"\xe3#\x9a\xa2\x05\xd0\xdb\xf0\xb2{M_ų\x19)H\x1a\x92zd,\x82[\x8f]\x0f\xd0h\xfa\xec]\x8c\x8b˖\x11\xbf6Ơ\xad\x1c\xaf\xf7@\xf3\x9bt\xca\xd5\xe2f\xb5\xab\x87\x97xVu\x02V\x84M*jG\x83$}\x93v\x8co\a_\xe2\x01\x92#s\xf8\xce\xf1Eg\xba2\x97۔\xf5.K\xb3*KIm0\xa6\xebJ\x8c@\xb8R\"\xf6\x840}\x01~\t\x93\xbd4\xaf\x1c\\\xabƷ+\xa9]/\xc2\xfb\x89\xe9=\xa1\x14\xe1|\xd0u\xd3mW\xc1\x94\xae\x90\x9f\x83O\x06\xa7\n\xabT֒\x97Է)7\x8e7\xe3\xc0\x93\xc2\x1f\t\xe5\xe2\xbfS\xbd\xb8\x91\x83\x1b\xe0\x8c'\x92U\x162V\xc2sx\xa6\xf3\xc2JDp\b#q\x10\x93>\xb3,\xe2\\\x83\xe3\x12\x11\xd7҅\b\xa5I\xec\xbem\xf2\xa0cp1ѕ\x8e\xec\b%˝l\xd7X\x8f}\xf0mޣ\x19\xf9l\xd7[\x8c\x9d'\xcdG\xe3\xb4N\xc5C\xf0\x94\xf5C\xaas\xb5V^\x1f)\x9789\xe89\x02\x98}~\xf9\xb0X\xd86\x97C\b\xc71\xfc\xf9\xf3\xab]mk\xabW\xc4\xfd\x18ARcY\xdeb\xbb5\x98-\x1c\b\x9a\xd5j\xc4\xfc\xfaE\xa8z\xecP\x88Dm\x93^ \xd3\xf4\xa5\x05\xdc72\x9b\x95D\x86)\xa2\x18n=\x14O\\\xe3C\xf6\x81mB\xf8\x06D\x19\xa7V\xd0V\xb1\x14\xb8\xb3Z\x83\x82\x04\xdbv|\xd0\xfc?qǇ\xafM\xf6;\xf0ր\xfe\xc0\xf7\xde7\nyj[\xbeQ\xbc\xf7/\xf1B\xd4\x1e\xdbF<\xe3\xcb\xe7_\xdbw\x91x\xb5m$\x1e\xddGRK\xdd\xd0T\xba#\x13c\xdb\xc9\x04wg\xbe\x90j3\xd4U\xdfRp\x0fc\x1d\xd5\x1a\xd53\xdb \xaa\rC\xb5مjӁj\x83\xa8֎\xca\xff3v\xb9x\xc2\xf1`\xb4ޟ\xad\xbdƮ\x16\xb6\xe7\xa3?\xdb4\xb3\xa4_\xd21\xfa\x02c\x7f\xb6\xf6[\xb0\xf5g\x1b\x7f;\xa6\xc6\xd6\x19\x8fש)\x00\xb7\x11\xab\x85\a\xaf\xb6yF\xf2VK7\xfb\x06h%\xd9 ë\x8d\xa7\x7fɮ\x1an~\xc2\xdf\xfd$.\xa75\x926\xfc\x06ݍ\xff\x17m\xb9i\xb6c\x13ʁ\xb7\xb8\f\xf4\xb6\u05f6;\a\xbdΗ\xee\xce\xf1\x8d]\xd2k\xbfe\xb7\x04\xa2\xe7j\xd4\xe9y_FP\x069ko\x7fZ\xfe\xef\x15n\x7f\xb9\xbep\xf3\x91$EMg\xf3\x00z_\xa6Ǎ\xd4\xe3f\x7f=n\xbeL\x8f\x9ap\xfb˥\xda\xc9\xc3\t\U000b208eB\xb5\x1fi\xad\xdd|\x11\xf9\xff\xc6{\xabv\xf9\x11\x11\n`h\xab%\x8b\xe2ok\xd0lg\x00\xbc\x80\u07bb\x1e\xdej\xf3\xb6\xb7W\x05(\x17\xf5p\xe5\U000f208eB\xb5\xb3\xeek\xe9m\xf1[\xb8Ȋ\xe8c\xb6*\xc2\xd6\xfd\xc24ck\xd9\x1f\x82\x02\xb7\xb95\xcbV4ck\xd9έ\xce\xc5\xd6]δ\xec\xef\xdc\xe7Lq\xfc\x8e\x9d\xceQ\x1c̳4H\xbeW\xb1e\x1d\xc9\x05D.\xde1!\x1e#؆\x065a S1k\rkK\xec\x86!*\xfa\x02U\x86_zP\x7f\x1aڡÊ\x88Α\xe2`\"\xda>\x90\x90rԆ\x11N}\xd4\x00\x97\xdbn\xf3\xb8\x9d_\x95\x9b\xe54K>Ɵ\x88U\x13\xe7\xf4x{\x99\x1f6y\xa3L/\x8c\x8b0!\x9d\xfb\xf1Xɗ\x14\xc8*\xe3O\xf2L\x0e*\xaa\x10\xe7n\xd9p>\xfeD\xc4\xf8\xfe\xfbo\xed3\x83\xcc;\x8f.\xab\x17\xb8\xea\xf3\xb5\xfc\xa5\xd2\xc0\x03\xdf\xf1\x81A\r\xf6\x03+\xb6o%\f\xd6q\xf9\x93z \x15'\xf98\xef2\xcd\r\xaa\xaa\xb0z\x95x.\xa3\xa7\xbd\xa6%MP\tA\xe1\xd0\xd1YH~\x8d\xa6\x8e\xdcy6w\\\xf7\x9d\x83_\xe4\xe5g\x9d\x97\xcd\x1f\xc7\vSƆs\xb3\x1f/\x1fW\xd3(\xbe\x8d#~\xb9\x10\xbf'P\xbb\x1a\xb6\\MŁ\x15\x11V\xa3g]\xc2\xe3\xc7\xc6\rs\xe6\x06\x9amw\x13ꇯ\xe8XQ\x900\az:r6αh\x12\xdfNB\x7f\xe2F\x93#\xe8\xf7\x97\x0e\\;p\xbb{\x14%/\xc7[\x9e\xc1`p\xcd\xee\x02\xd4\x01\x98t\x16^Tؗ\xf7\xec\r\xe0\x1a\x97\fl|\xebP]gh\x94R\x8a\xe2\xefVʱ\x84>\xf8h\x0eG(C\x83A\xcc/q=\x03z'*P\xf5ָ\xe8\v.\xce\x05\x13\xfe\xa5\xc9|\x17\v-K\x14\x02\xb4\xcd6\xa6d\x11\xdc\xc6Yq\xf5)˖\xafHR\x05\xd2Y\xa0r\x1e5@\xe2[\xc5EK\xa6\xf1NP\x0f\xdf\a\xea\xa9\a\x82\xa2\xf8V=\x01t\x1b\x971^\xfb[m\xf0\xe2\xf0E\x1cE$\xed~ hA\xe2\xf9\xa22\x92\xee\xe2\xa8Z\x18)\xd9-)fIv7\xa0(\xd9\xdbF]\xe4%\xc2\xde\xd0\xf3\xbc|ݳ\xe9늖\xed\xe6AA\xd2\n\xdf̯\x8f\xf4\x88\xf649\x9e\x94H*\x1e.\xe8ό\xb7(\x85?\xaa\x84\x8f\xd6L\xc0'\xa3\xb3-\xa0\xe2\xadc\xfa\xfa\xb7%N\xe1aĚT\xd8\xe5\xf8d\x04\x83֒\x92\bg\x1bB\xc4C/\xddVO\xe3+DĽ[\x10\x92\xd0:\xc7\xd8m\x80oRVA\x9c\xc0!\x9c\x9c5\xadH\x8a[7\xa1$\xd8d\xab\xeajJ\x97%\xbf\x0f\xaa\x05^>g<\xbd(.\xb4\xc0t\x1e\x129@\xe8\r\x0e4\x89E:\x0e$!2VG\xf9\x96\x04e\xf52[.\xb3\xf4k\x9c\x82\xc7\xc9\x14~o\x14I#\xb9\x1b\x16\xe7\x02\xf4\xf7u\xa4k`i\x8f&\x13į\xf4 \x98\xa2\x7fy\xa5\ve\xf3iHںi\xbe]7\x85\x1b1\x95(ܖA\x12ek\x10\xe4\xe0eN\xf7-\xdf\xd0G.\x89|d\x02\xb8B\xf0IT\x9d\x99=\xa7\x12\r\x8d\t5\x95\xf4uH\xc1\x01Zp r\xc4\xdc\x1b\xa5$^r\xd4\xe9rI8\xc0#\xf1Z\xb5\x10F\"b\xfe\x87\xd2\x11\x82\xf0\xc7!ul\xa0(\xb1\x1f-2v\xa3\xe4\xd2K\x80\x9d\nh3\x19}\xbd\x15][@G\\S9J\xe0M\x99j\t\x1b\x7f\t\x93-\x8a\rl\a\xa6\xbb\xc1\xa6\xb6\x03\x01\x82\xe1\xea\t\xfe-\xdd\x1c\x1f\xa8\xe2\x85a\x02S#\xb5\\\x04\x05\x89x\x96\x9al\xe2\x95\xc11Mx)U\x1bF\xb1@\xb9.h%.\xf2\xdaXh1:\x85|\x8b\xdegY\x11\x92WE0\xa7\xcdE\xc5.\x91;\x8b\xd7$\x82\xdf\xf8\xb6\xdc]\xc5\xd1Q7\n?曍\xb7\x15~\x87\x0f\xb6a\a\xd0J\xfbx\xbf⫪Y\xfa\xf1\x04F\xbbJ\x7f\x1d\x86\xab\xe5\x8a\x06f\xb8\x89Ձ \xc9\x17\x81\x03\xe1\x02=\x9b\xb1\xe8\x11⬚\xe7@\xb8Q\xcbsX\xc6e\xb0*\x11m\xf4\x11\xcdIH0\x13(\xf8\x06\x0fnx4\x1b\x9b[\xc9\xe2(\xfaS\xc6Q\"\xc2\nE\x85w\xc7J\xa1(\xac\xad\xa00\x16B\xf5R=>\xc2\x16\xa7\xe2\xf1Pށu\xa8!l\xe8@\x95\xd2\xc5\xedO \xe4\xbf\x15@\xb8\xd6\xd3\xe1\x10A\xe4\f=\xa0\xea\x1aٛ\xb6\xf8Gl*vs\xf3\t\xc5.\xd5r\xd6\xf8Sx\xc8\x03\x1d\xe8\x14A\x1aeK\xfa\x10\xa9{R\x13C\xbc\x96\xb7\x15\xf6\xbev\x8d\r\xd5\f\x8aE\x05(/4L\xf4\xa6>Y\x055M)\xb8\x97\xc2Xn\x04(\xd3\xd9\r\x1c\x1a2\xc8\xdcMK.\xd7ٽf\x83h\x9c!\xbe_\xa2Q\xd6m\x14\x8d6\xdc4\xf3\xb75\x8e\xb7qz\xf3*.+t\xdfFh\xc0\xfd\xcb\xd0\xdb\a\xc3Ǫ\xa0f݆a\x9bs(\xab \xbci9e\xe7\xaew\x15j9h\xe7nv\x15\xfa\x0e]\b\xdf\xf3+JGlFV̯F\xf44\xeanLED\n\xf1x\x9c\xbe\x00ظ\x1eS\xad\x92\xd9;\x91҅\xce\x7f\x91\"3P\x8a\x17\x10\xd0[,\xc5*\x93w)=\xc9F_\x81\x91^\xe4\x9a\xed\xb0\xdbx\x17ח\xcaoq\xf66\xdbj\x95\xf2\xf2.X\x7f\x8b\xd6n\x05E\x11l\xea{Y\xf8\xce\n\x8f]$OA\xc4V\xb4\x1b\xe6\xech\x1ag\xf1\xec@\x0e\xb0\xce\x00w\xfe\xa58\xba\xd2.\a\xc5&o\xddHL1b\u0087\xee\xb4\xd1\fP\x82|\x1b!\xfe{\xab\xb7\xaf\x96q\xd5\xf5.\t?\x90h\x15\x92\x8f\xabe\x8b%\x154Ϫ\x95\xf8\xb8Z\x8aWP\xb7!F\x94\xb9\x03u\xac9.j\xf3#\f\x1d\xc5\x17qYe\xf3\"X~\x13\xa7\x1f\xab\x15\xba\x1f\x8b_\x7fz\x1b$+R\xd6P\xb6\x97|\x83ݲ(\xa7\x9e\x04\x907q3T\xbcn\xb4\x8b\xb8\x87\xb8\xa9ö\xf7\xe4Ϡ\"\xbb+q\x90\x1dm\x15\xb7\xab\xf4\xd5#.h\xba\xfc1\x10:q0E\xd2x\x19}\x9b\xf5\xae\xf9\x1e\xc4\xd9\xc5\x1a\xadw\t\x87\xb0\x86\xbe\xd8j\xc2ş\xed\xc3*{\xb0\xa9U}\x17\xd8L\xf1\xca$\x9e\x8b{\xe6\xe8uJ\x02z{]\x91\"(\xc2ņ_:\x9cMq\xa6\xd4\x01\x99.\x88\xa9W\xbb\x1a \x0e\xf4\xf0\xea-\x1c熋8\x89\n\x92\xe2oJ^\xacճB.:V3\x9a\x95X\xdeb\x96\x01,\"\x10\xc1\xb6\xb2phC\xf0m\x8a\xab\v\xb8\xef\xb2P\xf1\x03\xd7\x11gZ\xa2d\x9f\xb6\x15\xd9ms\xf1,w\x1f\xad\xbd\xe4\xf2\xb64>\xa1\x8a}\xd0\xfc\x88\xaaj\xc1AU\xb8\x0f\x82\x8fx\xf5Y\xcbn\xcf)C\x01\x03\b\xf6GFk\x82\x8e\x8d\x9a-\xd5]\x92bNX\xa6yK2\x1bl\x89\x02\xb2\bO\x97\xea\xc0i\x80\x8b\xcb\xda\r\xcb4S\x15\x95\x85U\x02\x00\x1bӏ\xf9 O]\xa3\x88\x1b\xa2ph?\x06\x8aE\xa6\xf3*\x15\x13\x96\xf8w\x9bS\xc8\xd13vh\x91\xeb\x0e[\xfbN-\"\x9eoӒ\xd40\xa1G\xc1\x008p)ĕz\r\\O\xc1\xb1\x1aK\x9d\xf2Լ \xd8E\x04f*\x87\xe5Awh\xc2NwI\xc9&\at\xee\xf6\xe7a\xab\xd4\x15\xaeхUِ\x9cn잺x\x7f[@\x97[0:\x99\xba\x1b\xfa\xbdq \xc2\t\xff\xc0\xc5y\xf7\xa9[\x9c麏p\x19#*p>\n\uf00b\x10G\xb4\xc1_\xf8>\xbf\xeby\xdb\x023*n<M\xe2tn\xceSH\xd8)\xde\xccid\x01\xac\xdf\xc5\xc6[\xef\x98Ky\xa7?\n\x87\x02p\xa3B\xe8@>-\x88^\x97C\xf75\xe8@]O\xbfiý\xd1potܛ6\xdc\x1b\r\xf7F\xe1VÑG\x96ps\xf8W\xb6<\xba\x0e\xfbȪ\x8f\xe1\xe4\x15\x91jn\x82\x8f\xf6\xb8\x1eă\xfd\x8e\x10u\xa0R6u\x90M\x03\x04m\xc1\x81\xd0\x01\x9c\x93f\x81\x15\xa3D\xa9\xb8\xb3\xacx\x1d\x84\v-H\xc1JC\x17\xc4\xe5\n\x04\xbfr\x93\x1d\xdb52\b\x84\xa5\xb0\xdd\x0f<4d\x15*6ɡ:Rs)a*\xf1\xa9S\xa2h\x9a\x13ez\x98\xa0\xa1\x14H\xd5\xcb\x13\x02\xed\xb0}|;\xbcl\x1b\xbe\xa2\\\xdf'\x01oz\x0e\x84\x12\x9d \x10\xda]\xe5\x94G\xd1a\x02\xb3\x81\x86;J\x87\xd8*\x15̴\xd5\x1d\xc9\x18\x17\x03\xe4\x91\ftkG{\xb7\xca%\xf5\x10\x9b\x9b\xbdд\xe85z\xa8Z\aJܕ\xe1;P\x0e\xd5Ao\x8d>\x06ʺ\xbf;\x83kx\x84\xd3R\xf8c\x02\xd7Z\x16\xe2\xaa\xf3\xc7w\xb8uy\xa8k\xd4d\xbd\x04H\xf6\fv\xf0\xdfiA\x02\x19\xa7\xf3~E\xfb\xba?0\xe9r<\x13\xdd\xf04\xd9n4\xd5c\xe5\xe1#O\x8f&J(\x916\x81\x1b-\x05U\xd5\x14s\x87\xa07킶Jdʱ\x87\x84u\xbc\x98Q\xe2\xc3\xeb\xe5\x10\x9d\r\xd6\xf0\x04\xeb\xf7\xf1cl\\p\x0e\x81[\xd85\xd3Ѻ#\xc0\xcd\xd4|\xcfI;\x10\x8e\x96L+\xc6\xffŃ\x81\x9e\xd0؇\xbeo{\x12\xadBkH\xed\x8dSW\xc7}\xcb\xe0MN\xc2Yԇ\xf6\xa9\xe7\xe4Ǯ餜E\x1dg\x1f6Zz\xa1<\x8e\xfe:W[\x03l\x9bJ\v\xb1\x8b\u0099\x15\x95\xb0\xa1\tr\xda*\x94\xcb\xea\xd8O\x85\x85\x03!\xedr\xd5J;\xe2\xc0\xa9.\x1c\xa4`q\xfc-\xcfF\xdeK\xaf\xed\"\xa2\xb0\xd8\xed\xc6\xff_\f\xc9o\xec\x1d=4:{\xa3\v\xc6\xdffD\xa2\xa5pg\x97n\x9f+V\xd4\r\xcc\x11IHEtt\xca\xf35\xf3\x90\xd4\x0e\x1a?\x88UuJ\x86m\xbeu\xd4\v\x94\xd4\x10D\xe4[돕\xfah\xef#\xe7\xd6X\x8a\x96\x8b\x06#\xe7\xd6X\x8a\x96[\xe0\x03\xb6\xbc!c\xfb\x13\xe8\x05\vj\xbaC\xac\x84\v\b\x1e\x00\b\xf3\xa8\xcf\xdcv\t*\x8aӋ\xf0\x84\xbc\xcaD\xb6(\xcb\xec\xfd4\x15ES\x19\xff\x85\x18\xd6l\x8f\x17\x95\xacє\xaekGk\xf46\xd1F\xf3s(s\x84\xa1\xc3TÊ\xcdFD\x91}\x11E\n\xf1\xa3\x00\x15\x19\xf1H\x1b \x9a\xd2o\x1e\v\xab9\x01\xf7\x84\x1e;\x9ab\x1c\x1a`۵\x86\x881仞Uc\x92-\xcds\x80\x82\xe0\xd1\x16+\x9a\xe2\x04J\x88\x13\xbd\xf8{0\xa1\x1f\x87H\x0e\x11R8[G+\xe8c\xabD%!\xebR\x06S\x84\x90\x06B\x18\x0eq\x10\f,7\xda\x1b\xd15Ϩ\xa3\x8c\xa6\r,;\xeb4LVeE\x8a\x9f\x1b6\xc7cw\x1fE\x1dQ\x1d\b\b\a\xba\x06}\xbc\f\x05\x94\xb4\xed\xb3\x9d\xd4\x7f\xea\xa2.\x92\xc54\x98$\xbcv\xa0\x9d6\xea\x81\xe6\x88\xe6w\x8f\x13ep\xd4\xd6b\xb63\xf5\x96\xcc*\xc3\xf1\xecv\x045\xaeѰkd\xe1E\a!\x01Gw\x91\x8cw\xb9F^\xf2\x03\xeeM؛G\a\xbaٴZ\x9c\x8a\xdd\xc6,#) /R<\xf5\xb4\x0f\xc7UA\xc8G\x92\aE\x80\xda6F\x97\x9c\x9d\x80\xaf\xf5b\xbc1\x15\xbf_\x80\x0f\xe3\xadK\x82\x88\xf8Ϫ*\x91\x82g\x9fƼWAz.ޅ\x1cD;\xb8\xfa\xf3k\xa7V\r_\xc0\xe3G\x82\xf3n\xbc\xe3\v\xb3%\xaa}\x7f%\xea}U'\x9f\x02\x9d\x86ЁT,;v\xf5\\\xa2\f'\xc28cn\x02&\xedB\b´S\x13\xb28\x94o\x9c\xc2\xf7t\xa4r\xf7\x01-$\x98\x10\x01R\xeb4\xfe\x1e\x06Nk|\x99\x95\x1d3P8\xff0u\xd7{\x18s\x17\x0eٟ\xee\xc0\U0004a41ct\xb1\x11\x91\x9c^<?e\xbfv\xa0\xfa1.\xe3\x8a]Lȭ$H\x92i\x10\xca\xf8H\x96ÝZ\x15\a°+\xceV%\x9f\xb9i\xb1\x81N\xa3z\xb0Y\x19\x86%\b\xbf䖂K\xd2j\x89[\x92\xd8fp\x00\xc2\xcc\x04Mc\xb5\x1b\xb8\xa0m\x04y\fŷ\xb2\xd4X\xd9jj \xf5ڡAޛ\x1d(\x06\x18\x1c\n\xb8\xad\x8bEO\xf4q\x11\xb78HzK\x05\xdfe\xb0\u0de1{Ng\xed0-֪\x81\x83\x1bk&\x83A\x8c\xbb\"\xb5\xf6֢O\xe6H\x856h\x86\x9b\x17$\x89\x97\x18%S\xd6\xcc\xcce\x165r\xe8\a\xa62\x10\xfe\x89\xc6Cő\x19\xec\xdb\xde\x19\f!K\xef\xb2[b\x89-D\xcc{8@1\vyD&L\xe4O]\x1a\xeeX\x94'V\x93r\x9c/.\x05\x06\x88\x14*]-\xa7\x04\xa7G%:\x96\xc2\x19\x96\xa9\xbaX\xea\xce\x7f^I\x98\x00\x83\x96,\xa9$Mu4\xa3U\xdd4\xa7\xae\xeb-\xea\x12{\x98\xac\xdbx)\xb4%\x18\xaey\x9e\xdbxɻ&)\x91\xea\xf1\xb5\x8de\xf0\xa2\x05\x14\xc6\x12\xed\x0e\x8e\x96A\xfe}\x10\xbd_%\x89a\xf1\x9c\ta\x92k\xdeW\xae\xc5*Ć'lDB$@\"\t\x13\t\xa0hӽ\x0f\xbf\xc1\x8ah\xd0\xe2\x15,\xd5\x02\xd7\xc2N0j\xe5\xf9\x17#\xbcXEdl\xb4\fO\\\xaf¹R/X\xf1+#\xf8\a\"\x88$\x86h\xa3ey:\x9c\x98\xe2Dw\x1b\xd1\xf7D\x05o\x80K\x9ct\x9c%\xafY@}\xa8\xc9\r5Q\x1dm̂t\x90\x1bm\xd4\xfd\f\x80\x83\xb4Z\xc1f]\xe8\xd5`Ԁ\xa9\xfcmz\x8f\xca[+B\x8b\x8e+R8\xb0\x8c\x97\x04\x0f5\b\xd6\x14hyk\xad\x8a\xa4ّ\xe1\x04\x93[\x91uŲ\x05\x02m\xb8\x83\x99\nZs٘\x81=UTޢ\x11\x97\x84\xa6\xd8vc\xfd\xcad\x05'\x1d\x82\xeaCvg\x15ٝB\xcc\xd5Sdwl\x89\x8dB\xd1\xf5E\x9b\xdd\xfc#\xc5܆\x96\x16\xa8\xb1,0\x13\xf6(\x84[a\x90\xc0@^\xc0W\xbd\xaf\xf0*\x1b\xb2\xae܂\xe4t\x9c\x7f\xf4K\xefh\xee\xc0W\xbd\xdeWx\x80\x00!\xc6\x14B\xa7\x8b\x96\\\x90\xefQl\xfeH\xe9\a2\x7f\xbdέ\xde/\xc5/\xe9o\x17x\x02Ar\x8c\xc720\xf9\x12\x97\x97\xe7=ۑ̘e\xbf\xba\xe8}\xd5(\x97^b\x01\x99\xf6\x92yZ\xf9\x8d^\x90&~]Y\xe2i Y#0\xe9\xaaG\xe4\x7fA\x82\x88\x14g\xa6\x9ad\xd9\x0f\xd9]I5\xa9\xd9B\x91\xdd\xd1#̟\x0f\x8c)U=\x81\xa1\xce\xf0\x91\xcb{\xc7\xd88\xc3\xc8\xf1>TPm\xdb4\x93]0Ћ\xebK\xdc}Pdw\x17\xd7F,\xc2Y\xcd\xce\x0e\xb6L\x9d2\x1c\xac|Ka\xb5\x81TU\xaaf\xb35E\xa22\xea\xcat@\xdb\x1e'r\xa0\xcan\x88y\xf6\x1dU\xc4M\xc5M\x82\xb2\xa2\x1b{0X@$\\\x1dr\xa7\xed\xeb\xef\xde(\xae\xb0$ɴ\x9d\xc5\xf8/\xc9\x12\xe4$HJ\xd2\"\xd6\xeb\xef\xde6\xa5R\x9b\x98\x1a|(`$F9\xd2\f\xeaڦ\x1b\x81G\xc7\xcd\x1aƸ躥\x16\xe3~\x1f\xceM\xd1\xf4\xa2\xedt\xe2v:\x9d\xe0t\xab\f]U\xc1\"\xad+\x02\xdae\xb4ue\x98\xbf\x9bU\x83\xd7j\xf5\x95+\x17\x12\xe3,\\;':\xa4\xd8\x17:\x01_\xbbWP\xaf:}\x9b\xc7v\x19\x87L-\xbeg7\xb94\xc5\xe3\xf6\xaf\x11\xf7\xf6#nj\xa2Z\x15)\xfc\x1f\xee\xdem=n#K\x13\xbd\xd7S\x84\xd2Sڀ\tB\x99IJ\xe2\xc14?\x97\xecj{\x8f,{[\xae\x99\xea\xa6X\xfcp\x88dBD\x02)\x00If\xaa̺\x99g\xea\xbb}\xd5\x0f\xb0\xe7\x95\xf6\xf7G\xac8\x01\xc8\x03%\xb9\xbbj\xda\xd5T\"bŊu\x8a\xf3\x8a\x15\x82\x92z\x11\xd7M\x95\x15\xd7\xde;\b\x1b\xad\xdet\x90\x03\xea \xff/\x8bs\x83\b\xb2\x9aY\xb6Ɨ<\x11\x1d\x89\x05\rB\xad\x00t\x86\xbe\xd9\xc5\xf0\xd2\x16\xc3P\xaa\xd9\xe9\xfeN\xb7\xd3\x1c\xb0\x99t\x17\xed\xa5\xb0#L\xa5\xd9vߴ\x06\xbbF\xaaP\x82\xe5\xef~zE\x9d\xdew?\xfd\x89~U\xb2\xdb@\xec\x0e,-\x86\x01\xc3ŌRw<}\x84ФA7(\x0f\xe7\bԫHa|\xf7ӟlѡ\xf2\xc8\xf2\xe2\xb2\xca6\x04\xffJ\xdcR\xeb+\x8c\xc3Xq\xa5\xc0\xbc.\xafC\x1d\xcb:\xfb\x04\b\xf5M\x80\U000f11da'Xs\x17{{\xbe\xdf\xe7\b\r!ț\x10QGp$`\x80t:\xdeI\xe7\r'\x80\x19⭲֔\xe1\x97\xf2\x8e&\f\x83\xb7\xc5\xc0w\xb0R\x89\xb4\xee=\x1d\xb9\xe6\xe5\x15n\x9c{\xf8\x83h\x1dҿTL)T\xad\x84ASD\xfeXm\x9ad2\x04$p\xe1\xcd\xf1\x9f\ue29f\xabrΫf\xa5\x9c\xba\x90\x879\b\xfe\xad/\xac\xc4K\x82\xc0\x16\xa3M\xc5\xe9\xfa\x89 \xe2\xd5]ɫ\xab\x9e\xbc\xe0\xdc\"y0\xa3;\xa2\"\x133\x8b\xc8\xfdl\xe5\xba\xf7O\xb1\xa7\xffQ%\xf7ܒ\x1fz/\xa7B\xf2ⰲ\xf6JkT\xa5\xf3a\x93\xfbk\xaf0\x85\xc8|\xdfE$@/J%N`\xdd\\\xf3\x9fx\xd4,*\xee\x10\xe0\x00xex\xcd\xcb\x19o\xaa\xd5\xce\xd8^\x969]fu\xf0\xea\xd0\xe4h<e8\x91Uׁ\xfd\xe6\x85r[\xee?Hu)Cԛ\x0eq\xfd\x8b~S\xea_\b~7\x1a\t{\xf6\x89T\xeeJ\x1cb-\xbc\x91\xbd\xed\x06\xa2\x92R>\xc7\xdb<\x88\xaa\t.=\xe6+\x0f3\xc0\x80Av;\x91\xf4\xe3\"o\xb2ߕ.\x85$\x06\vBX\xe4\xe5\x8d\xd14\xd6e\xc5,\xf9\x94\xbd\xb3˶\xb9\x8a/\xde\x19\x1f\x96\xfb]\xb9\xfb\xb9\xccW\xd7e\xf1\x9f\xc0\xda\xc5\xf0?\x95;\xf1\xb8\xbe˖\x83\xd0\xe1kK\xd3~\x98\x90\x04\xa3\xbb\xcb顦y]\xf1\xa8\xf9\xa6J>\"Ȇ]\xfc#\xe2l\xd8\xc5\xed\xe0)z飁\xad\b'\x9e5T¦\xfe\xa8\x0f\x9e\xb3\xc2kĹ\xb5Ͼ\x84\xab\xcc7vN\x8a赔\x81)\xd27\xf8\xb9D\f\xda?\x8a_\x88\xa2\xaa\x92W:y5\n\xd8\aJ\xaeurm\xc2\xeb\x10\x97\x17\xb2\xa2\xa8\x89\x8a\xb1\xb7\x12Q\x11\x9e*\x0e1rEEM\x8e\xfc\x12\xe4\x03}\x89\x18\x0f8\xaeV\a\xda+\xbf[\x92]\xb6w\r\x96\x14\xf2>\xc1\x9f\x1a\x7fn\x90t\xb3\xa2wcAv\x82?5\xfe\xdc \xe9\x06\xbf\xd2@{K\x18\x81\x86)]\x1e\xb2\xa7IF\xc6b\x045\x97\xd4\xe0\x985R7\x0e\xa4`I\xca\x11b\xf8\xe9\x83\x7f\xacص\xa7\xa7\xa0d(%\x87sf\xf1;Y\x8d\xec\xb0\x1d\xeaeb<qܒn\xea\xcc\xc0\xech7\xd21zM\xd4\x1aH*\x111\xabL%\xf8\xba±\xe4\x97-!\xe3r\xa8\x81\x05c\xd6+\xc9\xc9\xca\xc1\xb2\x92XF=XT\x89ڔ\x00\xae\x95\xc1u#j!\x11,\xf5L\xfdfe\x92k\x93\xac\x8ebZ\x12\xb1d\xb0V6\xd25|\xa3lF\x8elF\x1be\xa3a\xc1\xcf\xd2,X\x93\x95\xce\x11\xb2\x19\xed \x1b\a\xd7\xca\xe0\xba\x11\xd1̀\x11\xb2э\xecfe\x92\xeb\xe5\xe8#eӟ\xbd[\x9f二\xab\xbd\x8b\xb5\xe0\xe8\xc2\xc86\xbdȧ\x1eP\xfb\xd0f\xba\xc5y\xbeK\xda\x1a\x82fWX\x11\x95\x8bꍸ\xfc{]e\xba\x97U\xbb\xe1\xe8\x10\xcc\xc2O-\xdd*\xb3\xea\x90\r\x19E=\xf8-9ǀT\xfd\x85\xf0\xe0b\x97\xee\xa8(\v.\xc5.\x82\xb3!\x80j\xb1ͮ\xf5\xc1l\x12z\xf6\xd3P`\xc9\xf6\xdb\x05\x1c\x1c\x1bG\xe2\xd9\xd5t\x91\xe7/_\xfeO/\x1b\x05,\x1b\a,;\b\xcc\x153Ȣ\xb1\x9c\xaeӀ\xf1\x80M(\x84\x04;c\xb7\x17\x99\xf2{\xc6L\xa4\xd1^\u0558-5\xda'\x9a@\xd56\x7f…}\xa0\a\x04\xca\x1dЉ\x03JB\xf6&8A\xc6@\xe4%8\xb9\x92\xceP:\x8d\xcb4\xc4RY'\x83\xb9\x9c<\xfcP\xd4\b53\x97\f+\x19\xa8ZD\x8c\xf3}\x11\xf1\\ॗ\x83\"\xf1r\xd0Wȷ\xbe\xbf\xd4\xef\rE\xebBe\xbb5\x93\x87\xad\x97\xe8\xe0ζ\x0ed#\x16\xb3\x96\xe5X\xc7sZ\x1e`\xea\"S\x0f1\x19\x15?e\xcb\x16\x0f\x01\xae\xc6\x14\xf1)`+\t+~JX\xfc\\\x8e\x90,\xc7\t\x84\x17\x1f\xa3\xecr\x8cODM?\x14\xb9\x87\x94\xbb\x12\xc0+\x00\xaf\xf0)\x80W\x00\xc6@\xb8\x12\xc0\xabC\xca]\xc0\x1c\xbc\xe5\xe1\x01\xfbR\x14\xdc\x17\x00_\xb2%\xf6ߞ2\x8f\xbe\xc6\xc0FP\xe3\x91\xef\xe8\xf5\x02A)\xf7\xd8\x02\x8en\xcb1\xaaП\xab1=m\xdf\x16\xeamY\x95E\x99]5\xbc\xaey.\xba\x99[^5Y\xc2\xeb\xee)\v$\xfb&k\xc4\xdd\x06զ\xf2\xacnN\x98*\xe3\xde+\xbam\xed\xb2\x13\x9d&\x01#iʗ',S'Et\xa6t\v\xcdXI\xab\x13v{1\xba\xd4)ԟb\xa7;\xc4\xe57S\xa7m\tV\x9d\xf0\xca\xfbJ\xf80\x9e\xb3}\xf8%!\xe1kJ\x90\xdf8F\x83\x93\x86\x06X\xb2\xaf)\x01\x00\xa6S\xf1\x15eq\xd94\xe5\f\x12\x91\x8f\xf1\xda]=d\xf5]z\xcd_eu\xd3\x11ׅf.\xe7\x93\xe6\xbb\"\x95\xe5Ub\x05_\x94N*\xaex\x9c\xf4M\x8f\x98\xae($t\xec\xcc$%\x18\x1a\xf8\xf7Q>A\x12\xcd\xc9\a\xb9\xdaSr\x8a\xab\x8a?\xb6<U\x1fV6\x02\x85tC}a\xce\xce:X\xfa\xf0\x03ߢ\x10g\xde^\x1b>\xe8\xe2\xd54\xde+!\xba\xdcX\xe2\xe4).\xba\xa2?\xeb\xb1\x1e\x93\xc0\x18\x00O\xc4_\x85\x13\xff\xa1\xe0\x89\xf8k\xa7\xa2Y\xf0\xa5\xabF\xfc\x97w\x93*˄\\\vWP\x99\xb8Fb\x91\x9c\xc7\x01\x9b:\xe4N\xb9\x90d\xae\x9dI\x19\x9br\xa1\x8dܺ]\xc3ė\x80\x9cr7\xd1I\xd25C\xad\x7fĪѪ|n\xd7\vc\x9f\xf2\x8d:LK\v\x1ed\x89\xaal\xa2\xee՜a\xca\xd9c\v\x95R&\xf6!\xb1\xdb#\xad槉7\xc5ɼ\x99\xa9\x1b\xa4\xf9i[\x81=L\xa5<\xb7\xd8\xe9\xca1\xac:\x14N\xb9\x16\x9b]ǔ\x870\x87\xd6TPW$\xc8][\x95&0\xac:%!\xf7\x1d\nv\xabD\xc1_\xf8uV\x16;\x14\x97\xb4K\xe2\xd99CwV\x87\xa6kc'\n(\xac\x04ʋ)\x0fa藝j\x05\xa7\xbf_\xbd\xd6pU\xce\xe7e\x9d5\\\xd3b\x88iw\xc20\x19\xab\x03\x8e\xc5\xdd \x8b\xbc\x1a+C\xe7&\x19J\x15\xfc\x0e\x06h\x15\xc4\xff*\xe2\xcdN\x13\xad\xb9\x1e)\x19\x10\xe0\t\xab\xc7V\x8a\x16\x11\xfe\xc7\xe7=\x18\xda\xfdA\xb7GP\xbc9\xbd\x03\xb9\xdac^[\x8f\x85\x87a=\xd2.\xfb\xf5X\xf8\xecףp\x15\xb0H8\x81\xa4\x18Ԇ\xf0\x0e^\xb2\x13\xb6\x9f.\x91\x819s\xbaR\x19+\x91A.\xe0\xf8\x1f\xc9\"\xc4,\x14ؕ\xf7;\xf0\xe27f\xcf^Ǳ\x1fs>;\x96\n\xa6\xf0\xa0\xe1kTh\x8b\xdbT\x10\xb5\xaf~\xa9\fL\x8e\x85cJ\xba\xec\xcbN\xd8\xd33\xeddo\x9d#\xf6\xd5\x11\xaf\xab\x03\x95\v\xaf\x99t\u0557-밲\xee\xdb&M\x90\xa7\x8fZ*\xcf\xd4\\\xd52:\x9e\x8f\x02\xc6\xf3\x8e\xd9qL\x11y>\x12\x8d#`\x1c\xf3Q\x9e\x8fCn!\xa6[\xa6\\\xbcJ\xfb\x98\x8b\xab^|D\x8d\x04\xbd\xd6\x19\xe3c\xfd\xe9JZ\x91j\xf5S6'*J*\x1f\x85\x98D\xf2q\x88k\x11|\x14\xc6\xf2K\xdf\xceX\xf3T\xc9h\xf8\xe0\xea\x96\xf2q\a\x8f\x8f\xc2Īr,\xbfF\xa1\b\xf0\x90\x06l\xa5\xe0tN$I\xa3R\x11\xc1\xf1\x11\xfamK\x1e\x90b\xc5\x1c\x99@\xf4\x01k\t\x94\x8f*1G\xe4c\xfc+d\x8a\x1fg(\x89\x1fO\x9e\x88\x94%\x81,}wV\x80є\xe7\x8eea@䣍VIŜCzQl\xbcN^4\xf8\x89\x1e\xf2LJ\xefkD\xfaS\xbc\x85K\x97-\x1b\x1e,\xe4\xa2\xef\x16K\xe9A>\x80\xed<\xde\x04R\r\x1e\xa4QʶK,O\x04\x95v߶:\x11\xea\xec\xf6f\xba\xcd\x10IV\x8b\x91\x83\xbdE\x8b\x8a\xd6H\x83D\xc0\x9ar\x8e!\x81\xd9\xd2\b\x98\xcd\xdc\x19\x9b\x87\xe8\x80\br\x8b\xa8\xa6\xdc\x11U\xaf\x1cl\xed\xea_\xc0\xf5x\x13\xb25B\x1d\xaeC&z\xc6\xeemVH ]!\xe0\xe4\\\xf4\xf3\x8a-\xdc\xe8_Ή[\x93\xbc\f\xd8$\x12\x8b\x91a\xc0\xa2\xb8\xbc\xb5\"\x91\xad'\x1c=\xe6Wl\bC\xe9\xc9q}\x85\xe9$\x9ePSe\xa0PX(\xfa\x90t9\xb7+\xeci\x11\xa6<\xa8\xdf\x13\xac})J\x7f\xcdxغ\x1a\n\x82\x89\xc06\x15\x06\xcfc\xf1\xc3-x\xdfA#\xa1\xbaX\x88\x8b\xd1\xfa\xf2\xf6o\x81\tEڈ\x84\xae\x96X8ku\xb0}c\xa8\xb9m\x8b6\xf1\xe0\x0e\xd7֖s)=\\{[\x89߫9:\xddt\x89Ǔ cz\xb3hLpOE\xd6\x1e!\xe0a\xec\xff\x0e\xa2\xbb\x7f\xb4A\x93`y%\xfa\xb7\x10\xdbL0\xe2/\xd1\x00\x03\u058c\xb4ͮ\xf2\x805\xe3>[m\xc4\xf6Hn%:\xe3\xb2\"\xb1\xc1Fm3B\xab\x06\xeb\r\xe2\x0f5\xd8\x1eit\fV\x9bNjj\xad\xb6\xcdΉ\xe5\x93\x16˺C\xe2E*N\xdfzW\x8cX76\x8e\xed`\xbc\x0e\xf9\xfc\x02\x1d)\x9c\xff\x90oȁ\xec\x1f+\x90\xbeY\xad(v\xe9F\x86p\x9cVQ\xd8\xef\x10\xa9\xf6t-\"\xf10\xbeM\x98\x99-\x8a\xc9bc抲\vQW\xff,Y\x99S\xa2\xee\x1co\xed\xac[Ķ\xfd\x7f\x16|\xc17l~tV\xb4\xe8\xe4!\xaa\x80\x95\"\x8a\x99M\xf9\x94\x87r9\xdd\x11甇+Ĥ\xa4\fq\xff\xb2t\xa2\xb4[G\x9b\xd84\x1f\x06\x82\x14vf\x91)\xf6\x16\x02\x06{\xc5/\xe7|3o\x9do*&\xe9v4\xe0[\xd7L\xa0aM\xd6\xd7\x02\x90>~\xfb͢\xf7\xcc\xcey\xf2\x84Q\xc7@\x05$\xbb\xed\x99\x06\xeb\xf1MZӓv<\n\xef\x1fu\x7f\x81z\x15\xb16\x13\x11k\xa7\xdc\x7f\xd0j\xb9#\xdbz\xaddkW\xae\x18`rܢǂ\x7f\xcaU@7\x8b8\x1dL7\vبK\x16\x9f͛\x95E\x98M\x16\x99o\x8b\x10\"@\xccn\x86\x1d|\x90\xbb(\xf0;0\xdb\nW\xa7\xccD\xf2\x7f\x06\xfe\xb5\xfbl\xae^\x06<\xedQX\xcf\x14L\xd3?\xcb앷]\x19H\xe69\x9fu\xa9Շ\x05\x16r\x9b\xc8\xe5\x89(H\xad/\\\xb6fr\"O\xd8\xf3\x86\xf9\x1c_6U\x944?\xae%\x8f\xeam\x91\xd6~\x8d\xd7\xe9f\xf4\x06\x116F\x15Lg\x17ጒ\xba\xe8h\x99\xbf\x16*\xc0\xd2\xf0\x87\xa2\xc1\x89\x97)\x91\xc7\xd8i\xac\xc4ߜ>\xe4\x97\xdcY(-ظlĴ\x14\xcf/\xcd\xe6\xe2\xa1\\\nj\x0fL\x98\xd0ζ\x1c\x96=\xb6\x04\"l\xddsN\xcd\f\x81\xaeZ\x116\xa9%4\x8a\x9aC\xfc\xe2\xc2g\x175&y$\x11\xb1\x142\xe8Õ\x9bwv\xe6f>y\xa23\x97n\xc1\xa5C0\x04\xd6\xde&\x14;\x8b\x8a2M5cU\vVL?=`\xb0\x80\xe2\xb2\xe9\xc0\xc8\x1d\xa86$\x06\"\xb1}(\xf5\xe4\t\xe5tkUZܰ\x05\xce\xd7\xee\x7f\xcb\x01\xcd\xcb\x1d{\xb0\x00\xe7\x8a\x06\xbd'\xb0\x1e\x16\xear\x16<\xcc\xd6p\xca\xf36\x83N\xbeC\xc9<\x90\xb5\xea3߹\xe1\xdc\xef\xebbHM\x8a*\x03\xb2\xa3t\xaa\xcf%\x1d\x05%[\xdc\xceҡ\x9aL\xe9\aK`S\xc7p\xfa\xc8\x19swh\xa7\xca\xec- \xdd!\x1a|\x8c\xe5\nP\x89\f\xed\xa3\xad\xe7\x9d\xdaE\xd5\x0fUml=f۸\x8d\xae)\xe76\xa0\xdd\xccZ(\x11\x02\x0f\x85i\xb80\x19\xc2\x02\xd54Z\xe0\xa7u\xbb\xf8\x89\x19/\xce\xd1\xd7\xc1W\x06\xbe\xea\x87\xd7\xc4\xf54\rK\xf0\xc8m\x91\xec\x94l\xe5\xcd1`\x0e\xf2\x81kyq\x89\x80\xd0b#!l\xede\xa2\xa7G\xd3)\xadɧ\x12t\xa3\x1en\xb0\x85\x8a\x02v\xaa\xac\xb1\x1a\xf4Yeo\x1f֔s\x8b`e\xf3\x9b[\xe8|\xd6ǿj\xa0k[\xa8\xab\x13\x1e\xb0\xbe\xc5\xcb|v\xe9j\xa6\xaf\xd7\xfb\x94n\xaf\xa5ܾ\x96\x9f\xaf\xef\xf8\xe2\xb2\xe9o\U0009bedf\xcf\xdf\xff\xac!\xa43\x93wf\xf14\x032\xee\x9a\xed\x1eC4\xcd\xce٨\x7f\xca\x04`߹\xda)\xebǁT\xab\x17\xd3kO\xddt\xfd\xd3͞2\x88'\x8e;\xc3xW@O\xf6h\xa2\xa7\xb0\":\xfb\x89\xb8Σ\xa6\x8a\xb8![۫D\x11\xc9\xdc:\v\xb9?\xddV\xa1\x88{\xe0Mԝf厸\xc4\x13\xf2z\xcb]t\xdb\x13\xaf\x17D\xc1\xd0e{\x1c\x9c\xc07m\x8f-\xc7\xf2X\x03΄H\x14\xbe\x84+\x9d\xa8.ɫ\v\xbc@^\xf7\x85F\x80\x8f\xcbz\xb2-(CZ\xbdD\xa5~/\xb6\xd1N\xd8\xe0\xc0R/\r\xa3밍w\xc26\x16\xee0\xc0BX\xc7\xfd\xd8\x0ev\xc2v@\xb4\xd5+\xad\x84\x1d\xadK\xf6E\xf35\xf6\xb5<as˗d\x85\xcf\xd1\xe5z;j\xb2\x19\xbfZ4\x89\xb6W\xbc\x0f\x17^\xd1\x05\xd7o\xe1\x1f\xd3~\x84\x10\xf1-ٹ\xc8\v\xff\xfc\xebK\xf2}n\xbf+\xc7N\xcc\xc7:\x0f'Q\xb9\xbc/\xf4M\x1cW\xfc6C}E4\xe3-\xee\x90d\xdd\xe4\x1a\x06\xec`=\xc29n\xf7\"\x16<\x97\xeb\x90\\\xfc\x92E\x03\xf3|:,=\x11=\x15\xadl\v\x1a\x9c\x00O\xacJ/wY\x94\x92\x9c\x05L+\x1e\a\x9a\xd8;\\\x11\x9d镭\x89\x1a\x93\xd8\xe8\xed\x1b\x83{{\xae!\xe1@\xeb\xe0\x85\xc1*\a\x14\x87\xbb\xaa\xbepP\x11\x1ak]\vR\x1eϱ\x88\x11\xef\x85ͽ\xb4%\x05l\xa6\x0e{ȴ&y\t{\xac\x99\xb7\b\xc6\r\x06\x9b\xba.\nmț\xe3\xca[\xea\xffE\xaa\xbd}\xfbɾ\xa0\xfdW\xef\xfc\x047\x96\x04\x9c\xf0\xbb\x121\xc2\xdf/ʆ\xab\xabc\xbf\r\xe8\xed\xb8\x80\r\xb2\xc16\x9b{U\x967\x8b\xb9[1\xacb\x16\xcd\xc9\xfeӃ\xab\x1f\xa3y\xe0\x84\xb3\x13\xe0}Ơó̢yX\xf3F\"\xc6\x1d\x9f\xa6|U\xde\xf1\xeae\x84\xd7\xc5\xe1#渱͢\xf9ZB\x85\xb6\xff'\xe77i\xb4\x92M\xa4\xa5H\xbd\xc1\xa2Jh\xc0_z\xef'\x82\xbf\x82\x9d\xf5\x82\x8b\x1b\x9f\xa4o\xd3\xd82\xdf%\xb7`\xe7\xb8h{\xc6\n\xf3\x9c\x02N\xd0G;1\xb1\x03\xf9;\x13\xfe{\x93\xfccY4\xd3ݤ>3\xa0\xbb\x91\xef\x16؝\rAH8\xeb\xc7$\r:\x84\xe3\xb3\xe0Ա;?\xe8\xc8\xc0\xdfU\b;\xb1\xff\x00\xc6?\a˿\a\xb3\xafJ<\xee\xf8'\xc4cY\xc31\xd1\xe5\x94\"X\x95&\a\xb4:L¦\xa4Kg\xbe\x8di\x17\x120\xb8~\x06\x12\x96\x9f@¯\xd9\xecs\x90\xf0\x97\x8f\"\x01*\xf8W\x1eU\xeb\bP\xc02\xf8\xd0n\x96\xa7aט\x9e0\x19v\xb8\xd6\x02\xe15\xb4W\xd0Ͱ\x87\xdb\xd6\x7f\r;\xe3\x8d\xec(\\|9\x8f\x8aTP(8|x\xf3\xb10t\xae\xa3\tw)\xf65{~\x04\xdf\xe3\xe3\xe1\x10a\x12\xf9\xb6\x19\x9c\xe8x^\v\x9d\xfd#\x89\r]/\xc4\xc2\xf6\xd9\xe8\xc1R\x12\xb6\xfdm\xb4\xfaGb(\xfd4\xb3\xfe\xbe\\T\xe3\xc3\x7f$\x86\xbe\xff4\x86~̊E\xc3\xeb\x7f$\x8e~\xfc4\x8e\xde\xf0\xa4,\xd2\x7f(\x8e\xde|\xaa\x8e\xf2<\xab\xff\xab\xd8:X\xcb֫Oc\xeb\x9b\xd9ϳu\xec\xb4I\x8df?Ϭ)\xd0\x1aZ\xcf\xd8\xd8o͌Z\xa4\x1b\x87dq\vDT\x1f\x8a\xf5\xcf\xc6Q\xfaCYX\xf7\x96A\xdc\a\x98\ah\xf95\x9bqd\xff$\\\x12\xb0\xe0\xf9\x803\xe3\x0f\xe4o;\xd8\x1f\xb0\x136\xd8\x1b\x04\xecÔ\x9d\xb1\xbf\xff\xdd\xf8U~\x80S\xe3\xf3!\x8a\xcc\xd4}EJ\xff\x03{>tH\xff\x00o\x1fM\xce$\xcb\xf3\xb1\xf7a\xea\xf7$\xceֳ!g)?\xd4\xe5\xeb\xa8\xc9n\xe5\x1cF1E\xf5 )l\xca\x1f\xde\xfc\xa4\xa61k\xb1\x89s\x9c\xdb(\xf7r̠\xd0\xd48N\x9eD[QXu\xa1J\x1c\x03\xda\x15\x92\xb7\n.\xa5\n\x04\x92\x9a\x80\xa5p\x1f\x92\x0e\x1e^:\xb4\xfd\x02,\x12\xe1(5\x84\x83\x14.L\xa1 |\x9e1Ҧ\xa3\xde\xc0k\t\xcfr\x87]<\x1f\xcc\xe5>\x81&\x80\xd6\xc0\x8d\x9a\n\xb2}\xbcu\xb6\x86\x82\xdej\x14\xd9¨o\xfa\xeb\xb2k\xd9#\x9eov\xaeA<\x8e\xe65C\xb8Y\x05,\xb5\xbc\x7f M\x90\xce\xce$\xb7\r\f\v\t\xf6k\xf0tۺq_mыz\x80#0V\xcb\x15\x91\u07bd\x11\x9a\x150>\xfb\x03\xea\xf6\x19>\xd4s\xbfj\am\x0f\x89\xaa\xe5Y\xfc#\xd9\x16\xe6\x86M\xf1.9\x1b*\n\xfa\xd1\u07fb\x12\x15\b\u058bT\xec\n\xf6\x89\xd5~\x19\\w\xb3Vϴh,\xafE\xe8`\xd1$\xae\x96]\x90E\x93\x88\rǆڷE\xa2\xd4\xed\xa2I4\x15\n\xe4\x9eM\xb2\"\xca\xf3~R\xbe\xd5Ƣx\x93\x7fE\xb3\n'y)\u038b\xc4ש\x95!\x9a$B`\xe1_;\x03\xe6CVd'K\xe3֍\xd3Ϊ(zje\"\x9c\x1aQH\x10\xf9\xbb\xddw@6\xb2\xff \xbdA<\x8ab-7$*j{1\x88L\v\x03q\xd0\v\x8b<\vTs\xd5\vL\x8ej\x06\xdc\xe1\xd4h\x964\xa8e\xbc\xb1\xc3D1oƛi\xd9^<\xa8\"\xdd\x1e\xe4\xf72C\xd3\xd1XdHڤ%\xde\xf8\xe1\xd5G\x9b\xe1ZQ\xd4X\xf6{xR0BDPQ_\x1dP|Lŵ.%\xa1-O9\xa2S\x96\xf7\xdcG\xba\x04p\x98\x15\xb7\xbcrb\x13t\x8b;Āz\"\x88\n{KՉ\x91K\x92\x80\v\xd3r\x16e\xc5\x1a̢\xa7\xd4'\x01z2\xe4\xd0K\x18<\x9f\xb6u[DP\x9d\xac\x05\xad\x98\xd4ԋ\x02=\xf4\x15\x99\x1b\xaf\u008a`g\x97TxӃ+\x81\xe9\n\xe5<'˷\x82j\x1a$\x1a\xcdL\x11t\xdf+\xa7&Kn\x9cp\x943Ǡ\xd1?\xf0e\xc3\v\xbb퉒߉\xd4\x16)\x9a{H\x18\xf1\xb2\xca\t\x9b\x89Xq\x03U\x81\xe3v\x0f\xf4\xf5<\x82\x9ed-\xf2z:\xfd\xc6L\\G\xaf\x10`O\xd9,\xa0\x90\v\xea\xf8\xdd!\xeaM\xc3\xe7\xb5*䟺\xa1E\xd9Y\x8b\x05\x01\xdd\xd6>Yy\xb8\xc2ƃ$$`\xb3\x16\xae\xc7Y\xdbZ\x84\x1c\xbd\xd96k\xb1\xee\xec\xe4\xe5\xb5G\xdc=\xed\xa1\xeb\"\xa3\xa7!\xbeb\x1a\xbc\x0f\xea\x92=U\xfc\xfal\x7f\xdfzW\x17\xb3U\xe2\xc6\xf1\x8c\xbdA\xba\x8eG\xa0!\xb1ڱF\x06\xd5P\x8d!\x914\xc4J\u008c\xee\x948\xba\x84\xaf\xa45I\xeaX\x99\x8eR\xabM\xcdX\x02U!;\x96\x9e\xf2I9_m,\xd9\xd7c\x89R\x98\xea\xb7;.\xa7\x02\x8d@\xbd\xed*P\x04\xa4W\xf8QA&\x03\xf5\xe3\x17\x8ca\xf8\xb2\xe2\x87\xe03ɣ\xd9|\xc3\xe9\x8e\xddfd\xc3U<\x886\x80\x80\x1e\x18\xddD\x8e\x90p-=S(E\xfeC\xb6*\fá\x1e\x9e\x9f\r\xde\x1cC\xa1sv!\x13\b\t\xde\xde@J9\x0f\xa8\xa2\xcb\xcdd~\xeb\x84W\xa2*\xb4ƛ-LJ=S\b\xc5\xf6\xe1\x99*Қ\xe5+_^*d\xf1)_\x15\xa6tˌi\xee\xf9xr1\xba\x94\xc8|\ar\x7f\xdf\xc0\xaa\xba/\x86\x04j\x19\xc0z>\xde\xf0Fl\x1dj\xdf\"u1OKb%<\xb5\xd5\\6\xc5\xf9\x9a\xde#V\xc7\xfaTw\xba\xb9\xae\x7f\xa1\xba\x9c\xc5\xebJ-^5R\x04o\x1e\xb2\xb3utҪ\xac?\u05ca.K4\xc1E_\x84\x1cI\xc5\x03?\x9e\\\xa5\xf5\xbf\x8am\x10\xfe\xf9ח\xdb%\xa3\xceᵈ\xa8f!\xa3?\xff\xfa\xf2#\xc5\xf4\xe7__n\x96\x94\x8d\xbaWX\x0e\xf5}\xf2\xb2\x01\x1e.2t\xee\x8f\x05\xf3\x05\x02\x92\xab_}]\x17a\xdc\xd3\v%Q\x93\xb0I3\x8bL\xcbDx+\x90\xf7\xd8w9Ǘ7H\xb3ہ\x1f\xd6\xcd*琨\x0e`9(\xe7Q\x925\xab\x81\x10\xfb\x80\xfa#\x96DM2e\x1e\xaf*\U000e8030\xe6\x83+\x81\xe3j^\x95M\x89\U0005a771\x97o\u07bcA\xe2\xb7<\xc9鹡P\xe7\a\xa6\x8cU/;\xebAe\x13F\xda\xdf\bc\v\t\xa7\xd4\xf4R:\xdeB\xc9\xca*k\xb4\xb5Y\x88\xac\xe2!|\xa3\xc8\xe3\xc3*\x8e\xa3\xf7\x81\x85\xa3\xd5\xf4\xd3\x03}5\xe6\x96W\xb5\xb8\xac?\x18\x87\xa3ax0P\xea I\x89w\xf0%\xa7\xe2\xe7\x1b\\\x89h\xa9\x8b\xb2<\xad7\xf5\x834'\x1f\x03\x81/V\xedc\xbc\x85o\x12\u008b\xbajR\x8c\xf6T\xfa\xb2\x9c\xaf\x14\xed6]o\x16q\x92G5fr\x17\x97\xe1\x95T\xe8\xd5\x15;72\x15\xe5\x03\xa6e\xafj\x11\xe9V\x913\x03\"jb'\xdbq\xe8\v\x19s\xa5̬\xb0\xa1D\x1d\x17*\x13\x97\xb1t\xa6I\xd5\xf6\x8f'\xb6\x85\xbbÚظ}\xfe\x10֣\x91Ⱦ\xe1\x82\x04UNy?\xdc\xf0U@\x11u/n\xf8\xea\xd2m\xdd\xca\xe9\x01\x89\xe9\xc1\x95\x10\xa8\xa7\xdc-\xa4\xb0\xa6Qm\x89\xe3\x86[FIH҃\xabY4\xc7c\xa5\x93\f\xb7\xb4\x88\x12\x18&ٞ\xf4\xa9\x13\xcfuoĄ\"\x17=\xe8h\\\xa3{\x1du\x1b\x0f\x19\xfe\xee\xe8\x10\xe6\xca\n\t\x1c\x90Hf\xe5-_G!\x98:c=\xc8ZC\xaeżx\xdeA>/)\x18\xeb0r\xc3W\xb6lMe\xa4Ow\x83\f8\xf4\x8b\x9b\xfdD2QJn{\x81\xfa\xd3V`~\x9bJW7B\x80뉑ٻ\x91\xd3ц\xc2.\xe9\x12\xbf\xd7S&\xb2]\xdax\xd1T\xd9\x06\xe2(\xff\xa3\xa9\xa3\xf2\x92<\x93,\x84y\x82?ʍO\xb3r\"9\xd2\xc9\xf7\xeb\xf9!\xe4\x0eCD\x97Ő\x8e\x85\xdam\xd0P\xbbM-\x06\xdc\x1b\xbe\xb2]\xc1\x86\xbe\xb8W\xe6\x18\xe7K\xeb!\x1bBl\x8f\x17\xc0`N)F~`LTs`\xd6Cf\xf3\xee\u07b7\xc6\a\xab)\x9c\xb1\xc1\xdb\xe1 pS\xd5k\x1bv\x9aK7\xf5}B\x0eb30=\xb8\xa2\x0fʒK\x14\xbbg\x94K\xbf\x80\xc9(\x83\x8aG5\x9f&\xf7\xb0\xf6V\x87Z\x12\xf5\xbb\x8aI\x94\x17\x12\xc4.}\x91\x89G4҃+Z*\xb9\x95\xab\x7f\xa9d\xabs\xb5¿\x123Q\x9d\xf0\x02/\xe9\xd8\xfcءèd\x84\x98`:\"\x18\u2069papk\x8c\xd99Ñ\xc2\xeb赍<\xe5;b\xc7\xed\xebHa\x8f\xd9\xd7,\"\xec1\xb0G\xfd\xd8g<r\xf6\x96h\\\x9c\xb4\x0f\xc6D\xba\x16z$]7\x87\xc6w\xef\x9d9\xf6\x83%\xb7\xf5\xd4\x0e8\xd0\xd6\x14E5\x97'\x84^\xa4*\xbc\xc8.}\x9f\x89\x87\xc0<\x04\xe8\x98az\xbf\xb7GOl\xb4\xb6\xf1\xb7㤦B<\xaa\x1ap\x12\xb7\xa9\x16[\xc6\xef\xd89\x9b\xb1\x13\xb6(R>\xc9\n\x9e\xba\xb2L\xb3\xcd\xd2\xec\x15\x8d8\x1aQ\xd3#\xf1/&\r\x9e\x8a\t\xee\xe6L\xb2\x1c\x0f\xf0i\xbe\\Ӵ\xb5\x84\x13\xaa\x83\xf0\xfd\"*\x9a,\x87\x9f1\xb2Dt;\xdbb\xfd\x80\x85\xcf\xfc\xb5\x1ce\x1b\xd9i\xbfE\xdc1\x92\xf8t=\xdb\x1b-B\\)t\xed@\x9f\xa5\xfe\xf6\x1b\x8b\xe0;\x1b\xf9>\x03\x84C\xf9:C\xf0b\a\xd7c\xc2\xf5\xe4\t\xda\x1e\x9a\x120\xc5;ؕ\xa1l\x835}\x06Z7a\xdfL\xbdc\x10\x8e6\xa3\xe5\xff\xf1\xda\x14\xfd\xde?\xad6{\xa8_\xabM\xbd\x99\xfd\t\n\rX\xf2\xe9:Mv\xd2k\xf2i\xba5d\x10\xb1\x1d\xbbW9\t\x06Y\x9f%v\xce\xfdC,!\xf9G\xb0\x86\xcf¯m;\x17Px\xc2.m\x13\xaa\xa2\"\xb5\xc2\xd9\x15e5\x8b\xec\xc0\f\xff\xf1\xef\x01\xfb\xdf\xffːb&\x03\xae\xa5\xa8jAN\xc1\xbebc\x9f\xfd\xef\xffe\x87\xb9Q\x19#\x9f\xfdǿ\x9b\x99\x82&N\xd7h\xb3\x8d\xdap\xbf(`\xd5\xda\xc0\x8fK\xe5\xc7\"Y\xf1puj\xecFdfl\xb5\v\x10f\xa9N\xdcz\x93\xa9CI>\xae\xd0]U\u0099\xc1d\x13\x0f\xff\xf1\xefl\x0fl\x03\x05E\x81\x17\xc1\xf0\xf7\xc7\xea\x13\a\x1f\x15\xe6\x16\xe6\x86⽳\x80\xc8\xcb\xeb\xd7\xff\xf9:\x00Fm\t\xda*Bi\r\x9e\xbf\x8b\xaa(Opɗs\xcf\xc8B\x89|\r\xc7Yu\x97\x15\xdfGy~\xb2\xe9\x00\xb1\xb7N\xbd\xa0\xc2rz\xa8f\xa0\xceS\x195\xdbki\xfe\xb4Mr͞\xb2Y\x9b6\xab\x89ԋٶ.\x96\xea\xef\xeda\xed\xa7\xad\x1f\xde\xc7\xfatDW\xbf\x8e^\x8b\xaeiO\xf5\x15>1\x17\x9dn\xefٺX6\xf4?-\xbcv\x0fR\xdb]\x87\x9aW\xda\xc2\x11\x8b\xe7\xda\n\xac\x06˂\xbf(\xe5(\x9e\xe1\xe6ľds\xecJ\al\xaaZ\xa7p\xc3\xf0\xbe\xf7\x03v\xab6r\xea\v\x01}\x190\x1c1\x7f\xcf\xf6\xd9\xf4\xd4&\x89\xe3\x85X\xb6\xc78\"\x82\xab\x128w\xbdŔ\xf6\xd6&\xb8\xa9\xa2\xa2\x9e\x97\xee\U000cfce8\xa92}\x9cNHӃ\xf0C6\xa7\x8bw\xe9A\xc0\b\xca\xc6\xf6!sv\xf5\x14\x06r\\\xeai\x9e\xbe>fU\x9b\x19\xda~\xd5\x10M\xcd\x0f\xd16ti\xb1\xf2\xfe\x90\xcd_I$\x01\xfb\x90\xcdk\xda.\xfc\x06\xea\xf3f\xbe\x88\x04#\xad^\xd1aaW\x8fM\x16\xa2,<\x053y\xd8j#)\x04\x124\x9d\xc2F\xc2\x00|\xf1\xee\xd2\xe6\xe7\xe2ݥux\xd53ЀB[T\xeaڲ-/\xdd~\xa8\x8c\xaa\xb0\x15\xa26\n\x10\xc0?/\x036՞\x9ak[\xd2W\xec\xc0gyi\xf7lk!\x0f}6\xcd\xec\x87gt\x01j:yɾjUJ\xbb\xb5\x19\x0e\xce\xf2\x92\xed\x01\xc3\xd7_\x7f\xed\x8e\"\xa8O\xb5.<\xa34\xcb\xd2K< \x9b\xe2B\xe0\x92\xe8\x9be)L\xffT6ZA\xc8,ӳ\x06%NK<\xb9~\xd4\xd3\ri\xf8O/*Dw\xe9\x17\x17\xd5\n\xb9H19\x92\xdbIT\xd6\xf9\x88\xb5\xb9e\x99\xa3e\x9cf/Q\x1f\xc7\x11B\xa1\x97{\xb5\xa3%K\xbf\xe2\x13\xf2\"Q\xe5E\x84j\a\xc6\xc6\xff\v\x94\xd5* n\xc5S\x89IV\xd5;\xcd\xe4\xfb\x87\x19\x95\"N\xdbi\x0e\xb8i\xb0\xc1鲽\aпy\xf6\xb7G]\x93\xa6\x01#`\xee\x1c\xfdk7\xea_\xb4e*\xea,c\xf2蟚\xf5\xaf\xce>\x81\xf7\x82\xbb\xbc+D*\x81a\x1f\x88jN\xf9\xdc~6\x16Z\x11I\xd8\xdb\x13\xa7\x03-\uf7ea\xcc\xf3\x05\\(\xe4\x0f\xc9\x05*\xa4\x01\x1f\x03$v\x83\xc4K\x8e5B\x16\x9a\r\"\x93\x0e(\x01\xaeXڼ\xa6\xc4)\x89$\xe7B\x10\xb7\xb7w)\x12\x052u^E[\xf5\xf5\x1fW\xff\x9d\xaf\x9c\xa3/\x95\x13\xd0Sɪ\xd2u\xfa\x91bЇ\x18\x16Z\x1c\xa1{\xaabI\x12\x1d\xbd\xd9\xdas.Z\xbb\xc7\x19tަ(\xe8L\xb1\f\xbc\xac\x8f\xce\xe4\x88\xd1\vb\x95\xad\xdfuw\x8b\xf7\x1di\x10\xae[R\x84\xa9\xb9\xbcP\xb9\x18\x99a#JlB\xe8f\xaa\xad\x7f\x91M\x94\xbd\xfe\xc8t\x94\xe1͢\xf9\xc3͌N\x1a\x95i\xa0A\xe2aWX\x90\xd4.\xfdj\x19\x84*#\xa6)\xb0\x9aL\xa0\xb2\xb9\xa4'Xw:\xb8\xa9Ol. \x9eKŊ\x065\xd2P\n\x80\xf1\x10y>\x8bv{\xf9\x83\xe0\xbd(\xc4\x01\x12\x8bÍ\xe7q\xce4\x1a\xf2\xa1\x16\x8f\aq\xf5A\xa0\x96\x96\xfd\xf9?H\xa3\xb2\xf5J4(\xdc>O\xa6\xb6\xfc7\xb7^\xab\xdfP\xeft\xd1\xdaF\xe0 Ym\xc7c\t\xd5\xe0\xeb\xc7\t%\x9e\xb1\xee0j\x9f]\x9a\x907\x84\x1f\xd4tQY\xe2\xd0\xf8\xca*5\xb7<\x98\x96\u0605e\x93b\xa5\x00w\xf1\xaa\xfb\x80\xfb\xfa\x8a\xa8\xff\xdb\\\x95\x06\xda\x1d7\xf5\xbf\x16^=\xa41\xd2*\xf8ۆ\xab\x95\xaeF\x0e2\x1e\x8d\xdbj;=\x87\xccz1`\xb7\xb4\xbe#\xe5\xd61\xb2\xaaM\xf7\xb0\xeb\xea\xd3\x00\x1bk\xb4\xfbW\xd5L݊\xcd)\xb1\xaa\xba\xc7T[u\x1b\x88\x8d\x95\x13\x98۩\xb4;\x14:\x05V\xc4=\xb2\x1b5\x91Hxl\x1a缚-\x1agaI\x8dE\xbcG䆿 `\xd5\xd6\xed\xb1\x94\xa0ɜ\xfb\xa7&\xaa\xb8\\\xc1\xd1H&\xcb\xe1P\xd3!U\xc1ڴ\xcexuݥTSH%Ū\xd0\xf8J\x85IY$QCKb\x90M\xa5l̈\xac\xbau&'\xcb\x11\xef\x96\xd5\xd0G\xb0vn\xb1aj'\xf6\xba&\xe4\xb9\x05\"\x1a^\xf5\v\xafg^\xa7F\xce[\x9a(\xa8\xb9\x81\xd8\f\xd1\x05X\xd7\xc2{f\x04@\xfb\x98\x8c\\\r\x93\x02\x1f\xd9<\x8d\xe4\xa7-\xa4\xbd\xfe\x13ָA:\x91\x98l\x89\xab\xbb!Z▯,\x1cd\xb9n'k\xe4f\xbd\xc5\x0f\xe8\xf6na\xbf\xa4\r\xbfpą?;jU\xe5\x98\xf6\x00\x1e\xf6͂\x81\xd6\x13\xe5\xf6% \xb6Ce\xddgg\xec\x87b\x82\xe8\xab+\x9f5Ӫ\xbc\x133\xc3\xef\xe0\xea\xe7\r2\x99\xc5\xe5\xed\xa9\x01\t\x8a\xb6.\x85E\xc3,o\xe8P\x1f0\xe2\xfa\xcb5\xaf\xde\xc0\x19\xd2\\\x82De\xbeo\f\x8dNy\x051xk\xf5\x86|\x8d\xd5O>\x17\xa9\xc6\xfeD\xd2W\x98\xf5\x93y\x89\x10@\xb2\xfc\x1e\x15\xc0\x8e\f\u07bc\x03&_\x92,\xb5\xfc\x8e=\x85_\xb9\\\xd2n+\xff\xd5\xda\xf2\xb6]hOwm\x162j\x8fc\x18JmT\xa6\x0e+>\xcf#<\x8e\v\x17\b\x01\x7fU!\x12\xe9۷\xff\xed\xc9\xc0o/\x9a\r\b;cO/\u07be}\xfb\u05f7\xff\xed\xed\x97o\xf7ޞ\xbf\xfd\xed\xed\xc5\xdb˷\xde[\xffm\xf8\xf6oo\xef/\x9f^+:\xe8\x06\x95\xa6b\x19\xb0\xa2E\bna\vՈ\x1bU\x1evνBm\b\xce\xcb;o\x84%/\x9e\xd0\x7f\xca\nv\xe2\xc0\x1a*Ӄp9u6\x98\x16U\x8e}\x048O\xaaHs\xaafa3\xfc=-<\xfe\xf2\xe3\xab\xef\x9bf\xfe\v\x7f\xbf\xd0C\xef\xfa֢pa\xda-\xae\xff\xa1\n\xf5R\x93\t\xed$R\x9f<a\x15\x7f\x1f\x96\xb7\xbc\xaa\xb2\x94\xff\x98̈́\x83\xa4ߛ*\x8ah\xbd\xbe\x0f\xcb9/\xbc\xc1\xbf|\xf7\xeb `\x82\x17Ĵ\xa3|U\x83\xc4T\xf3\x86\x88\xff\x9eG)\xaf\xbc\xc17I\xc2\xe7\xcd `m\x9cEţ\x14\xb1\x95\x1b\x9eL\xdb}\x87\x12\x0f=^\xc1߇\x02\xfa\r\xa0E\xe3<4\x10f\xdf[P\xd0D͢\xee\t+\xff\xb8VB\xa8x=/\x8b\x9a\xe3\xf8\xa4\xc6Rb<\x1c\"\xaf\x86X\x87\xe29\x88ZTr0<Ē\x95\xbfg2D\x9f\xdf\xeaD\f75/RO\x83(3hd\f\xf5]\xec@\xc10\xc1\xa7W\xf1\xf7\x86A\xcdB\xc5߷y\xf8\x95/\xf5ŋ-\xe6\xd2FGf\xa3x\xb2\x8d\xc7\xc2'\xad\xd9&]P\xe8\xf0\xf9\xae.\x1d\xcf\f\x01\xac\xaaQ\x15\x93<$\xa6\x01\x06\xef,\x11^\xd0OQ|`ݶ\x82غ\xe4\x8adv\xce\xfe\xef7?\xbd\x0e\xc5\x15z\x91\u2efa\xb9w\b\x9b6\xb3\xfc\x81\x84\x01\xe7S\x94[O\x11L\x12)}\xa7\xa3\xf6\x18\xa0\xbd\x95\xa5\x97\xf9/0r\xe7\xd0\a\ta\xcds\x9e4\xf0]6\xee\xcdqI\x12&?CTF\xb7.\xc9e\xfdeY\x80\x84E\x94\xff\xa9\x8a\xaeQ\x8b\x94F{U\xe9H\xafWF\xcbY\xfe;\xdb\xe8_~|\xf5_k\xa24n\x14\xf5\xcfʏP*\xac\xbe\xbd>a\x83i\xd3\xccO\x9e>\xbd\xbb\xbb\v\xef\x0e²\xba~:\x1e\x0e\x87O\xeb\xdb끜\x89/a\x0e\xbd\x80\xa3\xe3\xe3\xe3\xa7\"[\x81\xe6Yq\xb3\x01\x14\xd9\nt\rο\xfc\xf8\n\xc0GO\xe1m_ϣ\x84\x9b\x02E\xdd[D\xd0+\xb2\x9fj\xef\xfa\xf4 ,\xcc[\xa6\xd2-\xf2Ė\x82D\xfa~\x11\xe5\xd9\xc4~f\xc0\x0e\xc9hvؐ\x1a\x8a%\xc1O\x13op2\xf0\x03\xc2IyJEh\x1bY\xe7\xe9\x1c\a\xd4r\t\x1d\xaa\xb8\x13\x14\x1e5\x9a\xf1.Pf\xdd\x17Q*\xd7C\xb6\xc5P8\x8d\xea\x9f\xee\nuq\xc1\x93\x95\xfa\xec\xdc\"D\xc8\xd3\x11Å\x04\xd3Q4\xe9R\xf7\x89\xa0\x82\xd2\xeeى\xc5\xe4\xbd%\xe24\xab\xe7\xe2\x12\x88Ղ\x14\xe3\x10\x9d\x95O\x1b\x8b*\xa5\xbd\xb8p\x9bC\xff\x8aA\x95\xbd\xe8q\x1dUyW\x1c\xf1\xa0=\xf5\xe9\x9f\xda\x13\x1c\x95j\xb5\xff+\x95f-\xb0\xdc\xde\x1cI\xb8\xb7W7\xbc0[\x11\xca0\x90k\f#\x84a@r\x88\xf3La\x9e\xc9$l\x8b \bQv\x9d\xa2\xe9\xdaL\vƲ\x98\xd6z\xc4\x15 \xfb\x8a\x8dٹ\xf47\x06\x8a\xcbP\x9b\xf6IO\xaaş%\x9bpҹ[Y\xcfy\x92M2W\x0e3R1\xfc\x8aE\x89\xabJ\x85\x95\xd1\xe0\x01C\xd8\x12\x8c\xb9\x00\xc6}\xdc\xdf~c\x036\xc0\xa30ׅN?\x90\xe9\b\x98\xe2\xc0?\xbb\f\xd8]\x96\xe2\u0604\xedI\xd0\xe7\x97\x01K\xca\xd9,\xd2@/.E\xabL2\\\xb8ѩG\xb8\xee+\x85)\xc1\x8e\xb1%\x8au\b\x96X\x01\xab\x17\x13r\xae\x1e\x04\x8c\x96)\xd0\x7f\x94\xd7d\xf1Р\xc6\xeb;U\xec\xe9\x0fKIJ\x89('\x02\xb3\x18\xcd\x13S\x83\xa1\x8e\x01\x0e \xc1\x86O\f\xee;'\xf0\x1e%\xe2\xa8\xe8);tt_\xdfe\x10\xbcg_\x99aITs6(\x06'\x84_\x89\bs֖m\r\xae5\x15v j\x89\xe1\x0f\x1a\x83\x96\xd5P\xaf\"\x8d\xcc\xfe0h#\x9dl@:\xffX\xa4\xd5\x06\xa4\xa9Fj\xb4gsk\xebk\xb8\x1eMݡm\x7f\xb4\x13\x19fT\x97`\xe2\x158LT\x1f\xeb\x9a\xfd\x8e\xc8\xe9\xdb4\x19$\xd4\xe2\xa4\x04\xbf|4\x037\xf3[>\x89\x16ysj7{\xdd.[\xf7-@\x8c\x12Ɠ'\xb4\x9f\xf2\a\xd8\x10\x15T\x9d\x93\xda\b\xbf\x161\x81Ա\r\xe2\xe5\x82\x01Om\xc4\xec\x13~\x15\xd2\bmV!@]\xd2DZ\xef\x8d\x01\xb3\x1e\xf9to\"G_O_\xc2S\x12jmĈ\xbb\\\x80\f\x05\xee֎\x8ce,\nj5\x8b\xcb|\xedn\x90(\x8e\xbd\x03+p\x82Ҝ\xa9\x11b^OYOs\xa6wc\xe8TS\xe2Q=\xf0\x9e\x16+\xa5\x9c>r\xde\x06R\xfd\xb4h\xe0\xb4\x1f\v\xf7\x1c\xe3\b\xa2\x9a\xbeF8\xf2)\xfa/\xba\x11\x9f\xed\xd9\x17\xacZ}\x89Bgl\xe8\xba*\x17\xf3\x8e\x1c\x15\x9c6\x81\x16\xd2\xde}\xb5\xcf\\\xc7\x06)\xfe\xde2kM\xa7\xd4\xddNi]Լ\xdb\xf3h\xe2Un\xbfx\xe7'\xde\xc5_\xffv\xe9\x9f{\x17_}}\xf6\xd7K\x1f\xbf\xf6\xde\xee3$}\xe1\x9f{C$\f\xf7\x8f/\xf7\xfcs/\xf0Ͻ\xb7\xa1\xfe\xbc\x88\xf6?|\xb3\xffo\x7f\xb8\xf4ϟvk\x809\xd6\xe4\x87\x14\xcdi\x9f\xfcښ\xab.\x9dw3\x89\a\x04B\xfdY\x19\xaf7\xf7\x1d\x17?\xbeK\xe9\xef\x96\xf3\xb2\xe0E\x93Ey\xbb\xfcd\x97\xf2\x7fʖ<m\x97\xac\xb6\x95T\x9bT\"ױ*\xdd\x14E\x96\xef\xeb*\xc489\x8b\x96\xde0\x90c&ܵ\xc6C\x01\xe4\x9f\xf6ߧ\xb2{!\xb9\x87\xcc\x06\xb8\xd8<\xf8\x80?\x11\xfeL\xf0g\x8e?\x05\xfe\xfc\x7f\xff/\xfe\xce\xf0\a\xff\x7f\x83??\xe2Ͽ\xe0\x0f6\x85\x06?\xe3\xcfw\xf8\xf3o\xf8\xf3\xaf\x03v\xa9\"v\xd8\xf5\xe9\xabXV\x9a=\xbb\xeat=\xad\xc9&\x8d^\xfa\x9c\xdd\xc8P'\xa1\xebV\r\xe3K{\bkMbT\xd3\xd1R\xa7\xba5\xc5W\x1a\xb8K\x16ɖ\t\xa2\xf0L\x825a\xc1S\xc1c\x95\x04'[\xa2\xf4\xa9\xd4Ы\xd7#u`)\x8bk\x1d\xee\x8f\x0fm-\x1e\x06\xce4(\x13\xce\x1d2\xb8\xb5\xb8\xa5\x95\xa99\xd1\x01<\x88\x0f\xfc\xbeYqK\xfc\xbc\xbe8b{,C!\xe3zMv\xc1\xa3\x9a\x8b\x87\a\xa4\xe9\x89\xcfy\x99\xaf\xbc\xb1\x1f\xe8\x84d\x11gI\x1b\xe0\xc0\x02H\xe5 m+U)\xc9\xd0$ \xb3\x14-\xacY\xf5\xd1\xd1n\xf32h\xc8I\xbb\x16ٰ@\x83\xc9\u0097^ڦ']2:\x84\x00\x8ed'\v\n&w))\x00\x9d\xa2uV\xecR\xb0\xce\n\xa7\x18_\xcew)Ɨs\xa7X\x92UI\xcew))!\xdd:\xf3\xa8n\xb2\xc4H\x8e\x12$E\xd832Y\xf8\xa2\xf4rQ$;U)!\xd7tB\x02bV\xa6\x1dM\x0f\xb2bpұ\x11Yw\xb9hLV\xc5\x11X\x80\a\xaa\xd4~\xb9h\xac\x92\x15\x9f`[\x8d\xb2\xcbE\xb3/\x10\xf7\x1e\x7f\xbbtSI\xcf|\x8b\x8a\xbc\x89\xdd´\v\x1eYk\xef\xeeI\xff\xde\xc9>\x96\xc8XS\xcam\x12v\u07bb)B;\x0e\xd2\x0fw\x1d\xa4\\0#\xc2fV\xa8i\xb5i\x9cr.\xad&\xd2v\xbb\x91\xa0\x14e]\xabB\xc0\xcf\x1cx\xbb\x8dv\x04%B\xf3x3O\x9d\xc4b+4\xe8\x1c\xd6\xd6\b砼\xd6h\x8d\x8e8\x88\xbeהּŮ\x85\xb5\xb7\xa7|\xa41z\xd8\xf2\x95+L[\xc2\xd7\xeclM<\x8f\xd7o<\xb1\v\x16\xaa)\xf2\xedu\xc0\x06\xd7\x03\xdfa\xc7ۭ*Ʈ\x11y㛦\xa9\xb2x\xd1po\xa0\v\rT\bW\xffԎF\xc9\xceص\xc1\x1c\xc6Q\xcd\xffG\x94\xe3\xf4\xba.\xf3\f\x01s\xccf0\xd1B{D\xba\x90\xd7`\x0f#\x94.\xdf\xec\xc4\xc9\xfb\x81tC8\xee}E\xb0%V\x03miD\xc5h\xb7yU\\\x12\x1d\x927<9\xe2\xe1\x19\x0e엄:\t\xa1?\xfc\xaal\x9c\\\xf9-\xb2\xea\x1b~\xf7\x17\x93\x83O\x99\x8e\x95\x87\x95\x8eO\x911h\x0f\x01\x9a\xeao\xf9u\xc5\xc5$et4T#\xe8\xcf?\x04\xbd\x82Л\x9d\xd1\t\x1bQou\u0086\xd4U\xea_\xa9\xce\xe5:mr\u0086Fj\xa1\x15\x83j\xdd-gն\x1d\xe8\xb2R\xdbO\x01\x9b8[x\xfb\xfbԆ\xb1*\xf6&=\x05/\xb2K\xe9\xe5\xe5\xfḇω\xa5O\xab\b\x97\xe1\xd2\xd7\xd1\x17\xb3}}oB!Rp\xd6Q\x06eEl\x8f\xc5\xecKF=\xc3\xfd\x9a*\x7fi\x9f\xa1~|\x8d\xd6ѩ\xae\xdc\xdf\\{\xd7j\xed\xea\xa1\x10\x04\xb1\vػ\x80\xd5\bP4\fX=\xa2\x7f\xe9D\xfe=\xfd[\x04\xca\xfb0=\xb8\xb2\xaa\xa0k\xcc}ᣅ\xd7\x124><U=gOA\xb1\xe9\x17\xfb\xad\xe7p1\xf5\xc4ˏ)_\xfa\x8c<,b\xab\x1f\xaf\x15\xa9\n\x88$\xc1\xd8\xfb\xb6\xdbav´\x8d\xe9\xc4\xe5\t\x9b]\f/\xe9\xfb^\x97\xa6\xaa\xac\xe31&E\xb3\x85k҃\x9ek\xd7\b6\x1cS\xb5k8\xf0\xfd\xb6\x9c\xe4\xce\xf6{*vʼ-b\xc3\xcd\xc5'Oģ\xd4EK\x80p\xc0}o]\xf4\x80@\xcbp\x89\xad&0n\x00U\x96UV%\xd6\x17e(\x06\xccKug҅aLB\b\xbf\xbd\xbd3V\xb6\x9f\xad\xd7\xcf5\x97\xa1\xf3`\xb3\xe5;\n\xdf\tQ\xc7)\xa3\xeb+{{\xef|\xf6\xfe\xe2\xdde\x98\xed\xef\xdb%:\xfb\n\xbd\x14\xb0=Js\x1fL\xee\x923~09\xe8 \xc6v\xa1\xfbG\x1bh{\x80\x04/YGx뙽d\x0f\xe1\x13\xbc|\x06\xd1?\xea\xb2\xfc^\xd5\xd2Rma\x17\xb5\xf0t8\x02\x13g\xbd]\xb4'\x0e\xab\xff\x94\x97Q\xe3\tk\r\x98\x95R\x86K\xbf\xedW`\x8f\x1c-g1\xd1\x12\xc2y97s\x87\xed\xaa\xe9SK\x87\x81\x1dԱI\x15\xf7\x8fZ\x023\xbb\xc2\xedk\x0f\xad\xa1\xa0\xbe\x18j\xaa\xd99{\x8f\x00^K;:\x96\xcd\n\x951\x97\x1a\xec\xca(\xb3oر\xbbo\xbb\x87\xa9/<չ\xf8\x9a}Ϝ\xa3\x13ʚ\x1e\x1d\x1bl\x19\xa3~U\x93\x92M\xc3T\xcfh\xf4\x8d\xe8\x1a\xcdlы\xfc\x80\xfd\xb1\x9d\x18c倳\x8co\xccl,`\r\xee\x80\xfc\xd1N\xa9$\x8c\x9c\x93\xe1ya\x01\xa0>\xefd.\xe6e\x01\xbb\x93\x85\xe5\xc7\r\xe5`f\x16\xb0\x1b\xca2\x1b\xc6Pf\x13A]\x8f\xcfX\x13\xe3\xc7o\xbf\xb1&\xc2i\x96L\x19Y}1\r\x13\xf6\\\x12\x01\xf2\xb1H\x18\x04\xe6\xa7?\xd88ڍ\x9ca\xae\xb7}\t\x92 \a4.\x82\xbe\x0f\x98\x83\xe6`'4#\x89fd\xd0(}\x1bw*\xc3\xf7Vv1Սٞ\xc5c\xabթ2\x03\xbf\xddf*\x11\x86\xa2\x8a[\x8eP\b;S!.\xc3\xe8\b\x0f\xfa\xc5\x18&\x0e\x9e\x0f-o\xaf*\x06H\xa4A\"\x05\xb2A\xc6DF-\xfb\x15\x10\xac\xa6\xf7\xb6\x96\xd8>\x1bo\x97b\x15\xc1\xe06\xc8\xcf\xe6i}\xc5l\x8fU-\xd1i\xd9\xdc\t\xd9\xdcYxvc\x8a\x16&\x0f\xe7\xe9.BC\xd9\xc0\xd3\xddF\x9ê\xe8n\x1dK7\xaaU\xdd(\xeb\xbaQ\xad\xea\xc653̩z\xaa@#\xfd\xa8\xf6U\xb0}v\xb8]\x04\x82@\xf4\t\x1b\xdaX\xb1\x9b4\x05g\x01\xf1\xb5^\xa6\xa2*\b`$\xc4\x11\x938F\x1b%\xad\x97\x987}\x92v\xe6\xa3\xdbF\f\xb5\xc2\xdb\x1f\xe9\xc5B\xd7#\xe33\x0f\x1f\xbf\\\xc7\xeb\x06\x8e\x88\xf6\xaa\xafc/\",\xb1I\x8a)\tDG8\xff\x8d\xc2*`\x11\xf6F\xa2\xf0:`\x11`\xa30\x0eX\x8c\xdc8\xac\xd8>C\x18\xe6\x18 qx\x8d\xcf\xeb\x80ű\xf8D\x1f\x12\xc5[EDY\x83/`\xdb\xf0t\xbe\x8e\xaf\xa6|\xe9ً\xbb\n\xab\xbb\nkK\xdf_\x0fu\r\xa8\xebmP\xd0i,\x97\x8a[\x04\xf9}\x9do\x11\xe4\xb4\xceۂD\x92-\xc8\xe9P\brJ\xeb\xc9(\xac\x03\x96˴<`S,\xd8\xe2\x10\xe7\x93S\xb5~\x8bÚ\xed3,\xe7r\xf9\x99\xe3$\x8e\xfa^\x18\xf5t\xa4:\xe6\xe9\x88\xed\xb7\xfb\xee\xe9\x88}\xc5\xf6U\xb6\xddooWBzp5\xads\bכ\x0e\xd9\x1e\x10|\xc9\xe0~\x8f\x8f\x9a>r|\xe4\xe2\x03\xca\x18\f6K\xf1U\xb4\xcd\x1c\xf3\xa8c\x8ey\xd42\xc7\\\x98c\x1e\xb0(\x12\xe6\x189\xe6\x98\vs\x84\x9c\xf0\x8aN\f\x908\xc40\x17E\x1fi\x8e\xe9\xc1U\x1e\xc5B\x12Q\x0e\x8b\xc9\xc1\xaf\xa8~\x8f\xc5\x11}X\xb6\xb4\x83$\xbeO\xb6\xdaSҵ\xa7ĵ\xa7h\xaa\xec)J\x84=%\x81%\x9dx\xaa\xed)\x9a\x06,N\x84=%\x90DҒ\x93\xb1\xa7x\xaa\xec)\x9ev\xed)\x9ej{\x8a\xa7\x1faOI\x0eIz\x11\x8e\x91\xe3)\t.\xc1GB\x1fF\xbe\xbb\xd8\xd37\x14<\xaeW\x8e\x90Вf\xc4\t\xfd[D\xd6]\xff\x80\x15\x10\xacڇ\bX1\xd4\xc7g\x88R\xa1\xd3\xf5NE\xc0\xb2\xf6\xa6\x04\xcd\xfe\x874\xfd_\xd2U@\x87L/\x12Wnbt\xea\xa4>\xb1|\xa0\xb2\x11\x95M\xe8֓ޗ\xb0\x81b\x17(\xd6@\x0fY\xa3\f]$K\xec\x11vƖd\xb3\xcc\x7fR\xf7\x8c\xd7\n=\xa3+\xa0\x89\xba\njqs\x83\x1bk\x91!\x11V%\xd24\x06\xfc/\xbb\xb8!/D\xab\xe2?\xae^G3\xee\xdd\xf8^tq#\xc4yc\xee\x1f\xd38oP$\x12\x05@5\x8c5r\x1bb\xac\x8aA\xcccE\xa2\xdfE\x16\xafA\xb6M\x05\x02\xa3Н@\x03\xeev\x10;\xed]ww\xb7\xe0%q\xb1\xbfwy\ue75f\xbcM\xf7ކ\xe7o\xd3/\x7f\x13\xff\xec\xf9\xde\xf9\xc9\x05\xff\xeeR\xe4\xbfM\xf7\xfcs}a\xc5 *+\xb1b\xecUlЯW\"2fYQ7Q\x91\xf0r\"Ofؓ'-<\"Y\x16F\xcd\xf7\x9b1\xaa\xd7G\"\xb1\x98\x1f\xc8\xed\xbf\x01fh\x94\x11;\x19~\xb7>zY-\xa2\b\xe2\xe8\x87\a\x83\x87T\xed\xd6 \x02K\xa2\xb3\xbf\xc21Z\r'`/\x16\x87XO\xff\xea}\xf1[u\x1d\xbf\xf5~\x9b\xd6\xf9[\xcf\x7f\x1a6\xbcn\x90{\xe2J&=\xb8zY\xe6e\xd5C\xed/\xd7\xf1βqB#\t\\\x94\x82nk/\xee\xc1N3bS\x81\x8c\x88\xa6\xe8yء\x8d8\xa5\xc1\xa8g\xbab\xd5\x1fT\uef32\n\xd8u\x97\xfc\x8e\v-\xc4<\xc2\x05\x98\x96\xac0KUR\xf7*L4+\xcc1\xabPH\x96\xb4!\xb6\xb8\xbc\x01f\x86U@\xc0\x815K1\xa0\xde\xdf\xff^\x05\xec\xef\x7f\xbfƟ\xd8o7\xa8\xea:\xfeY\x89Av2\xbf\\\xc7F2ƳZ\xa8\x90\xc4g\x17\nc\x11\xdd\xc4=+ї\x1an\xd4\x18\x82\xfb]ዠ\xebI|\xcen؉\xde\xe9B3\a&)\xed\x80]\xabߐ\xa8\xfa\x1dK\xef\xee\x03\x1amEG%\x1c\x11\x1f_\x8b\xbf\xb1\xf6C$\x11\xe0\x11M\xe3\xd9\fx\x01^\xb1\xafp\x16\x8c\xfah$C\x96\xc0q-\xb3\xaeݬ\x18Y\xb1̊M\x96[\x99\xf11y\xf6\xccq2\xa9\xc4}>?`k!\xae\xb7B\xc4\x12\xc29\x85t\xb4\x91F\xd5\xcdg\xd2E\x0f[\xd2[\xe6\x063\x12(\xa2R\xa4\xb6үפ\xc7\x1b\b\x9f\xbak\nE\xb4C\x04\xac\xdbS\xa6\xa1̂0\xafE\xbcˁl{\x89E\xdc\xf5$^\xb7\x16P\xed\xdaM\xb3\x92=f\xdb\x03#\xc2\x0eo,n\x9e\x0f\xbe\x98\f'G\x93\t]@A\x8c\xb5\xf7\v~7\xcd\x1a\x99\x19\xf18}\xa12\xdf/\"$\x0e\x87\x93\x89)\xf1~\x11͢*+\x04\xfc\x8b\xc9d\x92\x1e\xaa\xac\x0f\x8bJUa\n\xc4<\xbb\x96\xa9\xcf&\xcf\xd2D\xa5f\xf5{\xa2g\xc2\x0f\x13\x85\"΅\x93\n\xea\xc4\x7f&\xb5H\xa6<\x8d\xf2YY\xa4T(NR\x9d-1\xa1\x84\xa96_\xf0۬\xcc\x11\xf3\x7f\xf0\xc5Q4\x8e\xf9XeU\xe5\x1d\x1e\xaf\xf8\"z6\x8eƑJ]T\xf9\xea\xae,\x05\xfe\x94\xc7GGJ\x0eI\x94\xf2FU\xf2lr\xcc#E\x18b\x927\x15_\xd4Z\x18\x9a\xe6dZ&b@@N:~~<R\x97~\x92\xb2B\x10L0\xf1b\xf2L×U1\xc9\xf1Ы\xaa\xe9\xf9\xe1\xf13\xaexLʪ\xa8\xb3\\\xc8f2\x99\x1ciA&U6\xab\xc5[\x1c_\xa4\xc9\xe8\xf0@\xa7\xaf\xa2\xa2\xa3<4T\x85\x1e\xd2:\x8a\xad\fS\xe2(v2\xae\xcb<\xe5E%%\x13\x1f\x1d=\x1f:\xb9U\xb4BFt\x8c\xff\x9c\f\xce\t\xe1\xf3\xc3\xe1\xd0\xcd\xe9/r3\x8dn2\xe4\xc4i\xfc\xe2\xb9]\xcb,\xba\xe6E#\xcc\xf1(n\x11^\xe6\xd9-\u05f5={\xf6<\x1e\xdb\f\x97\xe2\x92\x1d\xb2&\x93\xa3\xc4!\xa4\xac\x92i&\xd8:>>\x18'Jv(U\xf1TU\xe6\x14\xa9\x85\x11\"\x8b\x1f\x1f?\x7f\xa1\xac\aEj\x1ei*\x8e&qrdS!\xf6\x8f\x95\xec\x0f\x8f\x0eR\x87\x05\x91\xab$9\x9e\x1cN\x0e;e\x95\xd0:\xb9͢z\xbf(\xb3\x9a\xb4\x9a\xf0td\xe5\x9a6p|8\x1c\xa6\a*\x8b\xf3\xf9\\^l\xfbb2\x19\x1d\x1e\xdb\x19\xf5\xcd\xcaXIl\x99O6S4>?\xc6\x7fv:\xefI/\xd3kc\xcf#~l\x9a\xe7$\xabx\\e\xb2\xb1\xc7c\xfc\x9f\xca\xc9\xd1>L\x8f4\x99D\x13\xa5\x80IY\xf1\xba\xd1B\x1e\x8f\x8fbSn\x91L\xebLX\xc8dbu\x04\xd7QV\xd4qY\x95\xc8I\x13\xfc\xa7r\xa6eݘ\x8a\x8e\xac~\x11&\x0f\xf8\xc9$}\xa1\xd5ﴃ4\x8a\x9e\x8du\x0eI\xe5h\x88\xfft\"\x919\x1c\x1e\x99\xbeL\x10\xbf\xe2y^ޡ\x82(\x9dL\xb4\xb5*!:h\xa6e\xc1W)\xbf\xd3\x1d\xab\xc9h\x8c\x06\x9f\x1fǪ\x0f\xcd\nD\x89'\xfbM\xd2g\xc93\xc50r\xae\x85\x1c\x0eц\x94\xe4\xb2۲Z\x91\xac\rz\xdd\x16'C\xfe\xfcH\xa1ȣ[^\xa4\xbc\x028\x7fΟO\xa2VF\x9c/\xea)!\x1bN\x9e\xe9ܻB\xcb\xe3E21\xcd0糲H\xa6\xd9d\";\xb1\xc9d\x12\xe9\x9e=\xc7,O\xd9O\x94\xa6G\xfc\xb9\x9dczR[`\xa2\x90\xea̸\xdd\xfd\x89\x1c\xadE\xa3\x84I4\x89R%\r\tD\nM\x0f\xf0\x9f\x9bC\\\x1c\x0f9?v\xeaT\xea\xeb\x162z\x8a\x9f'\xaau\x8a2\xa6;\x99L\xa2\xa1\xeeNd\x9e՟\x8c\x87\xf18rsM#=z\x91p\xa3\bQ\xd4\xeeO^\xbc8::>\xee\xc9\xe6\xeb\xb2\x1b\xces\x85<\x1e&\x87\xa9\x1a\xbbDiKp\x93Ʉ\x1b\x19̨˰\x86A$j&\x0e\xc6Iz`\xe4\\HA\xa2}k\xbdZ\xfd\xbcӊgQUJ9\x1d\xd93\x83\x19O\xb3\xc5̝\x93<\x7f\x9e\xa4ZT\x12@\xf1\x82\x92ڸd\x96\x19\x02\xe2\xe8\xd93\xad6\x999_Tsx\xe3\x0e\xbe8>x1LU\x8f-3m\xf5\x1c$\xf1\xc1\x8b\x91\x9bmw\xf8/\xe2\xe7G\x9c\xbb\xf9sL\x105\x86\xe1p\x12\x1d\xbbT;\x1d\xfb\xe1Q:\xd2\x03\x94̗]\xbbj\xe8/FώTs\x9beia7\x9d\xd1\xf1\xe8\xf8\x85\x96YV4p\xaa\x9cѤl\xa2Mg\x96\xd5ͪ*k=/㚥2I\"\xe1\t-3bUS\x11\xddF\xefJ\xab\xb7Ny\xa4\x04\\D\xb7+=\xd3P\xb5\x97y\x8a\xb8 H\x9f\xa4\x93gZ\xf1b\x10W\x9d\x9f֯HM\xab(F\xce\xf3\xf8\x88\x8f\x95\x82\xecQ=zf\n\x88d\x92\xc9drh\xe7(E\xa7ыa\xaa\xea\x9dG9w:v\xce\xf9\x916\x1f\x91\xabTt|4\x89\x8f\x8f\xac\x1cGAфs\xadb\x94sԓ\xc6/\x86z\x8c\x9dG\xf3h\x15\xddM\xb39\xc9s\x92*y\xcey\x94L\xe7\x8bɄ\xa4\x19Ūe\xcey\xb5@b\x92\x1e=;P\xcd\xc2t,\xc90Q\xe69\xcf\x17B\xb7i\x1a\rS\xa5\x8cyy\x97\x9a\xb18\x1er\xd3挕\x1fٚ\xd2B\xb4\xda[U\xd6+=}\xc6\fG\xcfq\xaar\x15\xe9>\xe3p\xf4\xfcX\xdbN\x1d\xa5i\xceu\xa9\xa3\xf8\xf0\xd9HI\xc2\xea\xfe\xa2\xa3\xe1\v\xd57\xd4Q\x91\x9az&\x87\xd1\xe1sE\x81\xd3)\xf2\xa3\xf8\x99\x9a\xa5\xd7<\xaa\xa7<\xa7\x99\xf5\xe4\x99\xd6E\x9d\xf1\xa2\x10=J4|6\x1e+\x81\xd4Y~+Ǳd\x88\xffT\xb2ۭr%Tg\xfe\xf6<zf\x06)\xb7\xaf\x1d\x1e\r\x8f\x87n\x0e\xef\xc9)t\a\x1a\xe9\xe6\xd7\xed\x11&/\x94x\x9d.\xf9\xf0\xf9\xd1X\x0f\xf7\x8d\x1c\xe8\xd2q|\xa8G\xe9\x86\xcb\x15\xc6\xd0\x1e\x17\xb1jl\xa4\x9eӣx\x92*Kn\xcaYԔ4\x8d88T\xf2t{\x9f!\x1f\xa6\n\x91\x99Rr~4\xd6r\xbe\x9b\xf2H,\xb6&\xcfR\x1e+\r[]\x835\x12\x8b\xd4zV\xde蕡\x9e*\xb8\xa3\x8b\xb6<\x99\xac\x85s\x1c\x89\xa1\xc4\xf2۷6\f\xfb\x82]R\x04.\xb5\bw\xe0\xf5{w\x94*7\xba\xcc\xed\xa1\xf6>\x97\xef\x84\xecmm\"\xe0l1`\xdaYk\xdbNܴ\xb5\x13\x87c\xces\xaa̛\x86ӀMqH9\r\xf3\xfe\x9d\xb8\xa9\xa6zZ\xe7\x8aJ\x02\xc5\xce\xc5\xde4`{u\xc0\xf6\xf2\xce~\xc1\xb4\xce\xf5\x8e\x85\xd82\xb8\xfa\xbeηm\xc3م>\xef6\x1cI\x8a膵\x82{\xf1oM\xff\xe6::\xd5}\x1f9\xbf\xcb>T\x1f1zs)_OLk\x83V\x11\xe2 \x86\xde:Ȼ\x88é{V9\rX\xf2)&\x96(\x13K\xb4\x89%\xda\xc4\xda\xd081>W'\xb0\xb2\x04\x8e\xca\xc3\b\x7fԞ\xaf\xca\xf3(f\x02\xda\"\xce\x19\xe9[84L}\x1f\xbb\xabS쟡\xa4߃\a8\x84\xc5&\xfd\x16\x9bt,6\xd9n\xb1ɮ\x16k\xe9&i)=\xa1\xcdE\xdc\xc6\x1c\r\x87\x94\x8a\xb3R\xe2\xfe\xbf#\xb2\xd8Z\xa3r\xf7 \xa7\xc9nF\xbb\v=\xf2\xa6(Q\xb3\xff\xc9\xd4l\xb7Z:AnSCV+4m\xa3\x0fs\xd7\xe3\x00N\x03\x0f8\xa8\xc87X\xa3\x97ÂrXP.\x0f*\xf2\r\x96n\x97@\xeb\t\xa7ds\xdaVsc\xab\xb9\xb4\xd5<\xbcָ\xa9ν<`{Q\xc0\xf6\xba\xfb\xafR\xf0glt\xd4J\xfc\v;c\xe1\xf1\xb3\xe1\xe1\x8b@%\xfd+.\xc0\xe8\xaf\x7f\xc3W8<:::p\x8bj\xc5\b\xd2\xc0\xfd6k\xb7\v\xedj\xed\xe0\xfe\x93\xac\x9b\nD\x01koM\xb7)\xda\xc9\xda\r=\x1feݻS\xb3\xd5ڕ\u05c9@\x94\xafGL\x1a\x93\x81\xbfl\x94uOH\xc2\xf0\xfd\x82W\xab7\x02\xb6\xac\xbcZ\x1dDj\x04\xdf\xe4\xf9\xc3p|\x93\xe7=h~)\xcbƾI\xd7zaق\xfc\x11Ad8\xbd\xa3i\n\xe3\x96Z2嵪\x86\xee\x11Z\x00w<\xbeɚ\x1f\xb7\x81\xcd\xca\x0f\xdba\xea\xad e\v\xa2Á\x13Ʒ\bX;\xecl\v\xbc\xa2\xa0\xf5\x00ԪTAO\xca\t{\x93}\xf8\x80\xa8)8\xfdVh\a\n\xa5ƵNU\xbaV\x89F\xe6\x91+\xa8\x15\xef\xcc<\xf8\xbdM\xf7-\x84\xe1\xa2\xc8\xde/\xf8\x9b\xb2j<\xbb\n\xff\xd4\x0ee\xd8#!*\xdeRn\xeb\xadl)\xf6\xac,tkѱi\xf1j\x91ʵiUt\x12\x956\x12\xa8OK\xd8.\xeftg\xbd\xd5R\xbb\xedf\x10\x12GZċ\xa2\x04\xbcԋX\x84\xf0P.\xfa\xea[\xfc*ʔ\a\x8c\xbe\xf1a\xce]\xc9\x06\x14J\xf6\xb8e\x05:å\x9b~\xa1]\xd3\x0f\xbf\x154Z=\x98\x83\xbbS\xb2_\xa1\xdbT{{\xf4\xb6\x94\"\x9f\x19\xe2\xc9/\x96>\x05+\xfei\v\b!\x14qGV\xde\xc1\xf6\x14$\xea\xb8xw\xe9[٪\xa4\xa6\xc9\x0e\\&\xcaYD\xd1\xdd\nC\x14=\xf5%\xab\x11\xd0p\xab\xb2\xb2m\x92\x88l\xc8\x16n\xce$\x13jxB\xfa\xc8\n\xaf\xaeҨ\x89\xae\xaepb\xae\x19SU\xa9\xf2O\x9e\xb0\x81\x02\x1c\xc0\xa3\aE}\xa5G\x8d\x83\x9d\xb98\r\xba\x8e\x8fR\x9bR\xe7^\x9dr.\xeau5\xb2u\xae\xf5bu#\x9bL\xb6\xddƕ\xa1\xecd\xb5\x9f\xd7J\xc5\xc8A\xbf?\xc5Pu\xa1\x96\xd5\x05\xbf\x9bi)Wq\xfa\xb4^\xfe\xf7v\xb32\xff\xb4\a\xabۆ\x8c\xac\x7f\x17k\x88\x9aƙ\n\xe1\xf0\xbe\xb5O\xb1=L\xb6e\x05(\xdf\xf2\xd52\x92\x83FI\xb0\xd0M\x88\xdf^7R\xa3\xbc^O1$e\xec\x03\x03C\xdc\"5\x141\x15\x11\xc0\x80\xe2\f\xe8k\xf4\xaf߈b\xa1\b\xcc\x18X\xc0\x98ow\xa0\xdd*\xee]sB<\x174s\x80\xc89\x17\xb6G=[\x9aW\x10\xa2ګ\x01\xae\v\xf1[\xbbyj\xa2Q\xfc\xb4\xab\xb9MXm}l\xd3e\x92Gu\xcd\xd3\xffbu\xcaf\xe6\x91.\xf1O\xd8T\xd9\xcc\xf3\xc55\xbf\xc6{\xfa\xd7\xdf\xde\xd6{O\xaf}\x9f*W\xedS\t\xcb\x0e\xbbCݨ\xe0\xecUV[>\x95}\xf7&Lpz\xc4Ch\xa2\xac\xa8\x05\x1d\x18\x1b\xb4o\x94\x15\x81pM\x7fܭ\xfa\xb5\x15\x8b\xd4!P\xc5_0A{Ua'\xfbt\x1bю\xe2I\x8f\xbfpM\xbct1$+Xǈ\xfeE\xf9v\xb0\xbe\x8f0j\xa2\xe2\xf3۵B\xfc\x10Ӯ\x9bU\xce\xd7\x186B(ee\x85\xa0\x15\xa4\xc6\xcd\uf1eaGB\x0f\xd6[\xfc\xe35\x16\xaf\x8a\x8e\x8d\x9e\x95w\xba\x96\xae\"f\xab\x80\x05O\x1a\x9c$\xac>\xd5\xd3\x11F\xd6=\xd26z\xb5\b#\xa8\xbb\xacH\xcb;\xf4s/\xcb\xd9|\xd1\xf0\xf4\x8d\xa8\xcfm\xa9\x98k\x00F\x85\xb7\x15\x8f\xc0\xb8\xfd\xa1\xa2\xc8\xe2u\x17-K\xee\xfaմM\xdbs\xa2\xe6w\xee\xc9l\x06\xd0\xd6=\xff\x02P\x97\xa7\x0fo,\x8a\xe2\xcf\xdfZ4\xe6\x874\x97v\x88xGlT_\x8f\xe4F\xecܖ\x86\b`.B\x82\x17\r;\xb1\b$\x91R\x13p\xa6{\xec\xdc\xd4j\xf4\x80\x06\xa9\x1f<\xa5\xc0>@g\xed\x81k)!ݩ\xf9\x8c\xddZ\x17\xb9\a\x03\xf5\xea)\xfa\xee\x13\xd5\x0eu~O\xed=\x18\xb5%\xb3\x93\x1dKX\xf1\r﷉\xbf\x1d \xfe#ş\x15\x05\xaf\xbe\xff\xf5\xc7W\xff\xc9\xc27\xf5~\x1e\xd1\xdb\xf8v\x11\xbcS\xff\x03\xc4\x1e\xcd\xe7\xbcH\xd7\xc5\xcdR\x89L\x82Y\x95\x92F\x04\xef2\xf3\xe54\xcbSom\xfc'\x01\xa9\x03\xa9\xff\xf9\x97\x1fd\x83\xf7\xfd\u07b7\xe7$\xca\xd7o>\xa5\xc65\xb3Y\xa7\xc2m\xb3g\xbbR)=Ϡb\xe7\x9aN<\x87(~n\x93wVԼr\xfa\x19\xe0\vX\xcc\xe1$\xa7\xb8\xd5r\x90\xe0k\xa4 3\xff(J>\\\xf0\xd6.\x9c'k\x97\xfb\xa4k4\"+{\xfd\xe6\x93hY\xa3\x92\xddH\xf9T])\x0e\x10\xb1Q\xfcܦ\xab\x8a\xcf\xca[g\x06\xa5x\xb7\xab\xe2Α\xb5\x91\x0e\xba\x10\xb9*Us\xfd\xeeF\x0e\xa6 2\xd5'X\xaaT6&!\x03\x12\xc16b\xb1B\xb6I\xa5A\x15o\xb8\x11I*\x8b\xc5Y\x91z\xb4-!\xfe\xf96j\xac\x9be <\xeb.\xfc冂\x86_s\xf7\xaf\b\xd8\f\x93\xa4\x91N\x8c\x96*q1G\xa85\xf0\xaf\xb6G8nV9\tˬ\xb1\xbf\xcd&\x00h\xb4\xc5f1\xa6\x88\x06\x98x6S\xbd\xeeI\xe7,\xe2\x05Q\xf54\xa6¬\xbe\xe5\x03\xf1m\xb6Z\xf3SZl\xf5o}0\x8d\x8b\x89\xb70\xcd\xf6\x85B,.1vv2L\x1d4\x17u\xc8\x17ק\x14b\xe7N\x1d\xfe\xa7\xe5t\xf1no\xef\x92\xd6]6\u009e%\x1ak\t\xa8\xf56)2}\a\x85\xf5[\x81\xd1\xee\x8d\xfa\xb4\xe0\xef7Il\xb6\x8bĴ\x0e\x8c\xc2m\xcd\xd0\xe3k\x9f\"5\xcb\x00\xe9\xa5s\xb3_d\xe1\xb0߈u$\xc2\xdc\xed(vֱLҎ6kY\x8d\xd1VviEVܨ+\xd34$\x0e\xbbՋ\xfa\x81\xd0S\xf5\xfb\xa7\x9b\x19\xddF\x81\xf5\xbb%\f\xd9\x1f=X\xe3\x9d6\xb2EW l\x83\x95g\x97V[\xea\xa7\xfc\xfe\xd1\x1ai\xf6Q7l\x93\xd7j\xaev\x15J\xc6-c<\xeda\xae\xcd\xc0n\xe6\xd2k\x96\xa7\x1b\xad\xe1\x9fȢ\xcc/\xebV\xf6\x8c\xeeS\xff\xed\xd1C\xe9\xb3U\xe0\xd0\xf80\n{\xa9*F}dm7\xc26\xe5\x1dR\xfa\xeb\xbe\x7f\xd4.\x1eʒ.\x8a\xd3.\x98\xb3\xdbm\x81\xba\xdb\xe0Z\x00nr{\xc3\xdc\xc1/\xfbwS\x95\x16\xb1\xacEf[5\xea|TF\x85U\xb5~\xfb\x95c\xfbL\xc1:\x99\bX\xef\x19\xc9\xe3\xf6r\xcf\xe8\x85VQ\xcc\x04\xdb/:Gg\xc3K\xb5Y\xaailo#\xfe\xedQ\xbb\x01[\n\xf6\xbb\xfb\x9b\xa4L\xbbQ+\xd4]\xad\xd2\x1c\xd1^\x03kA\b\xf9\xb6M\\$z\x17\x97z\xa6\xd4\x02\x90Y\x90sO\x86\x11ۆ\xb5\xaeai\xbd$\xcc\xe4P\t2S\xfbj\xd60\x1dt\xac\xa8\xff(\xf0\xfeQOw\xf4Q\x95\xb7Q>\xb2\x8cR\x89\xb3g\x16NZ\x10\x10\xa4\x85S\xa7\xa8\x14熒\xcb̎Ū\xd3e\xf9\xed\x93\xf2Ō\x9d\xad\xc9m=\xd9\xfd\xd0\x1d\x0f\xbd\xcbe\x0eWՓJ}y\xb6\x1c7\x90<\xc9\xf2\x960e\xcan\xa7\x9c\xbd\r\x99,\x920\xb7\x8f:u\x856=W2U\xd5\xdds\xc29\xec9\xe0\xa4\xe3\xcdw{{\x8a\xdaM玺\xd5\x18\xa0O<\x8a\x1fvWM4\xb0\x9c\xb2\xcc&\x8a\x1e\xbc\xed\xf48O\x9e\x9086\x1f\x81Zxl\xda\xd1\xf9\xb6f\xf1n\xa7\xe4\xec\x9d~\xdc٧x\xe8ܶ\x0eE\xcc\xefp\xfcl\xcb\x11O,\x04\xac\x90{\xb4J`dhL\xc5t>e\x0f\xea\xd3\x05\x000>y\"1\xc34Q\"\xc4כ,\xce\x11\xc4[dY\x9aw\xb7:HE\xf6\x83\x8f\xf8\x8f\b5\xed`\x8b2\xd4\xfe\xf6\x06\xc9\xe3\xbdy[\xf0I9\x9bGUd9 \x98\x94vkBٗ:w\xe3~\xe6\x83\xf4H\xea\n\x81\xdf&\xa8\xbb!#\xec\xc6\xdbj]\x1b\x9e\xbb\xc3s\xa1\xf3fa6\xc9>\xe5\xc4\n\xb5\xedrb\xa5*w_a\xd3r\"\x8apl\x05\x8ck\x8f:\xcaB\x81\xca\a\xe0.\xe8\xebҰg\xc6̎Q\x18kqh#(OϦ\xe8<fpu\x85C\x85=Q\x93\xecT\x8aPOT\xa8f\x97\xa1]\x0eU6(d\x9bZ\x81\xcc1]zTT\t\x9d*v\xaaC\x192Q]\x0e\f\x8a\xf0\x1c\xef\xba\x0f\x94n\xea1\x03\xf6\xce\xdfq?\x1cX6\x91\xaa\xab\xb3ې9Q\x83\x9f\xe0\x19k\xb7,\xff\xd4fs\x87\xb6\xcegs\xf7$\xaf%\xa9ǖ\xbe\xb7\xa0\xa2\x1ep-C\x1f5\xa8n\xee\xb7\x1f0\fZ\xee\x10\xaa\x8f>u\xdb!\xc63Ŷݝ\xf6t\xa2j\x95\xb5A\x1a\"$l\xb6\xce\x03rW\x0f\xae\a\xf5\x91\x8aٝ&\"\x9f\xcd\x1fK\xa1\x97\xb3\x82\xee8h?\xc5*\xf7)\xa4\x93\x8f\t\x1a\x8a\x98\x029n\"\xab\x17\x1e\x84ؾ\xe5y\xb4r\x80\x16\x95x;\xba\rG\xc9\x1a\xf2\xde}\x1d\xbaW\x81\x0e\x063'1\x8fL\x88\x8c\x1fR84\xef\xed9\x89\xaf\xf9\xb2\xf9!\rطQ\xc3â\xbc\xf3\xfc5^\xde\xe4\xd5\xda\x1a!\xbd\vv\xa1=\xae\xd9%\xbbT\xb7ӜB\x88\x87m&\x02쌥\xff?y_\xff\xdc\xc6q,\xf8;\xff\x8a\x11\xeeʵ+.!,)Q\")D\xe5\xd8\xc9\xc5\xf5\xe2$g\xf9\xfc\xec\xc7bX\v\xec\x92\\\x11\xc4»\x00\t(\xe2\xff~\xd5=\xdd3=\xb3\xb3\xf8\xa0\xa4J\xbdz\xb1cbgz\xfakzzz\xbe\x19\x807ʚ=\xb2Ҽx_\x1b\xd7\x0fIK]\x11\xe7:\v\xed\xea]\x8b8\xafiXl\xa7\x0e\f\x8a`\x90\xb1\b\x8f\x0eW\x1bv0~\x16cΖ\xc46o\xad]~\xb1\xba订K\x1c\xb0\xad\xdf\xc3l\x86}\xed\x82a\xd0\xceM\xcb\x1e5\xbf\xea\xbdlZ}\xf4p\xf8ٛ\x90\x98\x85\xc00\x12\x9d\xbd\t\t\xf7\x13a\x1c\x98\xbb\t\x05y\x860\x06vy\xeb\x10|\xb1\xed܋\x19\xa5|\x19\x7fK\xe8\x82\xe38;x\xdf\xda3s\xaa\xeb\x00\xfc\xa4\x96\x1f\xdf\xd1mo\x1c\xb3X6\xc0\xaf\x93\x8cz^\xaak\xbb\xb6\xcfchDy\xe6\x92p\x00\xd6Ly\xb5\xa7\x99\xbf־lr\x10\xd6\xe1\x1b\x03$\x83\n\xf4\x06:\xa0q2\x82\x89\xf4\x9c-vmk\xb2\xa9GSCu\xf8\xaa\x03\xe6O\xe6\xc5<x\x97+\xea\xe1\xb3t\a\xf4$Z\xdc*\xa3\xe9\x85\x10a^\x12\xeeR\xd5p-\x7f^)\xc3R\xbb\x04dQ\xeb\x0e\xe8\x95CaY3n&yY[\xb6\xed\x04\xc4ˑT\xd1&*\xa6\x86\xa0\u07b9\xf4\x7f\xc8\xd5;2`x\xca\xdafD\xd0\xe1\x99\f\xaf\x83\xc1~H\xc2\x1a\xc3q\x18\xf4{\x80\x80\xd8k\x14\xf2\xc5<]pz\x8cq\xed|\x16\xc0r\xf1\xdf&:\xed\x9c\xfdZ{\xe6\xa4/Fw\x8eG\xd2\xe7\x02\x04~\xad\xd8\xf6\xa1\x93\xfe\xfa\x93'N\xe2Y\xa7W\x93t8v&\x94226\x014@\xf4s?p\x96\xa1\xb3\x86\xa0O\x01\xf3\x18\x7f-gk\xed\xdbz[:\x1fY\xe6\xf4c^\xde\x15\xb1\xf6d4'\xd0\x142\xae^\xdbL>\xeb\xb0\fi\xb3\xf9\x1f~lF\x1f\x9b\x99\xd2~\x98\x1d\x1b\xc4Y\xc0PֶnG\xb0[\x92\xa3\xb2\x81\x85\xd4ȭz\xab*W\x94M\xed\xc4k)\r\\E\xed\x01lh/۴\x18\xb7Ͱ\xdd\xff[ۃY\xe0\xf8\x9f\xb4\xa2b0\a[\xc8\xd7X(Y\xdf;\b\x8c\x9f\xb5d\xf2\x15\xed\xe4\x8b\x1c+\xeb\xdc\x7f\x0f\xd8\x7f~(\n\xb33\x10\xb8\x80o\xba\x1d^\xec\xbeg\xa2F)\xdeĥ\xa7\x15\x0f= ja'\x9av\xc7\xef&E \xab\xbe6\xfe\xf6>Qs\xc8`y9WY\xf29\x04\x03F\x1d\xe0\xa3\xe1\x8dO,\xa5m\x05\xd8M\x14\x80Qu\xb5\x8f\xb5\xf9\x82_\xe1\x14\b\x8b\xf4\x13\xee\x88R\xef\x14]\xfd\x8b\x9f\x1e\x06:&\x02;\xe5\xd1V\x03\x17\xdc\xd3\xc6m\xe7%[(\x9b\xa8\xabȼ\xaaB\x8ab\x8d\xb7\xe5\xfd\xdb\xfbϔx\xe3F\xe9'ia\xdd^ߧh\xa6\x13\xdf:mA\xe5wn\x1a\xfe\xdb{*DҡbP\xb2\xa8\a\xd6ԇ\x15\x146:KO\xbd\x93\xbaW\xa7\xf6k\xa3Y\xff\xf7?\x8f\x85\x12\xe8f\xc6\x10a?¹\x17B.\xf6*\x96\x94\xd0\xfcٞ\xeb|\xb9\x94<\xfdЪ-\xc1\xceF\xa7#\x18٪\x9e\x82\xfe\x87\xbc\x8f\xc0\xb5\xde/\x1f\xc5!A\x02\x06\x87$\x8dŉ\x15\xaf\x9d\xda\xf6\xba\xe3k\xebϭ\x99J٦\xa9kfu\x83g\\;{=\xc4\xd1XfH\xbfИ\xbd\xaa\x92\xcdzc\x1b\xdb\xe6\x10\x97T<\xc0\xf7:\xf5\x1d8\xd2D-\x8cZ\x8e\x13x\xa9wr\x9b\x94\xad\x18\xd0G\xe7I\xa8\x90\x10;\x9c;\xe8\x15\xd3\\\xcco\xf4\x92v\x19:\x89\xc0\xaa\x04ϡ\x97\x0f/\x85\x06//\xa1\x9b\x8a8P\x13\xb3\x85q\xacf\xdb\x1dJ\b\x89\x92\xd3\x1c\xd7\xda\n\xe9Z\xf7]\xaf뵫\xc2v\ba\x0eq\x99@Q\x8e\xf6\x13凊\x88\xe6\x13\xbf5\xfd\xa8N9\x9c\"<\xea\x13\x04\xca\x0e\xf1ud\t\xcd标\xc72\xff6eY\xfa\xe6\xdcFJ>{W\xdd\xc5\x01嵐\"\xe0\x06MZ\x9evS\xa6M\x93\xea\xf44IA\x06\x8c\x8f`\xa7\x83\xb1kB\xac]\x84,\x0fo\x89\xd8\x17c\x18\x1bg\xeb\xbe\xe1\xcf\f\f-?QY\xfbH\xe1\xa8\xe5\x1f\xb8i\x12_\xf2\xf8 \xbe\xaf\xd9\xebA\xe3\xf4\xbc\xf1\xa9λ\x87,\xc1\x15<\x91so\x1f\xf2\xe1n\xd3e\x92\x1e\xfei\xb1H\f \xe2Q\x00\xf1\xc8\xc1I\xd0\xee[@L\b\x8c\xceQ\b\xbe\xf4c\x05ci\xfe\x06\x9f\xa7*\xc2\xf7S\xe1\x15\"\xc1`{\xf6_\xc8\x0f/dɬ\xf2\xae\xa8/K1\xbd\x8f\t\xa3\x15\xce\xf8\xff\xebQ\xa4\xfd\xbe(\xa0\x89Ы\x9c&\x19\x95x\x9f\xc9$\x18\xc8U\v^\u0605\xaf:\xb4\x95\"\xd1\x13}\x10\\\x17\xd3\xcd!\x01k\xbb\x03\xe20V\xecA\xe4\vr\xcf\xca\xe6\xcf崜\x17\x11\xe6\x9a+\r\xd8x\x80\xb6\x1a\x8a\xf5oYU\xa0$f\xdfH\a\xba9g\x19\xfae~!\xe6\x17\x00\x00\f\x00\v\xf5\x19\b\xab\x98?\xac \x1a\x88\x18\x80?\x86'\xcc`i\xf0/q\xa5\xe5\xead\x05^i\xda7\xb9eN\xc7\x01\xdc\xfac\xf2\\\xee\xd4\xfc\xe2i#\xe0\x056\xc4\x16\xd3dϙar&\x97`\xafީ\x87^n\xf8\xe5\xcb&\\3\xb1\xe2\xfb梆j<)\xb2\xfag\xfd\x19\xf9\xf9\xf1\x99_\x90q\xc2u\x82\xad̫\x1a\x1eo3\x9fͼ\x98\x89fh\x9c\x15d\xf6\xaf\xe0\x16\x7fi\xa2\xcc%\x18@1\xc9fM\x91\x83\xdb~p,%Q\xf3\xb4\xa5\xe03\xbe\xf0\x17\x9e>\x9f\x8b\xb7^\t\r4\xa0\xeaA\x1d\xa8yڗu\x8eښ\xa7\xba\x17\x8c!\x97\x99\x9a\xa7Ɛ\"BbT\x81\f\xccS\xdcxi\x85\x93Z\x00$bY\xc7i\xf6\xa8\"5\xe4\x00\xb8.~_\x14\xcd\xfc\xdbiy\x87\xddǟ1\xfb\xd3'\xce\u05f7\xdf\xfd\xb4\x01\xea\xae\xfa\xb8\t\xa4ڈ\xa3\xe9\x84hy\x11\xd6pS\xcc\xd9r8+Q\xe9k+z~Կ\xab\x16\x8d\x13 \xd2\xd5-vR\x91\x9cs~t\x89\xb0\xff\xa8\xca\xe9\xdcB\xa1\x8f+\xee\x8b\xe9\xfc}\xb5\xa8\xc7\xce\xec\x14i\x16\x8b]\x8e\x16\xd7/_\x0e\xde\x1c\xc1\xcby\xffY\x8c\xfe\xa3\x9c\xd3\xd3m\xd3쾼\xc6ͤ\x8b\xa6\xa8\xbf\x85\xdb\xffaS\xd1A\xaaNu\xec\x04\xebnՂ\xae\xaek\xf3\x99(\xca\xdd\xe0/\x0fc\x06T\xc3\x16\xd3L\xc1\x1dW\x11\xf8;\xbb儒b|[\xc9\xf0\x82\xa9^\x94\fjR\xc3n\xb5\xe92l\xb53\xc8\xee\x97y1\x9d\x97W%N\xb9\"\x80HbPb\x0eK\x90\x81ø\xe0\xfcBV+>\x9alz6N\xe8Ó\v\x99\xd3\xf30ׄ\x15bB\x80\xbcԐ\xd19\x84\xa8\xa9\xbaH\x94\xfd\xe5>w\x97\xe8ݟ\x8eQ\x11\xb1\xeaz+J\xd5u\xe41\x18ŉ\x93?kٔ\xc9\xfasU\xdfe\xb0\x93;?\xea\xc3#\xfb\xd9<\xea\xf5\aE\xcf\xec\xba28\xe0\x16j\xc9\xcf\xd2c\xc8\\\x0e\x9d\x0e\x12\xb5\x94\xf2X$\xd3MH\x0e\x1c,\a\x0e\x1a\x12\xd1\xc3\xe0!0\xd4\xe0\x9a\xea\x80Z\xd2\x00\xc6\xe6\xf7z\xbe\x16%\xc1\x01ʸ_,gմ\x98Σ\xfe\xab\x00\xae\xaa\xce\xcbi6Y\x8b\x8e8$\xd0\b\x96\xa6\xd9\xf4\xe1\xfaw|\x80\x81.mW*;U\xe7\xea\xfcB]\x90\xa9\xb6I\x8e\xe1\xf6\xfc\xaa^\xa5\x83m\x84`\xaaq\x1f\xe9@\x9fh\x11\xac\xc1~\xf8\xb9\xd8\x0f\xd7c\x1f}6\xfa\xd1Z\xfc\xe3\xcf\xc6?n\xb5\"\xceC͟\xab\xde\xffJ\xaf^\xbf\x86w\x05\xe8m\xb2A\x81?\x0f\xc7\xd9\xe0p\x8c?\xf3\xe3\xc3ׇo\xf0\xe7\xc9\xcb\xe3ף\x1c\x7f\xbe\x19\xbf:~9\u009f\xc5\xd1\xeb\xd7\xe3C\xfc\xf9\xfa\n\xfe\xc1\x9f\xa3\xf1(\x87w\x9a\x80\xc4\xebQ1\xbeꩋ\x00\x1f\x87\x01>\xb2b\xfc\xbax\xe3\xb3tu5\x1a\xbd~\xe3sw\xf2&\xbfz\x93\xf9\x8c^]\x9d\xbc99\xf6y\x1e\xbf\x1a\r\xf2W>\xfb\xe3\x97'㓗\xbe$W\xafG\xc7yK\xa8\xf1k\xf8Ǘ/\x1f\xe5\xa37\xb9\x14\x15~\x9e\x14yV\xbc\xea\x92zDb\x1f\x9d\x1c\x8d^\x9f`\xd9W\x87\xaf^fG\xf8\xf3xtlЌO\x8a\\W\xca\xf1\xd1듣\x13\xe2?;|\xa5\xa9\x8f^\x8d\xaf\x8eI\x94\"\x1f\x9d\x8c\t\xe08?J\xf1\xe7(?)\xa8X\xf1z\x94Q\xb1\xe2\xf5xDb\xbfyy4&\x80,\x7fy\xf2\x92\xd5y\x9c\x12\xde\xe2\xf5\xc91\xe1}=z\x99\xbe\xd6Lf\xaf^\xa5\x84a\\\x1c\xe7\xa4\xe4\xbc8)\xf2\xe3.\xb1\xc7,v\xfa\xe6\x90\n\x1c\x8f2(\x00\x18O\x8aq\x06O\x88\x00\xc6\xe3|Th\r\x14ǯ^\r4\xecU\xfe&\x87\xd7\ue802\xf2\xac \xf6\xae\xf2|\x90i\xa9\x8e\xd2\xec\xe8\x95\xe6\xe9\xf5\xcb\xf1\xcb\xd7\x1ao\x96\xe6''\xa4\xa2\xd7\xc5\t\xbc\xfb\x01\xa2\xbc:\x1e\x8dR\"|\x92\x8dߘz\xcd\xc9\xf2\xb3<\x83\xb7@\x80ɣ\xe3\xa3c-6>\x82vL\x9a\x1d\x19\xb1O\xf2\x93\xfc\x84\xc46\x8d\xf3\xf7\x05<\xb68)\xd66d\xf2\xad\f\x8b\xce\xf5\xdcۀ+\xb0}\xdc\x01\xdb\xc7\x02\xae\xd2NmW\x1e\xc0:\xbf\xa9\x8b榚\xe4۠5\xc0ѹ\xea\xbfR\x17k1\xeb@f\xbe\xda\x061\xc3Fa|\xf7\xd7Nds\x7f\xdd\xcf\xea\xa0{\xe4\x04\x95\xd5c\x93HϽ\x82\xab\xc1\xab\x8e~\xca\xf2r\xd1t\x9cdJT\r\xe3\x89j1\xdf\b\x98\x01\xc6f\x9e\xd5\xf3o\xa7ד\xae\xab\x9e\xf4\x8d\xea\xcd\xfd\xf5eV\x8f\xff~u\xd5\x14\xf3De@\xa3\x98\xe6;\x17\xcc\xe1-\xf5(K\xd5[\x95\r`\x94\x1daJ6 v\xb2\x94\x90\xe7\x19p\x98\xaa\x03\x95\r \xb8\x82\xb9\xff<So\xf5\x9b\x06\xff\xf8\x01\xae\xf6\x1a\xc0\xdd^=h\x03cs\x11˸j\",ј\xa4\x06\xdee\x87\xa4\xb1\xb9\x99\x05\xa1R\x802I\b\xc5oi\xd8\x1a\xce\xd4\x1f\x86B\x8e\x1f\xb3%\xbcE<\x00\xf2?\x0e\x12X\xb6\xa8S\x98s\xfa\xd6\xfe\x14\xa9j\xa0\xd2$U\x1a\xf0`kH\x9d\xc4\xf8\a\xb6\xd4\xc0\x96\x1a\x98R\x03\x83\x7f[H\x9d\xfb_\xa8\xbe\xaf-\x05R!\x85Q\xeas5\x16\xec\xa5\xea\xb9j\x06kqBz~\x85驢\x9c\xe7j\xec@>\x87\xba\xdcW\xbd\xbf\x92\x80n\xfe\xc0\xe4w\xaaG\x10\xd1\x14\xeb\x81\xc7\xe9\xc0pJ\xaa\xb3\xac|Ey\x06\xc9\x00\xe0{\xff\xe5,\x06\x82K\x10\xde@I#\xfd\xc1\xa6'\xd2\x138@\x7f\xb7\xe9\x89\xf0\x02\x0e\xcc{\x93\x9c\x98\xd6\xee\x00\xfc\x89\x125gY=\xee\xbb<\x19\awo\x9dY\xc7\xfd\x06\xd4\xe0Dyn\x8a.\xca\xfc\xe8\x12\xb1Vut\xef\xb7֬\x96\x0f\xe2k\x86\\\xf9wgH\x94gj.\xca]\x19rt\xbd;?\xb68\xd3r\x10\xeeʍ\xa8\xd6\xddy\xe1\xc2LG ە\x8fq1\x9d\xd7U\x19\xec\xc6\xed{瑰\x85\xeenG\xd4O\aP\xac^\xa8\xc3DA\xd7\x13Y\xf5ucd\xc1֡\v\xf4w\x9e\xdc\xe7\xa2\xf3\x89\xd5sUӋ8\xd8\xf5`\x82\xbap\x14\xe3\xea˙N\x90dԐ\x86\xf1\xff\xf8\x01\x18I\x04\x00\xf4WCu\xa8\x9e\x9b~\xf3@\xa5\xc5\xc1\xb1\bE`\xe2$\xa4u\"N\xb8\x00\nFg\x1c\xe9\xc4!\x8e\x00\xe8\a3\xd3Rխg\xbd\x01 \xabO%\xfc_1I\x0f\xbe{:\xff`<\xa9\x9a\"\xef\x05\xe0\xbe\xc3\x1c\x82\x86\xf9\xe0\x03}i\x9e\v\xfb~^\xcc\xf4݀\x122\xbb\x9a\x17u\x1b\xf0[H\xa6\a\xb5\xb3\xa6l\x1c\x80?B\n!\x19\xc1\xef\x83jVL]$\b\xf3\xf7\x19ϴ\x13\\H\x06\x84\x94\"\x8c\x16\xd3|R\xb80\x98D/bgzx\xec\x00|G\x89DmL\x9f\x01\xc6\x18R\xf0f\xa0C\xec1\xbc\xe4\xf0\xae\x9aV\xf3j\xea\xf2\xf8#%ʷ\xec\x82\xf5\xbfͻv\xb8\xbcٿ- о-Vg\"Q3\t\x13\xb0\xa4\xce\xffMS\xb0p\x03\x9e}\xd1γ@\xd4\xf2\x1f\x8b\x8fe\x81\xa10\x86\xe4\x87\xea\x85:\x82\xc8\x1c\xff\f \xf4\x0f\x978\xe4\x12\x04z\xb8\xb1đ,q\xec\x90:V\x17^C\xeb\xd7Y^\x86g\xab@\nj\x8b\x82P$~Co\x9d\xf1>w\x83\r\xfbu\xfcZ\xe2j\\1/\xe8S\x00f\xe4\x96\xf1c\xe5\u00ad\xced{\x87$Ӷ\x83m\xaa_\x17\xf7E\xcdGkd>6\xa5@9L\xef.\xa6\x9b\xaa\xd0TV\x17YHE\xaeK\x02\xa8\xb0K\x12h6(\x9c(y\x18\x83\n7\xd8P\xe1\xf8e\x15\xae?\x05\xa0\x1b\xb4`\xd2r\xe0\x81\xd3F\v\xfcpc\nLZ\xa6\x1e<-\x90a&W(~\xd8\n՟\x02\xce\t\x0e0e山\x92l\x88\x1e\\gz<\xac\xd23Y\x13\x90\xe6k}|S\xd5\xc1n\x9c\x13\x14B\x04\xf6n\x82伳\x98\xfa\xd8\x06\xd7hh\xb3@\xa2\xe6m\x88yV_Ð4\xb4\x9d\xa0\xf7#D\xceM\x7f\x06\xe18\f\xa9\x1bx\"\xad\xe9\xcfR\xf8/\x8e.\x9b~6\x80\x1e>*\xe0\x01\x89\x06\xde#\xc2՜\xf1\xa2\xbe/\\x]t\x00k\x17\xad\xdc9\x00\xce1WS\xe2\x04\xccCJs\xa2\xa4ˊ|\x8b9n\xc7\xf9FiF즘\\%\xea*Q\x01\x05\x12\fh\x1f\x17\x1c\xe1\xc4\aC&\x18AAsX42\x93\x11k\x18\x7fR\xa0\x03p\x8bY\x81\xedKz\xb5\xc6\x12)U\x9f*\xea\x99\xe1\xdflp\xaa\xb2\x81\xf8NOU\x96\xda\xef\xd9\x00f\xeek\xf5ܛ\t0)4\x11\xa0\xc4\xe1\x8bY\xda.\x93\xb6ʤ1\xad\x06\x90\xa5\xb7*\x87\x8cG>\x13h\xc4\xc9\xfa\xa0S\xd8\xfc\xa2g<\xb2>Lp\xe0w\x1aD\x06\xe6S'j\x16ڟBcJ;P\x14#\xca\xfd(S\x7f\xe08/\x16\xa3\xcbY\x90\x8c\xb6\xc3z\x90\xa8\xd9 Qu\x9a\xa8Yڦ\xf7\x7f\xd5 \xd1\x03֙\xc3-\x9a\x1b6N\xeb>\xb1]\xbf\xa7\x16\xabۥ\x97\xf935V\xe3Fe\xe6\x97\x1d\x8c\"=\xeb\xafw\x1f\xdc\xd4ΘOr\xdc9\xb0A\x9a\xa4%ɅQ\xd4\xee\\\xe8\xa2L\xc7 z\n\x17\xa6Fv\xe7B\x17e:\xb2jwׅ\xac\xdd'\xe8\xc3\x14gz\xbe\xb9\xec̑0\xa6\xdd\xf9\xe1\xc2LK ۍ\x17?Cv\xa9y\x99]W\x1d\x8b\x9c\x9c\xa0\x18(б\xceЩ\xa3\xed\xb4v\xe0%j\x06\x11\xac\xae\xd3@.\x9c\x8b\x8af\x83\xfeJ\xed\xab\xd9Q\x7fECg\xd8.{\x8e\x9e\x83\t)\xb5<U\xb3A\x7fi\xbd\xeb\xeaT\xdd\xd1ǣ\x0fx\xd4\r8;\xe2q\xb0R35T3\x1cA\xce\xea\xea\x03\x1d\xab\xf6\x94\xa9\xbb\xf9\x19\\@\xb4\xafz\xdf\xe9\x8f\x14?\xb4\xe7:?\x94\x1fG\x17_ΑY\xa6,\x00W\xc4?L\x1e\xbdkH\xe9\x01w\xb0\xdc\xd6\xdc6\xbb\x83\xa5\xef\x0e\x98\x1d\xc7\xda81\xe0\x14\x96_\xce)\xecȋ\xa3\xcc\xdd\xf9\xb1ř\xaa\x83p\xb9\r/\x81\xbcPC\xdc0\xaa`05\xf4\v\xc2F\x91\xedlF\xc2\xc1)m\x86\xe8\xdb\xe4]\xd5G\xc2\xf9\nT\xef$)\x1e\xff0Z\xe8\x95%_\x91Cf\x19C8l\x93vQ&\xef\xe4\xe2M]\"\x8b72\r\xc5^*\x91ݬ\xeeF\xd5zo\xa8A<_H\f\xb1\x88\x1a\xa6\x81S#\x91\xb9\xd9Az\xbf\x98\x1ft5\xc0ߕ\xf5xR\xc4QS~\f\x80\xfb~\xc5\u07b6`1\xfc\x8c\x17\xc9Ay?\xe7}\xf9\x91Z\xb6\xfe\xeeS\xf1PU\xaeo\t|\x99\x03\xfccy\xe8l\x95\x9a\x9cSu\xc4\x01\xb1\xb9;\a\x8d\x91E\tYw᠕\xe3O6R\xed\xf9S\x8cc\xac\xa1\xd3P\xb5ѤZ]5\x8dxM\x06\xd8k\xcf4c\x18\r\xfb\x94\xb0\xaa\xd5\v\xf5\n;>\x8fo\xdd\xf3\x1c\x1c\xa9\xe7\"&?\xc0\x9f\x7f\xb1?\x7fq\x81\xfeb\x02\xf7_<p\x03\xf2\x8b\x01\xf1\x80]$\a!`KȌ$\x13v\x14w\xd54_/\xfa*${\x04\x13ȎB\x7fΦG\x83\x18\x06J0\xbf\\\xaf\x82\xf9-e\xf1\n\xe2\xca.\xd4-\xe1'\xadp\xf1\xba\xe1\xcat\xd6\aN\xbe'O\xf3\xfb\"\xab\x8b\x1dkrM-\xfa5\xf8W\xa3W\x91\xa8\xdcD\x9bv\xe0%z\xcc\xf6\xe6u\x89s6\ay\xf50\xed\xad\xe7z\x19\xaa\x04G\xbf\xef\x7f\xaf\xe7G\xa0~\xa8\xafz\xe9\xab\x1f\xb3\x83\x92J\x15\xcb\x1a\x10\x15\xe3h^\xa4wJ\xb4\x98\xfd\xbb\xe59\b\v\xd4!\x8f'\x8e\x98\xc5\x16\xbd\v\xf8i1B\xe5\xbe\xe2\xb6X5\x91?\xeb,\x99\x94\x92\x1eŉ\v\x82͂A\xe6\xd94:\x1a\xf0D\x03\xaeݤo\x06\x92\x93lY:cWV+'(\x00\x88\xae\xad\xb6\xaf;\x9f\xe4\xd15qM\x11\t\x9e\xa2\x92\xc7\xd9\x18`^\x8eo\x81$\xfc\xe57c\xcc\xc1\x15\xdaq\x83 \xce\x17-\x8baJ\xa2 \xe9[\xee\x18.!>\xc0\x8c~^\xdde%\xccw\x9f\n\xf4\x1a\xdcl\x8a\xb5\x1f\x97A\xc2\x04\xd8N\xdaĂ>V\xa3N%\x05W\xf4f1b\xe9\xa9\xce@\xbb\xef\x17\xa3\xbc\xbc/\xf3B\xa2\x86I\xc2r|k\xf2`#\x89.\r75\x90z\xe1R\xc0\x1e<\fTս\xb8\x0f\xf7\x87EL\"!vl\xb9?\xd1}~\xf4\xa9/\xed\xc37\xdd\xe0.\xbc\b\x17\xc6`\xa7\xd6u/\xc6\xfb\x06\xa2\x1e>\x8b\tIP\\1\x19<\xa0\x1a\xf5\xaaY6.\xe7\xab^\x82\x8b}\x82\fߠ/\x8e\x891Wx\x1f|\x14w᠃\x90\x91E\xf6\xff\xecM\xfd\x01t!<\x01c\xf3\x15vͺ\xf2\x15%\xb5\x14T\xd15(c\x96\xcdo֨\xa1S\a\xbb)`\x8d\xf4\x9d\xa2\xebR?\x83\x9e`۷\xab\t\xdc\x19L\x86\af\xf6\x13|k\x8b\x83\x01C6\xbfi\x19\x96nM\xac\xadsX\xa1\"P\xd6\x12\xe8\xc2h\x89^\xb1c\x05y6\xc4\xc84\x82\x0e\xd1 ˫A\xe4\x10f}i\xf3o5[\x81~\xf0k\xc0\aj//\xc77Y=\xbf\xbc\x84h\x1a\xb3\xd23\xf7H\xb4\x85\x18\xb6\x01\xb8\xde\xe9rIj\v\xc1fЋו\xc3\x03Ϟ\x00\x80KZ\x15\xfe&=3%\xb8\xa8zZ\x18\xa5\xd8\xcao\xc1\xd1\x11l\x800y\x90\u058b\xf1(ud}\x0f\x01w\x93\x06xF\xb9\x8e\xb4/T\xf3P\xce\xc77*\xaa\xea\x12ϥX\xff\xaf\xc6p\x1d^oT\xcd\xe7\xd5]\xefԦ\x8b>\x82\x15g\xcc\xd4u\x86\xbfZ:t\x97\x8ce\\W\xc7\n6\xb2B\xe2\x8f\xe0\x90`D\x13\aː\x14\xba\xd0\x12\n\r\xe2mQ\x98:k\x15\xc8>\x04\v\x18Ms\x81\x1em\xbc\xb8˖\x91S\x10\xd8P\xfbH\xfd\x1fY\x9e\xf3AL\xfb?k\t\x1bx_Ê[\xde)\xfet\xc6@F\u0092\x83\xcb\xea\xf5_\xa7ŝi\xe9\x90}\x90Ma\xee\n2\xef\xca<\x9fЙ\x13\xfb\x8fm\xfb\x8c\xa9\x97Pp\x8c\x0e\x8a&\xd80\x86\x02N\xfe4\xcdAD\b\xa6~\x19\xfcł驷_<0\x8fب.\xb2[\x99\xf4\xe8[꼚}U3=\xf8\x02vz\xb0\xb3\xa1\x1el2\x0f\xa7\x04\x90\x88\xb66\x89\x00\xfd\xed\x04XÎ\x8b\xc0)\xffY̵\fv\xf05\xcd\xf5`K{\x95p;\x1b줸\x9a?\xcdb\x7f\v\x1a\x9f\xb4\x84\xe5Fk\xeb\xb4X\xaf\x14e\xa1\xf9\x0e<\x1c\xbe\xc5.\xb72\x11\xa7\xc4W\xb0X\x8f\x81u\x02\x04-v\x17~\x18y\an\xd7\xc5\x1e\x1dv\xdbl1\xcdw0X\xdf>\x13ߊ\xff2\xf8\xc57ؿ|\xb6\xc1\xd6\xe5\xf5\xcd\u05f5\xd8'\x19\xecgګk-\x9b\xcduk\xe3h\xd3\xee\xe0\xdd\x10_\xc7{\xd0T\xb7\xe6\x851w \xde\xdeNq\xf9t\aK}\xaa\xa1>\xc9N\xed/\x98n\xb6c\xfe\xc6\tl\t\xb7\xb9\x12\xd11[\x1e\x8d8D\x85\xddu\x96\x90\xa3U\x1aN\xc0;\x7f[\xc2;\rbK\xae\xdc\x06\xb1#\xa1\xady\v\\\xa6\vC\xd0|i\x06_\xfa,\xe6\x1f3|\xf5\x1cW{\x97r\x16*w\x95o&\xe3t\xe1(\a\x1f\x9f\x9b\xd561\xa5/4٩\x96e\xecîQ\x87\x04~\xdcs\xef@\x15\xab\xbbt\xb4<p8Y\x8f\x92\xd4\xd0\f\x8b\xbc\x06\xac\x86\xea\xd8\xf3G6\x89[\x81I\xa0\xb6\xa9\x86\xeaȟ\x82\xc2\xd5\xf2\x14\xf7\x9dʩ5\xba\x88E\xccIy3Kx\x13\nJ\x02a\x829%\xff\x84\x05\x19(\xc9\xdab4\xfe\x92(\xd0 \xedў@ j\x94\xb4;U]\x94\xa9\x18D\xdb\xd1\xe5\xd98Cv[\xaa\xae\xea\x99V\xabBL\xf9\xad\xb91ն\xbb&lqɏA\xb8\xbdF\xccT\xe9\xd3x\xf0\xa6>E\xd2N\\\x90\xe1[\x1e\x12\xb5J\xd4\xc7]81\x8d\x8c\x89v]\xfb\b\xef\xdf1\x8c\xdf:\xf7\r\xcb~#\x9d\xaa?\xe0{\x91\xfb+u\xea\x16\x93%l\x1b\x06x8\xa5\xb6oȟO/\xba\x8anԏu\x05O\xab&*/Y\xb5(\xf7\x97[\xf3!\x1d\xc9\xd381\x18$/\x12\xedfn\xbct\xb9\a`Tw\xdc\xda\xc3\t\n!>s\x8d#Q\xa3\xeb\xd6\x04*\xdc.s]W\x8bi\xeeO\xa2^\xb5\x81\x8b%\\\x82\xe8\x03\xce?\xb6\x00\xeb\x02ֿ\x18P\x7f\xc9ilcB \bM\x13\xe3\xcd(E}\x80\xb7\xd2\xe0df6\x99\xf4\xe2~5\x8dz\xb8I\x02\x96\f\xfb\xa8\x88^\xa2\x15\x82Q\x9b\x86\xc0\xbd\x12\xf8\x1d\x02\xb1\xe4F\u05ed\xc9\xe0\xba\x18\xcf\xdb3\xa9\x8eb\x88\xc5\xfb\xb2)G\xe5DO\x87\xf7n\xca</\xa66w\xbc\xa8\x1b\x1dM\xe2\xe2\xfaMV\xd62\xa2\xbcږ\xb2\xd1r\v/̼K\x94\xf3\x8f-\x94\xed\xa5\x91\xae\x88\x85챧k\a\x97\ai/\x1e\xfc\xfb\xd8&\xbf\x01\x11\r\x8bP\xfb\xdfa\x99\xf3\xfc\xc2A\x18\x14{\xb9\x19\xf3\x8b\xf3\xe2\xe1\x82\x0f\xbd\xe4\xb0/\xfd\xe0\x88\x1e\x13s\xf1\x9b\xc1\xc0&\x84\xff<\x9f6\x17\xdb\"|(\xf3\xf9M/QǜpS\xe0\x88\x11S\xd6Z\x86E6\xff\xc8\xea\xcc\xcbf6\xc9\x00\f5\xa5\xdfh\x8a\x80\x87\u07b4\x9a\x16=\xe2ëe\\\x931k/6\x0f<\x97pd\xf0/\xed2\x11+(n49\xba&1@\xf30^\xf1\xe5\xc4\x01ˁΒ\x05\xeb\"\xaf\xb3\x87_\xa3\xeb@\xbc\xa99Ym\xe2d\x15\xe6d\xe5qb\x14\xbc\x9e\x95\xdf:Xѹ\"Ӎ\x86\xd98\b\x8b\xebU\x83^\x8c\x86\x8c\x1crw\x1a\x18\x99W\x0f\x97\x8b\xe0\x96\xa5\bZ\x95n\xcf\xe7\xfb/\nk\xc3\x174NL\x1c\x80\x7f6\x02@\xcf\x1fǽ\xad\x84\xf85$\x85\xf4آ\xca1\xe9|p!\x95\xea\n\xae9N\xfa\xd3?@SM\xfa\xcd\x1f\x9c&k\f\x05\xc1\xceS\xc0\xa4\x0eB\x88\x83\xbc\xfe\xb6\x1d\xaf+\x87\xd7t\x13\xaf\x05\xf1\xfa\xe0\xf2jM\xc90\x9b\xba̦\x1d\xcc\xda\xeeCt\xae&\x17{\xa4V\xaf\xeb\xec\xd8Î\xac?\xbe\x01\xcb\xcf\x7f\x96\x17\x95\t[\xe1\x12\xef\xc4\x1e\xbf\x88O\xf3\xd0w\f\xfa=5;\x04)\xdb\xe8\xe3\xd1\xe7\xed\xb6XA_\xe9p\a\xcd\xd3\xf0t[\xac\xbe\xc3{p\x87\xeaH<\x1d\xc0p\xcf\xf2:\xbb\xbe\x86\x9e\xda\xc9R\n\x8e'\xe3\x02\xbd\xeb)iTs]N\x81у\xa1\xd0\xf5\xe0\"\b\x96z`\xa9\a\xc6\f\xc0S[2Ƕqe\xee\x82\xfb.\x9b\x8e\x8b\x89t\x8a\x8f{\xee_\xa9\x98\xc5lK\xb5\xa8o\xbe\x11l\f\xe5\x13\v\xae\xbc\xfb\xdd\xf2Zi\xf7\xbb\xa5\x15\xb2\x0e\x9c\xf4\xdd\xe5C\x93\x85 \xc1\x91Q\xdekGf\x9b(\x80\xcaۏ\x1aC\xf5Wx\x90\xc9\x15\x17˓\xb4:\xdf\x13U\x03\xa4\x12 \xbdh\xb3\xbc\xd6\xc0\x9c\xca\xc8&\xf3\xff(\xbc\x0e\x85Jk3\x8c\xad9\x9e\xab\x88\xb4; \xc7j\x95=\xb8\xa0\xd9\x1b\x01\x92\xba \xa9\x06\xb1\xdb\xed\xfd*6\x95\xb7\x1f\x19E\xbc%\xf2\xe0\xec\xd6\xd9y\xabl*ʦ\x17\xb1o\x0e41\xd5\xd1\xd4\\=b\aUN\xaf\x7f\x05c\x85\x1aM5\x8dD-aB\xd4U^\xa8\xfbV\xc6\x0e\xe6\xf5\xa2\xd8D\xe6\xb7\x16\x99U\xa2\xd2 \x99ߞ@\x06aB\xc8<\\\xd8*.i\xa3+\xff\x03\x1b|OU\x8fb\x7f'\xeb\x0e_\x1d2\xcd\xec\x1d\xc5\xd1pw\t\xf5\xf0\x02\xfc1n\xb3\xd7jg\x8e\x0ehG\x94\xd8`\xbd\xcdn\x96\x1a6\x86\xf0\x8c1]Wĳŉ\x9aU\xe6\xf2j\xb2$x랶\x10\x93=\xa5\x17\xe7\xa5ۓ\x01\xcc]9M\xd4]\xb6<s]\\\xb0\xbd\xd5\x03\xf0\xc2L˖P\xc0\xce\xc1PoY\xde\x0f\x00\xb0B\x14\xec\xb8\xe2\x8d|\xb0\xd0U\x0fx\xf2\xbe\x9cFx\x98\x0e\xcc\x11\x1e쉷a\xe8\x0e\xefi\x88\x00\xe9\xbee\ffR\xe5N\xea\xe0\xe4-\x18\x10\xfb\x05.\xb8\x963\xd8\xccK\xad\x10\xd5xWN%\x93\xda$\r\xa6\xb7 \xa9\xcb,\xb3{W:\xaac\x9dp\xd1@\xdb\x0ea\t\x82\a\x14\x0e\\\xc9\x1aWφ\xc8\x02\xec*\xa2\xf4Ԧg\xfe\xd8\x00!\xbe\xc7}N\x81n\xdcAܒ\xccA?t\x8d\xcc\xc63\xc1\x06\xdejA\xd8Na\xc0*\xf9\x13\xdd\xd7\x0e\xf3\x03\xc1\x88\xfdɃ-;g\xd2\x1bU\xf9*0\n\xf7\n<\xd8\t\np\vf\xf6\x01\xc1l\xdeb\x16\xc8\xc1\xf8\xae\xa3\x14\xe6\xc1\xe3\x0e\xed,\x8a\xef\xc29>\xa1\xb3\xbd5~SzMX\xaa\xdd\v\xb9\xc1\xae@\x84+\x15\x9c\x9d9\xf7\x043M\x89&\xf53\xa7Y\x9d\x9aޝBX\x82\x84\x1do\xf8\xa3_]\x99\xe0\xd7\xcc\xc7ŉ?\x9bŅ\xb9c\xe2\xe2\x9a\"L:-\xee\"\x91\xff\xab\x1a\xaag/\xfe\x19M?51\x8f\xc18\x0f_\x9d\\Z\xd8\xdf\b\xb6\xf8\xf4\x10\x86\x85k\x06\xc8{ytqƥ\xc8#3\x83\x93\x90\x87I(\x1e\x90\x01X\xe5\x1c\xfa\x06\x1d>8B\xea\v\xa4\xe3\x0e\xeb2M%lb\\\xa1]f\xe6\x15oٚ[\u07b77\xfa\x0e\x99\x1c~\x19\v\xe9\xf2\xf4\x81\xc8j@\x83H\x93u\xe6C\xcbXj@\xa38\x93u\xb6\xe7xX\x19\xb5H\u00a0\xe6\x02\xdc\xed\xfe\x8b\x87V\xe5&\no_\xd9\x7f\xf1ϩ\x9f%\x98ỆΙ\x17\xb8ՠX\xfa\xec\xdb\x01'd\xaf|~e\xac\x19ІƷV\x05\xc5\xea\",w+\x826\xa1\xa4\xc6\xd2o&\xe5X\xb8\xd85\x0e\x16}\xe4\xb6\x1e\xd6\xf16\x9bݨl8n\x9e\xed\x86[\x1eK\xfa+\x9c\xd9\xed\xed\xf9\xfeʘ\xb6\x950\xec\xc0\xec\xd2(\x92\x11\xb7\x8b\x7f_6\xb3l>\xbe\x89\x10W\xe2\xd0\xe3/\xf3\x03\xdcf\x9c\xa8%u\xa7\x89Z\x99_4צּ\xce\xdc\xe8O:U\xd8\b.\x8b\xe2\xe59\x03s\x1b\xe7@](\x03\xa0;l\xcd6\xe2\xe8;K\xd1[/8\x99\x0e\x1b\x8a\x7f\xe4\x8f\xf5l>[\xaa\xb7oU\xaa>\xa9g+cn\x84\x0fy!mJ\xeeVO\xe2\x8e.lQj\xf5u\xb93J\x0f\xb1\b\xf6\x00W\xd4\xc0\xb53pG\xcc\n\xae.9[\xcf?\x97U\xea\xa3\x1a\xba1\x96\x89\xca\xd6N\xd9.a0\xf0\x11\xe2.0\x8a%l%\xff؞M\x80\x82\xcf$v\x17\t\xa1!\xf7 quMO\x10/\xfdrz_\xd4\xf3X\x97\xe7\xcfh\t\x97\x85.S')\x8d\x03\xe5\xe1\x1a\xd3\xe5 \xc6kiPu\x88&\xa5\xc2Fy2d\xd80q\xbc2\xfa\x80\xc1\xd0\xca\xe8\xc3\xfa\xbb\xad\xf4\xb1r\xf5apY}\xb8\b\x89\x17\x926\xd6\xe5W,\xfc\n\xf4\xb1J\x9d\xa4\x90>V\xa0\x8f\x15\xe9\x03M\bѤTx\xa3>\xc8z\x97\x18k\xa8w\xe8\x1a@\xad+r\x0eh\x99)\xb8\au\xaa\xe0\x1e\xd6s2XLXA\xb1s2]\xdb\xcb0z\xa9\xaeN\xb7s\xb6\xd7a\xa9\xc6N\xa9n?:\n\xa4\x8aD\xa0\xe5\xc0B-S\xc7\xee$\v\xda\xe4\xc9n\x9c\x8c\x943\xc4!\x88\x90\xb5J3u\xeds7۴fB\xfc\xc0\xebT\xde\x147\x94\xb4/\x83Y\xa5\xb6,\xd8\xd8/\xd5y[OK\xb6\xae\x815\xcbUz\x9e\xae\xd1\x13\xc6?\xab\x80\x9et\x86\xa7'ߊ\xa5\xf9\xbav\xbb\x9b\xcdZ=\x11?\xbe\x9e\x88\x9b\x90\x9e6\xf8e|3G\xbae\xabR)2u\xafg{>C^8)\x83\xa5\xd4\xcde6\ag\xdbq\x86ktaΨ$\xb6V\x97\x95\xa1G\xfd\xd3'\xb5r\xa1R\x1f*\xbdp\x88\x13\xee\xfc\xa8_\x17\xa3r\x9as<\x82AJ\xa2zմ\x17\xbc\x80R,\xc1\x9aW\x92\xa6\xa7\xaa7m\x0e(|\xd3G\xa1\x8bS\xd5+\x1eܴ&\x00\xf7\x10\x80\x9bB\xe2\xf4\xa1)\xbcd\xc09-\x1a\x1fk\x11\x84n\x1e<\xe8.a\xa8\xbb'\x8f՛B\xf4\x85\xf7\xe9c\x98\xfa\x00\xff\x99\xea\xff\xeaT\xfd߇\x9e\xf6\x98=\xfc4_X\xba\xd1_\xe4\xed\xf2\xa3\xfe\xa8\xb8\xc9\xee˪\x96w\x94sZ\x1f\x061\xa1\xfa\xe7\x04\x1c\x11\n\xab\x80Q\xb0\x1d\x98\xe1\xe0\t z\x892\tb䅑l\x1b \xb8*e\xb2\x055\x93\x893\x92\xed\xb9\x7f{\xb1\x8d}Ց-\xdfX\x19r\xf2C\xee.D\xcd\xe2>\xbf\x1aN4\xa2\x99DoJϜGp\x86\xf4\f\xce\x0fΆ\x02o\x15k\x16\xfb\xfe\xc1\xc8\x01\xaah-c\x80\x9fz֒\xc3D\x8fP\x06'\x94\xceZ\u0093Z\x12\xbd\xbbs&Gj\x97\xe8\xa4s\x888gr\x88v\xe9\xb8b\xe0%W\x9f\x86\x80\xe0\x93\xcaW\xfe\xc0\f\xe6/f\x9b'L\xd6M\xc1\x00\xfb\xd4*\xa8\xaf=՜\xeeӐ\x13\x18\x15٫S\xbe\xddǬ\xb6\xc8\xec|y\xaarq\xb5\x90R9\xbcz\xb6\nL\xf0\x04\xb5\xefOͭg\xbcs\xea\xa8cZ\xbf[A\xca\x1d\xc2\xf2\xb4\xd2Йh\x89i\xd2m<\x81#\x86\xd4n\xf0#\xc1\x05\x06\x81\xefѝ\xa7\xb3F.&E\x00\xc1\x01\xac\xc3s\xee\xa9\x123.\x84\xde̱\xf9(`\x8a\xae\x13\xc3b\xe6\x94?\xdbs\xb92*G\xe6\x1d\x85w\xab( {\x10y״\xdc\xc6\xc96\xa1i\xb9\x90͠,\xa3̢E\xeco\xbe\xb1i\xee\xba\xf7\xf9\xe0Bx\b\x9e\xfd\xe2y\xb1K\xd9Hy\xa9h\xb0\xd5ܘ_\x1d\xdb\xd7(;\x99ϩU2~\xcf\xec\xc0\x84\xb5`\xb2F\xcd\xcc\x11M\xc3\xd0%ڭ\n8k\x978\xa7\x9f\xfd\xa5\xe7\xb8(y\xe5\xf8-\x11\xf5\xfb\xeb\r\x02\xa3\x9em0\x90\xf4\x17X\x7fF\xf2Ɲ\x8e\xac\xe5\r\x84/\bN\xcbl1\xd7\x02\xaaL\xd8\x0fJT\x94H\xf3,f\"\xd5ƀy\x9d]\xf7M:\xb7\xa9h\xb9\xed\xbc\x83.ʲ\x19Df\xb2Dt0\xeb\xc33`\xa4#:\x93\xc1\xc4Ǫ\xba[\x1bL\x00\xc0\xfa`\x02 \xda\xc1\x04\xe6?\xdc\x14\xc5\xc4\x01\xc0\x14\x01\x01F\xef\x00؉\xe0\xef\xff\xfe㏀\xe4\xfd\xb8\xae&\x9dX\xf2\xd1D\xbb\x1f\xca\xe7\xefVHC\xf96E@H.L\x82\xc8\a\xbf\xda./\xcd\xc9\xe8kR\x8d3?6\xa1\xca9W\x11\xf5\xf7fO\x16\xed\b\xa0eۈ\xba}\x9b\x9d\xdalu\x11\xa4\xa7]\xd5$@l\x02\xb4\x9eS\xe1}\x87f\xa2&\xe7i83\xbd\xe8 \x84\xa0?W\x918\xe8Ç\x16\xcc\xc2&&\xfc\x89\xc7\x14b\x91Sf\xc0\x18\xb3\x89\xe3 \x11\xc3\xc7\xcfU4K\x94\x10kbܲ\xed],\xd7\x10\x9b\x98hj\"\x86\xf8\x16$%\x90T\x83\xa4\x17A\x06rv\x01\xd8r,yh\xb5\xcb4V˔/MY\x0e\xe8\x814\xef!Gg\x92\x82*#ZvU:\xf3\xf9\xa8\xb1,\a<T6B\x02\xe5U\x1a\xab\x95\xa1\xbc\ua83c\nQ^uٓGyզl\xba\xcf\x19\xdc\x13>\x9d\x7f_\\e\x8b\xc9\xdcs\xbdmϫ[\x8aIEjt\xe3\x8cM5<\x9dڟAG\xcd\xe2Y\a#ܑ\x9b\xd9\nӹ\xfbN\xcf\xdat\x7f\xae\"\x7f\x13\x1b\x18\x9c\x85tM\xe1\xb2\x15Ӹ\xb4\x03{\xb9(\xd8\xdc\x14=\x19\xd6\xd9\xc5\xd8%T\xee\xe1e\xc6Y\x9b\x86\x13\xebl\x13\xa6\x12\xbeP\x98\xfa\xc5\xc2\xc1\x10ό\xfck\x85\x83&\\K\xb6Z\xc0$\x16MBP\xe9\xf4\x19'肌{\xf7m\xc7\xc8H\x8f\xed^U\xe3\x05]Kխ\xb3\x90\x85c\xdf&\xf4\r\xb5\xfc\xccX\xed \xb6\x16<\b\xf3\x03WH\x19\xba\xec\xb5͛\xa2\x87x\xff\x15\xf7\xff\x97 \xe4\xf7\xc5d\x9eE\xf0\xdaI\x7f08\x8c\xb9[08\xc2M\x06\xc8$\x82\x19\x03\xee\xb6\x1b\\E\xf7\x1fg\xe9\x96\xdek\xc2\x16\xbd\x13ey%\xb9\xcf\x17\x05y\xb8\xed\xf3\xeb\xd4ᬥ&cQ\xcdMy\x05{\xfe\xf8\xaa+ܓGNL=W\x87\xf1\x99\xcf!\xf7X\x9f\xab\x06\x1b`x\xe2\x84n\xe0d\xb1\xfcG\xb4\x99\ts\xed\x8f\xe3\xf2\x1d\xad\xfe\x8b\"H\xc5;|\xdb/\x848\x97\xd6\xd8\xc2\xe7\xf2\xb5\xe1\v\xa9X{\xc8\xe5qC\v\xa0\x17މ2\x9d.\x83\x87\xdd\xc5\xc5\xe7\x1a\x86\xde\xf7\x06Hx\xa7\\\xbdU\xaf\x06\x03\td+\x9d\x04\xc1Q\x89S\xe16Ð\x97\xb5߮ݵ5\xbcS-ۚ6\xaaF1\xf0\xe1r\x06y\xec4\b\xaf]l\xb2\a\xbc^\x9aҴ\x1a0\xc1V\xddl \xeb\x8e郞g\xa9(\x9a^X\x9a\xa4\xdf4Q\x93\xd4C\x96\x86\x90\xc1\xf5\xff8\xba\x8bf\xb0\x00\x03\xb7>\xa7\x14\xfa\xc0\x0e\xd8\x19\xac6\xe8\xc4\xc0\x9e\xd7\t\x15\x9dPщ,:\xa1\xa2\x93`\xd1v[\xa6j\xf5\xcew?\xee\x05\xeaw\x90\xa8\x89\x05q\xaa\xc9x\x9f\x9d\xdb6\xe8\xcdP\x11\x03^\xe9>i\xef&<\xbaO?9\xe9O\xbcD\xec\xfb\xed\x1f\xa6W崜\xaf\x92\xee\x81,\x80%\x14\x91\xc5I{1\x99\xe5\xd3\x02\x03X_2\x1a\nj\u05cf_Mi\xa3B\x81n\x89\x91\xe6\xdf\x16w\xa3\xa26:\xa6\x82@\x9bt&\x98a\x9d\xecΈ\xe3\xf0\x18\xcd\xfer{\xaaF\xedO\xa4\xad\xcb;\x1c\x18\x94Kq\x93bW\xad\xaa\xd3'\xe8\xeb\x89[1L|\xbcL\xe5v\a\\\xd5\xfcHw\xc8mE\xff\x89\x9b-\f\xfd\x95C\x7f\xb5\v\xfd\xd6\f\b@t̀В\x8e\xa3\xf7\xef\xcb\xfb\xa4\xbb2\xa8њo^\xa9\x99d\xabj1\x97\xeb4:\xa5\xaf\xdf\x11\x93\xea`m\x10\xa3&}RN\xe5\xed\x19\xc0\x1b\\\xf6\x01\x8e\x1d^\xee-\xe1-\xb94Q\xb0\x8f\x17AI{\xac\x8f\x87\x9brR\xa8h\x7f\xbfTo\xd54\xc6\xeb\x02\x9b\xfe\f\x8e\xe8\xe6G\x97\x9a\x9bK\xcd\xcd?\xb2\xf9M\x848\x9c]\xd0\xc4\x10\x16\x14*\xf5\x04\xda\xfc\x88P]hP\x93C\x97=\xd0S3 п\x1e\x13\x85\x1f\xef\x17w, ~\xff0\xcdq/\x1cL`\xe1\xa8z\x1a\xdbGj8\x13\xa0o\xf1<\x01l2(\x13\xf5\x81E@\xe64BN24m\xd2-\x1cka\x8dv\xe9\x8fYW\nH\x0e\x12\xf5\xc1\x01\x17\x05>\xf8\x05\xa0\xc8>lF\x9e\xd7\xe5\xf2\xbc\xbc8\xff`H\xdb\xee\x868\x03\x05\xe8Z\x92'\"\x1d\x89\xb9\x12\x8dF\x04\xe0\xad\xda\x17\xb3\x82\x8c\x1b\x9aYS\xd5\xf3\xff\x038\x84M\x11M\x8d\x15\x00ll\xe7>\x90#\xcc\xc1\xe2\x89\f\xc3\xe7\x19\xd7\x17\xb0\x7f>\x92a\xd4c\xdc\xc5\xcd{\x12\xcaaȕ\xb4\x15r\xba\xb7\xc4\xc3?\xf9F\xc6\x1d\xd6\r\xd1\xc8V\apo\xbf\x1c\xee%\xff!Y\xc0t\"\xf7\xd1\xc6\x19\x9d\xfe\x7f\x0eF\xf0B\x99\xfbr\x96;Z\x198\xb9\xe5nf\x06\xcd*\a3\xb6*\xc4\x03\x17\xf9\a\xf1\x1a\x17j\xf6<G3LԽ2\x86\tI\xf9\x87\vzYj\x89\x8f:\x0f\xb5\xe9ޫ\xe7V\x10YM\xcdy^\xc2\x11P\\Y\xca?\\\x98\xb5|\xfe_\t\xd4NU^\xda)\x1e*\xcf9\x1f\xbc\x1c\xf3H\x8c\xfb\x86\x94|\xb2\xc5}MʼBx\xaa\xeeE\"\xf9\xff@\x1b\x03\xa6}N\x83|\xda\akN!F\xda\vq\xe2,`\x12\x1b8\xaf\a\xdb\xcd^\xa8۽\x00?\xa8S\xb2\x93\xb3=\x97\xc7m\xed\x03\xaa\xb4\x94\x17nl6\r\xf3\b\x89\xad=[yP\xf1f\xee\xc5\x02|0\x00\xa5pZ܈\x01_\x1f\x85\x86\xb3\x1d\xb4\xf0\xed\xbc\x19\xc9\xffhO\xac]\x97S\xee\xadSL\xbd\xf3\xca\xf1\xd3)\xa7\x04&\xb5m\x1f39%('\xf3Q\x9dv\"\xd3\xe0]\xc8\xf4_'S\xba\x01iP\x8f{\xee_vnߡ\xbc\xb0\xf2\x0f_QxLϙ\x86M\xd2\xd2z\x8f&\xbc\x99\xa6\x12EY\xdf\xd1\xe9\xbe\xcah\xee\x8b+C\x0f\x90F>ب\rf\xe4|l\x8dU\xb8\xab\x87\xde\x1a\x7f7\xe4\xf5\x1b\xf6\x9f\x89\x9a\xc2\xc5\xc4|\xfd\xc9 \x11\xddE\xe2\xfa\xdfD\b \xdfx҈\x9e\x14_\xeb\xa2\xcc?\x04F\xe4\xe4\xc1\x9d\xe1\x19\n\xfd\xe9EJ&H0\xa1\x81\x9dQj\xbf\xf6d\x1a2\x16\xeb[aw\xe7\x97\xca2%\x8bj\xf9EX\xb3\xaa\x7f\x12w\xb68\xd3s\x10~9\x1e\x8dI<\x99M\x83\x81\xa9\xfah\xdb\xcc\xee\xc8\xe3w\\\xeei\fJ3W\"E\xb2\x06X\xc6A\xaf\xb1\x91\xc3q\x8b;\x8f9\x8b\x97C\xf1\x10\xe6&\x80\xba]3\x1ej\x0e\xe1:Q˚!Ԓh`HqUy\x8fn1INPu1[L\x9a\"\x9a\xe2\xee\xaa\x7f\xb9\x04M\xb1\xdf\x17YN\x13\x1b\xb0\xd3\xfb0Q+\xe7\x84<\xf0\x0f0}\\@\xc4S\x8a.Bv|\xb8'\v!\xc7г\x03P\x7fI[\xb2t\xf2\x8a\x93\xe1\xe4\x15x\x1ex\x94\xd8>/\x91\xe3\xbb\x1f\xf0~G\x8e\x0f\xb0\xb8w\x8c\x00\x1f\xd1\xf2\x10b\x86\x14\xa6\xd9\xf3\xa9z\xab\xe67\xc5\\\xbc*i\x99\xb95Do\xc0wkx\xf8\x8fD\xa947\xb3%\x9c\x9e͗~\xf8\xc6\xd9+\xcc^\xb5\xb3\x83'%\xb9/\bj\xef\x9boT\xd9\xfc\x19&\x9e\x8a(\x9f\xc6k9G}\x7f\xf7\xd5\xd8\x7f\xdck\xff\"\x81\x9e\t\xcdq\x91\xc7`\xdfl\xf6\xf7\x89}ay\x7fF\x83Q\x9c>\xe0g\x84a\x102[\xc9\f\xb3\xfb\x0eM\xb9_\x17\xcd⮈Z\x1d*\x1b\xfa\xbf\x1e\xe5\\]\xdfL#ҕ\x9f|g>\xdfUkNY\x9fä`\n\x9bD\x81\xdbDe\x93\xd9M\x96\xa8\xab\xba\xe4W\xbf\xfa'\t\\\xd5{\xfb}\xd9\xccaY\x0e\x99\xe4\xd1?\x92\xff\xab\xc8հ\xef\xe75ͷ\x87`9\x17B\x00\xac\xc0\xa1:8\x1a@ \x90\xdd\xeb\x19\x91>\xcc \x82\xf5\xc2\xef7\x89\xc2+\xb3\xee\xb3\t,G\xe4\x05\x0f\xef\x81\x12\xffΉ><#L\xe8\x1b\xc6O\x9e\x03y\xed\xd3;\x14\xa6\x85۪\x01k\x8cP~\xf5\x1c\xc4>\x89\xd5[\xd5\x1f\f^Y\x10Z\x98\xeeþA\x9bf\x96\xa7a\xf3\x8e\f\b\x11٩\xd6)\f\xdd\xf6B\xa1`\xa0\x9d<z\x97\xe4\xa1\xd0\xd4)$\xeaΛ\xaeI\xd4\xef8eQ%\n\xde*IԄ\xe72\xa4\r\xa9\b\x86\x05\x833\x05\xc3Ļ3\xb5\xbf\uf302+Fz.\x83t\xd0nE\x11\x9fM\x9dc\xaa\xfb\" \x8cH\x86Jo\xddj\xacU\xebSN\xf3>8\xb7\xa6o\xf8\xd1ʆ\x05\x14\xf0+\xe0Ԡ\x05:\xbb\f\x14.\x8aRu\xd8:\x853\xdf\xcfU\x14Mxs\b\xbe24\x89cu`m\x00\xe6\xa0\xd4\vezg\xf8w\t\x95꤬Z)Ⱦ\xe6)\x02#i\xfa\x0fx\xaf\x0e\xbc\x8a5\xe7\xdf\xfb&\xd9\xces\xc1?(\xe4P\xb5\\I\xd3Ǳ\x9aA\n\a)o\x9d\x92\r\xbc\xb7\xd9*\xf9\xb8\xe7\xfe\x05\x8d\xdd\n\x95Pk\x91:\x83*\x80v\r\v\x1b\xe2\xfd$]\t\x98\x91z\x19\xeeP\x91\x88\xc4kƌJUl\x90\x8e\xa5(Ui9q\xe0Z\xf5\x97\xb1\xaf\x87JK\x19\x81)T\xfd\x95\x97\x1f\x92V\xb7^I\xdds&ߎǋ\xbb\x05\xde@\xf5;\xba\xa5\xfeuQ\xdd\xf5\xc1A\xcf\xebB\xf7\xedMl\x9c\x1ay\x83\xb8[\xfcur\x03G\xcf\")=\xec\x1a_\xfa\x9b~\x95\xfa\xbd\x0f\x17\xe8\xc1\x89[\x1daT\xae\x9d<v\xcb\xecp\xd3\xcdK\xb8\x06\x80\xbf*\xc4\x11T\f4ؙh\x95\x90\xbaҩ\xa2Q\xd2\xf1Z\xbf\xf0\xc1\x100Ϡb\xf5_(\a\x8fA>7\xfd\x84\x8f\x99\x8a\xac\xa8\x88&\xb5\xea(\xe2\xeb\x81v^\xc0^\xe1\x7f\xedy.\x16ܷ\xf0\xb1\xd2\xc3\uee7e\x95\xfad4\x94>w\x1b\xbb\xc7\xdeX\x92ye4F\x93\x04\x84D\x02d\xb9\x87ڝ,\x96d*\x8cf[\xb2Է\x7f\x91\x97\x1cw\x11U\x84\bO\x93\x98\x110M\x0f\xe9\xbaW%\xbb\xf8\xe2NA\r\x03\x8c\xfa\x02\x88\xb8\xe5i\x020\x02)\x80@\xfa\x14\x01\xb8\xbd<\x89'\xbf\xb1\td\xdbV\xac\x89\xd1v\xa7\xee\x06\xc9\x06\x114\xe5\xea\n\x02\x86\xe1P\xf5\x18mO\xbdSKu\xaa\xf6\xb7ĕ\x8b\xbbsFe\x99\x92E\xb5-q\x8eNw'\x8d%\x99\n\xa3ٖ,\x87\x92\xbb\x93ŒL\x05@1\xc1\x96\xa6\x8d\xa8p1rl\"\xd6\xe5\x99\xee\x11\xf8{\x10\xb8\x88\x81\x8aX<\xb45\x02\xc6\x1d\xa1 \x99\x06$\xdda\xf22\x18&\xc3N\x94\xf2\x0e\x8eh\x990>>\xf3:\x8e\r\xcaC\xcaRy\x96kN2\x17\x14EyyWL\x1b|\x9b\xd9}\xe4\x92\xc2r\b\x06GU\rn\x99\x7fGe̋-:J\xe7\f\xae\x8cD-\x03\xb1\xc6\a\b\xc8cT\xff\xb3\xb2\xf9[\xf6\xb7h)˞\x7f\xb887\xcc\\ĦB\x05*J\xc1x\xb8Φ9\xee\xab\x7f\xeex\xf3G_P\xc6/\x94@&d(\xcb\x1ce\x19r\x16>I\xbb*\x02\xb9\ag\xea\x03\x04+0\xba\xf8\xe0\x96\x16\xe5\xcf?\\\xb4P<\xaeAw\x17D\a\x95`\a-r9\xd4%\xc6×>.\xcf\\\xe8\xe5\x03\x1e\xbd\xc4ݥhV\xdb-\xa5Q\xc5a\xce\x1f\xfd\xfa\xb0\xe8l\x9c\xc6@\xc0>,3'[\r\xf0\x1el`\x9f\xa8\x1b\x1b\xcc'\x96\xe5DU]\xa3\xbdik\xb4煱%\xad\x82\x97\x8c\x01\x828\x1a\xee\xc8FO\x7f\xcd8˩F3DsR\x9f:\xf2\x04K\xa4~\x82\xd5\x0e;[zSܲҋͰ\xd4\b\xc2\t]Hxe\xcaEb֫\x18\x89N\x10H\x8c\xb40\xf4\x1c:!\t\xbdS\x81{P+XV\xb6\xa5\x9c\x01\xebЉ\x03֔\xda\xdfg)H\xfdn\x16٤\x9b\xf5ء\xe9v\xadw\x8f\x1b\xb4\xdb\xd1A}\u0557\x17\xb5\xe9\xcbt\x1f\xe20\xfc\n\xe1W\x0e<\\\x92s\xd3\x01?\xd3\x04x\b\xd1\x01\xa4\xb1\xf2\xa0\xc1\x97\x93Fq\x8e\x95\x89\x8a\xe6(\xc3\t-\xa4\x166\xeb\xc9\xd0\xd05\xbe\xaf\xbfd\xb5\xb1\x16\xdd\xda{t;\xc7\xcf \xe9\x06N\x02\xf3\x9e\xe3`\x82S\x83\xb2\xc7\xd3Y\xe1.\xcf\xc1\x81}o\xd4O\xe3`\xb7YͶF1\ba\xe8:WN\x1d\x0edǊ\x80\xfc\xf3\xe8QL\x87\xde\xe0!\x7f\xbd\x17t\xbe\xa2\x03Z\xe2\xf0\x9c73\xf0=gYH\xff,\xa49n\x17,\\L\xf3\xf8,x2\xad\xba/j\xd8\xc8<.\xdad\x7fd\x00\xb1\xdf\xdf,Gt\x81/汶-`\xc8U_k\xe7\x19\"\xea\xd8zfW?fY=\xe7\xab![J\xe7\x04\xdbd\xc1\x9aq\xefS\x0e\xff\x17\xb3p\xd0I\x8do\xcaI^\x17\xdcK\xf5\xf9\x9b\x95\x83\x89K\x19\xc2bʊ\xe1\xf3b6\xbf\x81\xd9yӐu2\x14\xb1\x0f\a\xe94(d\xe1\xc06\f\xf5o\xbeQ\x11\xf0\xc0\t\xd45:\x8b\x04\xc0\xae\xd9Ӗ\xa8q\xa2h\xa5\v\xfe\xc5\x05\x18$û\x04\xf2\xa5z!SN\xd5 \x10\x9d\xb5f_\x94U\xdcX0\x84\xbbd@\x7f\x90F\x18a\xc1&\xf1\x97jp\x96,\xefj\xdb\xfe\x12\x02h\xcf[\xa3ZW+\x9a\xfe\xe0˨\xb0[\x1b\xb9<\x9a\x97'\xc4&#v\xb6\x00>\xba\xbe\"\xb5\xef/x\xa2\x1a\xa3\xf5vh\x01K<\xefrS\x16uV\x8foV\xd2\x19\xe7\xd2\v;F\r\xdb\xf1\x13ؗgb';\tj5\vP\x96\xdf\xf6\xb4\x8f]l1\xe4\xd5P\xb46\x93\x1a\xb5\x97U4\x06#\xdaW\x9d\x991T\xc2.\x84=\x8fa\xf7'}\x9c\xd7\x14K\xac|A\xa7R\x06;\x12NP\xb3\xb2\x88\xe01\xefV\xe5\xdd\xf3\xbbG\x90\xeb\x9e+\xf4\xf7\xe2\x11\xb3\xfbXD\xd7\xf1\xac,\xbc*\xb6\xa3B\xa8\x13\x181\xees\xe7ow[\xb5\xe7\x16l\x1e=\x1f\xefo\xacW\xa7\x02ơq\v\xfb@\x98\x06\xef\xdbjS\xe0\x9cn\xfc\f\x01\xab\x15\x82\x96z\x01\xe6\xd4,\xee\"\x14\xdc\x1e\x8e\a\x01987\xbb5Q\x8dd\x1egޞ!\xf5L\xefK\x88Uiwd\xc2\x7f\x90[k\x04\xb3\xb2x_\xd5\xf3?\xae~!g\xc8rD0(\tT\x89f\f\x86n\a\xfc\xdb\x06\x91\xb0Mj\x13\x02`\x02\xed\x03\x1d%\xfe\xf8p\xd1U\xa7\xf5\xd8\xdd^[\x86\xb7r:\xb6\x03\x05\x85[\xcd\xea1\x05S\x16D!\xddS\xc5l\xb4\xb7݁c3\xe2ɥ<[[\xa7*\x93\x19\\\xa5\xa7*C\xc7\x0e\xcb\x1e&\xfb\xb1-\x1f\xa9\x03\xd8\xf3\xdd\v\x12VC\xa5\x8f1\xe8\xddM\xaa\xbb\xd6\x12\xc1\x14Ė\x89\xe1\x05\xde\x13\xb0\x1bJ\xc9\a\x95\xdc\xcf=\xc5\xfd`I\x16\x81Ѵ\x1cPY8\xaegV\x16}\x92aw\x8aP\x90\xf1\x13\x92\xad\xe8I\x95<\x81\xaa)nhK\x84\xdbp *aw\xfa\\\x98\xe9\bd\x9bh\xbb\xc9\xf20B\xb8ͷ\x0f\x164\xf3,\xbc6\xce\t`p\xe3[\xf6\xf2\xd0&\xad\\`\xc0MQ\x97\xbbyz4\xa5F{zD\xbe\xd6\xd7\xe3\xa6\x0f\xc0\xaf\tmE!w\xa1\xee=(\x03w\xae\x96\x0e\x1b\b\x98\xa8U;Q\x9e\b{\xecപ\xf3\x02\xe7\x06\xf1\x87\x83C\v\xc1\xfa\xe3BVuG\xfdYQ\xdf-\xe6E\xa4\xd3\x12Bf@\x8d\x16\x04(#\xf5@\x91\x15\xbc\f\x05y\xc1_[0\xc3\xfb\x0eH\xcdr^J'\xc1=7\x9c\n\x1d\x86?\xf9\xb4v\xf6\xaeZ\xb8<\x18\x94\xb8\x9b\xbc2\x8c¬\x1es\xa8s\xe5\xf38bT\x9dv\x8f\xaa;h\xd1\xce\xf5\n<6\x11\xc0\x8d\xd0D\xc4P-\xdbT\xddpݚY6ς\xfe\x9c\xaet\xe5\x91+U\x91\xe3ֱ.\xfe\x0e5G\xf7 \xf0\x8d7\x01(\xac\xc2\xff*\xea*Q\xd5\"\x04\xb0\x98\xeb[z\xbd\xf4_\xf5\x8d\xbd^*=E\x8e\xbf\xfb\x86\xdd\xdd\xddֽ\xf3إA\xe4\xbb,\xa4\xe38-L\xe9\xb3Jv'\x8c%\x99\n\xa3Y\xbf\xbe\xe4\xa9\x00\xf5\xde\xf4\xaf\x8b9P\xfd\xf4i]\xbdl+\x0eW\xde\x13\xe4q\xaeK7\x88v\x93\bK\xad\x13\xc9\x18і\x02=\xf1\xec_\xc75\xcckI=\xf1\x98_ǝ\xcakI\xe9\xf6\xb3;\xb1ja+h1ߊ\xa0\x9f\x11蝅1\xd2\xe5\x02ٌ\x96\xcfz\xe5\xb4)\xf3\xe2\xa0Z\xcc{\"Іn\xd8\xf2\xcc>[\x8c\x0e\xd87\xeb\xa77L\x9f\xec\xd1\xfc1[\xe2y\x1e\x18\xbc.\xee\x9c\xdeۃ\xfc\xa9\xc8\x17\xe3\xe2\xfd\xe2.Nڣ\x92i\xbc\xe1\x14\x15\xe9\xe0.[\x9eg0\x8a\x80\x1f#ӕ>\xc6p?\x12\xcc3\x0e\x12\xa5\xdfgֿ\xe7\x15\x1d\xb5\xe3dwd\xb0yj\x15\xfa\xa2\x92\xce1yK\x03\xd5L\xbd%\xac\xb2\x04\x9cΞA\xd7\xd0,\xee\xfc\xa5%`G\xaf\x06}\x88\xd7n\x9c!\x19\xc2XH\x92\x00\xa2p\xef\xc2\xf0p[N\r\x0f+\xf4\xc7\xd5t\x9c\xcd#`\x87\x8a?&dj\b\xd2i'\x8418\x9a\xb4\xf8\x1d\x94\xbd\\\xbb\xbf\xde\xe9:\xe7\xb8\xc71PȮM\f\xe2\x18vSNn\xaaE1\x9f\x17\xbb\x99\xf5\x1d%\xc80\x84l\x17\xf6\x82j{\xa7s\x9c\xb8Ʋ\x1a\xb4\x8dfm\x8cb\xcd*Q\x95c]\xe5\xfe~\xac\x03\a\x1aE\xea\b\xc1V!XV\xa5\xfe\x00\x0f\xc7\xc4Ċ\t\x8e\xe0\xd4\x1aW{\x15\x9fy\x95\xbd\x99\xad\xd5\x00F\xe0x(\x05vc\x91e\xe1ٜ\xb3\xb0\xe5\xac\x06NU>\x94\xd7ד\x1d\xb5\xcd\xce\x03'\xd0@\xf5|\xfa\xa5\xa5\xe9\xdbD5i\xa2\x9a\xc3D5Gp\x0f)*\xbf\x1a\xb4*`\x85w/\fQ\xb5\xd5@\xceX\x1a\x1d\xa4\xdbTM\x93\xb6[~\x93\xae\xa9\x1c\xa7\xf0\xa1\xfe\x8b3\xc3K\xa8F\xbc\xe3ky\xfeA\x87\x82\x83\v\x17\xb1e\x81\xf0С\xdf\xe6\b\xa6\x87\x1c\x82\xea\xc02\xa0\x91\xe9\xdb$\xf0\x9cg\xbe\x8c\xcfԭz\xabJ\xe0\xf8\xd6E\xacTs\x04\xfcc38\xbf\xf5\xf0\xddz\xf8\xbc\x97\xf7\xc5\xef\xe6\x10\xb04G\xea\xb9\xe5\xc4U\xc5c˪*|\r\v\xde\xd1n\x0e\xe1\xea\xb8\x14\n/\xddyqm\xddoU5\x88U5\x90\xb6\xfd\xe8נgŚ\xca\xc1PU\x83\xb3\xb56Z,g\xd94\xff|\x8fpKg(\xa6\xffNG\x10\v\fnQ\xa7\x94z1T\x15m\xb6ٮ\xc0P\xdd\xee\xa6\xf9\xa1ڠ\xf7\x8fE]u\x04\x930\xe00\xfe\xddN\x18ܔͼ\xba\xae\xb3\xf5\xf7<\x1a\xa8\xf0\xf4𨜲\xe36\x03\a\x13\x81`J\r\x87\x01\xf0N(~l\x0e\xff\xd6r\x11\x00\x01a \vq\xc4M]47\xd5\x04\xcf3\x8d\xca\xe9\x94G\xe0\x1a\x14\v\xbb%F\xf0\x9c\x9cY\xf70s\x80\x8eyY\xac\x94\f\rQ[\xd9U]\xfc\xbe(\xa6\xe3\x95z\xa7Ru\xcaVg\x1a\xa7\xb3|rg\xc5Wj\x84wp\x82\n\xf4\\\xa55M\xcc\xd3\xcbd\x962\x1e\xb6\xc5k\x06#\xc8\xf53\xe58\x15\xf2W\xb2\xd2\xd9J\xa0\x05\xdf\xf9{\xb6v\xd9\b\xbd\x94s\xa4\xb6\x88\xd9@\xc6/\xfc\r.\xf0\xcd)\xf5\x96\x13\xdc+\x7f<\xf1aݷl\xe0)\x1d+\x11.J\xa6\t\xec\x8e\x02'*i\xb1\x80\xfb\xa2\x1d\xd8t\x8c\xab\xa8\xbd\b\x9dX%\xd8_\x8fn\x9b\x00^\xfcq\xbc\xad^\xb8\x9bh\xc1\xb6S\x8b\xa9Z\x14\xb0v\x06\xd8\xc6\xec\xf1ED\xb4\xb1i\x17\xc8\x1f\xcb\xe9\xfb\xf9\xc2\x1eU1\x19\x9f;e[\xb3\xf0\x86\xe3\xe5\x99+\xb0!ER\xfb\fp\x93\u06dd\x01,i\x18\x90\x1a\xea\xdc\x03\xbb\x89\x19\xf2\x15\xbb\xf3\xa2\x95\xcf\xd4LU\xb8\xe3j\xda\x16$WC\x90kK\xc40\x1a\xae\xc3?\xc3\xe6\xfb\x88\xfc\x8b\x15\x0e\x96G>Gji\x7f\xbb\x8bnJ3U\x89\xeeٳ\xed\xec!\x94\xe9\xcd\x1e\x9b\xc5C\xc9%3\xc9\t\xaa.\xe0\x85A^)\xc4EX\xdaN\x13X\xe0n\xe4j5:{C$Q\x02C\xacQxm\x8b \x7f\x98N\xca),qA\x01\xe7<\xbf]\f\xa2\xa4Gw;\x02\xa0\x06\x9c\xf0W\xe6P\x00\x0f?\xe3\xd6J{㮳\x9b\xea\xe8\\eO\xd4\xd8_\xc5\xe7\xee\xd0\xde\x12\x83\x8a\x02\xdf\xef\xeciX\xe7\xa5a%\x8b\x95\x8d:\x84\xd50\x8cҁ\x94\x9d\"\xa6\xb3\x87\xf8\xd2\x00\xf1!\xb3\xc6\xe4K\x1d\xf8{\x8cz\xfa\u03a2\x90\xf5\xa4v52Vc\xbb\x02)0@>]\x17 \xf6_\f\xd5\xfdٞ3\x9e\x16pV4\xa7\x84\\)\xee\xb0\r\x98\x00ku\x82d\xcfVZo3B] b\xda\x1aC\x88Z\xf6\x19܀q\xff\xe56`xu\xdf]\xf1X!\xcc3\xa3\xd7\xf5\x1do\xa3\xd3\xfb5\xaa\\Ӣ@ruJ\xade\x93\xca7V:\xd5\xc9}\xb0B\f\xf1(\x0fo\r\xb1\x91\x93q1\xb8\xf5õvQ\xef\xadn\x9eV\x19\x03\x02òY\"\xab<\x00\xf3\x1d\xe5Rp\x10\x06\xfaŶ\x18\x93\xf6U\x97H\r\x15\xafka\xdaB\xa6\xdd\xe9sa\xa6)\x90\xed\xca\a\xeb\xec+\xadLo\"_\x17-\x06\xea\xaa\x12\x97\x93rۂTxs\xdc\xc3\x0f\xc9\x0e\xea\x10\xdd\xc0\xa4\xafߤ\xc4C\xf5\xb6c\x9dmZ\x95\x05\x80'm\x9aJ\x90qra\x8d\xb8\xdb\x1c\x92\xfbK\xe9\xc80\xc5\x19OX)\xe0\xac\xe5/p\xe4\xf1۫yQ\x93\x8e\f\xbb\xceiļ_;\aws\xed\xc9\x03\xab\xa8\x1b\xd0\xdbl\x90\xfe}9\x9a\x94\xd3\xeb&\xf6\x1f\xd3\bo\x9a\xbf\x95\xdb\xd7\x0e\xd5sTC\xbfV/\xd4C\xa2\x9cow\x87\r_\xaf\xe2\r\x9e\x80X\x0erq\xfesu+\xa7䞨+\xe8\x97kp\xec\xb9\t\\\xa5\x82>WE\x9f\xc5\xd5A7WOQ\xee\xe3\x9e\xcf\x11\xd4\xea\xcfp\xc1\xe7UU\xdf\x11C\x0f\xa0T8\xff\x80\x7f`\x90}\xeb7ħm\xdbC\x17,\xee4\x04\xe2\xe0\xf3c\xff&\xa2\xf0\xe6\xbe\xf1\xedW\xde\xd7\xe7\xad&!E˘!z\xb9-Q*\xcbt,\xaa\xfd\xcbM\xb4)\xddꪽ\x9d\x10\xd6\xd9\xd7\xee$\x1cO\x16\xcd\xdc]\xf0e\xce9A\x11̗qk\x89\x9a\xd5\xc5}Y-\x1ax\x13,\xe1\xeb\x17o\x97\x89\xba]\x9d\xb5lo}k\xf0\xefwY\x17\r2\xeev ȿM\xd5X\x84\x14⺋\xf8\xa4\x8f_\r\x92\xf8\xcc/\xb0\n\x15\xf8-T\x80B\xc1 I\xa9)<\x91\t\xd3\xc8\xc5,\xab3#\xbd\xab\xce؝ \x16\xcc8\xa9\x0e^\x7f\x9c\xf1\xb8\xe7\xfb\x11\xd0ꤸ\x9a\x87d\xfakq5\xc7Z\x81\x9a\xa6CJ-\xa0\x9f \x83\xa1\xf0\xbaY@\a\x17<Hq \x8d\x90\xd0\rgxO-&\xc0}\x06\x12\x16\x13\x13\x05E\x9c\x15\x97\xa7\x1a\x8eQyD\xbf\xf8¿h\x99\xd2\xef\xe7܁\x9d\xb9\xa5\xa0\xaa#\x00\x8a\xa8_~\xc7\xe9/\xb8\xa7>Uil\x10ع\xb3\xc7\xd8k\xe1\x9f\xe31q\xa7i\x9fFJ\xf0;\x11\xfar*\x054\xf3\xdeduxR\xaa\xb9\xbe\x83\xe3\t.\xd5\x14gQ\x1d\x84\xbe{%\xaa\x8e\x973\x9c|E\xb7\x1e\xa2KYVo\xbew\xa5B\x1b\x1c,h[\xb2\xcd\\s\x82\x02\x00ϵ\x9a\xbc\xab\xb2n\xe6\xff\x99Mn\xbd\xb6N\x01Ķ\xae/Q\xe6\x02c\xc0ӿ\x04\x9a\xdd\xfep\xe3\xc0\x98\x9c\x7f\xa2\xf9\xc3і(\xe18y\xccL\x14\x9c\xd0k\xe6\xf8ԥ-C\xc38\x9e\xed\xb7\f\xad\x9fM\xa1\xc1\x8d\xa4\xe8\xce}+\xa17\xa2\xe0\xb0c\x9a\x1d\xedN\xb6\xbce\xb3YU\x83\xe6\x83\xe5\xac\x18\x1e\x06\a\x8a\xf9\x92 \xecS\xdb.\xea=\xbc@\xe1N_\xb1~\xef\xca\x1c\xb7\xbe\xc1\xbdK\xaf\xe0\xc6\x1a\xab9]\x83\xfdY]L\xca;\xb5\xafƭD\a\x1bT\xf0\x1aӁ\x7f\xc9Z\t\xe3Py\xe0>\xc1\xce^\x88џ\x85\xb0\xdfU0\x13\xe6\x92:0r\xca\"\x81^\xb1\xcdc\xb0\xe0\xde\x1a\x14\xfff=<\xee\xf9\xbf\x1e\xfd\x06\xdf\x14\xe3j\x9a\x8b\x16/\xbc\x9cR\xd3*/\xfa\xff\x9f\xbdw\xedn\xdbH\x12@\xf3\xf5\xf2W\x94\xb9\xc7\x16i\x81\x10I=\x9cP\x92}<v2ɬ\x1f9\x913\xb3\xb3Z]\x1f\x88hI\x90@\x00\x01@\x99\xb0\x87\xff\xfd\x9e\xea\xae~\x02 )\xd9\xced\xf7F\xf6\x91\xc8\xee\xea\xea\xea\xea\xaa\xea\xea\xea\x97:;吲8\xdc\xc8\x16|\xb6ګ\x88X\xed \x95A\xd5,\r7Wg\xa3Ѳz\x1e/\xd3\xc1\xf9\r\xb9\xa7շ\xb13\f\xfd5(X+\x14\xa8\x8b\xb7QF\x8c\xf4\xe065>7\t\t\x82\xcc\b\x84\xa2\xb9\x8a\xfdt\xf2\t1\xdcF\x99\xe6\x16\x9e0\xe0\x89\xa9\x9d\xc8\xf1\xdfF3\x1b\x92'\xa6v\"\x1a\x92\x06\x9e\xdfr\f\xb6\xd1\x11\xfe\xd8m\x84\x9b\xc7D\xcb\xec|\xee\xd4\xddF\x19Ϟ\xc1\xa3G\x80_,\xa6\x00\xb5\xb1\xa9`:\xb3:\x0e\x88c\x8d4\xa4Y\x1d\x96\x9a%;\x8b8i\x83\xf1\xe6Z\xac\xd1:ɭ\x8a\xe6.e\f8\xdb-\x95\xbd\x8df\xd8B\x97\x04\x94\b\x81\xdf\t.4\x19\xf0\xd7\xf8d\x8b\x9d\xf4\x9c\b\x17\xf8Q\n\fɓ\t\xbc\x02\xa7b\xf4O\xc4f7\xb73\xf1_\x91\xb6\xe5-;\x16\x1cr\xc1\x11\x9a\xc3NC-\x96\x00:\x10b\xbb\xdcm:k\x87\x108Ҭ\x19¤\t\x19J\x82\xf4\xa0M\n\xea\xe2%\x11\xe3\x1at\x80\x03\xc7m4;l\x81\xc1\x91\x05y#\xfa9\xcdVS\x925P\xa2D\xb7NȬNHv\xd8\x02\xa3\b\xc98!\x0e\xc1+Dz٩\x7f\"\x17T\x96:\xec\xd8\x00\x9f\x19X\xbc\xcb\\\xa9>\xb0\x19\\\xd2v\xdf9\xe1%\t\x17\xcb\x12\xe6I-\xa1\x93\x13\xb0na\x9f\xa5\xa1\x93\"^\xf4u\x12\xb9\xe68ib\xb9x\xe2R\t\xcf\xdc\x14\xea&\x01\x8f\x8bx8cV\x88\x96\xf5\xa9\x99v%\x91\x8d*\xd9\x18\xb30݃\x01\xfeiv\xc1\x1a\xe7\xcf\bx°ˈ\xdfv\x16\x8a\xe3,-\x9a\xa7\xd5k\xcar\xa5\xa2\xc2!c\xd9]ʾd,c\xc5W\x9b\xa7\xe3ݽH\x0ec\x19\xad\xe9\xfe\xeb_z1\xed.\x12\xf9\x05g\xef\xc6\n\xf3\x0e\xd2W\x9b\xa8\x03\x84,f%3\xfc\xae\xba\xa0\xfc\xf1\xe6\xf0\xc8\xc4\xdfy\x02\xafYC\xaa\x84\t_u\xea^\xab\x91\xd25\xaf\xdcy;\x96\xd8`\xd2>\v\x1aoڐ\t\xe2\xd5.\xe5@{`l\x1e\xb5\xdc\xe4\xbaS\xad\xe6\xbdA\xce\xe8\xa0\xd2*\x17\x19\xa1P\xb6\x1bf\xbd$,89\xc4M\xacCx\x06C\x98XW\x9arh\x9fp\x88k]\xf0\v_\x17\xc6\x0f\xb8%L\x963\xe9i\xbc\x02\xa1\xf8m\x1e\xe4\xd1E\xe5h\xe0&\xf3\rw\xb6\xe1\xf0\xc4l0\xa2\xcb\xd9\x14m^\x16\x84\xa2.\x1c\xc5>\xd0^\x8c\x9c\xe1\x03\xb9\"J\xae\xd0\x14q4e\xbd\xbe\xe2\xed9+\x10\x81|\xca\t_YKs\xe6\xc1\\-\x89DI\x0fk\xf1qk\xb4\xf8P\xf5\xadi\x8d\xe8aU\x9b\x84\xc2\xdbc\t\u07ba\xb4\xc2`z\x9e~\x90,7\x02\xaf\xd4\xc5|\x9e\xa5\xb0\xaa\xf6\xd7|MD\xc27|ȎWeN\x13\xdc\xefh\xee04\xaa\xdc&\xa6\xf8fo\xca\x1e\xe8q.\xc01|H\xf3\x02\x03\xb7\x1f<\x98\xf7\xfb(\x04\xc80\x9b\x00\xd05\xfaY\x9aɓ\b\xf2\x878\xcc1\xae\x9d\xbb+\xf2\x06ǜT\x8e\xafF\xa2qg\x84 M\xf0\xdc\x13K\xb1N\xfd\xab{҆\xc5*峓-\xbdc4I\n\x8d\x99\xb9\xec\xd4?!G5f\x9by-\r\xb1^\xe1ݐ2]\xa1\x92vy\xfc^*d\x7f\xb5\xda\xe2M\xad\xff.\xb5\xddDY\xa5n\x7fuݫ\xa9\x92\x10\xecU\x8ag\xa0\xdeT\xcbD\xceG}\x01ħ\x152.\x81\x9f\xa9&Nd\x13\xa5\xd0<\xd0\xe4\x12\xdb\xef!\xdd\xcbN\xfdS]\x9eHRV˓e<T\xe3P\x9c\n\x83\x00\x0fr\x0fru,%\x9fE\x89\xa1[\x9e5B\xea\x16\x1cv\xac\x0ek\x18\x0f\x91\xc3\x0fz\x18\xae\xcd\xd3\x0f\xa7\xd1\x19om\xbf\x0f\xd34)\xa3Do@!\xfd\x84#^u_\x12\x90\xbb\xf9O9\x91}Ijm\x9aU\xe0\xdd\xe4\xe4\xd2\x01\xcc\xf1\xdb\xdcqB\nxF\xa6(X\xf4\xe6(\xa3\xd8\xec\xc7\xc0\xfd$<\xbe\xe1A\x81\xee\xa8Ȋ\x12\x99\xd5\xc7\x05;\xdb\xda8\xacn1#\x17\xf1\xbc\xb8jw84;\xc5\x02+\x8a\x11>qQ\xc9ϕx*l\x0e\xcf O\xe7I\xd8SR\xb3\x83]\x8a\xb3+}\x9a\x04\xd98\xc7\xcb\xfaH@\xdd\xce\xe0Ġ?q\vO\xa5\xe8\xe2>8Y\xa0\xaa\xa9aC\xaf\x8a+\xf1D\x8f\x1e\xd6n\xcc^\xb8\x97Z\x1f\xebs\xa6\xf8/\xc5\xc1\xd8ؿ\xa6b\x92)\x8e\xd7\xee8\x81\x8b\x89R\xd9\x06\xb8\xaf\xfdV\xf1!\x95\\\xb8\xe5\\\xe8\x1f6hM\xea\x7f\xa4\xdd\xe7:\x97׳}\fM\xf85\x14O\xe4\x1b\xe5\rJ\x89I00Rkch\v\x9f\x17&\x9f\x17\xbf\v\x9f\x17.\x9f+\xe2sU\xe3s\xa5\xf8\x80\x97\x8fW\xf7\xe3\xb3څ\xa5(\xe0\x156U\xa0\xa1d7X\xa4\x12\x97lF7)\x1d\xb9\xff\xbd\xb0y\xb7\x830\x92\xac@\x91Wӈ^\xf8y[\xb8x\n\x97Ug\xa2*2\x8cW\x03d\x06\x8a\x84$\xa5_\xdf@\xe7\xc4+\xb8/{*(<\xf3Tu\xb4\x05(T\xab\xd5\xf6p\xaa*\x80gʋ\x80\x89\x9a\a\xf4\xedJ\x14EU_3\x89\xd8\xe1X\xcc\xfbM\x93\x91\xeey\xa2n<\xe3_j\x93_s\xa3\x10\x0e\xbf\xfc{m\xf6<\v\xb2\x9f\x83\xf0\r\xcf\xe7\xb4VR\xd6\xe8{\x84\a\x92\xb8\x95V\vpxa\x9a\xde/\xb7//*#t_}\x9a;\v2k\xa6+\xeb\xd5\xcdm\xaaZ\xa6!\x17~\x90\xf9\xb6#H7\xba`\xb5\x14>\x14\x98e\xacZ\aHTW+\xc22\xfb\x91\xe3F\x0eSu\x93F\x00\x8a/\xd2\xf1\x8f\xcc=\xfeq\n\x99'\xff\xc3\x19L@G\xeb\x97\r-|\x91&x\x1flYk!\x91\xbb\x82\x82E\r\xf1\xea^#\xb6\xcbB\xc8Bl\x84\xfc.\x84N\xedS\xe4o֭g\x15L\xc4\xed\xa9Ɓ\x98~\xfd\xa6\t\xa3+a\xc2\x01]\xb6\xf1{\xabOql\xa3\xff\xa4\x17\x92?8\xba\x19_\x0f;\x16\x97VI\x9b\xd4\xc0&Y[\xcd0Q\xf2\x81<\xa9\xa5\xea$\x84\v\xe9D\t\xb8\x89\v\xb6\x9e4\xad\xc7w\xa6M\x14\x95u)DJ\rMsV\x7fkn\x15Q҄܃_XR\xd5Dh61\f5Yo\n\x81q\xfdn\x8e\x82M\vt*\xc2\xdd\xf7aq\xdb\xebz]\x0f\xba%[\x94;\xd3\xe2\xb6+\x8f\x9d\x96\x16\xcc\xff\xa3`\xca\xe0|@!B\x16\x0e\xf8hR\xa8B\x97,Uw]\xd1\xee\xebK\x96\xbeσ0\n\xf8i2\xf9\xee\xee\x0e\x8c\xbe\x1d\xeaB~\xf01\x9a\xcd˫ 6\x19)\xf9(\x13@A\xf5\xa6i\x9a\x87Q\x12\x94\xeeY&\x1e\xaa6r\xf1\xec\xfbc\x97\x0e\xdc\xf0E\x91j\x13tT\a\xf5`\xca1r\xba\xa7i\xd1[\x8c\xf0\xee\x0e\x9dVD\x89H\x9bV*\r\xe1*L+t\x1a\xc2\xf1\xb4)\x9e@\x9a\xe1a\x8e\a\xa8\xd1i^^\xe1\x11\xb0\xec*\x9a\xa2^\x17\xd5\x10\x1e\xf3\x82\xdb0\xe5\x9f\x11\xf1cNƄ\x86\xbb\xa9\xd8U͑p\xb3\x80;<\x99\x89e\x84\x93\x11\x8eb\x8a\x86@C^&\xe9,M4\xd0tje\xb3\xdf摸\x83\xbbD\x88\xdeT\x92\x1f`\x9b\xa6S\xa4\x1f\x9e\xc1T\xbd\x96\x17%=^\xc3Ю\x86\xfd6\x0fbt\xb4\xbbR\xe7\xf9\xd3Qc\x83,,\x80\xcf\xfc\xc11ܨV\x16\xfc\xf5?J\xea\x15N\xfb\ađ\xa2\x1a\xf5\x1d-9\x15\xe1Zz\xe0\xaa\xc4\xcd\xcc\xf8T\x91X\x8d\xa7\x9c\xca\xca\x19\x9d\xc1\x99럈\x068\x9d\x82\xb7HE\x97xhYTq\f\xe3\xe1\xd0Ә\xb8\x1d\xde\xfbv\xe8\xc1x\x7f\x88V\x98\xcb\xd6\x10%\x02\xb71˃\xdfJv\xfd(\xb9e\xf6)\x14C\b\x1di\xe6qaCD\xf9\xad\t\xba\x11C~\xd3\x01\xa7K\xf0\xcd\x02\x1e\xd9\xc0#\x138S\x82\x89\x1dc\xbf\f恒\xd1f\xf1R\x17\x1e\x06e\x90\xf4\xb2v\x11k\x05r\x04-k\x97\x1e]\x17\xaa\x10\xf7\xd38.\x9d\x86\xdb\x18\n%\xa9\x982\x95z\xa6\xd4qZ\x17\x98\xdeb(\xdd=lƘ3\xa1\x98z\x90qq\xe4\xa27%\x9e\x90V\xa2\xd4\xee\xd4,\x84\xa6d:%\xd8\x01\xf42x&\x8aN\t\xdd\x0eo\xe5\xb0\x01\x85\x12DWRH\x1e\xef>\xb2̌\xc5gB\x82\xdd\xdb\xed:\\PU\xb5\x10 $\xff^$\x88\xa2\xb2>\x85H\ro|\x9b\xb0Hn\xb2\xd1\x12\xac2\xc0F\xed`\xc8`\xa3\xbb+=\xe1,t\x0ev\x91\x91\xb3!\x0f\xa4\xd6ߝ\x05\xbc\xa4\xa2\x83\xd0l/\xeeX\xbfR\xe0{ѠJ\xcbZMt\xa7\xb0\xbd\xe0&r{a\xd8\xc35\x94\xb9\x99\xf2\xca\xf8S\x8c)\r\xe1\xcc\xf24\xf8\xd8\x1e\x9f\x8b\x8b\xb6\x14\xf5\x92x\x99\x00\x02\xc44^\xba}h\a\xd1ZbP\xadg\xf7>j\x96a\xef\xb8q\x8c\x93\xcbaߵo/`\xc0\rI\"\x8d\t\x8a\xc2J\\#|\xfde\a\x12\x87%z\x9c\xc9LTe\xbfu\xd4\xe9)@\x1cBK\xbc]!\x1b\xf6W\x0eE\x8a-9\x8b\xd3 TK\x934\x8d\xbb\x8aЫ\xa8Q\x8f.Y\x1c\xb3\x18\x03\x13\x1edW\xd1x5\x14\x1eΊ\x83r\xd8\x04\xa5\x14\x0e㚆\xf6dWш\x86\a\xd5$\x9e&ل\xdc_\x81PG=\x125\xe9.Ԥ[T0\x96\xd3n\x80\x17\xe8\xa0a\xc7\xc0\xb6\xea>\xa5\xf2\xd9p}\x17c\xfb\x1a\xfbQ\b\x9c\xc9td\xad\xb2R\xa70\xf8\xee[\x0fv\xbf\xc5\xf1\\\xb1\x8cg\x8c\xbf\xf3\xf7=\xd8\xdb\xf7\xf7A\xf5\xf21\x8c\xd8\xee*\x97\x00\xd9\xc2\xcf\x18\xbf\xf0 \x93\x0e\x01'\xe1\x0f\xe2\r\f\xd1y\xc8p@\xac<(\xe1\xd8\x1a\x17y~\xbf\xcdgȆ8\xcc!\x84\xc3\xe3S\xe8a\xbbQԱ\aV\x0f\x9d\xbc\xff2\xd2+\xec\xc3D\xdd\xe1\x94l0d\n^\xaa\xfe\xbb\xbb\x9dl\x19\xae\xd6XH\xa9\xa0MĘbswzTiY\xa3\x89\xee3\xa8\xfa꣙\xa9XV\xcdJ\xf4\xeeU\xbb*-\xeb3ѭᇥ쇝\x16NՇ\xac_\v\xeb\xf97I\xaaL\xa0\x86\xfdZ\x04\xa6\x9e\xe9\x06\xa1\xca\xc6|\x17\x8d\xa9\x86h\x9c㠬M=\x1d\x9a{\b\xf3\x14\xf6q\xebF\x10\a\xc5M\x00\x13\x8e\xed\b\x06\xa3=L\xbd\n>\x04Q\x84\xa9A\tG0ƻĲ9\xcb\xcb\xf4\x97h\x9abz\xfa\x81\xe5{\xdf\xf6-\xea\\\x93G@pl7]\n\x0f\x82P\xf55\b=\xf0\x0fF\aC\x0f\x0ep\xf0\xd7b\xdf;\x85\xfd}\x0f\x0e\xf6\xc9%\xa0\x10\xb0\xa0z-\xb2q\rٷ\x1e\x8c\xbe\xb5p\x19\xad]\x85\x0fi\x1b\xadA\xa7\xba\xf23\x14\x84X)0H\x06\x82\x93\xac\x83\x90\x82\xad2\x19\x1e\x83\x7f\xa0\xf2D\xdf֊\xe8\x06\x1b\xc5F\xfe~\xdf\x11\x1e\xdd\x1a\xa5%=I\x86N\xe9\xf7\x1b\xd4\xd4*\xf4Yl0*\x92\xe4\xa1\x00\x84\xb8\xb6\xe6\xb0\nv\xc4\x00J\xb7\x03\xa2\x8a\xf0待\xa1\x17u\xb45V\xea\xacS\xe0+s{C\x9c\xb6\x85\x1f9\xbam\x18=\x11_\xe1\xccet\xad\xe4\xe8;\xab\xe4xX+i\xf4\x85Sz\x1b\xf6\xbf\xb5J\xef\xed\xd6J\xbb\x1de\xf5\x84\x9bIlr\x98V3Z\xe7i\x92X\x92+{L&\x00\x87h7V\x8b\x9a\xa9j\r\x9d\xd5\xccW\x13\xa4\f}\bũF\xba6\xd2_ĂQ\xaa\nc;\x95\a\xdf\xd3#\xe5ʷ\xacp\xe4\xa7ا\xbc\x0e\xcdr\xbc\xbfW\x1c\x15\xf7\x11[\xce\xf6\xf7\xe8lOG\xadk\xae\xfc\x99r\xa3.\x13\xd5c\xf3\xc4\xda\xd2\xee\xb5/\x13l\xbac4\xa9\x1ay\xaa-\xbc\x1f\xff\b\x9e\xe3\xea\xfe\x9d\xaa\xfem\xf3\x1dyl\xc4\xe9B-\x11N\xc7\xf3x\x8d\xea`\xe5\x9bN\xfb\xb0\xd3ԋ\xb5\xeev:\x15\xfb\x7f\xa7\xb1\xffkݽhp]\xabu>\xa9\xe8$9\x1c\xde˔V\xa3Z%\x92\xc6\xe9\x88n\xdc\xe4\xf4\x97\x01F\x97I{\xec\x02\xfdC\xbb5\x9c\xac\x06B?\xc3w>Ž\xe4.\xa1\x18\x03me\x11\x85\x7f\x16k#?\x8bUA\x9f5-\xfaڮn[\xbdJS\xeeU\xb7*-k\xbb\x83\xa3[\xa7\xc8̨\x05j\x94p\xf6\xf6\xf6k\xa3\tFhqCE\x90\\\xce\xe3`\xf5\x05\x12\x0e\xaci<\xeavȴ,C|\x1dl\xf7\x80F\x94\x81\x995\xa2\xacïi{\xf7W\xd9^Q\xb3Ӷ?\x92\xd5U,\xd9=@\ac\xe1\xc1@|\xaaT\x8b\x9b\xdb \x9b\x7fw\xe9\xdcL3\x9c\xfaVҢZx/zTiI\xc1\x1d\xb4e\x15\x95\xed \xa6\x8e\xccX>\r\xe8Ի$]R.\xdb\x02\x12\xc8\xecܺ\x9c\xacЊ\x1e7\xf1qz\xd9S\xb6^/\xa3\xee\xc1\xb6U\xb6\xc9\x13ہqC\b\xa5\x7fo\xfdR;!\a\x18\nS[\xc2\xf0\v>'\xfb\x05\x95O\xf2\ue3edu\xf6r\x18\xff\xc4\x16YO)\xa3\xdb!M\xf1\xac\x01|\xa7Z\xbdt\x1a/\x19vw\xf5\xd8L]eE͵+f܋\x02UZ\xd6y\a\x05m\xa4\xab!\xcfT\xc9,(\xafLB%\x9d2\x01\x10\xc0\xb9\x8e\xc3xј_T\xf0K\x10F\xf3\xc2ٕ\xd3\x17\xef\x14\xbd\x88\xf2iL\xb7\x9f\"\xaa\xf7S\x9e\xd03\n\xb6<I\xa8\x1c1,\xf6\xaeʘq\xd1'\xca-\xbe\x1f\x1c\xa3\x88\x9f\xcf/.XN\x8c\x84g\xf2\xfbu\x1a%\xbdn\x17\xd7>\xcd\x1d*\"\u05f9\x94\x91\x82[\x85zG\xc7YX\xc8\xf2\xf4\x1a\xaf\x7f6$_\xb3\x83\x8a\x13\x8c\xabkD\x87\xd7\xed7cN\xe3\xea2M\x9e\xe7lET\xac\x98\xe3\x99\xf5\xc0\x81\xc1\xa7AŮq\xbcJҶ\x89č\xc3N\xf3\xf6WD8h\xc0h\xdcHM\xad*\xe6\xb3Ut\xbf`I\x99\xa7Q\xd8N;\x01\xaa\b\xd6̧\x14\xab\xe2\xe1\x19\xbfR]\xf3\xb0\xdf\xf7\xe4A/\x82\xe7\x1bdq\xcb\xe3\x94\xea4\xb2dR\x0fa\xe8\xe0\x97\xf8̧0\x1e\x8cP\f\x06\xb8\x16\x83\xe6N\x82s]\xaa\xcc\x04\\\xe4\xf9H\xbc\xbe'oe\xe3aæG\xf5\xa6\x1f\xbag\xddl\x16\xe8\xec\xcfa\x85\xc6\xc2w\x00\xcbr\xc6N[\x9cכ9:\xb8\x04\xf0Q\xcaO\xfb$\xd0\xc3y\xec\x01<\x86\x8fp\xd6(C\xae\xf8i\xde\x11\x12>4\x04\xe7Eo\x05\xffj\xcc#&\xf5\xdda\xd42U\xb0\x87\xa3\xef\xa6\x16\n\xd7rT\r\xaa;}\x15pB\xa9\xac\xd9\x15.\xfcd\xba\xf4\x12\x1f\xdaMza\x06\xe0\a\x16\x94\xf3\x9c\xbdH\xe3Xt\xbd\xf1\xb0DjJ\x12\xe2\xba\x10\xc0H|\xea\xcb/\x9eu\xccA\xa6:\x12Z\x97Q2\x91\xfcP\x8f2\xaf\xb24\x1e!AEee^iY\\z6\xd1m\xa4*t\xa9FR\xc3\xf13\xf2\xb6\r\x83I\\\xf7uW\xf1\xbe\x97\xfa\xa6\xb0X\xddW\xaf\xe2\xf5<.\xa3\x95\xf5 S\r\x84\x9c\xaf\xc6w\x9b\xb5\xed\xfa\xbf\x9a\xbbV\x03\f$xXuM\v^E\t;)\xf3(\xb9\xfc\xea-p(\xeeoֶ\xd6fu_u\xfbu\xe4\xe6\x89I\xbb\x9b\xbezK\xf1\t\xa6s\v\xfeڃY{+u\xc5\xe0\x94\xb4\xd1;gF\xaek\xf7v\xe1\xb8m#\xa8\xf1~\x15\xf7\rʮ\xc5\xf3\x1cM\xfc\xb7+8\xbd\xaewAs'H\xebht\xc7\xcfb\x14\xf9\xbf\xd6\r\xe8\xb1\xf6Z\xfb\x02\xd7/\x1a\xce\x1b\xaf\xee\x98/\xd75\xb2\x9eSۑ\xc5\x13\u0378\xb9\xf2\xbf\xd5&4\xb3\xc7\x1a\xfa\x8e,\xde\xef߁<\xd5\xcd\xc0\a\\\x0f;5^\xfd[\x14\xcc\xed)\xb3v\xa8\x13_C鼐\apS\xa3\x83d,\x83\xe3:\xbeub\xb6NЌ\x06\xe0\xad\x06Y\xab\xa89T\xdf4K\u06dd\xe4͔\xb8\x95\xd2\xf7W\x1a\xec7sf\xc85\x88H\x04\xf5W[\x02u:Qyة\xb1\x84$\xaaѥ\xd1\xe5q\xbc\xed;\x87\xdc\xe8;҃>#\x96@G\x9a;\x9d8w\xf8\x02N\x1b\xf9\xefC\uf2fao\xf2ĵ\xa4\xba'K\xdaO\n\x91\x17my\xe9\x9e݊6ڍ\xa2\x1b8s+M\x0e\xa1\"םOt-\x9b\xd3?\xbc\xaf!\xc3Y,\x9f\xa0}\x86Ekg2b\xdf>n\x9b\xa07rZM\x96\xef\xad\x17\xaaI_^A\xa8=\xaaKuѵm\xa1\x97\xd8ԱTd\xbe\x9c\x15\x9aZ#\xd3VkN[\xfb\xa9b\x13\xf1\xe7ȝI$\x1c\xd7\x02\x16\xcd2\xa8\xa88Ue1\x1e\xba\xa3\xbf\x8d\xcft\x04\xe2t\xe4d\xc1Y\x8d\xcaM\x85Y[\x8a\x95\xd2,+S\x17\x85\xe3\xc3_C\x11\xb4\x18\xde_\xd85-\xb0\x8ai\xad\n@\xa7\xcb\x1b\x83\bt\"\xba1\x8c\x80\x81\x043o|vX\x1bb\x8c>\xc1\x85\xe5\x8f\x18\xe6\xdf\xd1A\x05%\x9fD\x0e\x1a\x7f\x9f\x06\xc5\xf6[\x9e,\x007\xc0\x8b(\x9c[\xed\x11\xa7\x0e\b\xb4 5\x02\xa3\x8b\xe6p\xa8*\xbe\xa0\a\x15eQp\xf2u\xd0\x196\x0fS\x1cvlε5\xc7I7\xe3\xc1\xe7x\xd6\xd0j\x1f\x8d-\xb2\x95\xc6\xe5m\xfa\xea\nzP\xd8L\x92\xb7\xb4\rt\x12\xbe\\l$`\xad \r\x85\xa8W\x0ed\xc6\xddfxK\x83\xcd\xe1\x05\x1c\xd1\x05jD\x87b\x15=\xf2'\xefb\x93$X\xf9\x95\xf1\xac\xb2\"[\x1dtD\f\x15<\xc5G\x96\xfbDouhy\vĻS8\xe5D\xa8\xb6\x9fyp\nt\xbd\x1b\x16\xa4\xf0\xbc{\xb4O\xb4\x13m[\xa1.\aTvт\xa1T\xaf\xd3\xe2t4\x01\xeb|\xaf\xd36\xf8X\xe5\xea\x00^Ǎ=X\x05t\x86\xd7i\x9c\xbf[\xd0N\xae\xd7q#3\xebq+\xd3YGLY\x02k\x13B\x9e&\xb3\x9b\xd0P\xaa\xa3\x03S\xa9jJ\b\xa5\x00\xca\x04 \xe5\xeb\xc3''\xa6y\x1b\x15ѹTK-\xb7$5A>\xf5\xc51\xae\xa9\x029\x02Z\xd3\"9s*\x8a\xa3\xcc\xf4;\xda\xef8\xa9\x9b{\x0f\xa6q\x94e,\xa4{²\xa1\a\xd9ȃl\xecA8\xf4 T3\x98\xf6\xe1 \xc4}36\xd9c\xbb2{ @\x05\nG\xbaQ\xfd\xfa\x85ӣ\xbe\xa4Kx\xeb\xd4#\x979\v\xca\xe7\xf9\xf4\xa7\xa4dy\x96\xe2rbOP\xdb\xef\xf5\xc2!\f4\xca\x1d\x10\tᨯ\x9dz\xfcg\xe1\xcd\xc6V\x1e?\xff\x90\x8d\x9c\x03\xcb\r\xfb\x9e@@e\xe3C\x87\xf2\a\xd9\x10\x1e=R\x95ȕ;\xb3$l\xdc4\x82;\x95\xf0\xc6,\f{\xa9\xdf\xebQs\xf1\xbdfqyc\x88\x9b\xbc¡\xd3f\xd523q٩\x7f\n\x91\x01\xba\xcfeF6t\xfaS\x8fݜ\x0f\x92P\x9d,z\x11Y\x91\x8d\xd1;\u0091.\x1bқ\xaa\xd9\xf8t\xa4\x92F\xfc\x99\xd5\a=\x03nT\x87\x1bᲱ\xc9G\xc9\x13\xce\xc3l\xd4wI&m\xcaY\x11̲X\xf1\xb2߸ʠ\xa1t\x1b\xebZԺ\xcc#C\x1c\x12\r*S\x02\xcf\xe0\xd4\xe1\x19\xbf\x8b\xc1H\xd2%\ny\"\x964\xa9H\xe7\xf9\xd4\xd8\xe5ۮ|\n\x83]Ҭ8\xe2\x12c\xedYG\x854\x9d\xc6C\xfb\xc1\xe8k\xf1`4\xce3\x14~j+\x7f\r\x1bC$\x87\xd0W\x99\xd4\r\n\x16\x83Xn\x87\x18ĉ\xa6\xf6\x0f\x9b\xbb*tWe\x14g\xe4.+|x\xf32g|\xde\xf3\x1d*\xb9?\x1cyR\xf5\xe1X庛\x04p\xb9p\xaa\xd7f\xa4\xca\xf5\xfa\x0ea~\x19䗬DՌB\x96\x94Q)\xa7\x16¦\xfb(|p\xdc\xf8\u0094\xe1\xdfI\xba\x1d'\xafƈu\x8b\xdb\xc4\x1f\xac\x94ָ\xf1\x16\x1dm\xa1\xc8cC1\x950\xabgW\xe6P\xdf>\xdbh\x8cF\xf0\xa54YK߿\x88b\xfe\xcaQ\x8dQ\x06\xdd\n\x0f\xbe\x1f\x89w\x19\xbc=Ǖ\x0e\x7f\x8a\xecg\xbd\xb4\xef\x19\xf8\x8d\x10\x87\ai\xbf6U\xa2\x16\xac\xa2[\xce\x06\xc9.\xb5\xcd\x11\x15}\n~\x15}\nHͧ\xabF\xfa\xc8\xc5h\xa6\x8e*\x94\xe3\xbf=\xbdD+\x98\xd6\xf0}\xf6\x12\x95\xec\"\xaa\xb4\xde|\x13\x96\x06\x98G\x8f\f\xfc\xc0\xefJ\x99@\xea\xe3_I\x17\xfe3JZfM\x81,k\xcd\xd1\xee\xdb\xe6\xcdAisx\xb5a#Z{\xd3\x00\xb7\rzc\x9f\xd6\xfc\xd7\xfbu\x84\xd4\x1c\xa55M\xe6\xc3h\x93\x1c\xefuc\x97\xbfsÕC\xfc\x99\r^E\xf5\xe9\xf0\xec\xcb\x13n\xba\xfe\x9fC\xfd\xda\x1ejh\xe2r\xe3\xfe\xd5M7K\x1fv\x1c\xb8\xaf\xda\xc5M\xb3\xbfv~\xb5F\x1c\xef1,\x18\x857h\x95\x86\xb6\"\x9aV\x9b\xac98\x8d\xd4\xcawh\x8b\xc5\xdc\xf9\xf8\xea\xe2\xb0u\xa4\x7f\xb0n\xa4W\xb4R\x15\x82Hk\x14'\xba\x83\xe4\xf2\x9e\xfb\x13\xc9\xf9Q5)\xaf\xa8'\xdd\"\x8c\x1c\xf5ם\x00h MV\xb0\xeb\xe7\xf4\x9a\x17\x87\xe1^\x95\a\xdd,gӨ\xe0\x81,7b$=-\xb3A\xb2=2\x01\xb4;\xa6Z\x8a2\x87δ\xccR3\xcc\x16\x97I\x9c\xb3\xc6i+\xfeU\x04\xe1\xd6P7fz\x8aS\xa23ǿ\ue578d\x12\xf2\x19\xf7\xa8o\x96\x10\x0endL\xceJ\xed\xa0\xd5\xe0\x8c\xf9\b1\xedS\xc7\x1eM\xbbz<\xe9z\x9d\rGԥ\xeb\x19\v7R;{\x92O'<]\xcc\xe6\x85/[\x87y\xc7\xd3i\xb6\xaf8u\f\a5\xc9\xf0\xc0hw\x1d\x91\x9e\xb1\xa6\xb9\x9c\xb1\xd4:\xac\xa9\xe7-\x15\x92MqTȨY\xaa\x12\x9f\x8c\x8a\xcf\xeb<g\x03\xbfd\xc3\n\xfc\xe4\xf5\xf3\x19\xad\xf8\xbc\x0e?u\xae\x89D6\xb8\u05f7tG1Du\x99$b\xf3gOEQY\xb7B\xf4\xbe\xa1\xb5\x94\xf9`Ek\xeb\xdct\x9b%inn\x88\x92\xab\xbb7D\x14\x95\xb5)DM\r\xa1\xcc\aw궻5D\x9b\x89\xfb\xb4\xc522\x8d\x06\xd5\xc4\xff~\x9d\xd9m$\xb5!\xaff]\x8dؿ\x11\xa0\xd4P3u\x89\x9c\xdc(\x89w?\xa7s\xeb\xa0\xc5e\x8e\xabFE\x19\xe4*.)o\xa8\xe6\x898\xef\x14\xb6a\xf6\x9e\x8a\x9f`:/H\x97\x98`\x14\x11ם\n\xb5s\x16O\x00\xd0A\xe4!\x9dB\xc6(#Ox\x13\xbc\xc1\x8f\x95\xfc\x18\xe9\xfb]\xc3Tw\x81y\xeb+\xf6\a\xd6\xd7[`\\\x03\xab\xc0M\x13}\x88\xd0v\x8f\xeaP\x0eĸ\x0eAx$\xc4^#\x0e\x99\xfb\xad\x99\x1bq\x83r\xa0\x85\x05D;y\x8b\x8e1\xf0\n\xcf\xf0\xd7D\x13\x06āÎ\x15[\xd4ؾ\xaba\x1b:e3L=>\x86:\xf2Z\xa02\xa4gx\xcd.{\xb9\xb0\xb6̄U\x03He\x80\xc8\b\x0e\x92\x18.\xf0\x86v$\xe0\xd1#$\x86\x7f1\x16_\x00\xa6b\xa8\xe4\xf7hV\xc6\xf1l\xa0\x1e7\xaf\\\xe6l\x82\xb0rk\xe2\v\x85\x1a\x90\xaf\rJ\xa8\xa5\x1c\xacQ\xc0\x90\x98\x05J%\xca\x18~\xa9\xfa\x96\xcaL\x1b\xd6W\f>\xc8\x1bp\x87\x1e\xfe\x1ey|]t(\xfe\x88O꿑\xf3&x\xc3cP.\xcbd\x84j0\xaa\x97\x1b*\xf4\n5\xe5pl\xa6^^\xe1m\xa7\x86R\xe2\xcdv\xd1T\a$\xb1\x1bd\x1aY#8\x82]e\x91\xcc\xcd\xc51\x7f\xa5\xc0\x81\xf6 \x13\xe9\xf8\x9b\x8b>_f(Hy\x8bR<؏\x9f#\x1e\xda\xc4\xd3\x1fC|\xb2\b/x\xf4`1\xf6\xa0\x1a\xf3\x9b\xd5o=\b<(h\x17\x17\x8f\x1d\xa2\x06\x8f\x0e\x01\x17\x8dc\x96`\xb8\xd09\x16\"\xa99\x8dΰӎ@%\\a\x82\x06\x06\xfe\xea}Ԡ(\x0e\x8ac\xdd\xc4f\x1c&\xfcЭrx\x06\xcf\x00/\xfd\xb8r\x16\x8b\xed6\rW\xb5Ih\xeeU\xfdB\xfdj\xe4T\xcf\x0fC\x99\xd5\xeb\xa5\xee\x85\v;ta\x8d\xb0;\xef1\xa1j\xba\xad|\xd6 \xef\xf1ㇹy\x7f\x8d\xfa\xdaŌ\x92\x90-&\x10u\xec\xf9\xa6\xd0<!\a\xe2qd%\x7f\x81\a纵$d\x81\xcf\xeb\x82\x01\x9c\x8bO\x87\x1d\x13\x1dn\x8c\x12\xc8p\x92k\x00\xdcZ\x19\x9c\x18Qb\xae\xed\x9c+FY\x9d\xe7\xd7\x1aMd\xa1\x11\xdd\x11\xc0\xb1\x99\xcf\xebׅ]N߮\xe4\xb4ۇ\xb7+\xfb\x10`16\xa1\xaf\xd7\xe1v\xa1W\xe2ƶ-FxB\x8e\x0e\xf4?F\xe2\x9e\x1ec\xa5\x8f\xf1\xd76\"|\f\xd5\xd8l\xad\xecW\xc5*ggbm\xd0P\x05\xe6\xcd\x05\xac\xfe\x95\xfc5\xb3\xe7\xa6\xde\xcan\xbf\xd6\t\xcbNK\xcd+\xf1:X-\x9c\xa6\xcar\x03&T\xe3\xaa\xef\x88\xd4Ѓk\xad\xccc\x14\xabk\xcd+\xe4/U\x7f-\x9b\x8e\xae\xe7\xc0\xba\x87\xc1\xc0\xef\x00Sm\xf8?\xda\xden\xa4\r\xb77\b\x04f\xe8\x87\xd3w\b׆\xb4\xaf!\xeb\x98\xc8r\r\x0e\r\x8e\x0f\xe4X\x87\xa3ɋ\x17\xff\xe8\xf1:O\v|bq,\x8d\xbc\xf8\x8aΙ\x83\xddS\x12h\xad\xb9\r\x06Ef7\x8a\x98qZd\xdb\xdbgp\xec\xe21m\x8b<\xe8e\x1c\x7fѽ\"\x14\xbd\xc8\x1c5GxёJ#D}љt,\x96\xe6p\x8f\xf0\x8ew\xac\x0e\x04\x99C\xaa1\xb5\x96\x95\x19Irg\xa7\x02\xbf\xd3R``\xa7ӻSh\x05ܛ\x15\xb9[|\xde\f>j\x00\x1f\x9e9\x9dܰ\x1c\x18\xc0\xb6\x8d/j\xa9>\xb2-\xcbyK\xb9\x1a\x1d\x91I\xc7\xd2\x1e\x14z\xe70\x80\x00CK\xfe\xbe\x1d\xd4\xd2\b\xcc\r\x80\x8a\xc1\xabށk`\xb1\xbd\xad-hc\xa2\a\xd3\xc3\xd5s8ts\x06x\xe3G\xef\xc0n\xa6}@l5Ï\xe1\\\x82A\x8d\x12\xd3\xd1ƥ\xc7@\xf4Ĺ\x18C\xceŷ\xc0\xea\n\xee\xfc\xf68\xe06\x87@\x86\xaa\x96\x907\xdc\xc32<\x7fd\xe7;]rʯ/\xb9\xc19\xd0c\xb8\x81\xb3\xf6nq\x964\x8b9\x8f\xc2:\x1d\x93d\xf3\xd2[\xdb?b5\xbcY\x11<8\xf7`\xeaA\xb8\x9e\xb5\xbc64\x97\x82\x14\xf9\x02\x96,\b*\x83\x9c`k\xa2\xb4\xae#8\xf2S~\xe0\x83\x7f$$x\xbd\x91\xd9\x19\xeei\x82U\xc7\x04B\x85\xd6>\v\x80\xa3\x8a4\xc7d\x8c~J\x8a(dxf\x1a\xa5\xd72\xb2J\\\x9b\x8bL[\x8ahn\x18\xbbJ\x8c\xc2%\xcb\v<\x020\xf5@\xd5j\x12\xa9%\xa7\t\x9b\x05J\xa3v{\xb3\xdah\xfc<\nM\xfa\xb0\a\x95\x04\x99Y\x96::\xba@\xd5[*@Y\x86\xa4\xd4Ə\xdb4O\x9342\xc7\x0f5:\xc2'kl\xbbLy\xa4]f\xdbkH\x9a\x1b\xceDM:\xcd\xe1\xee{\xaa\xeb}Ɋ\x82\xc51\xae\x7fId\xc6~G\xe3\x91\x0e\xd4\xcab\xe4A1F?\x9f\xe6fx\xe1\xbb\x15\xe6`~@\x01\x83G\x8f\x80\xf9\xe7\xf0\xf4\xd8>bR\xe0ԃ\xf9,\xf3\xe9f\x00\xfc_\x8ceb|\xd8\xe6\xb0\x15\xa3\x1a\x8cY0w\xbb¢Ƥ\x80;\xd9\x05F4\x8a\x91_\xf1\xe3\xc7\xec@\xa3\xe4\x1e;\xf3\xa70\xe0\xf4\xa3߫3+$\xb4\x18c\xd91/k\x17\x1d\xbbEǭ\xcdY\x98T,jTT&\x15\x01\xf7\xc3u\xe6¤b\xe1RQ\x99T\xf0\xa2\x9a\n\xfa\x8b}y\x8b5\x9c\xd2T\x1b\xa3\f\xb7X\xee\x94\xe6\xdcz\xc1BJ\xdb)\xf3sv\x19\xa5\x89\x1f\v\xc7\xf0\x8c\xfc\xa5\x11\x16\xed\xb7\x83\xe7\xed\xe0˾\xa5\x1b\xb2\xac-͔j]\xfa\x80\r\x98.\xcc\t\r\xf7\x16\xf0\xda~'Q\x9bX£^\xd3S\x15ܚ\xd2qK\xd3M\xba[L\\Tv\x8bC\xe4\x00\xa6\xf8\x18\x19\x8e\x86\xf8\x96\x01\x91\xae\x1b\xe16c\xe5\xe4v\xdd\xf4\xb6yu\xd7\xe2\x81B\xf1 ¸T\xa8\x10Q\xfd\xe4XQ\xf2S\x18\xb1\xc1h\xe8\x12\xbd\xec\xbbq+i\x18Ҍ\xbfn\xa7_\r\x8f'\xd0\xcdi\t)\x9f@7\xee\xba\xf6+dq0O\x02\xeb\x8d\x17\xd9\x17\x92n\xec9\x16^\xb2{Y/\xbc\xee)\xe2\xed)\f\xe7\xfe>\u058c\x93\xd0*\xd2T\xb4.\xc3\x16\xd7\bK]\xa00\xb9&\xae\xb7\xb6`z$\xbf\x86\xd4\x1a\xbe\x19b\xf8\x8a\x82\xca\xd1\x7f\xaex\xcaO|n\x85\xddj\xec\xe5\xe3\x15\x90\xb34\xc0\xe8\n\xce7g\x87p\xbd\xbdmV\xa2zSFro=\xce\xd3\xd3\xeb3\xf9\x01_\x81?3\x82\xbbˎY;Q\xa9\xf0Ԇ\xd4\xdf\xe6AHo߫\xa6\x8a\xa9\xa3\x1be\x94tI0\x88\x92\x82\xe1\xb3\xdf\xfcu\xabfX\n\xc9\xf1w\x9b3\x7f\xc1\x9fm\x96\xdf\xf02%A\x9e$\x1da\xf1\x95\xe9\xe0B\x97ײ\x91\x88#\x1d\x12\x98\"\x98&\xa4HR\xd7P\xdc\xf2\xa7\xd4y\xb5t\a;^Nq\xeb\xe3\x1a\x04V\x0fG\xe0\x0f\xadp\x02\xfe\x17\xadz\x81O\x8b67M\x13\xd00j\xe1\x7f\"\xb4\xb6u\xb9\x01\xfb\xedJ\xecw%\xa6\xb3\x82,M\x94\x8a\x1b\xe8\x125\xf8\x8d\xeb5\x03*\x8e`\xac(\xadj¾-p\x98\xea\xf10\xdab\x8c\x13(\xbc\xeb\xa3@\x85\xc7K\x1f1\x8c&\x13\xe5\t\x91\xcc_\xa0\xdbT,\x8c\xa3,\x99_\xf1\xb4J̉z\x94qt\x84\x87ŷ\xc5Q\x0fI\xb4\x901\xf7qF\x9cB%>>\xbc\x86\xe3\"Jj\xcf\xf8\xaa\xd7e\xa4ƼIC}˰\x10=:\xc9\"\\\x17yfH8#VPT\x9eh\xe1NLQ\x11 \xf7K\n\xb5\x02\xb3J\xbd\xc8\xcaj\x8fמ\tR\xf0\xd8\fk!y\t<z$\xd5O\x05~\x17}:\xecT\xe8\x828ܸ\xcd\xe5\xdb)\xa9bD\xe6N\xe1\xe1\b\xf6\xb5.5B\xa0\xa7\xb9k\xea\x1bo1\xe7\x8f\xe5C\"W\xb4;W\x13N\xce^\xce:\xfbl\x92\xf2\xfc\xaaq\xfd\xe0\xd2\xea\xf9-@\xa6Cf\xe6\xfc\x94\"~>\x9ebZ\x8c\xa8k3\x7fQ\x87\xc0\x93JxI,',\xf3\xab:\x04\x9euB\t_\x8c\xdbq<\xc5\xdeE\xf7\xd2\xc1\xa1u\x1bU\x86\xaf\xde-\xc60\xe0r\xc1W\xee*\xfcfr\x91\x96\b\x9fBX\x11B\xaeM\xa1%\x96\\\xeb\xdcE?\x1d$\xc4\xe71[%\x9f\x06\x18|u3\b\xad\x18R\xa6YKB\x8c\x88Vȱ\x81\n7\xc7Z[\x19\x8c\xb1\xc0\xa5\xe3\xef\bۻ\xc0g5\xd3r5n\x12l\xe91H\xaa\xedQ\x12S\xcd\x01\xb2\x8cf\xcc}\xf0\x8d\xd2^\x06%\xf3\xe4\xf7\xf7aP\x9dT\xb3\xf3\x94\x9e/\xe8\x9e̓0\xa8\xf0Q\xb9ש\xfc\xf4n\xce\n\xfa\xf8\x0f\x16&\xea˻\xaby.?\xff\x90G\xf4\xe9\x04\xf7V\xe3g\xb9 )*\x9a\x97\xb8I\"-SzdQ\xb0\xf9\x92\x95H\x8f\xb1]P\xf3L:\x00WQ\xe1\xbf\xf7/Y\xf9\xeb\xbb\x17/\x8dk\xcbi\xfb!GQ\xdd\x01CUG\xf0\xc3<\x8e\xffɂ|c,\xb2@\x1dՏ\xe9</6\xc6á\xebH^Gq\x1c\x15l\x9a&\xe1\xe6\xb8\xccBM(\x93y\xc9\ue08d\xc37 J\x93\xf2js4\b]GrrǶ\x11|\x1dѻh\xb6\xa1\xf4 ds\xf9\x8fi\xc2\xde^\\\x14\xac\\\x85ih\x15\xe5\xef+\xbe\xbdؠj\x82t\xaa.V\b\xbeT\x19\xa5.~\xa1\x84\xdf\xd8L\xe6\xbf7\xb7\x93ձWwD^m\x8c{\xa5\xba\xb4U \vm\\K\xbb&\xb5U\xc1Kl\x8c\x7f\xad\x92\xb5Uc\x16\xbcCm\xed\xfa\xd7^\x11/\xb3y\x1d\xad\xaa\xd9Z\x03\x96\xd8\x18\xff*\xadm\xab\x81\xcal\\G\xabB7V\x80\xd0\xebP;A\x10\x1b\t\r\x88zhr!/\xd2|\x16p]ź𞤇\x01<<\x87\x87\f\x1e\xfe8y\xf8z\xf2\xf0\x04\x1e\xfe\xb3\xeb5\x14\xe0\xc0\xb3\x9d\x87\xe1\xceê\x06\xa0\xb0\x11\x92\xae[q\x18T8&\xd7\aj\x8d)\f\xaa\xe7\xe7\xe79\xbb\x8d\x02d\x96\x03\xae\x9ca\xa3V\x05\xce\xfa\x1a\xcd\f\x85\x80\xc6\xff\xbf\x05\xc9<ȑ\xdc\xee\x0f\xec<\x97\x9f_\xe3[\xb5\xf8\xe1y\x96G1~x-F\xfb\xbf\xcd\x13&\xfe\xc6\xfc\xfb\xf3\xf9\xe5\xbc(\xf1\xd3\t\xcbJ\x86\xcf\r㗷\xd32\xa5\x8fo\xd2[\x95\xfc\x92M\xc5g8s\xc8ik\x17\xcf\\\xd32\xc3\a\xc2@\xcb,\xb0\xbc\xb2\x92Ͳ8(U\xc0Hf\xe0&\x9dYP\xf6\u00a0tC\xe3\xfcĎ\xdc\xccD\x93\x15\x8a\x88L=\xb88\xec\xacs\xd2ѣ\x95\xf5\xfaӫ \x7f\x91\x86\xecyً\xfa\xb8\xc7d\xf7\x89\t\vT\x9f\x88\x9b\xa8Rx\xeb\x12\xa7\xa3w\x8d\xf1'\x0fz\x17\x06[\x04\xed\xc5).iX\x15=/\x91\x9e\xfeY\x1f\x9e\xc1\x05\xb5mb=\xed \x96\xa7\"\f\xc6ԝ\xf6egs\x92\xfa\x87\xf6\xf8Ge\xe4\xf5\xb8Z\x1b\xe9Q\x7f\x93Tg\x1b\xc3,(\xf1\x0e\xfc\u008a\xed\b|\xf5}\xfd\x9au\xd5\x04F\xdf\r\x87z\x9f\xd2l\x02Ʒ\x10\x1f\xb6U\xdf~\xb4\xf2^[\xdfN\xaco\xaf&0\xa4/K1[\x95l\xe74b\xecV6ģV\xeb+l\xe4֮\aǒ!r\x8d\x90\xd8dFZ\x10\xb4\x9bu!J \xecC\xe8\xff\b\xc7\xfc\xf7C\x18ᶜ\xd0\xc7W6F*\xe6\xcf9 \xb6\xd3'샤J\xe6bg\xfb\xc6h\xdd\v\xfd\xca\xc3\xe3F\xf8+\xec\xbb`|\xf0\xec\x85\xfe\x8f\x98\xfd\x1a\x7f\x9d\xe0\xafWn\xb7\x86\xea\xce\xe8\xa5\xd5_ez\"\xf5Du\x99\xee,*,\xf9d!\xa0<\xd1\xefj\x16c\xda\u008f\x17Q\x1c\xe3\xcc2\xdc%\x8d\xeeu\x87\xe3\xb0k\x181\x0e\xb2\xeb\x80\xec\xd6A\xf6\x1c\x90=\v\xa4h\xa8\b\xebi0ο\xb0\x9a\xfe\xfd\u0094QB\xfb\xdbo\xb0\xd4kKY\x86\xcf\xc0\xc0\r\xdf\xea\xd2\x1cĬ\x95'\xbcJӛyV+'\x92ה݄溹n\xc6r\a:ll.\xef\xc9\xd6)\xcd\x0f&\xd0tH\x8e\xa4\xaa\x8d\xb5\xa7!N-^\x06U\xaf\x7ff\xf9 \xcf7E\u05ce\xe2|#\x14\xf5\xa6\n\x844e\xb2Q\xfees\x94\xab\xd0L'\xce\xc8ث\xbb-\xef\xa2\x19\xa3\xbd\x9e\xe1F\xb5r\xd5\x1b\xf7\x88\x1b\xe6\x03f\x02\v\xdb\bK\xb1\x06ˏw\xa6\x85&\xd76\x9a\x9f\xee\x8bF\x18\xe1\x7f\xfd\vF*@#0^o\x8eq\xb7ǣV\xd4\x03aP\xbd\xbd\x10\x96\xd9i뫻\xa0\xe4D\x9as\x12\xb7ɳ;7\x99d\a}\x02\x1b\xd5뻣\x92q\x04\x1bQ\xb6\x1a\x91\xc9\xf7\xa7\xc7\xc8\xf8g\xd0\xfd\xf9u\x17&\xd0}\xfe\xbak\xa1:\xb93M'\xcd|\xfa\xf5N\x88\xa8\x13\x8by\xd2ޏ\x1fVcT\xe6\xc3*\xf4\x8f\xfb\x901KW\x90\xb1\xd8@\xebI\xe3\xffk\r\xa8a\x1c\xaa;\xd1\xe9\x1ba6\xa1LáM\xe5?7Ƿׄ\x8f\xed\xd9\xf8\xfe{\xa2\v\xa5\t]:\xd0}\xd8]YM\xf7awմ\x11\xfd\xbd\xdc\x1az\xac\xac\x7f0v\xa3\x06\x1a5\x9c4\x81\xa8\x81\xc2\xca|\xad\x87\x04e\xf6\xeb\x00\x86)7\xb2^\xa5\xf8\x8c\b\xb2D\xd9n+\xff\xa5\xac\x94\xb5e\xfc\xe8d\xa0\x02\x8e\xf7\x94\xd9l\xcd{\xe5䙶HY \x1b\x02\x9b\xf1f\x8eS?eW\x1c\x14\xdcj([ae>\x9f\xfd<S\xaao\xe5\x90b\x1bRod\n\x06\xe1\xd0b\x88z-\xff]4\xa3\xfc\xca\xc9GIS\x92j\xe5H9l\x12\x99\x84\xb7\x92\xbbP;\xff\xef\xff\x14\x8f\xff'\xdc\xdeqa\x82\xd9\xcf3\xc3=\xe2\xb3[\x120=\x83\xc9f\x13\x18u\xe4\xfa\xb8\xad\xa4\xfe\xbc\xb4\x8eEK7[\x8a\xb7\xcch\x9e\xe3\x96y\xa5>\xab8\x8b\xe1\xa8\xcdKc\xe3(\xd2-jk\x98r\x00`\x98\xff=\x1c\x1b\xb3\x04C\xb9b\xe4po^\xea\xe9\xe7\x12.\xa2$\x88\xe3f\x02^\x1aH\xdc\x05\x1e\x8e\v\x8e\x1dF\xa8\xc9)հ\xe9\\\xf2\xce,@\x0e\xf2\xf7R\xa7A,\xd0K\x9c\xb5VsP<\xe8\x86\xf3\xb1\xf7\xf7ny\xdbd\x8b3B%l:\xa3\x12x~*\xd2\x1a\vQ\x96z݇\xff\x1c<\x9c\r\x1e\x86\xefd\x90\xcb\x7f\xf8꿻M\xa2\x17\x15i-\x88\xe6\x97\xe9O'o\x89\xc4g\xf5J\xdf\x04et\xcb`R\xcf1\x97\x8c\x1c\xf0u\xbd\xe8̆Qx\xec>!\xae\x88ed\x84Ġ\bN\xbf\x91\x10b\xf8r\x15\x01\x06\xd7k V\x0fH\x16\x89%\x1c\x03\x9c\x1f9\xbe\rb\xbd9\xc7TE\"\xd0P,\xf1\xb2\xd8E\x9c\xa69\x87\x14\x0f\xf2⮂\x11\xdb影46A!\x84\a)_ʐ8\xe5\xf4\xfe\x1db\xe3_\xd4Z\x88|\xdf_\xa0\x97\xc5\xdaQ;dJdʛ:\xacY'\x1a\x04\f\x19\x13)~\x1e$\x97\xac\x01\x92\f\x99\x03\x8dF\xa5Vb\xc6\x1d\xcb/\xcb\xdb\x03\xb6\x87\f8`{\x8d\f\xf8\x02\xbcmC\xdd\xc2[\xe5=\xd7y+\xda_\x18\xdc\x12)m\x9cryKЍ\xbc\xbd\x12G\xba7\xe3,\xea]I\xcbhd\xf9\xfd\xda\xcaZ\x0f/\x16\x94\x8f\xda5\xf4ECg\xec\x1e\xb0}\x18(\xd4\xc8R\xfd\xf91\xcf\xfeZ\xbdԊ\xbb\xa5\x9bh\xaaR\xef$d\xa4\xd9E\xf8\xbd\x99\xddn\xf7`Zs\xe7\x84Au\xa7\xbe\x11\xf0&\xb3G\xdf=\x19\xea\be\x18Tv\x98\x90\x1a\xa5\x12\xfa\x9ej(M\fu\x825SW\\\xa9\xee\xd2/\x1c\x85\x8d\x0f\xb6%\xec\x1d:\x81\x8a\xe2\x9e\xc4Z?\xa8\xc5\x14\xf5\xb5\x91\xafn' \\[\x1f\x88\xa9;\x1c7ӆJQ\xb1 7\x90U\x92\xb96\xb7\f\x01乸\x19\x06\v\x0e\xa0תJ\x02\xa4)K\xda\x18\u0601o\x0f\xf6\xa4\x14\x9b\x83\x9a^H\xaa\xefB\r\x83\xca\xd8\xdcJ\x82\x16T~\x99\xbe\xc27\xce_\x04\x85ZB\xc70\xf8\x13\x18ȳ\x94\xd8^)\x8a\xbaͧaP\x9dm,\xac Zl\xb0,\f*\x9e\xd4\xef\xb7\t\x8a\xc1%\x1e\x88\x83m\xa4\xff!<!2-)\x91\xd3\xc3\xd5r\xb9N2\x1b-Ɠ~3r\x8dT\xabb] \x9c@@\xb3lh\x9e\xc8i>\x16\x85m\xe4@\xa5\xdb\r;\xf8\x8b\xf3\x05oV8\x86H\x92F\x7f\t\x11\xf6\rlC\xb7\xe8b\x0fɞѢ\xde\x04Hꡀ-ݨ\x15\x10dr\xf4\x7f\x04\x9e\x10\x17\xea\xc6\xe1\x03c7\x06\t\"\xa2\xe3\x02\x98\xe6C@\xe8\x96[p\x8e\t!\xd8F+\x82\xf0ʌ\xd8\x05D\xb2\t\xcc\xc3\xc5\x1b\xebR\x9b\"\x1dvj\x02.C|\x8e\xa2\xacS\x13\x89C\f\t\xce\x00q/\x03Ne\xeb\x1d\xa4\x16\xa8\xad\x84:?iq\xd8\xee\x00\x9e\xd8lŕ\x81\xfer\x1c\x15m\xc0\x8b:>\x8f\xab+F\xe3{\xf1V\x17\xaf\xb3\x17\xd9`r\x17\xbf73\xcb\xe5-\xa6٬5\xe7\x98\xfc\x91ۓ\x92e\x88\xfc\x14\xa7\x15\x1e\xec\xe3\xaf\x11\xff\xbd\xcb\xf6<8\xc0_\xbbl߃\xef\xf0\xd7\xe8[\xfc\x8d\xee\x97\a\xa3!\xff2\x1e\xf1o{\xbbc\xfc\xc3\a5\x0fFO\xc6<\xf3`\xb8\xc7\xff\x8e\xf7\xbf\x1b\xb3\x03\x0f\x9e<yr\x80\x7fwG\xfb\xbb\a\xec\x00\xce\x1ai①\u05ec\xbcJCAکj\x91\x98qx0\xc2\x1d\t\xf5\xe4\xfd\xe6\xe4QK\xfa\xee\xd0N\x17>w\r\xbbL\xdeoN\x1e\xb5\xa4\xbb\xd8\xd1e\xac\xe1\x16\x89\xbbM\x89\aM\x89\xa3\xb1\x9d\xca]\x02\a'Os\xe0Ж\xd5\x00\xb9\ued65:4\xa1,q\xd0U\x9d\xf6\x83Z\xfb3;\x8db?݇\xff\xec\xf6Mupt\xa1\xcc\xc5\r\aK\xbbbU\xfa/+K\x9b\v#\xab\xb0\x9c\xc3\xc3p=\"\xf2&\x1e\xd0eSmȂ\r\x91\xa1\xe7\xf3\xe8ѝP\xff\x04\x0f\xb3\xf5\xa8͙M\x1b\xa2\xc9\xc3\xd7\xeb\x11\xd93\xd9fT\xb8\rj-\";\xdcЌ\xc8\x7f\xf8j\x13\x8a\xcc%3\xc2\xd6&|Q\xa2Fi\x9e\xe0\xc7<\xa5\xd7\xf7Z\xa5\xd4\x18Yx\x91\x1f\xec\xf5\x94\x9aP\x93Mn\xb1Sr\xb0Rmb\x8b\x92%\xa5\a3\xb7m\x16~N\xa5\x1f\xa6\xb3 \x92E\xac\xcdS\xbc\x9e\xbf\xb2\x12ǆ~\xdf/\xa3\xe9Mћ\xf5\xeb0'\x04c\xcc'\xc8ֹ\x8f\xa0\xaf\xa2\xa7\xb7\x11\xff\xa8\xc9\xed\xac\xad\x9dl\xd4P\xb8\xe7XY\xf6V\xd4\xf6\xb1D\x97\x85\xa70Ë\x85\xe6\xe5ԃ\x99z\x00}\xd9o\xabp\x95U\x92\xe1\xd4\xfb[&°\x81u\x92\x1b:{\xfdu\xc863Rj\xc3\xfdjcB87\xb3Ur\v\xbe2Ww\xabd3\xabe\xef\xac_\x89o#\xe3\xe5\xee\x87_\x81q#\x1b\xe6\xeeh_\x81o#Sָ\xfb\xbfݚ)\x91\xdd\xc4Di\xf9\xae\x1b(\xadl_\xd3<\xfd\xfa\xee\xc5&\x16\x8a3u\x95\x91\"\xe7U\x91\xf8Y\x86J7\xbd\x9ea\x1b\xa9e\xbf\xd7?\xfc\xe6ϟ\xff\x03?,\xcfӼ\xf0\xaf\xcaY,\x93\xbe\xf8\xcfp4ğo\x86\xc3ƿ\xc3'\xa3\xd17\xa3\xf1\xee\xc1\xfexw<\xda\x1d}3\x1c\x8d\xf6\xc6\a\xdf\xc0P\"\xf8\x9a?s\xbc\xc1\xf5\x9b\xe1g\xd7\xe54J&\xff\xd1\x7f>}\xday\xdc\x01\xe0\x86\n\x0f\x8d\x9c\a\x05\xe3\xd2\xd0\xc1\xf3\x82\xd3x\x1e\xb2\t\xe4,N\x83P$?\xdeY.;\x9f>A\xc8.\xa2\x84A\xb7\x8cʘua\xb9\xfc\x9eK\x12\x9cϣ8\xc4u\xdcO\x9f\xc0\xffY<\x15\xe8\xbf\tf\f\x96\xcbO\x9f\x80%!,\x97\x9d\xa30\xba\x85i\x1c\x14\xc5q\xf7\x8a\x05!ˁKb\xf7i\a\xe0\xe8j\xf4\x14K\xbfH\xe7I\t˥\xc8Z\x8d\xfah\xe7j\xf4\xb4s\xb4\x13F\xb7O\x91<>\xd9\a\x9f\x88Z\xe2*{C\x9d\xd34d0\xc3W\x11x\xc5X\xf5.V\xfd!*\xaf\xc0G\xcf\x17\xcd*,\x97\xcfK^),\x97\x13P\xad\xc0\x14^\x81\xa8\x7fW\xa0P\xc5qG<\xb6\x15\x13\x01\x8e\xb2\x9c==\xc2\n\x9f\x12\xa6\xa3\x1d\xfe\xedh\asdQ\xe2\x0f\x80n\n%}\xfa\xa4\xf6dC\xd7\xe8\x91.'\xab#;\xf4\x8e?l\x11\x95,\xfc\xf7\xea\xff\xfeޮ\xab\xff\xbbO\x9e\xfc\xa9\xff\xff\xcb\xf4\x9fK\xd2\x1dT\\\x14\x10ʂ\x9a\x80\xe2\xefc\")\x8ePi\xa5\x93\xa4j\xff\x822}\x8f\x94(\xa5Ӛ\xc2Q\xf9o\xe7%^\x90\xb6J\xe7\rm\x17\xd0Z}\xd7\xeb\xe9酪\xbfϿY\x9aDe\x9a\x7fU\x03\xb0F\xff\xf7\x87\xc3\xf17\xa3\xfd\xf1\xc1\xde\x1e\x0e\xfcC\xd4\xff\x83\xe1\xf0O\xfd\xff\xf7\xea\x7f\xab\xa2\x9f\x94A9/\fE\x8f\xa3\xe4\x06m\xc4q\xb7(\xab\x98\x15W\x8c\x95]\xfe<\xd6q\xb7d\x8brgZ\x14]\xb8\xca\xd9\xc5q\xf7\xd3'\b\x8a\x82\x95\xd0\xcd10s\x15|\xc0\xa0\xb0\xcf!\x96\xcb\xee\xd3\xce\x11\xc7\xe1\x96F\xbd㧿J\x9a\xe1̂\xfc2J&0\x1ef\v\x18\x8a?O\x1eҎ\xb28\r\xca\t\x7fcV^8PD8\x90sO\x86\xef\xbc\x12\xe9\x1f\xa2\x10O\x97\xee\r\xa9\xe0\x15\xc3;C&\xb0;\x1cf\v\xb9;-)\a\x17\xc1,\x8a\xab\t\xc0\xf3<\nb\x0f~d\xf1-+\xa3)\xde\xf1\x1d$Š`yDg\xe6.Ҥ\x1c\x14\xd1G6\x81\xd1X Yjگv\x1d\xf2\aD\xff\x10F\xfb\xd9b\x13\x14~\xb0\x88\n\xf8\xe4\xb4+8/\xd2x.׃?\x0e\xe8:kT<\x91T\xa6\xd9D\xde\x03)n<Q_\x15\x17̺b\x9c\xfc\xd2\x15\xb0\xf8y\x82\xb7\xbb\\\xb9\xb4L\x83\xe46XG\x8dY5\xf6\t}Yv\x8evx_?m\x1a*>\x04y\x12%\x97j\xb0\xf8k\x9a\x84i\x1c@\xc1\xf2[\x96\xe3\xf5\xba弰\x9c\xbe\xf5\xee\xdd\xd1\xd5\xf8\xe9k6K\xf3\xeah\xe7j\xfc\xd4\x19\x1ex\x93\xba\xb8\xbc\x14\f\xb28-\x8f\xbb36\xf3\x9f\xc7q:5\x86\x8b\x17\xf3<gI\x19W\x10`N\x80#\xd8yU\xb2B\x8e D\xcd&\xb8\x7fdA&\x1e\xf5*\x8c\x1a^E\xb7\fR\x91|\x0f\xa4'\x95\x89\xec/H\x1aL\x15\xd1ep\xc3\x12\xb8\xc8\xd3\x19\x14UQ\xb2\xd9=jx\xcd[^x\u0604\x1f\xf0AI\xa3>ʃ \t\x81\xe7\xadƏ\x92\xd5}Jy\xf2\xcfF\xfd\xf8\v\xfbmΊ\xb2ش'\xcb<\x9a\x16~N\xa5\xfc\xf1b\xe1\xd5\x12w\x9b\x12\xf7\x9a\x12\xf7\x17\v\u009f\a%ک|n\xfa\x14\x928\xc8PTyX\f\xce+\x12Z.\x95wf\xbb \x15]\x8cdZ\xf9\xc5|\xb6\xe3\xa6Mq\xb6\xb4\x8a\xaa\xe7\xb7,\x0f.\x19P+\x80\nB\x8fbl\xfd{\x12\x95\xe6\xb3\xf5\x95\xbf\xfd\xe55\xfc6g\xfc\xa52͕Ϩ\xd1d\xc1F\x14\xc8\xe6KJ\xaa/ƀi0\xbdb\xfeUT*A\x11)\xb3\xa8(VQ\xf4\x02\xa1\xe0**\x85\xbe \xf8\x97\xe0N\x19\x147\x85\x9f\xde(jD\x02\x9fD\xaf\"\xe7]P\xdc@>O6'\xa1Q}\x8bY\x10\xc7O_\x8b\xbaq\xc36\xfc\x9c\xe3#uWl^\xc04H\xe0\x9c\x01[di.L'\xde\xecQ\xe2\xa4\xfey\x96\xf9T\xca?\xda\x11X\x8e\xces\x85\xf1M\x8a\x97\x8b\xbc\xbb\x8a\nȰ\x1f\xa3\x02\xd2\x04\xed\xf0m\x10\xc5\xc1y\xcc\xf8\x19cv>\xbf\x84Y\x1a2\x85\xa3sTL\xf3(+M\x97\xe2:\xb8\rDj\x17\x8a|j\xba%\xb3\xf9¿&g\xe4hG\x00\xdd\x15G\xb8\xebߎ?\x1b\x8b\xe5 }.2\xe9\xe7\xd7\xf1HO\xf0ϟ\xff?\xfeh\xb9\x90)\xbf\xfb\xfc\xef`|0t\xe7\x7f\xe3\xfd?\xe3?\xbfK\xfc\a\x17\x7f_\xbc\xfd\xf5\xcd;8\x06\x9c\xf7\x1c\xc2\xce\x0e\x8c\xf6\x87d\xff\vH/\xf8\x90\xd1A\xc0\x9f\u07bc\xfb\xfe\x97\xbf?\x7f\x85o\xd0\xf0\x99EG\xae<\xc1e\x1edW'||ﱘ\xe1\rN\xceb\x14\xa5\xe2b\xe6\xf3\xb2̣\xf3y\xc9z[j\xfc\xda\xea\xfbE\x16Geo\xcb\xdb\xea\x1fv\x96\x06\xee\xa8\xf8\x05\x97S\xef\x8c\x16݀-~\x1f\xc9\x16\xfa\x02[\x1c\xeb\xce\x0e\xce!\"V\xfc\x1do\xb1\",\x05\x94W\f\xf8\xbdV|\xc4\xc2o\x02\nn|\xf8!\xcd\x01q\x89F\x16^gg\a\xa2\xd2*\x1a%\xf8,k\xc1\x8c\xa1\x13\x8a\b\x1fxDT\x19\x1e\x88O\xe7\x05礸ِ#\x11\u05ebPE<x\x85и\x04\r\xc1\xce9U`\xe3O/ \x800\xba\x8dB\x16\"\x8a\xf3\xaa\x96\x7f\x8eW\x89_\xfa<= \xa7\x87\\\x9d\xbe\xaf\xb9jp\xa1\x87ty\x9cL\x0fn\xf0\xc9x\xbd\xeb.\xba\x80\xde\x033\xc1\xe0=n\xd4I˒\x85\xff\xc9*\xc2qC\x9b\x04\x97\xba0\xa2m(<<tο\x85,.\xad\xf7EnX\xb5a\xa5\b8\xb0s\xa81\xac\xea[7s\xa0\x18gA\xce7\\\xddH\x89\xdb\xd9\" \xa4\x97\xe7\xea[2\xc1\xb8!\x95\xe8\xc45f\xa4U\x80\xe2\xbbZ\x87.\x91!<\x85!<\xb3\xe0\xf0Ŋ\x1d\bAM\x86\xad7Z\x04\xe4\r\xeev\xc7Y;\xec(ms\x94!\x89\xca(\x88\xa3\x8f\xec\xaf\\\x1a\xd5\xe6\x14$\x8d\x94\x01\x1b\x17\xa6ӹT\x8c\xef)\xf9/\xd5\vt\x1e\xdf\x043\xd6\xdb\xe2\x0e\xe4V_\x95\x15\xc2m\xdcT\x8d\b\xb3 f\xa5:\x80\xf6\x8b\xf4H^\xa4q\x9a\xfb?\x8bL\xb9\x9fY]\xad\x1cɷk\xf0\xe9\nI\x12q\xf4\x10\xa2ȾV\x19\xaba\xf8\xb2\x9b\x84<\x8d\xccK?1\x9b\x87<\x8c&\xa1\xae\x95\x8cZ\xd5\xdb\n\xa3[ف\xf8\x0f\xa1\xfd\xc22\a\xdca\xdd\xf2`\v\xf3LX\x16\xe3][,\t\xc5\x05\xb9\x98m\xe4b\xdd\x14\xe2ظv\x01\xdfV\xbf\xc8]A\x81\x000\xf2\x91\x06\xb2\x10\xbakT\x06\x1c;\x96\xd7(\xa9\xaf\xba\xa6\xa7\x9d\xae\xaf\xe1\btG\\;\xf7[\xe3\x7fQ\x13\xbd\x9f\x96\x0436\x81\xe2\xf4\xfa\xfaL\xee\xb4\xd7b+I\xb8te\x83\x8b\xa5\xf1\xf8\x1a\xfe\xa7\xae\x9d\x107\xf5EDF\xfc\x89ž\xd8\x04\xfc\x0f\x8cG\xd9 20\xa7`~\xe4\t6PΒ\x90\xe5,\x9f\xc0\x16>S\xb3e\xe7\x16e\x9eް\tߘ\xe4\xe4\xf06O\xecV\b\x86\xfa?D\v\x16\xbe\x9c\xe7\xfc6\x93\x9e\xe0\x8e'\xb5\xc2s\x98\x87\xffq\xbf\xc5O\xb4\xebz\xa2Tخ\x10\xff͂\xc5ˠ\f\xf8E\xbe\xc5D\f\xc1u(\xc4\xf6\x97\xa0`\x13}\xfe\xb3/\xcf\xc8\xf4К\xa0\xa9\xb0J-\xfb\xea\xab\xd9gD\xb1?E\xc5\xd5\a\x10\x00.}\xd9\xe5J\xd3铆\xc1n\xaeH\a\xeb]\xed?G}\xfb\xa7\xd3\xe3ܚL\xe0\xd2n\x13nu\x11[I&\x1a\xcb\x0fѢ\x9c\xe7\xac\xf0\xc5\xf1y\xda+\xf4\x9f\xaf\xff\xf2\xcek\x16\"$\xa5\xb1\x95\x9cH_ȁ\xd5H$\x86\xdb\x15\xd4\x17\xd3\xf8b\xd3p\xa4\xb0\xee\a/X\xf9\x93\xbbq\x1e\x94\x9d\xc5\xff\x05KB\n\xf2\xf4\xb6v\xde_\x8a\xe0\xe4{r\xa6\xdf\aY\xb4\xe5q\x84\xc66\xa7\x9c\xfd\xe6j\x1b\x9d\xef\xc5A\x8f\x9f\x04\xfe[!\xe0\xfc\x9c\x15Y\x9a\x14\xec\x1d[\xe8W{\x01ڭ,gw\xbb\x8d\x95?\xca\xceK\xbb\xe1\x18\xdb\x1a\xdcKtX䭻\xf6\x8fe\x98\x1a\xdc?\x8e\xda!~\x85YbƓ\xf7ͶI\xfe`\xc5x\xbaET\x8e֩^\x89\xeaul\xc0\xe9\xcd\x19\x1c\xaf\xf2wl\aS\x10\xde@\xf9\xb2\xe3$ೖE\x1a3?N/{\xaa\xba\x86\x92<OjZ\x10\x86H\xd5\x06\x05\xea\x92\\'\x83\xa4\x17[\xa4\xc1\x96\x1el]\x17i\"\a\x9a\xa5\xa7L\x91\xe3[7yS\x92\xf1\x9cѬ\xb2\\%_\xa2l\x96\xc3\x1bV\xb5K!\x89:\xfe9E@\xe4\xf3Y\x93+\x84\xf3\x8de\xa7Swu\x0e\xff\x8cV|\xe1h\x85\bw\xc9o\xff\x96\xf9\xff\xdex<t\xf6\x7f\fw\x9f\x8c\xff\x9c\xff\xff^\xf3\xff\xbf\xbe}\xf3\xf2\xed\xab\xe7\xef_~\xff\xf7\xf7'\xdf\xff\xf2\xf7\xef\x7fy\x7f\xf2\xee\xf9\xbb_O\xe0\x18\x8c\xd1-d\xb7\xef\xc5\xf2\xdb{\xb1\x92\xb1u\xd8\xe1a\x81\xffz\xfd\xeaǲ\xcc~\b\xa6e*\x1dU\xfb\x92\x16\x1c<I\xb9с\xa0\x02r\x04\xed/\xbd\x95\xe0ϧ\xb8d\xfb_b\xa9\xac\xd7}],f\xf1\xd8G$\xef\xde\xfdܽO\xe9\xdd{\x97\x8e\xa6yZ\xa4\x17\xa5\x81\xa0sf\x06B\xc4܄r\xa9\x98iP\x17\xb3\xf8\xaa,3\xcb\xe1h\xb6\xa5.[[\xed\xaa}\xd5\v\xfeӕ\xb8H\xd0\xe6\x9a\x03\x8a\x1eL\xa6A9\xbd\x02\xf3\xd9!5\xc6Y/\xa9\x9ae\xces\x16\xdc4Xp\xaa\xdf\x1ejL\xa7i\x9e\xc7\x1e\x8f\x86x0\r\xe2\xf8<\x98Z\xa3N\xce~\x83\xe3fV\xea\x89\xfa\x03ǣ2߯\xd1\xde\x1d\xae<\xa4!\r;\xfcε\xb7'\xef\xf8\xadk\x7f\xfd\xfe\x1d\u074c\x95\xb3\xdf\xfc4cIO\x00{\xc0\xc9Ù\x82Q\x1d\x96\xb7\xab\xfb\rgyԢ\x1f\xf9Jeo\xebE\x9a\xe0\x96\xef\x01F巼-\xbc\xc89\x12\xbb\bw\x16\x83\x0f\x1f>\fл\x1d\xcc\xf3\x98%\xd34d\xe1V\xdf\xe2\xdeo~\x9a\xe4,\b+\xd406\xbd\xe2\xbb\x18\x8f\xa1\xd9\vE& \x15\xbc\x00\xee\xca`\x18\xeb\xda3A\xf0\x9fd0z\x96\xd4\x1e]\xe5\xb2ӂ遃\t\xb3\xb1\a\xb9\x93@h\x96V\xffj\x17\x16\x17#dYD\xfd\xb7\x93\xb7o\xe0\xd1#\xc0\xbf\xe2\xce\x1b\x1b3\xf6\x9b\x91\xd9+\xb5\xdbk\x89\x15\xc3\xc3\xc1[\xbd-\xbc7\x83-J؆\xad\xbe\x1b,\f\xb2\fY1/z\xb2ݲ.S\xfeZM\x9e\xeb\xb6s\xbe\x98ԢL\x89=^\xee\x9b5\x9a\x8d\xb4Ћ\x9d1l\xef\x0e\xac\x88Z\xd9\xf8\xb4\x8a\xae\xad\xc8\xee2CP\x15 \x8cY\x81:3o\xf5Z\x1aǿ\xf0\xf6(\xd9\xc2Jq\xafm\x89w\xff\x06\xb8NG\xaf\x97+\xd6\xcaҢ\n\x83f\xd2K;\x11\xff\xed\xec\xc0\xf3\xa2\x98\xcfD@4\xc82\xb8\n\n\x89\x1e\x02+\\J\v\xd4n\xf9\xa8\x80s\x86+\x85\x17i\xfe!\xc8C\x16B\x99Jt\x1e\xffp\x15$a\xccr\x8a\xe1F\x85\x8b\"\v\xca+\bSV@\x92\x96\xc0\x16QQ\xfa\x16LL[~}\xd1\xc5=\xc3\x06\xb4\xf6\x11g\x15\x1c\x03\xf2\xc2\xe7_t\x01z\n\x9a7\x92 \xe8kS\xbf\xb4M>-\xadߠ\x17\xac\x9e\xe0\x8f'q\xb2P\x055\x91x\x02\x8a'\xf3\x87\xc0z\x92\xcaG\x8f,2k\xdf\xf9\xad\xcf<\xab\xe1\xadյ\f\xd4\xed5ۼ\xf4`\x9fߝ\xb8\xec\xfco\xf0\x99\xfe/\xfddA\x12M\xbf\xee\xf6\xefu\xfe\xffho\x7f\xcf\xdd\xff=\x1e\x8d\xfe\xdc\xff\xf9\xef\xdd\xffy\xd7\xfd\xdf?\xa3$\x19\xbbB\xeb۸\xec\xed\xdf\x12\x9e\xaf{\xf9'\xf3s\xbe\xc1\x14\x8f{І\x0f\xb5\xfdZ}\x15\xfb\xad\xef\xbe/<\xba\xb0\x0es8\xdbX\x8cMf\xc6n\x18:\xf1a\x14\xfb]\x8ex\x107\xf05\xffVJ\xed\xbd\x91\xbbO9\xb4کs\xaf\xfd\xeb\xa2Z\xf2\x8eZ+\x8e\x92\x8bT\xd5J\xc0\x9fQ\uf68d=\x97,ay\x90\xb3\xd0\xd9\xd8\x03\xff\xb8b\tnT\xc2\r\xa2\x98\x97\xe5i8\x17\xde\f\x02x\xf2\xbcP\x903\xc8\x19\xed4\x92\xaeB8\x8b\x92\xa8(\xf3\xa0Ls`\xb3 \x8a\xf5N\xa1\xff\xcd\x1b\xf9\xef\xf9\x93\xe5\xe9E\x143܀-\x93~w\xfb\xff\xe4\xa0~\xfeo\xb8\xf7\xa7\xfd\xff=\xec\xff\x7fPtg@\x8208\x9f\x97e\x9a\x90g\x17FE\x16\a\xd5\x04\xce\xe3tJ\x13}\xbe=\xfd\x03-ȝ\xa71M\x0e\xce\xd3<d\xf9 \x0f\xc2h^L\x806\xae\x03\xe0L\xe42O\xe7I8\x81\xfc\xf2<\xe8\xe1=}⿿O\xfe\x1f_\x95\x9a\xc0\x7f0F\x93)\xbd\x95\xfc\"ZH_\x99\xef#W\xbb\xe4\xf9\xf3\x9e\x13X\xb5k\x1e\x97\xbdB<\x8bh\x80\xe1\xd4r\x10\xc4\xd1e2\x81)CG\xbba\xcb<nmYv:\x927\xef\x897\xef\xd1\xfe\xf1+T]\xf6$i\xc2V\x14\x89.\xf2`\xc6\xde\x7f\xc8q\xadYn\xab߄16U\xa3u̩o\xb2\xafm\xf6'\xb6\xd9[\xffGCy\x02\"\xbde\xf9E\x9c~\x98@0/S;mPM\xa0\x98\xe6i\x1c\xafN\x1f|`\xe77Q9(\xf3 \x91\x94\xa6Y0\x8d\xca\n\x86\xfe\xb8\x00\xdc(3\x88(R3\x98\x15\x1bB\xae\x87Z\xd3\x01\xf0i5\xab\x88\x1b\xdf\xea\xd3\x1f\xb3`1\xa0\xd4\xef$\x8b\xe4\xd1\r~rCsɑ\x7f%pM*\xb4\x9aC\xfb\x1bsȅ\\\x0fe\rb\xff\x1f{o\xdfݶ\xad$\x0e\xff\xbf\x9fBf\xbb.\x11\xd14)Y\xb2-\x99\xc9I\x93\xf46\xbb\xcd˓8\xbb\xc9Qt}(\x11\xb2\x99ȤV\xa4\x1d\xe9J\xfc\xeeϙ\xc1\x00\x04\xdf,\xc7\xed\xed\xbd\xbb\xbf\x1b\xb76\x01\f\x06\x03`0\x18\x00\x83\x81.\xff\xff\x81\xf7\xbf\x9c\xe3\xe3\x8a\xfc\xef\xf5\xffu\xff\xebO\xbb\xff\xd5\n\xe2觴u\x93\xf0\\\xfdA\x1b\xe4\xc4j%q\xeb\x1b\a\xb3\r8\xeb\f\x90\x03\xd1\xc5w|\x1d\xa6\xa0P]\xb7\xf0\x9e\xd8\xf7\xde\x01S\x87\n!\x88\xe0ȟ_\x90\x9d\xb2\xe4G\xfdBؽ\xec\x9cwb\xfc^\vjPY7\x9b֏ @Z\x03//\x80\x10\xe2l\xd0\xfa\xf1\xbf\xc0\x87ܳt%\x94f\xd8:\xbb\xb8\xa8\a\xf5Z?)|\xdb\x16\x1c\xcf&\xad,\xfbi\xf8o\x1aE>5QN\xc7 G\xf7\xfe*\xfe\xf6V`|\x19\xcdb\x93\x19\xad0\xf0\x8c\xfa\x19\xdcx,\v\xb3_\xcc\xfdE\xc2A\xe5n\xb5\xa1\xf4\xb3C_\x99}S\xa6\x7f\xa4\xfdo\xa7\xd7\xed\x97\xc7\x7f\xa7\xfb\xaf\xf5\xff\x9f\xb2\xfe\x97{\x88\xad;،\xe6M\xd8Q̡~\r\x03^\x80\xaan\xe9\xeb;\xf800\xa4\xfes/[:\x02\xb6Q\x9c\xd8r6\xf3ZN19\x84\xa3\x9d\x9f\xea\xc6[I\xeb\xfa)7j$m\xa0\x99\f\x01 )\x11\xa1&B(UL\xff\x95hL\xfc\xb9>m'\xe9?\x15\xc0\xc1\x1a\xaf\xe5\xe5\x99\xf3\xfa\xa0F\xda\\\x1bH\x96u\x81\xef\xbb\n\x86t*\x16>m_,\xab\xe1\x1c\x18W\xe0\x87\x04\xadè\x13\xb6\x9f\u07bey\x7f\xae\xa7\xa4\xfe\xf2\x92\xa79\xd1P\x05\xad\x1b\"\xb8\xa6\x7fG/@\xba\xea\x04\b\xd8\xf4\xbe\xe9O \xab\xa9 \x91@\x8d\x83\xe6\xe4\x85\x04a\xd3\xed5\te\x8dX\xdd\n\x13\x91\xb2\"\xa7\x15ұ\x13\xc4ѝ\"\x7f\x12\a\xeb\x82-'\xa0e\xc3\x1d@\x84\x9eਡ\xe2H\x1e0\xc9-~}t\x11\x90`Ha\x1a\x99\xb71\x1c\xd7\xf2(\xfd\xef0\n\xe2ov\xb1\\\xa1\xa5\v\xdbI8<[\xac~\x1a\xd6c\xcdٜ\x16\x1dY\xdeP\xc9\xcd\xe4:T'\xb1\x18\xb5\xf0\xe1~\xe9k\xb8v\xb5\xe4\xd7\xf1-\xaf4\x80l\xc5\x1a\xf4\xfai\xd4\x1d\xf2\x85\x1a@\x99\v\xd7\x188\xff\xbc~\x19\x98\xf7\x12\x06D\x15\x884>\xd7ۖϛ*S\xb0\xad-y\xb9+\x9dSұ\xe0\xff\x86\x1d#\xbd\x91\xfe^\x8b\x80\x1d\xf3\x7f\xaf\xe7\x94\xf5\xffN\xaf\xff\xaf\xfb?\x7f\xca\xfd\x9f\xb3\xbd\xe7o\x9e\x9d\x7fz\xfb\xa2\x05\x9d\xff\xf8\xdf\xce\xe0Ok\xeeG\x97\x9e\xc1#\xda\xec\xe5~@\x1b\xe3\xd7<\xf5[ps \xe1\xa9gܤ\xb3\x83\x13\xb9g\x8eI`\xdar\xc0\xff\xe7&\xbc\xf5\x8c\x8f\a\x1f\x9e\x1e<\x8b\xaf\x17~\x1aN\xe0\x8c\x80D\x93g\xbc|\xe1\xf1\xe0R\xdd=Ŝ \xc1=\xe36\xe4\xdf`\xc3V\x03\xc6\xf5\xb7\x17\xf0\xdbp\xca\xc5bܒ\x97 \x0e\xd0=\x9d\xe7\xdaN\r\xaa\x80\v\x05:\x8c#\r[\r\xa0\x7f\x93^\xc5\xcb\x1a\x98\xfa5\x8dP\xd0\x0f\x0f#\x9e\x06\x91oO\xe28\x85\xed\xe4\xc54\x88\xeci|}\xa8\"\x0e\xbb\xb6k\xbb\xb0\xf0\xc9㔳\v*\x02\xcf<\x1e\v9\a\xbb٠p\x81yt\x18Gg\x87\"Q\x00\xee\\\xaa\x14\x8c\x98\xd2\xf8\xf2r\xce\xcf\xe1VlI\xbe\x81\xf8L!\xbe\xe5\x95d\x9d\xf6\x19\xf1U\xfa>\x9c\xcc\xc3\xe8R\xff\xd6犖\x89X쩼Pb\xe3N՛\x99\xf9\xd34\x9e\x8b\x05\xc7O\xf8ҡ\xa3\x17\xdfj\x95\xb2\xb5\xbcr\x8c\xbd䋹?\xe5\xe6O\xad\x1c\x93\xd5\xfaIj\x02JN\x87Qė\xbf\x9e\xbf\x82\xebh?\xed\xffp\xda\xef9ß\xee<\xab/\x97\xdd\xf6Zz!\xbb\xf0\xf7\x8b\xf8\xe9K\xfc\xcd\xd7p\x18jtb\x02\xa96\xcdP\a t5\x02\xbf\xc5\xcb\xe0\x00&ʁ0\xac:\x80\x88\xbc@m3*߰\xcb\xe9H\x97\x8dx\x9b3-\x96\xbc1\xd7U\x98\xf2\x83d\xe1O\xf9\x00\xae\x86!e\xe5\xfc*3\xbf\x05\xab\xa7y\x98H\a-\xe5-N\xb1EZ\xca-r!\x9fk\xd9\x1a\xf7\x98\x89\x85\xf9\x12|\xaf\xcc\xe56n\x1a/\xf4d\xda\xf3=\xa0\x9dNWm\xe6\x15\x93q\xc3\xf4\xd4V\xfbt:U\xc4\x10\xadd\xe1˝\xf0V\xab\xb0\x99\x17F\xe0D\xf3@\xdbӻ/\xf6\x1f\xea\x97\xebZ1\xaa\x10h\xb2\xd6^x\r\x02я\xd22&[q\xae\x96Yޔq\x86U|%j\xe5\xfe\xed\xa0u\x15\x06\x01\x8f\xca\xf8q\xb4h\xa8\xf5\xddE\x7f>\xc7\xfd\xc7r\x1eߞ\xce\xe3¨\xd3\xf6\xe5\xbbj_>\xdfG\xad\xeb&5\xa2`\x10\xc1\x80:;\x94S\xd0\x19\xe8\xcf4\xc6|y:\xb9\xb8\x99\xcf\x05\x9e\x16\x96nT7Q\x84p\xb3\xefP/\x8d\xc7\xfbp\xdb&\x19\xe2\xeeH\xabU<\x00\x85\xb9\xc1\x0f#\xbe\xa4\xb9\xa1\x98\nǖ\a@\xa2\x96N\x87\xdcu\x92]\x1ep\xa7q\xea\xcf\xe1DXۡ\x91g\x91\xf2\x90\xbb\xd5R\a\xa7\xf0\xd9j\xe5\xbe\r\xcf\xc3\xeb0\xba$熲\xc8\xcec\xc0GN\x11\xb5\x93\xf4\xc52\x8c\xd2Y\xcb\xf8\xf7\xa0\x85\x83.1Z\xe6\x9cG-\xfb\x05\x86\x18@\x1f\x00r\xfb\x1c\xa9\x82cx\xb9\x19\xf5\x83ъ\xa3\xe9<\x9c~\xf5\f}fI\xaf\xe0\xb6\\Q\xe95\x1e\x93$\x86f\xd4*ӑ\xf4ä\x87\x8cEm'\xb8\f\x7f\x1f\xc0\xdbv\v\x1ehm\xa8\xd7WP\xaaW\x17~\xceҥ\x0e\x0e?gi {F\x0ee\xe3\xf1\x19\f\xe6ǕƆȳÔ\xf4\x9bZ\x1cr\xc0\xaa\xd3\xef\xe2O\xb1:UyX\x93E\xaf\x15\xb8\xb5\xa8T\xea\x8e\xca\xd5\x10\xa8IQ\xdc\xf5\xb3\xcfɒ\xa2\xaeb\xf2\xdfY\x1a<>[,+\x84\x8bZn6\xad/q\x18\xb5L\xbc!۲\xc1̰eX\x06k\x19V\v\xf6Q\x855As\x01g\x87\xf5\xa4\xe7\xa7\xff2F\xfe;;Ć,g\xaa\x16QF]EYBU\x06\x80Q\x12\xa7W|\x99h\x03\xc4~\a\xc6\x00hR\x90eU\xc6Uc\xf0\xecP\x88\xa0\xb3CД\xff\u05fb\xcfЬ\x1cdԟ\xbd\xfes\xdcNe\xff\xb7\xfb\xaf\xf3\x9f?\xe7\xfc\xe7^g \xb9Q\x8c\xf4\x8e\x83\xf60\xad\x82\xf1\xb0~\x8e!\xb1\xff\xeb\xdf?\xfb\xbf\xb2\x17F\x19\xff\xe7\x8d\x7f\xb7\xd3w:\xa5\xf1\xdf\xe9v{\xff\x1a\xff\x7f\xc6\xf8\xb7%\x03\\\xe0uԖ\x1d\xf0\xd4\x0f\xe7\x1btR\u0097\a\xa8[$\x03X\x8a\f\x95\xe1\x89\xf2\xef\b\xcb\x1cg(\xadT:Cm\xc1G&-\x8e\xe5X\xb6ˆd\x86\xe2\f\xc5\n\xd6]\xac\x86\xdazB\xee\a\u06dd^҂\x95\x95\xbf\x1c\x1e\\\xc7\x7f;\xd8\x05\x13\uf128\x9aZԀe\r\xcd`\x87\x11\x9c\x80\xdc\xf2\re\x1a8M\xa0-;L\xf9\xb5]\x02w\x9b\xc1W\x17s\x7f\xc2\xe7\x1b\xddè\xf0/\xaa\xf9\x14-Z\x95t\x17\xab!\xad0\a\xfd\xc5j(K\xb1{\x048p\x17\xabV\x12\xcfà\xf5\x03w\xe0g\x98\xaf\xbf\xd0,\xaaڇZ\x9f\xfd0\x9b͆\xfa\xba?\x8aa\xd1\x7fw\x957U\x94\x1aC4\xd2owz\xfc\xbaLݝm!k\xeb4\xb1\xd9\x11\x1b\nK2\xacG\xa5EJ\xa0\xb4\xfeD\v \x97_\xcb0\xb0\xf4\x01\x84\xbf\xb7\x1d*]\xdfD\xe6\t\xbb\x1b\xd1`\xc2g\xf1\x92oh?r`|\xee\xf4\xa6\x1d\xa3f\xf8!\xed\a64\xa4\xa8\xb8^\xcc1\xa3\xb1v\a\xcb\x06q\xba\x11@G\x8bՐ\xf6\x0e\xe0So\x9c\x03-\x02[\aذȘ\xbdzΊW\aɕ\x1f\xc4\xdf\x06\x0e\xf8\xe8]\xac\xa4\xa1\x1b\xfc\xd8}\xa6\xb7\x90\xd6g\xb4\xcb\xd5ɋ\xc1\xed\xb4\x012\xf6Png\xa0H\xca\x11\x1cL\xe7\xe1b@\xdcu0\x89Ww\xd6Zv\x96D\x86[#\xe5\x1c9gK\xb7\xc1\x15\x9c\xc9\xede\x11\xc7P\xed\xaa\x88M\x95J\x0e{u\x91\x86ӯ5\xa3\x06\xda\xd6)\vJG6\x01\xf65\f\xef\x00\xaf\xeb\x17Z\xb2Æ5\xf2\xba\xa9薍K\xc5\x1a\n\x1e2\x1a\xed^\xcdH)\xf0\x8f`\x17\x14\xff\xeeB\xef\x16?\x8a\xe2\x14\xf7\xba\xf1\xb1\x19\x90\xfb\x1b\xe2A7\xef{h\x96\xaaX\xd3\xf8\x11\xb7\x8e\xeeꪚrZv\x1eY\xd3\x10D\x05\xc8X\xd1\r\xfd\xf2\x98\x00\xe6\xbcc0\xe8|Y\x19\x99\x9d^\x8d\x04ЈĆ\xd8\xc1\x1f\a9mE\x16\xe9\xd4ʻ.+\x8c\x9b\x9d\xc5\xdfk|\x14r\xe1\x83\x1b\x9b\xbcޅ\x1a\xbb\xc5\xe2\x87\xf7\xa8\xdc\xee\xc2\x1eH#eCGB\xd3%\xe7Ѧ\xa1ev\xb0MK\x1e\xb5\xeb\x95F)F\x93\x10x\xc8S\xa3\xe4TM| +wH\xc6\x13Vb\xa9n\x1d\x87\xe7\xd3lu\x96\xa7\xb2\xfa\x8bU\xebD\xfc\x8f-\xec\x9e,VC\x1c\x94\a\xae\xab\x18\xc8\xed\xc3\b\xd2[a8\xbdY&\xf1r@R\xe5\x01\x8dR7\x89Mj'1\xa0KP\xa3Mް\x10\xcd[\xe8\x00ƿ[i\xa4\xfb\x93E}n\xdd;\xc3\xe0\n\xe4\xf8\xe6\xcea|\xc2d3=\x90q\xa8\x10ٍ=\xe7\xfe8\xa8B\nU\xe3 \u0601G\x90ЀfXK\x19\r\xab\xf5\x058[\xb2*\xf1+\x8c\xbf\b\xba\x9bY8\x9f\x97\x9bFe\x86\x89(\x81\x99h\xfa\xb5\x0e\a\xc4'\x17AW@l\xc8y\x97\xde\xfan\x9f\rE\xb4\xa6.$W\xfe\x82\x1f\b\xb79\xa0$O\x97a\xb28\x80\xb3\xe6\xe4~3\xe4\xfa\xe2r\x19\x06\xcdtAjN\x96l\x9f\x03\x97H)\xf0G\xa7D \xb08E\x04~r\xe5/\x97\xfez\xe0\xb6\xdcF*\xe0\xb6\xf2]D@z\xdeʄ{G\x8b7\xe1T\r^\x8f\xf4r鯛\x91\xc2h\xbd\x13)\x00(\xc5\xd8\xeeU\xc4\xd5\xfd\x95\x17\xfbr\x1e\x7f#\r\xc6j\"H\x00a\xa1\xd8<(\x8a5\xa9\xacK\x17%[\x1c1avz=K\xfeo\xbb̂\xe4\x83\x1d\xe9w$;w'\xee\xc2\xec4%\x1e\xecN\xbd\x03wc\xeb\x86\xd1-_&|g\x03K8\xad\x8d\xb5\x99\xaf,\xc1\xcbmL\x03\xe4\xa4ںŔ\xda\x04\xa7)\xba\x19\x8fS\x8d>\xb8+\xbe\x16\x93\xd6fs~ɣ\xa0\xbar/\xf3\xb5\xd6\x1c\xba\x8ep\xe4\xc0\x8f\x9ap\vg\xc9r\xe6\x86i\x1co-\x15\x15\x81N\x9d\"P\xa1\xac4\xb1\xb8\xba\xf8\x16\xb4\xb7\xec\xe4\x1b\xf8{\xa1\xd5\x1f\xaa\xd0R\xf5v\x16\xab\xbb\x17\xcf\x1dV\x83\x0f\xea\xb0A\x8f\xf0\x03|)\x04\xc2\a\x12%\xbc\xb0R<\x97w{\x8bU\x03\x16E[m\x03\x91\x16.\xb0t\xeb\x1a\xa8\x0e-\xee\xb4\xd0\xcd\x1d\xa7n\xc1R,K\xeb\xc80\xba\xe2\xcb0\x1dVT\x01\xdcx\x12\xe7\xca\xd4\xd1\x12T\xb7^8r\x9cBSDp\b<W\nZQ\x16\x95\x04\x9f\xa4ޟ\xe6Su.E\xfb\x8d\x90\x9bB\x1bٝ\xe2>\x8b\xa3o\x1cuJ\x9a\x9e\x0ex\xd4\xd8Cv\x10&pV\x17\xe4\xd4\x1cUAo\xe6\x1b8\xff\x14\x8bwt\x88\x83s\x13\xad\xa3\x06\x8e\xd6\b\x14\x05\xdc]\xa4\xa7\x8au\x1enT\xbe\x16)\xcf\xc3\xeb0\xa2I\xf6\x04\xaaW\xed\xdf:<Ԡ\xdaȬ\x8aJ\xa7N\x15\xafEF\x8b\x91;\xb1u\xea\x90ɝ\xd8\x7f̿\xd2#\x032\xfa\xcf\xdb\xffw\xfbǝ\x9eS\xde\xffw\x8f\xbb\xff\xda\xff\xff3\xf6\xff\xc1\x1ePzb\xf56`\x84)\x86\x8d4%4U\x94\x15O\xbe\xb0\x8d\xf2\x9f\xed\xa9\x04r\ri\xd8\x06\x1bR2\x98QJ\xac\xc3Y\xbc4!>\xf4\\K\xb80\xf3t\x1f\xdb\xc3\xf0L~\xb4ۢ\x00z\xaf譿L\x05\xe8(\x1c\x0f\x05ڑ\x966\xf6j\xe2\xb6\xdbMF\xb0u\xc9\x19Y\xab\x88\xa4\xcc\x02?\x94yeU\x15!\xda\x1b\x8d\x15\xed_\xf9\x1a<\r@:$\t\a\xc9\xe0\x9f|H\xf8 6\xb3\xc43\xaa9\xbe\x80'i\x18\xe1J\xcbJ\xe2\x9b唳\x8dD\xb9X\xc6\v\xbeL\x11\xafL\xd3\xe0G2}\xec\x89\xd4<B\xd6A\x83ά\xe9<\x8e\xb4n\x03J7U\xcfZ\xf8\tf6\xd1e8[#\x14˲a83\xd3\xf5\x82\xc73x:\xe5f\xce\xf7<ϸ\x89\xc4\xcbo\x81\xb1\xbf/bm\xbe\x02S\xb4DtR\xd0\xf5\xc0QS\xb8\xe4\xa6\x11t\r6,\x02\xa9\xfe\xcfr?E\x97\xf3x\xe2\xcf\xd1E\xda*\x15X.\xd2\xf8=\x92\xe3\t\ao\xf6b\x19\xa71\x10c\xcb\x14\xeb\xf5\x87\xdf~\xbb\x00\x1be\xcfx}3\x9f\x1bև\xd7\xcf_\xfc\xf2\xf2\xf5\x8b\xe7\x14\xfbA\x91j\xfd\xfc\xe6\xcdo/\x9e\xbe\xa6\x84\x9f\xe3x\xce\xfdȰ^\x7fx\xf5\xf3\x8bw\x14+\xfc\f\x1b\xd6\xfb\xf3w/_\xff\x85\"EY\x86\xf5\xe6\xe7\xffx\xf1\xec\x9c\"\x05M\x86\xf5ˇ\xd7\xcf\xce_\xbey}\xf1췧\xef\xdf{\xc6H\xbc\xcf\xd5\xfa\x85j66\x86\xb2\x92\xad0\x91\xb1м|\x9a\xaa~Pu\xb5\xc1E\x97L\xf5<\xaf\x88>S\xa8\x04;}/\x17\x853Sp\x8c}\xe5'o\xbeEo\tĔ\xb0\x8cݏ\xcf$ok\xd09m\xc0\xf0\xaa\x82\xe1\xcc<_/\xb8\f\xefy\x9e\u058cl\x93^-\xe3o\xe8N\x11\xa0\xd0_KF\x9e\xcdn\xe6ia\xa0\xc9\xc2i\xb4I\xec⳹B\x1bB%\x06\xa6\x8a\xcf\xe4P\xa1\xe4\x9c| Č\xd9&\xf9\x16\xa6\xd3+\xf8\x9a\xc2\xf3\t\xe0\x93m@y\x14\xdb\r1\xe96\x0e\x83\x96#\x13\x8b,\x88\xb5\x01\xa6\xf5h\x18\xc5CB\fa\x81ۘ\x103J\x14:\xabb\x11F$\x18S\x02hL+\xd2\xc5\xc0U\xe9\x1a\xff\xcazj͞\xd75L\xd4\x00)\xb3\xa4$\x17c\xbd¨\xc7:%\xf3pʽ\xa7\xb0U\xa1\x8dL\x8c\xcd9\xde_^\xe2\xe5&\xb0:L\xccY$F6\xce\x0f\xde,R\xe3\xd8d\xf65\xe8\xf5\xe6\xe1_G\x9f\x93\xcf\xe6\xf8\x91\xc40\xfa\xab9~\xf4\xd94G\x7fe\xe3G\xec3;d#w\xac\xec\xc0\x0f?\x1f~>\xb4\x1f=\x19}^~\x8e\xc6\xdbχ\x9f\x1f\x99O\x06\xf6V\x84٣'\x9f\x1f}><\xbc\xb4\f\x83iy\x926E\xd1\xecd\x19JV#e4\xfbx\x9e\xbb\xbf\xbf\x871#g\xfcd4\x1e\xe0w\xdexߖ\xfe\u009cE\x16\xdd\x1c\"\xa9u!.\xbdy\xb3H\"\x95\x19L\x01\xe1{7\x8b\x00^-\x19M\xc2(0e\x06\vm&ǖl\xb2D\x11E\xf8\xe1n\xd8|m\x02\x98\xe5\xb3,\xa7\x83\xd0\xe1\xae\x11d')\x8c\xe1\xdfDU\xf0\x9b\xea%gZ\x80\xa4(X\xf6\xcc9X}^\xa6W\a\a\f\xc1G\x1a\x82\xb6H\x1ac\xa6\x11\x05$}\b\x97\x93s͗\x97Ej\xf0\xdbC\xd6\x10\xf2\r#,GհZ\x83\x1c\x1d6\xd2,\xb2\xa6rn\bg\xa6j#\xa2\xff\xac\xb3\xbf\xaf\xb3\xb2J\x1f9cƨ\x10h\xb8a\xa9\x8b\xb0\xb0\"e\x94\xd3\xea(\xea*\xfd'kx\x99\xd4\xf4\x96\xc4N\xddEdc\x8fA\xe1\xfcz\x91\xae\xe54\xe0i\xa83\xa4\xed\x19ڊ\x96J|\xf9\xfe\xe2\xf9\x9b\xd7\xe7/^\x7fxu\xf1\xf3\x87\xbf\xfc哞O\x89\xc7V\x18m\xe4\x80\x1a\xb8\x196\xd4\xc2\xf3\xc00\x97f0\xd9\x12h\x8f+\xe5\x02\xb8\xe1\xcbL\x96\x8f\xd9\xe4f\x82\x86\x9f@\x93\x8a\x14\xbek\x89\x1eҡ@\"Z$PC\x0e\xb2\xda\x16-)j\xae5M83\xb5\xb9/ς\xdd#52\x15k'W\xe1,\xd5I\xfaJ\xf4@\x17\xdat\xc3'\xfc\x9b,\bb\xb5\x8e\xc8hn\xc4L\x166\xa8\xfd\n;<aC\x8c\xb4\x93\x9b\x05_b%I\x11T\xf1\xa2\xeaX\x19P}D*\xdbȄ\\\xd2Q\xc6<b\xf8\xb5\x04\x00\x93\x9a\xcc7L\x97\xeb\r\xe5\x90q\xf21\r\xcc\xc72t\xb2kr\xb6\xc92٥\xa1\xe7(\xb58o\x9d:\xdd\x18q\xd8~\x10PE\xe5<\x87n}\xc7\f\xaa\xb2W\xa2OkG֜\xe4\x15\xf8\xb5\\G8\x1cH\xd2\xe5\rxw\xf60M\x8e\x02\f\xe4\xec\xa3Q&\xd5\x14\xa8\xa0\x1fMy\x02y\xa1\v\xb5^\xd9\xdf/E\xe4E\xea\f\x87\x8a\x06\xe1\x83*V\xc6\t\xdb\xe4\x1a\x8f\x1c\x05{\xcd\xea$\xcbq\x8b\xaeц\xce0Ǆ\x17\x87\xdf\xccj\x10QJ\x15\x0f%\x18\xec\x01}\xbbѕ\x1f\rx\x14\x8e-\xc4[\xd5\xce@DR\xd3\xee\xefk#\x0f\xc1\xd9\xfe\xbe\x1c,bZ\x16\xb1#g\xecyƏ\xd8\t\x86\xe8\x1e\x12\x93\x98>\xc4\xdf\x1e\xccE\xb9\xd6~\xad\xb4\x05\x15\xa5b$\x01\xa3\xebq\xc38\xcdrm\f\x1e\xa4\xbc\x8a\x03X%\xceoT;z(\xfbI\x9cR\\\tRvP\x01TFJ\xd8\f\xb9IuS\xdeP\x1e\"U\x92\xf0*L\xe8{#\xe4\xdd@\xfc\xb1\x88w\a\x9b\x9c\x8f\a\xf9g\x96\x81\xfc\fg\xc5EL\xbe$\xaa\x8d\xb6Q0y\xf8;\x03ױ%0=9c\xa6\\2\xb1\xa1\xfc\xb2\xd5*\xdb4T\x9c\xb8S)p\xff\x16&\xa9\xc1\x86\x8di\xfa$\x92\xaf\xf2\xe4\xdd\xe9\xf2:o\xcf4\xa6\nk\x18)\xb8\xd2\xd5|\xc37\x18c\x9b\x9cE\xe0\xda&\xdb\x18\xe0A\x06t\xd4ij\xe0<\xa7p\xc1\x12\xc4\xd3P\xc3\xf8Nc\x11\xabz̰\xe0\xe5\x90g\xe9\xf2-\xc4x\x88Ԇ\xfbwT\xecv\x8b1\x14b#\x85c\f[\x13\xcf\xd2%\rU+I\x97\xe7\xcb\xf0\xda\x13\xfc\xa1\xc1\xd9\xe92\xbc\xden\xabl\x8c\xac\xa3tǿ~N\xda\xdb\xcfI\xfbG\xa1Af\x96\xbf\\\xbe\x84\x9d\xf473\xa1\n\xeb(\xf1\x04\xf0\xcdL\xc3\n6\\l\xa3\r\x7f\x0f\xd1\xd3`\a\xd1 ĺ\x18\xf70k\xc2b\a`\x84D\x1c\x85c\xcf\xf3\x04\x16\xa2/\x94\v\x99\x037\xb3\x9e\xbfy\xf5b\x95\xf7+\xc8$\xeb\x9a'\x89\x7f\xc9i\xf2\x04\xa6\xc1\x95\xc8\x10\x83\xd38\xe0\x1e\xe6\x9ar\xbc\x0f;\x82\xb4\xb1H\xa4\x9c\x1e\xfdͬ\xe9\x15\x9f~=\x8f\xbf\xf2\xe8i\x14\xfc\x05\x9e\xc2\t\xb8V\x9c\xeaD+\x05\x18\xac\x00~y\x9eg\x18\xfaZ\x0fK4\x8d\xf7\x9f^\x9f?\xfdx\xf1\xe2\xdd;\xc32\x9e\xc2\x03f\xb7>X\xf2\xc4\xcbV8\x9f\xf3K\x7f\x8e<\x13]\xb6\xbe\x81G\xe8\x05\x9f\x86\xb3\x90\a\x06\xcb\xc2\x19\xe8\xf1\x87v\n\x1e\xbb\xb1\fV\x83\xff\xe5\xeb\xffz\xfa\xdb\xcb\xe7\x17\xcf~}\xfa\xee\xe9\xb3\xf3\x17\xef\xa8(\xd1\xfb-\xba\xb9\x95\xb4\xfc\xbc\xe8长\xf4\xa7)_\x1a\x8cڵ\x95w\xb1P\x11\xcb\xd5̬\x9a!\x05\xfc*z\x1a\x18\xeb\x9a\a\xcfH\xaf \x0e\x14\xa8\x00*\xbft\xca,\xa9|\x14\xf3<)\x06iS\xed\xf0s\xd2>d\x83\xd1ؒ\xbc$\xb5\x8a\x06v\xc2>\xc5i\x8d\x00A3\x10\xa2\xf1B(\xe0\xea\t\xb8\xbc\x1elS\xa4Q0\xac\x14\xb0&\xcb2K\xb5\x87\x18\x9c\xaa1\xb4\xa1\xe0\x8d\xc69\xd8_\xe01\xa9\xa5^\x0454\xf4\x9cʍ\xb3\x05ˆؕ:*\xdc#\xd0\"\x86\xc5\xf2\xd1\xd66G\x1e*\xec4~\xb6[P[\xb3r.\xc9\vyN\xe2a\xfc\xd3\xf6\fCj6\xb5\x83\x00\xa9%~\xd8\xf3\xbc\x03\xb7R\x80\x1f\x04w\xe0\x0eg\xe6N\xbc\x1e\xe0ջQ`\x19\xd6\xf6 \xf4L\x99\x04\xe1\xb2\xe2\x0e*\x80]Qhy;\x89\x01u\bA\xf74\xaa\x801\xa7\\\xc4[\xeewP&\xae\x0e\xfe\x81\xed\xe3\a\x01!\x11s+Iq\xa8\xbf\x8c\xaf!\x82T\x89*gbv\xb8\xf5f\x1a-\x83ᦨ\x98Wl1A\xcam'ڣ\xd6\xf0.\x9e\xf3d\xeam.y:Pŉ\x01`\xf1\xe8\xe6\x9a/\xe1\xb0l\x00k0X\xe0\xce\xc2˛<&\xc3UCmA\xa6>\x1f\x16F\xe0\xa2\x18\x82\xe2\xd5\xdab\x85\"\x99\xafl\xb1\x9b\x04\f\xd5q\x8f\xfa'\x9dn\xa7\xd7a\x9bJF;'\xd1\xc35\xe3\xf0\x8f &\xc3.i\xa96\xd4Ʋ}q!\x1aT4\xd1\xc5\x05\xdb\xe8\xb8+\xc9fCa\";\xcb2f~Co7ŝ\xecw/\x9f\xfd\xe7\xfb_\x9f\xfe\xf7\xc5\xeb7\x17\xcf\u07bcz\xfb\xf4\xbc\xa2\xedTA\xb6\xdb\xc6\xdc\xc5\xdd1\xb6)\xbc\x84WV\xbb\xb2;\x158|\"Q\xd7\xda0\"\x1f\x19\xb0逽\xb8\a_6'\x8d\a\xa7\xbe\x12\x96V\xc4y\x90\xb4\xfc֒\xcf\xf8\x92㋳1\xccu\x94\xc9\x10\xe3\x93B\x9e\x8eO\xa4\x805/OD\x82\xf8\x16\xf1\x01\x9f\xf9\xb89\xbb\xc1\x83\xe1E\f\x16\xd0q40\xa6\xfe2\b#\x7fnX`A\xc9Ӂ\xf17\xbe\x8c\r\xeb:\x8c\x06\xaa}\xack\x7f\xa5\x85\x16K\x8e/\a\r\x90\xc1\xb2\xbc⸸+\x94\xc7\xe0\xfd\xbf\x17\xfe\xf4*\xd7.\xbf\x8a\xf1>\xfaJ;S_\xc7\xdbm!\xcf\xe8\xeb8\x13;k\x82v\xc1\x0e\xde&\x13A\x92M\xf4,\x06\xae\xfaa\x04'|>ÙnX\xdaq(w\x04&\xa3\xe6ড়\x1e\xb9\xd3ڋ\n\x95\xaf\xbb\xe19p\x8dx\x81\xe2(\x87=\v\xe7)_\xe65L$\xd4^\xa2\xce\xd63F\xe4'<}\x1f\xfe\x8d\x9bd\xab\x81%\xe3\xa7E6\x05\x18#\xbe3V\xe8o[\r\x16\xd860\run}I\x1c\x88\xc0\xb7a\xe2\x05];\xe1sx!I\xcf\xcf\xc8U\x95i$\xb7\x97\x83\xe4\xf6\xd2`\xb6\x9f\xa6K\xd3@\x02\f\x8b\x9a;H\xafd\x82\xa0\x83RD\x80\xa9\xdd}PLA\xd9-\xf2\xb0\xfd\x8e^\xee\x14<\x0f@\xdb\xed^\x03L\xf9\f\x00\xa0\x19S\x8f\x14A\xc7.\xbd\x86\xbc#\x00ƅ\xf3\xder\xbb\xdd[\xe6\x8b\xfab\x88\x9e\x90̱b\xdf-\xf9e\x98\xa4|)\xb1\x99 \x01\x96\xe6\x06\xdbr\x00 \x19#U\v\xdf\x05\"(\xec\x1d\xf96\xe9vk$\xa9?\xfdj\xc0V\x96d\xd7 L\xa6`\x8a\xf0\x0e\xac\x9aM\xd9\xedE\x86\xcb\x19\x8a\x98\x0e\x9b\nW#v\x98\xe0_\x99\x02\xcb8z\x981\x8c\x92\x14\x96\xe8 \xd0d\x93\b|L\xccb\x02\xee}x\x19\xf9\xf0\x06gu\xb3CΗ\xb4\xba\xa7\"\x86B\x14\xc9R\xc4s*>m\x12\x0fZF\xbb\x84\x17\xcf\x12\xd0\xc4$y\x16\xdfD\xe9P\x8e\x83\xf2P\xa7z\x99\x05\xd2\x05QR\xf7\x97\xa5\x12\x8bj\xa5\x8b\xb3\r,\x1e\xd6\x0e0\x96ୢb6xv&\x8a\xf1\xed)\x80,\x1d\x88&\xb8\xea(\xb7\xab\xc0SB\x04quU/c\x14\x99\xb1\x05V\x9e i\xe4\x8c\xed\x15\xae\x90\xd7Z\xccZ\x9b\xbcV{\x9e<\rRS\xd2:\x8f\xdb\xdf_\xefy\x1e踒\xa8\x15\xbe\xa1\xb3\x96[HP\xd3xFM\xdeJ\xae\xe2\x9byК\xf0\x96\xc0)Z\x97\xfb\x01\xbc\xeen\xb4e\x99m\x03\x91\xa8\x885\xb4\x85\xa0\x8f\x96\x1b\x8f\xbd.v\x10\x11\xdd\x19۫3\xfav\xc7\xf6j\xbb\xd5\x022\xc1\xd1\x13\n\xd8\x0e\xca`u-,\xe6\xb74\x06\xea\x13\xe1\xca?\x8eZ+\xf1X{\x02~\x06\x89\x89ћ\x13t@\x82sn\x96є@\xc3\t\xca}\x1e\xc3\x15\x7f]6\xcb\x17Xio\x12\x11\xd9\xd7\xfe\xa2F4\xb7\x04\xed\x19l_-[\xd7a\x042\xf3:\x8c\xf0A\xacb\x9e@\xe5\t\xb0\xab3F\x99\xfc\x15f\xf2W;2\x05z\vAn\x912\xba\x0e#\x98Y\xc7Y\x8d\xe0\xa8TKT\x96\x94b\x12\x9e\"\xd2$\xc1\x83Ԡ\x0f-4\xeb\xf2\x97&\x93\x10⏽b6^\x9a0G\x8e&\xebǔ\x7f\xbd3\xffZ\xe5\xd7\xe6\x03\xcbQ\b\xeck\xff2\nӛ\x807\xa3\x1aI\\#g|\xa0}[\xea\xdb-ď\xcb4\x8bYh,\x05\xab\x90\xc5\xe5\xe6B\xb1̃\xe793@\x04\x04MV\xd3\xda2R\xb5\xacz\xab\xb5N\xe5\xa8\n:\xf9H\x17ۨ纘\x9a\xf1\x85¢wݰH\xd2N\x16\x16Z\x88\xc9\x1aY\x8cx\xb99\xbd\xac\xa2\xe4iH\xca\x05\x1e\x02\x99\x01\x93\xa3\x8c\xf4/zP\x19\xb5=\x8fvd\x85\xee\a\xd2mO\x06\xd8F\xa7\xb6:\r\xe4s\x1c\x81 k\xb0\x8d\xcc\xef\xe1\xd2)c\x19TÓ\xb1O\xd4$\x87\xd608\xc8\xd8\x00~\x0fk\x1b\xa7\xa1\\\v\u05f65\xa5\xe73&\xf0\x85\a\x88G\b\x8b:E\x9e\xc26\xf9w\xb5\x90\x80m\x02{\xed\xe9\xa8\xcd\xc0^C\xf7g\x19+\xf5\xb4}\x15\xc7_IjV0\xf1(]\xae\xd9\x06\x12=\xfc\xb6gj\x9e\x9eϬ\x11$\x8c\x19I,\x8d\xc1\x81ڽ\"\xfbހ\xa60%M۾P\xca\aD\x82:j\n$s\x7f\x1dߤ0Vŗ\x18%&\x1bRP,\t\xb0xz\xa9\x9d\r\xb5\x82=\x01&:&\xd3\x13\xb4\xef\xedV\xeb\xb1R;\xf8\xb3\x94/\x1b\x1bBG\xb8\xb3=B\xcf\x19~'\x13J՜\x8eX\xa5\x16\x83\xc5\xea5\x18\x85\xed\xf6\xb8Е\xd5Z\x92H\xd7\xe5N6lh\xfc\x8a\xac\xc2r\xf5\x01?\xfc\x0e\x05K\x03\xf3\xb4\xef\xed\xb609\x03\x8fh\xa9\xfb\xfb\x85\xd4==\xa7\x9a\xb6EU\x88\xbc\xd6\x14\xef\x1e\xb5\xae\xfc[\xde\n\xc2\xd9\f\xaf\xe4(\rDi'0ek\xc8\xdaF\xeb6\xc1Y\\+\xaem\f[\t\xe7e%\x16\xd6Qs\x93\x19\xa5\xa9\xbe4x<\x1c \xb0\x87\x8a\xdc3\x18\xc9\xe9SȰ\xbcq\x03\xeck\x9a\xe6`\x05i\xaf^\x85\xd1v[\x8c\xf1W\xb4\xb9\x9f\xbc\x8cp*@Y4\xacɹ\xbf\x1fث\xb3r,\xcb3\xe2j\xb8\x9a\xd3_a\xce\xc7\xe5\xd8JNb!\x15-7\xb3i_\t\xb2\xc7\xd1\a1\x99\xd4\xcc<\xb53\x95\xd8;\x960\x84\xa5\xbc\xf4ɱI\x01\"\x05\x87\f'^)\x8cv\x99\xa5\xb8\x91\xfcB\x8dm\xec\xc9 \x01ʽ2^\xb33\x92\xaf\x82\xb7[m\xfd\xcb6\x85%3\xa4\xb0\xec\xc1\xbb\r_a\xad\n8\x9eо\xc3\xe0\xab<\xaayB0\x83;7\"\xeeX\a\x16\xc5/ԟlb*9\xb5\xc6&S\x9b|\x9d\xb0\xf4<C&\x1blS@\xeaլO\xeb\xbb\xd3,\xe4c\xb8u\xb7Qs\x84\xde_c9֧\xb0\xa2\x80W#fa\x14\xb4$D\xcbh/\xb3\x02\xb22\x1b\x8c\x96\xe3,'\x1f\xaa\xe3y\x86X\xb8\x95\xe9\xcf\xfb_t{\x96\r\xf5\xce-3\x05\xfc\xc6\xfd!䴼\f\xb1\x19\xb4\xe7yj;\x8a\x84(\\K\xf0hx]\xf2\x14\xb6\xf0nR\x1e\xbc\x87\xf8\xc2.\x88\x85\v-\x14\xb1\x14\xf3\xdf\xc0x`\x1c\x92\xf0\x97Qj\xe2\r\a\xf0C-w%\xfe\v\x16'r\x93\x84Y\xaeS\xc8-\x1cp\xef\xceN[)\x98?#a\x10H\x9b.\xe2}\x9d\xa0\xed\x16n\x9bh*\xaf\xa7\x8d\f\x05\xfa+\x05;=\x82\xbd\x95\a\x8f\xf6m\x98<d{'+o\xa0Q\x7f\r\xef\xdc\x04\xfd%\\\xc1\x96Cb?\x83\xcb3\xfanh1%\xefg\xe2\x8fdz\xc5\xc1\xb4P\xca\x13\n\xdbɂO\xd3\xe5͵{䍌\x1f\xf8tr|\xda7,\xe3\x87`z2;v\xe0k\xd2\xf1\x8f\xc4\xd7i\xe7\xe4\xb8\xe7\xc3ױ۟\x1e\x9d\xc2W\xd0\xe1\xc1I\a\xbe&\x13~\xd4?\x81/\xdf\r\x9c^\x00_\xfcx:\xe1\x02߉\xef\a\xf8埜\x9cL1\xc7i0\xed\x04]\xf8\xea\x1f\x9d\xf2\t\xe2\xeb\x9e\x1c\xfb~\xd7\x00CF\xbcTg\xb2zz;\x8e\xe3\x00Ž\xe3\xaeӟAΞ{4=\xc6\x12\xfaG\xfd\xde\t\xe2=\xee\x9etO\x8f\xe0\xab?9\x9d\x1e#M'G\x93~\xbf\a_\xfe\xf1\xd4\xefa\xcd&3~|\x84yyg\xd6\xeb`-f\xb3\xd9qG\xc4M\x83\xc0A\xb8\xe0h⺈%\xe0''\x14Ǐ\xe8kz\xea\xf6\\\xc4|\xea\xc3u\x06\xf8:\x9e8G\x1d\xacY\xef\xc49\xe9\xe2W\xd7uN:\x13c\xdcX3\x17j֙u\x8e\xbaX\xb3\xee\xb43\xed!\xde#\xbf{,ڸ\xd7\xefuD\xaf\xf4'\xfd\xc9\xf1\x14\xbe\x8e;\xa7\xbdc\xccq\xd2\xf7\x83>\x87/ߝL{\xf859\tN{\xd8\x16A\x97;G\x187\x9d\xfaA\a{t:=9r\xb1W\xa6n\xaf#\xea\xe8\aݓ\x8e\v_'\xbe븢\xb4\x13\xf7\xd8=\x86\xaf^\xd7\xe5.b\xe9\x06\ue24bTu;\x8e\xefVj\x86\xfb\xa9\xe1\xf4\x14ju\xd4\xe9\x06GH\xe3\x91\xdf?\xe9#Γ\xa3\x93\x99h\x19\xbf39\xeeb]\x82`:\xe9a\x1fN{~\xb7\x839\x8e\x83\xdeI\x17{\xe4\xb4ߝt0\xef\xf1\xb4\xd3\x17\xbdtt\xea\x06]\xa4\xac3\xeb\xf4\x8e\xfcf\x1e\x02\x7f\xdb\xe2Mfo\xd3s\xba\x03\xe3\a\xee\xf7\x1c襞\xd3\x19\x00Owg\xee\x11\x84\x9c\x81\xf1\xc3d\xd6\xed\xbb]\xc3:r!\xc4g\xfe\x94\xfb\x86u\xe4\x9cB\xa8s\xea\x06S\b\x01\x96\xd9Q\uf61f@\xe8\x04\xd2\u070e\x1bt \xe4\x02\x96\xd3N\xe0s\b\xf5\x10\xf2x\xca'\x10:\x1a\x18?\xf8'\x1d\xfft\x06!(a\xd2\xe9w\xa7}\xc3\xeab\xbe\xfe\xccw:G\x10\x02\xcaN\x8e\xa7\xdd\xce\x04Bǐ\xcf\tN\x8e\xa6\x10\x02,\x9d\x93I\xaf75\xac\x0ebq\xfd\xa3\xd9\xf1\x11\x84\xfa\x90v|҅\x12:\x88\xb3\xd7\xf1\x83\xe9)\x84\x00\xe7\xf1\xf4\xf4X\xa4A\x1d\xfc\xde\xe4d\x12@\bpN\xddi\x10\xb8FIf\xe0ŽoW\x9cϡG'\xbdI\xdf\xc7\xde;\xe9\x9d\x1c\x1f#\x0f\x1d\x9f\xf4fG\xd8{\xa7\xfd^\xef\x18\xb9\x04N\xd3&\xc8\x7f\xfd\xde\xe4\xd4\xc7^>\xeeN\x9d\xae\xe0\xbfI\xcf\xed\xde\xd1g\xd38\xc6\xd2z\xfc4\xe8̊ys\xccǓiw\x82\x9c蟞\x9c\x10\x8f\xbb\x93N\x1f9\xc4?:>:E\xaa\xa6\xce\xe9l\xd2+\xf3\xe9\xf5M\x14FP\x88\xe3L\xa7b\xf8:N\xbf?\xc1,\xb3\xd9\t\r\xe9\xd9L\xa6v\xbb\x8es\x8aU?=\x95_\xd3\xe9l&\xe1\xa4\x108q\xe0\a\xbe\x1c\xe7\x84R\x1d\xe7\xe8D\b\xa4I\xb7狸IW\xa6\xf6'\x8eӟ\xc0\xd7\xc9lҕ\xa9\x12߄\xc3\x0f|\x9d8@\x97\xf8\x9a\x9e\xcefD\x1f\x956\x9b\xf1\xbe\xf8\xf2}\x80\x84/Ρv\xb2F\"\xb5\xdf\xef\xf7%͓\x99\x80s\x1c\xa8'|M\xa7\xfd\xbe\xac%\xd4\xd3\x18\xdfw\x92z\xe7GA|\xfd\xdcO\xfdڙ*OΧ\xab4\xbc\xe6\xf2)o\xb2\x84\fp\x198\xd4S<=\xb0ݺ\xb4\xccNR\x81\x125\t\xaf\xe38\x18\x0f\xb0?\xfb\t\xf7^\xf9\xe9\x95=\x9b\xc7\xf1\xd2\xc4c\x88\xe7~\xca\x19h \xe7!\x98\x05\x1c\xba\xbcK\\\xe7\aA\x91,X\f\tr\x96Z\t\x88PD\x98\xec\x91\xeb8m\xb7\xd7.\x91\xa1\x99\x0e\xc8-[Z+B\xc2\x14\x96\x82|\xe9\xb9\xc3\xfa\xcd\n\xb9\x9c\xd6K^\x86\xb0\xc5_*\xbc#\xeaz\xebi\xf4\x1dvzm*\xa0\xddn\xb5M\xcc1\x8d\x13a\x86\xf0\x88\x92\x1e\xb9\xee\xe1i\xdfa\xed\x0e{\xe4\xf6\xcaP\x87ǘp\\\x89wE\x82+\x17\u0378\x04ڬ\x06\x02\xb7\xde?m\xd9\x01\xd6zp\xdb.\xd6\"\x83\x8d\x96r\xc7iUxd\x9f\xf4H\xfa\b\x1b\x8d\xban\xb9\xbb\xe9\x88>\xb2\x9c\x86\r\x06\"\xa7]\xe0\xa2\xec\xbeL\r\xccR\xcbΐ\x90\x93F&N\x7f{\x83\x1b:^\x95\xe1\xfe\x16G\\$\x9a\xecQߩ;]\xbd\x8e\xa3\xf4*\xf1F\xc6\x7f\xf8\x91a\x19\xbf\xc0\x94a\xbc\xf2\x97`\x13\xb6\x80߯\xfc\xb5a\x19\xffq\x13\xe1\xef9\xc4\xdf\\\x82\x19\x17_\x18\x96\xf1\x06na\x19\xaf\xe3[\xc32\x9e\xf3\xa9\x14w7Q\bׇ6\xa0_\x0e\x8c\x80O\xfd\x80\x1bV§q\x14$\x83\x93\xfe\x91\xe3<\xea\xf6{v\xa7\xf7\xc8u,\xf1\xb4\x02l\x01\xd4\xecj*m<\x80Z}8\x7f\xf6\xcb\xcd|\xfe\t7\xa2\x0f]\aT\xf0G\xae\x93e\x16\x95\xb5\xe6\xfe\xb2\xbe\xa4\xbb\x8b\xa9b\xcfqb\x1bU\x90:\xf6\x0e\x94\xd0\xd26\xe6MF\x12\xfd+\b\x9al\x9c#\xff\xc6\xf9\xd72\xee\xe3{ \x16\x10 _\xcc@\xa35\xf0\xd7%l\xf7\xab7\"\xd2\xf0\xf4[W\xf1\x8d֒ݾ\xe3<\xeaߛ.\xe0\xbe\x02]Ul\x0f\xc7\xe5\xf6\xe0\x98\xe7&\xd5X\xaa\xef<r{\x0f\xc7XEw\xbfV{\x85\xf9\x12\x9dY\xdc^K`ɱ\xb9\xbd\xfba{\x8f\xf9\x12\x93\xb5\x8d\xc4\xc81V\xd0=\b\x9b62s\t\x02\xe3\x14\f\xd6T\xe6|\xf4V\x0e\x1a\x00V\xc1Ɍ\xb0z\x0fS\x94f\x19\x93WGH\x9a\xe6\x1c\xeaՑٵA6R\x8f\x98ƿOZ\xff\xce\rf\x06\xc5\xecE\x89\xa7\xd72\x8d?\x9c?+\xdf`3?\a\xed\xc1\xe7\xa0\xcd\x06xW\x8dPMy8ϑ@\xb1\x16\x90M\xf7ḿ\xfc\xe5\x06\x04/\xcaGt%\xc1\x03\xd8\xfdSU\xf3<\x92\x01l\x93C{Rܚ\x88\xb1\x8d\xd0\xd4G\a.{\x84S=a\xcba\x1d\x15g'E\x81\x93c\xb6/\x8b)\xac\x9cGH\x91J\x06\x12.eh\xe8\x01\xd3-\xc7\xfe\x1a\xdf,\x93*9\x92\xa3+\t\x92\x9djr\xcc\xe7!\xd5\xdb\xcco\x95I\xa0\x82\ue4d5Z\x15\xa5\xf5?U\xa3:\xff̭G\x89BM\xe2\xe1\x1c\xdb\xe8Po#\xf6H\x0f}\x97\xc2a\xff\x16O\xfdy\xa3\xda!\x92\xff\x9fV>\xfe\x8e\x9aG\x8e\xfa\x8fW;\xfe9u\x8e\x7f)\x1c߭pH\xe9\xf2\ah\x1b%\xe5\xe0\xf7\xa8\x1a\xff\xf7\xf5\x8c?M\xc9\x00\xe5\xfd\x8f\x9e\r+\x13L\x9e\xf4\xbe6\xb6~\x0e\xab\x9b\xf0p\b\xe7\xd4棺\x00U\xa7\xac\xd4i*J\x04\x16As\xc9\xf8}\xaa\xc5\xdfGa\xfb{\xb7\xa6\xfb\xcf\xd0t\x7f\x17\xad\xec>4\xfec\xdb\xf9\x1f\xa6}\x91ߛ:\xcdK$\x91\x8c\xfa\xcfW?\x9f\xe7\x12fM{\x97\x93\xe4b-v\xea\xfcIb\xae\xf1.,F>\xf6\\\xeev\x94$[\x1fB\xb0m\x9c\x1b\xea\n\x88\x02;-@\x9d\xb6\x8d\x9fk\x80\xfa\x05\xa0~\xdbxU\x03\xd4-\x00u\xdb\xc6\x7f\x96\x80\xce\xdc\xfd\xfd\xf5c'\a\xb3\xd3\xf8\x97p\xc5\x03\xb3Ê\x90\x9e\xe7)0C`Q\x99\xb2lWc\xc1n\xa0\xebt\x8e\xfe\xf3\xd5_\xce\xdf~W\xab9\xab#r\x16\x86\xff\xe5\x94\x1e\x96R\xda\xc6\xdbj\v8\xa7\xa7=\xd7\xedw\x8e\x8f\x8f\v\r\xa6\xc7\xd7v\x82s\xdc=>rO:G\x85\\2\xb2m\xfc\xa5&\xcb\xd1I\xafT\n\xc6\xd4v\x8dS\xc2\xdc9\xfa3:\xa7\x8e\xf1\xf1`\xd9~\xeb\xcfᢐ\xbe\xe0($\xe4}\x96{U\xc1c!\xafp\xc3G\xf5>f\x1e\x16\xad\r\xee8\x97\xf6\x10\x97L\x1cA>\n\x8c\xc9rE\x84\xb6\xdb\x02\xa0v2E{\xc67\x11<N\x86\x17\xe2\xa4\xe5\xda%\x8f\xf8\xd2O㥌\x95\x861\xf9\x95\x1d\xb0g\x88\x17hYEf\x0fX\x1aF\x90\x01\x19F\xd0N\xfe\x818\x7f\b\xad/\x16\x91O\xbe\x99\xc0Z.<\xd32\x8b\xdb\xcb\"\x82n*\xe6\xd8F\xe1XX<(\n\xc1dP\xa3\xea\xd7d^\x82\xb7\n\xc1\xb6;\x16\xb7F\xbex\xee\xf0\xcbYs\xa5\x86_\xcad\xa8\"M\xf7\xb09\xdf#t|\xd6D~]ÌY\xa1[\xc5\x1f2v\x01oڍmJj[і\x01|\xc7IFֲ\x8c\xbe\xf2\xb5\xbcݤӢ\xf7~\xbb-!\xb4\x9a!W\x9al\xbbUGtٰ\x16&'\xa1\xee\n\x89V\xb0t\xa93T\x03BY\xaa\xa9&Fz<\xaf\xdc\b\x8f:pG\x139xG\xbfנ+r\x823f\xa6\xddc\xc3\x1a@9\n\U00102f4e\x10\x11\x7fH\xd95\x00m\xb7\x99\x9ev\x9b.\xffhL\x85t\xa8\xf9\x1eC\xbb\xa4\x96\xb8\xb2\xf4\xf4\x8b\xbf\xd2EV\x1e\x9b_n\xc2۶\xe4\x9a\xc1\xdc\xe4\xe67\x83\xba\xfbkp\xb2\xf4\xe1\xddo(\xb3d@\x9a\b\xe2I\x14&\x88\xef\xed\xb6n\x85B\f\x15G`)5\xe7)\x97YdX\xcbƤ\x10\x8c#\xbc\xca-A1P\a\xa7\xe4)\xf5)\xff\x9f\x1b\xb8\xf5\xcf2\v\x9c\f\xf2$\xcd+\xc56?\xda\xfe\x17\x7fenn\x96s\xb2\xbf\x13\xf5\xb1\xa0^\xe0\xd9m`|I\xe2Ȱ\x92\x9b\xe9\x94'ɀ\x1c\xcd`\xc0F'\"\x10\xc3,\x0e\xe4\x88T\xfc\xd4\xd22\x96Q\xb2V0x\u0089\xe7ܞǗ\xa6\x81\xa9\xady\xec\x83\x13\xdb\x165)X\xb3\xea$\xb1B3\x10jE\x97B\r\xb9\xad\x04\r9Ȟ\x9b\xb2Aw\bs鼝\xc8\xe6\x97L\xeb\xe8>6\xe6B[wK$\x0f\xca\xf0\xd2\xfa\x0f\xef\xaby\xf9\xe7v[\x98\xe8\x90\xd3L\x95Y\xcfD\xa6y&+s\x82\xac\x17\x11Sf@uEA1\x1f\xed\xd2U\xae\x9e\xaa;\xaft\xe4)y\x0f\xb2\xd0\xe9g\xf5t\x94&6L\xfeO\x0eW\xaa\xbe\xf25\x983\xc3\xf0\x02\xa1\xb5\xa7\xd2X႑\xbc;\v\xde=\xe3e\xcb\xc7+DF\xc3\xf9u\x90\xd7\x03\n\tD!A^\b\xa5P\x11\xda\xe5\xa5J\x01ʢ\x1c\x10y2\x9f\xee\x02\b\xdaed\xa0\xbc0,\x03\x8a0,\x03\x00\x8d\xf10\a\xa9\x12\xb9@\xa1\x1e\x8c\x16c\x96\x8c\x16c\x0f\xbe\xe0^\x01\x9cMS[\x123d\xec^b\bL\xb8\xfd\x94\u05c8\"J\xf1j\xfa\xba\xcca\xa2\x8b1\xb6p=5\xf16\xf2Q\x8a\x81~39\xab\xdbą\xda+\xddJ\"\xb0e\xfeƛ\xae5\x0f`\xc8{\xafEo\rpZC\xcf`X<\n\x10\x9am\x80\b\xe4\xd8\x11\x84\xc7^)\xbc\xddn&\xf1\x8a'hT^J\xb31\x85\xac\t\b\xf3@+a K)\xdf\xfbQ4\xb1\x8djt4]V\x05Ԙ-\x03\"Zs\xa8\x1a\x97\xa9\xc5f\x85\xe7_D\x02v\x88\xbdª\xe3r\x0e\x92Μ\xed\x16\xfe>\xd6A\xe8B\x15\x1cu!\x83\xe5\x8d*\xbb\x8cm\xb4\xb8\xba\x0eQ\xcf\xed\x18lX\xcdn\v\x13Z\xf2\xc2\xee\x19Q\x1cq#\xd3\xe0D[Vj=\x89\x85\xbb\x83IL4J\xef@\xe5\x88;\xc8QC\x03\xb6v\xf6\xaa\xb4\x89V\xa5\x80WM\xf7\x9a\xbc$\x05\xe1\xad\xc1\x86\xbc\x9e\x80\x1c\x8fd\xc7*O\x8b\xcb\xd7Ϯ\xc2y`Rj\x8e\xcf\x0f\x02|\x11\x1b\xea\x04:\x8ai\xe0\x13݆\xa5\x1a\x87\xb3M\xb5p\xe1\x85\xc34\xc4\r\xb1bg\x94\xfa\xed.\xd0?\xb0?\xca\xc5\xc0̋7#\x98\xce\x00\xb2&\x82Q\x80C=\xf8\xd56\x16+c\xd8\b\xa7\x18\n\x1f\xb41\xee[\x03\xbdÁr\xfa\x861\xb2G\xdflS\x03\xf00V \x99\xa0\x01\x84Qė\xe0\xfa\n\x91Sz]-k9D\x83\x83\x1e\xddAT\t\xbaL\\\x9e|A\xb2S\x13\fud\x94\xf0\xa1`\x81Z\xf0(`\x9b2\x1b젭\f~\aq\b\xb6\x9b\xba2F\x96e:}E\xf9\x89\x97jާ\xe0\x86\x1cxmX\x93\xfa\"\n\xc4\xf6\x0e\xdc\x04\xd6\xca^)\x9cV\x93 \x1d\x16\x04i^\xd6\xe3j\x11lS\x8dC\xf6\x1f\xd6! \x82\xfc\xd5\xf7\x10\xe4\x8cYVS?qӡ\x14\xf9\"\n\x0eJQXp\xb5\xbf\xb4\xd1Z\x97A\x8cކ\\t١\x98\r\xe9iȖ3\x870\xb0+J\xf9f>\xaf\x81\xd6\xc7\x1a\xb0}\xad\xd8\xc9\xe4\xadW\x9a\xbeE\x93\xca\x1bW\xb9H!-\x82\xfc\xdf\xc2=\xcf{\xa9^\xab\xb0j\xa1WJ\xaaS\xbeJj\xd3\x0e\x05lD\xe7h\xf0\xfaOr\x0e\xf3W\xee'\xa6\x18\xb7\xdd\x1a\x8b\xb9\x1fF\xe4Qf\x06{\xa9@އ(T\xf0\"\xa4LU\xf3h\xda=\xdbn\xeb\xf7\xd4\x00\x0f\xe9d\vPo\x97\xa1\x9fr\x85\\kH\xc0|#\x8b\x80\x8f\x04-\x1f\x85)Ѱ|\xed]\xf2\xb8\xba\xf5N\xb6\xa7\x97\x9c6\xf7=\x91\x92_ \x1f9\xe3!\x1d\x17\x96'\x86\x1b\x9c\xd84\xc3[\x1d\xd1\xe1\x8dڛ\x7f\xecu\xd8\x06p\xe0Q\xe2v{\x93康\x88\xc9)\x1e\xe5\x9f\xf9>\x13q\x13\xb4\xbe0zМ\x7f\xb0ͽ\xea\b\x18\xbdj7ѶQM\x1bS>\xb4\xa3\xf5\xf2\x93\a\xb3\xa6}X\xd1\x10\x04\xf3\xd1\xf6\xd4y8\xfd*\x9b\xd4\x19cJL5\xd0\\\xbc\x8b\xad\xc4i\xbe\x89\bpP[4\x93\x15\xfd\x89\xa5kX\xc5\x11\xe7P/G\xe5(\x1c\x13\x1dv\x86T$)\xde\xe8'b\xa0\x80\x11\xd1\x00~e\xca\xf7\x1feht\x0fP\xd4\xce*\x1a\x03\xa8Y\xf0\xbc\x02\x8f\xd2\xd7q\xc0I\x9cМ\xcc2V\x1dqzÔ\xfb\xdad\xaa\x02\x95\x92b\xba\x8c\xac\t\xf5X\xb8Qe\x8d꺶\x89\xc7\xef5\xe7\xf2\x82\x10\xae\xae\x16d\x89(\x02\x87\xbc\"{Q\xbb\x15ω\x19\xac!\x1d\x89-\x8a\x17FR#\x9d\xefRX\x10\xa6\\ F\xaa\xc4\\\x7f\x8aq\xa0\xd1YMJN{\xf0ؐꁇ\x8alX\xa7+ Ν\x1a\x05\xc5\x11\x1c\x85Ȉ\\\xa6e\xf7\x9c\"\xe4\xfe\xca\xf7L\x11\x1f\x1b懏wO\x0e\x10\x9a\xf0ez\xf5\x0e\x96\x9c\xb6[\xbe\xc3WΝ\x93_\x99L\xe2e\xc8#Zqb\x9a\x16\xb1\xdd\x1ai\xbc\xa09c\x11\xae\xf8<y˗((\x10\xb4\x10\xb5\xdd\x1e\xf7\xd4\x19\x06\xb2\a\x93\x17\xba\xd3p\n\x99h\xb7\b\xd3\n\x908\xb8\x13\xa6F\x93\b\xe7\xd0\"\x9cKV\xbcA\xaaR!\xb4\xdd\x1e\xe5\xc99_j\x05\xd6L\x85\x92\x04\xd9ћ\x1d\xde\xd6.\xa4\x13\x11u=Y\xa6\xeb\x0e\xa1\x8a\x0e\xb9t\xa8f\x87\\u\x173w]\xe6\xc41dX%\xb7`-\xf5\x84gie\xeaI\xe2F\xce\x18&J=\x8dą|\x9b\xce3\xe4\xe3tF\xe1\xd2\xee=\x9d\x98i\xfe\x1c\xa1-\xb4\xa1s\x1b&\xd9\xf7\x8e$\x9d\x802[\x17\xcf\xf1ԝg\xaa\x95<\x02\xa9\xe9\xbbB\xe5s\xe9_\xea\xc6\at\xce#\xd3m\xab\x81I\x8e\x830\xeci\xba\x87\x06\xae`\x0f;\xac\xaeKP\x82\x1f\xb8\x02\x0e\x85v\xe3D\xa7\xcew\xb0\xa9\x91\x9a=\xafp\x83\x1b\xd5oV\xecQ\xff&\x8dѹ%9\xd6\x00\xe6\x81c\x9f\xe4\xf6҆o\x93\x91\x93\x13\r\xf7\x8a\x91\x900\xcb\x12\x84\r!\x0f\x8e\xca_Ph\xe7c\\\x84\xb5Ӄ\x95:\x9cXeL\x9dN\xe5Ý)T\"\\I\xd7\x06\xbcW\x964\xdbm\xb9\xbd\xb5f9\xacʳ\xef\xeb\xa9\xed\x96nt\xc1+\x820;)\xf2\xb5\xb6\xc0g\x1d\x16\xe4\xa7}M\x16\xb0\x1a\x0f\x91>'\xe8\x12l5T\b=\x03?\xe1\xe4\xcb4\xda\xd4\xfb\x96\xd1&<m\x83\xd1q\xfa\x8e\x1c-\x87\x19Y8+0<\xc99`p\xe15\xf0\xe9|n\x1a\x8f\xf0\x01\x16\\K\x91\x1f<\x00\xd0\x05֥Q\x12=##\x7f%ְj\xc4\xef8\xf7\xc8*\xb3*z\rK}2z\xd8C\xf6\xb7\xd6\xd5\t\xc3?\xefo&Ax\x1b\x06`i\xa5d~\x0e\x05c\x9a\x06\xdb\xe52\f \xe8\xd5\xf5\x87x\xa1\xdcx\xe2\x0e\xc0\x94\xab\xa6\xf9\U000d87557\xd4S\xbe\xc6C间26ԇ)y\x18\xa8\x90\x97\xd5H\xb2\\\x00P\xe7V\x9cL(\x0f\n\x05\xff\xa7\xbb}(4\xbaOx\x88\x03\x84\xfch\b\xe4\r\xdb\xfc.o\f\x99\xeeN\xa1\xe0KDGY\x18^\x98Ί\xc2\xf9\u07be\x16\x8e\x9c\xac\xac_\xdd\xcbG\x82\xa6\xce}jP\xf3>=\xf0<\xf8A\xaa\x1c\xbe\xba\xfa\x7fV\x99S\x19\xc4D\xe35O<\xb9\xedƚ:V1\x85g\xbb\xaa.Ei٬\x15>D\xdfk\xd0\xdd֨\xbb\xfd\x93*nM\xdbS\xf7R\xe3,\xa2d\xf0 \r\xee\xf7K\xb3&\x85\xef\x8fp\x0e\xd3,\xdb\x1e\"*\v\xd2\xed\xfe\xc2\xedQ\x91\x91\xef-\xdc\x1a&\xc1\x879\x93\x01\x01[\xa4\xa3\xa0\x02\xeb\x90%r\xeb\xf5'\xd0~\xa5c!\xa2\x9b\xf4\xe24^\x94\xd4\xe2\xcc\x12\xec6hT\x89\x05\x8d%\x9dXt\x11+\x8e\x16])~\x80\x92)\nj\xd42a\x8c\x13\r\xc1\xd2\xff\x06\x13\x81\x9e{Meb\xe2_\x96a\x80\x8a\x05\x1bV\xa9\xaeQ\x11,\x852o\a\xcd\xffa\xa3v/`\xa8\xf9\xef\xa1֗\xa4\xed\xc3\xf4\xf7pV))\xef\xf4{\xb1MA\t/j\xc09Â\x1a\xac4\u2feb:\xbc\x16\xea\xb0a\xd5\xcc^\x7f\xb2.L3\x1c\xe0\xc9,\xc5J9S@\x02\xdb\xecԘ\x85\xcePU\x98Q\x12|\x9f\xbe\xbcF}\xf9\x0fP\x96\xefk\xfd\x02\xec\xf7\xc9~\x0f\x9c\x1d4\xe9_\x94ܠ\x86\xd5f\xb1j\x953\xf1*WE\xfb\x86\xb0\x18b\xe5\x17\b\xf2\xa7pЬ\xce4\x04%-\xb0\x98\v\x97<ia.\x83\x98\x0f\x03dl\x05\x9fڔHZ`\x18TK\b)\x01\xd7\xf7\xf9\xe4\x0e\xfd\xe0\xa9l\x99 \x9dT\xda:\x01Bu\xd3\xe5H\xf0\xe5&\x01\x8b\\\x88\"\xb9\b\x9f\xf64^\xacM\xe9\xc2\x173\xc8\xedlŒ\xb28\x1d\x05\xab\xe5Q٦Ȫr\xd4\x02\xc9l#\x91@RvO\x86\xf8\x99_\xf9\xb7a\xbc\x94\xfe'\x7f\r/\xaf\xe6\xc8\xdflx_P\xef\xfeڸx]^\xbe\xe1\t\xdf%\xc3(e\x9a\xfbޟq0\x8e\x82\baG\xf1\x1b\x9c\xfc\x83n\x82Y\xa4\xe7Ra\x02\x8c\b\vQ\x9an+\xec\xc30Vi\xb9EC\xdaw\x97\x13\xb2_C(+\xe8\xda\xcbˉi\xfc\x10\x9c\xc0\x8f\xc1\x98i\x9f0\xedB\x1b\xe9\xc8~\x10\xa8V@\xd3\x15\xed`k\xce6s5AWM[\xae㛄Ê\xb5h\xde\x02\x8a\xb6\xaa\xadT\xf5\x80K\xf5F\x98\x8b\x9dyрx\xf6[s\xb4\x02њ\xdf߹\xe7y\x10\x85\x01\x8c~\xec\xec\xefk'\x01eǹ\xfb\xfb&\xc0\x93\x1d#\xe9\xad|\xf9\xa4.R\xe6A\xfd\xa0\xe0\x88\x1fm\x8c\xf5\xe3\x16\xcaIg\x83Hȁ;\xfc7\xc4\x1a/\xc3Kx\x88CfR\b\x88I \\\x83K\x98hR\a\xbe\xa4W|`;\xb7\n\x8ag\x18d\x89I\x87e\x99b\xb7\x91^5\x90\xa1c\uf3b4\xedV\x8fB\xc0a%\xc6+\xf0d\xa1A1\x1d\x0e\xd34*Ձ:\x99\v\rws\xd0MZa\xa0\xbd\xdd\x1c\x84\xc3\xe8\x9eLT`\x9f\xfd}\x80,?Ya\x14z\xce(p@M\x87-\xe2\x85Ɇ\xd5x\xea\xc8*/X\x0e\x99\x00\xb3a\xc0\xc1<\xb7U\x85\x01\x15\xe6\x8e\xeeb\x9bJ\xe3\xdfջ\xd9\xdd\x1d\x93)=M\fB\x12z;\x1a\x93\x96\x80U\xa9a\xcea[?{\x88\xc0~\xb3\f\xf8r\xb7\xb0F\xb0?RP\xe7s--/\x7f\xac\x9b\xcbK^U\xbf\xfc\x7f7|\xb9n\xf9\xa9\xcaddUD\xf6Mx\x7f\\\x1f^\xea\xe8\xec\x9b\xd0\xc8~̛\x9cm~4\x8bl\x9e\xa4̆W\x17\xd0\x05\xf8\x86\xdeO\x03\x19=0ę\x87a\xa5\xf1\x9c/\xc1\x01\xd6\xc0@7\xce \xa3\xc5\xc8̧a\x0ecѺ\t\v\x9c>\x1a\x0f\xeb\x8a\x03rMc\x1e\x1a\xcc\xe6\x05\x86@\xe9g\x89w\x11a\xdc\xc2\x17q\xa1\x1c\xb8ē(\xb7\xf4\xe4,\x7f\x82&l\x16\xaf\xee0|\f\xb6\t\a\alS\x01\x82W\x19\t\\^\v\xaf\xe3\xf8\x8c\xd5V\x8a\x04\xdb{\\\x1d@\x8b(\xbb\x80\xe6\r\x10\xa0\xf6\xaaq;B/\x83\xa4\x1e\xa3%ΰ&\x8d\x16\xbd\xb4\x9c\xbf\xca\u0603\x86\xcf9\x9aj\xee\x1e?祧\xde~\xff\x00\x92\n\xc4\xd3hz\xa5_b\x021E\n\xa5Hi\xb2\x1d\xf0\xd1\xd4\x11@4\xeb\x00c\xff\a\xd7q\x9c\xa3\xa1!\xd3Jf\x05>yV\x16s\x95l\xcb0J\xf82\xfd\x99\xcfb\xf0M\x8c\xe5Z\x05\x80Y\xb8LR4\"P\x85\xc6\x11\x9a\xe7\xe6\x94\xd3L\xa1\x89R9\xfb\x15\x85/\x8fp\xf0\x95H\xc8\xe9\xa4%\xa6!s\x1b\xb9\xfff\x8d\xc1\b\xd9\xfd\x1e\x9e\xa2\x01q\xe6\xb9r`Ր\xd9L\x11\xb6\x9c\xc4f\xb0,ӮՐ{\xc3\t\x9f{\x85ܗ<\xa5\xaeJ~^\x9f\xfb\x97\xe0\xc5\xc14\x92\x85\x1f\x19`\x9b8\x9c\xfb\x13>\xafmDM\xbd}:\x9f\xbfI\xaf\xf8\x12\xe6\xf2ī\xa1\x19=!\xec\xd5A\xe7O\xac\xd3MGm\b\x01\xa2\xc4.?\xd6<\xf7\xf4a\x860\xa3\x10\x1f\x82\xd0\n\x06=\x80>\xd9F\xddl\x9d\x97ɢ\xb4M\x1de\x1eh\x89\xc3ɒ\xfb_34V\xad\x03\xfa\xbd,s/\x15\x99mvUnS\xae\x19\xd0p\x1f\x0e!\x9e\xbd\x1f\x15\xf3j=\xefUIP\x1a\xaa\xaaH>\xa3\xfe\xb8\xa7ϣ\xfb\xfb?ꀥɐm\xeeJ57\t\x98\xb7\xd6M\x807!>^*\x86\x84\x11Œ\xa7u\xbd\xf4\x16\x84\xf9\x06\xf3\xd8\v\xf0\"\x1b\xa5υ\x87y\xda\tO\xe3E\x1d\xeeD8\xaa\x88oҼ\xbd\xf2\x12o\xa2J\x99,\xb3:=\xd80fY\xb12\xbb42!\x86\x85\"\x869/\xfc \x90\xb3@><u\x99/;\xad\x826a\x1b\xc5/z֢\xa9\x1b\xe5\xce%\x13\xa99q4_\xb7∓V\xd1\xc2\r\xdfL!\x14;\x17\xb5\xca\xe9P2\x90^\xa8\x96\x11\x17\x15\xb59\x95Q\x89^ky+N@Q\xecM\xb51\x8ay\xee\xa7\xc6\xfe\nK\xdf\xe7<\xf5\xc3yu\xf2\xd5\x12\xbf\xff,\xf0^\u05f5V\xbfH\xf39\x91\x94\x87k\x8d1\x94\x89\xdd\n\x8d\xeb\x8a\x1e\xf4\xa8\xe5\xd6%\x94\xeb:\x94\xda1\x9b\xe7\xe1*\xec\xc9zP\xf0\x15\xa0\x9f\xbbx\xfa\x96\xec\x0e\x1bB\x82Ҟ<6\x02lA:\xf5\xba\r\x93\x10\xd8\x11\x99\xe7\x1e\xb6\x7f\x98\t\x9c\xbf\xe2\n\x05I\xcd9D.B\x13\xc9\"q\xf4\xfe*\xfe&\xef\xc4·\x8c\xff5\f\xe8$S|\xcbx\xf1R\x83Ly\xa7\xbd\t\xa5,\x1bE\xa2\nn\xb7\xc5\xe4\xac\xce\xc5\x12\xbdw\xb4\xb2\xd6*5\xf8\x98\x7f~\xb2\xf2\xeb\xbf4\xfe\x80G\xdb\xc6`?\x9a$\x8b\xa1\xd1\xceA\xb3\xaa\xd2\xcf6\xdc\xe3\xdbm\xb1m@\xfe\uea55v\xa9\xdd\xc4EJn\xa7\xfe\xf2\x92\xa7v\x14\a\x1c:G:D\xfa\xab\xb9\xf0ӫmr{\xb9]\xf2i\xba\x9d\x86\xcb霳\x1f\x0f\vw\xd5\xcb쌑($?z\x9c^%\xfa\xb8\xddr{\xee\xaf\xf9\xf2c\x9e\xfcI%\x7fRɟ0\xf9\x8b\xe7h/\xecH\x13^p\x98Ó\xf4-\xaczte\xfe\x9e\xcfL\x91\xea\xa2.\xfbR\xe6\xfc=\xa0їv{\xac\xae\x96\x92\x02\xa2\xd7T\x98Z\x7f\xf4Ȧ\xca\x0e\xa3[\xbeL\xc5\xfc\xf1\x91i \xb8р\xbb\xc3w=\xb3F>\x9dWx\x8d\xda\xc6\xc7q\xcc\x03\xdc\x1b\xb2W\xfa\xa3j\x1a5\a.9z@\x9b\xf2Ux\xed\xa7\x1c\v#\x9f\xce\xe0\xfb\xc6,\xd3@\x11\x1f\x19\x9e\xdfTrz\x05\xfc\xac\x9c~p0\x94\xed\xa6\x95\x04\xd7oʐ\xdbm\x89V}\x15X\x06\x1e\x86g\x05\xd8!N@{\x10\x87\x0f\x8a\xd3\x178\x00@]\fH\xa7D{uFV\xef\x1f\xf7\xf7\x15\x98\xbdz,\xeb\xb9)\x11\v\xae`(\xed@\xe1`g\ri\x88\x8b=\t\aa\xdb%=P\x95\xddv\xf5\xd2\xd9&l\xb7I\xfb?8\x10ʢ,\xf9\xcca9\x15\xe4a\x1b\x8e\x8e\xb1\xb5G*i,\xb7\x8b\xd3\xdc3w\xf2?\xcbT\xf8\xcc^\xc4\xdfLE%q\x9d\x89f\xd4\xf6\x8a\x1d\x10\xdfY\x1d\xd6n\x82^\x13\xf4\xbaM\x7f\x1d\xca\xf6\x89Y\x1d:\xe9\xd5f\x1b\x1aI\xfa|S\x9a\x900Ǻ\x92C\x9fNJ\xf3M>\x90\xbd\x8d\x12_\x1f\xf1@q\x90\xe3U\xb5Ҥ\xa1\x80\xc91\xd18\x16\x873O\xf4\x80\x1c\x89TI6\x90\x1f\xf2n>\t^\x8c\x16\x89\x96l\xf3\x81\xfc\xb0b\u0605\x1a|\xb1@\xe6R\x0e\xdc\xeaB\x1dvO\x97>ۭ\xcct\xa6G\xdb2\x96m\xf4h\xf1vW\x86\xbfi\xb3\x04\xbf\xe5u\xa6\nz)s\xf48\x12oB\xbd҅Q\x01\x88Z\x11ۼ\xd4\xd8E\xc0Rb\xd1DE[\xb0\x1b\xc5\x14\xcd\xc4U\xb2\xa3\x1c\vx\xa6_\x98\xcd\xf7\xf75\xbbWsCϏ\x89?\x96\x98\xfee\b\xcf\x1b>\x0e\x905?\x8a\xd0'\x11\xfad\x95\x99\xa6\x14\xa6g)?\x0e\xe8/(\xecWa\xc0\aeEP\xea\x18B\xcf,T\xab\xb4R\n#u\x057_\xad\xe8\x1aC\xf5\x11(\xa1>\x80~i%W\xf1\xb7\xc6±\xfb\x1aʖ+\xa8\xbb\x8a\a奶xH0Y\x8dME\xb3ީO\xb1\x00e\x8b\xce\xd0\x06,\xb1ly\x17c\xa1\xb4\x94\x05\xb1e\xee\xbcQ\xbdcg\xd3\x18$MR\x9fGK=\xe8-jY\xb2\x00\xf9\xa9\x16\xf2\xd3\xefe^\x9d\xd2\x15\xb10\x94\xbb\xfa\r\xf6?v(\xb5\x02H\xd7iW\x17\xb8obȤ\x9c\x96r\xe5\n\x84\xe9*\xae\xc8IO&\xa6\xfcz\a\r\xb8\xb6\xd5(\x80\xb0A{y \xf2\xa8ŴW\v\xfdiz\xe3\xcf?y;\x85i\xa1\x17٠\x10\x1cB9ZS\x17u_\x12Ԗ\x9eeeQ\xc1\xe5\xf1\x9c\x87Eo\x8a\\T\xb3\xdctHS\xd9\xd6EҜv!\xa8\v\xa2\xba\x06\x06\xbcRW\xdbu7+\x88\x8bK\x96850.'\xabH\xa5\x968\xc1\xd9\x04\x0f\xd6dS\xe3\x81N3aA\x9cj\xa3G\x8c+\xb6\xa9\xeb`:+3j\xe8\x93Id\x83\x80B\xa1*EhyS'GD\x12\x99\x16dVa=U\x91j\xa5\x95Z\xfd! \b\xb5\xe2)`\x83D\xa4\xf5>g\xfa\x06\xa6<kܱy\xaf#(\xe4g\xc3\xfbSZw\\\xc9m4\xd0\xe4\xc19\xae\x91\xe0\xb1\xf7R\x94=\x8d\xaf\xe1p\xe69\xb1\xd2[2\xed4\xab\x05\xb3}\xb8\xb3b?\x7f\xf3\xecë\x17\xaf\xcf/\u07bey\xff\xf2\xfc\xe5\x9b\xd7\x17\xcf\u07bc>\x7f\xfa\xf2\xf5{F5\xb9\xa2ɤ\xda\x10\xf7\xb4\x9c\x80W\xd1\xdfV\xf7.0\xfa\x9e\xa63_\xfc\x95\xb5y\xb87\xa8\xc5\xefu\au\xbf\x8a\xfe\x86[h՚\x8ax\xaff\x1a\xacݾ(\x18\t\xd7M\x96\xfa&M\xf1\xa8\xa4:\x91\x17=\xe2\xcc%\x85\x90\r\xf6-1\x1b\xeeo6\x8a\x9f\x9b\xb9\xb6i\xa2\v\t\xc8E\xef\v\xa3P\xa1\x99\x8c$L\xd3K\xea\x99f\xf2\x1a\xf9\xe9\xcdҟ㑫|\xe2FJ(\xf5\x82\x16mO\xc2ƴ\xba\xb2\x8e\x92 \xe2^\xed\xb2\xfaN\xc7\x17p\xb88̝\x00\bA\x06a\xcd5S\xf94FA\xb6=C\x9e7\x04\x06\xbaLH\xbe\xc1\xf6DciBt\v \xbd@\x11c\xc8\x14\x12\xd3\xfe\xf4\xeb%\xfa\x85\xad\x11\xd5\x15\xa70\"\xab|\x9a\xf9.\rA\x9c\xa4\xd01\x8aF\x04\xe9\a\xf3\x92z@\xc5\x02\x9bWKE`8\xfdIʼ\x10qj\xd7B7j\x8d\x1aſѶ\xbb\xe6U\xa1\xe4\xf5\a*\x83\xbeF<\xe9be\x00\xa1\xe2\xd2\t\xd7@\xc0\xf3vr\xc5\xe7\xb7at\xc96\x85\xa0\xb6K\x8d\xc8ذ\x98\\ڦ5Y&\x11^Ik\x03xն\x1cSg\x8e \xf0g\b\n\x9f\xb4\xa6\xa2\xe8\xbb\xfc\x96\xc9\xedt8\xb02\xf1\x98\xbayF\xb9瑭p\xae1\x0fk\xed\x1c\xb4ćm\x15\xff~Y\x85\xb1\x93\x1b\xe0#6|\xc8\xfc\x99Y\x98[\x9f\x01\x9a(k\xde\x0e$\x0f\n\x18a\xc3<\xf1\\\xbaO(\x99C\x10&\x06\xdba\xb8jD[D\xb4ܲ\xaeÈ\x16y#gl]\xfb+\x19r\xc7\x16*\x7f\xc9`\x94\xa7\xab\xb4\xb1\x85\xb8\xea\x0ep\xc0Av(\xf4\xc8d\xe4\x8eϼ<䨫\xfc\xd2|\x96ޅ\x0e\xa3\x02T9\xd9_iɮL\x96-\xbb\xa31`\x03IV\xc0+\x14\xc365dȳ\xb3,\xcf\xe7\x16\xf2\xb9\xd5|\xfeJ\xcb\a\aQj\x9e\x81}G\xdd\x13\x8c\xcc(\x1d\xc0Tw\x9c\x1f\xc2\t\x822\xaf\xda\xd3F\xbc\x00.0,C\x80\x18\xbb\x1a\xeb.\x14\xd7adH\x0ep\xc6;`\xfd\x95\x82%7A\xa56\v#Z\xc3nT\x7fx\nyV\x85\xf7W%xW\xc1\xbb\xe3\xec\x1eU'v\xbe\xaf\x0e\xf4\x8e\f\x1dk\x04\x10\xa5|\xbf\xf4\xb9C\x9a\xa4<Jԅ5\nl\xb7z\x92\xaes\x93\xf1\xa5|\x9a\xba\x14Y\xe7\f\xd3\xfe S\xe5c\xdayΌ\x95\xde*\xa7\x95\n\xcdVo\xfd\xf4\xea\x17\x7f\x9a\xc6˵Φ2\xf9}\xba\x8c\xbf\xf2Z\x00\xf9\xa4\xb8\x1e+\x04\xc0\x86*5\xb0O\xac\x04\x11\xe0\xad\xecAǢZ\b\xe9\xb4\xf0\x03pL:ؤ\xf1b`;\xae\x85\x86\xf8\x03\xc7\x12WX1\n\xf6\x1e\x06NFh\x06\xa8\xd1[\xb3p>\x17\x9fYF|8\xa8y=R;\xa8\xc0=\xe4\xed\xb6\xfe\x14\xa3\x1a\x0f\xb1$z\xd0P\x06w\x00=-\v]S\vg\xa6\x96^x\xc0\x9bZb5\x18\x01_[\xf0kl\xad\xf5P\x86\xaa\x19ʥ\x1c\t\xedD\xa2T\xacĮK\xb0\xeb\xb6\x1ep\b\xc6_\xdd\x05\xa3U\xa2\xf9اI!\bp\x02\b\xecuu\x87\n\xfc\x8d\xae\xdb\x01\x14\x11\xce\xcc\xf5\x19\xd0\xca\xe0\x97\xb7\x06Eh\xfd\x18\bc\xf0\xcb[\v嚬\xd6\xe0<\xe7\fZ\x81\xc1/R\xc8@\xb2\xae4 \"H\x1e}\x8c\xed\xd5ch\"\x06\xbf\xbcF\x98\x8c\r\x01\xe7\x81g\x02\xdc\x01|\xd3\x05\x0fb=t\x975\x84\xc4v3\fr\xe5\x10\xab\xa2\xf1\xc95\x888π[T\xc6\x13HԆ\x9c}\x1dFp}\x1ek\xabG\xfb+\x9dI\x9e@z!\x9b\xbfR\xc6\x1f\xd5R\xb6[(\xe6\xcca\x1b\xf8{\xe0\x99\x90\xfd`]%X\f\x9f\xac\x84\xa9X6 \xc1Z7!I\xe3\x05\x19ToV\x83\x114\x9d\x05\r\x84<\feZ\x90qܸ{Z\xbc\xf3\xd88\xb7\x91\x16\xae9\xd4\xddni\x1c\xe6\xfbopO\x13!nC\x95|\vw\v\x9ao3\xa9\x038b\x8e\xfb\x9a\x955-\a\x85X \xf7\x11p\xe8\x9bx\xa5\xd2\xe1\xdc\xd7`v\xa0\xbc!\xdb\x1c,M\xcd\xe2\xfdX\x82\x12\x97\x86\x02\xbaCU\x11\xc4&\x9d3\x81\xa1W\xd3P\x94C\xb5f9H\xa3\x92\xa2\xa1H\x0fI\x06\xa1\x15\u0081-\xb9e\x06\xcdEX\x1dKl\xd2[\x9c\xa5'\x0e*e\x02m \x83\xa5\x03\xaf\xf9\\n\x87\xe2\x86\xdd@,\x93\xb0\xad\x84ܖ\xb7\n\xe1\xbb\x16\x94\xe2\x80V\xb8\xcf\xfb4M\x97\xe1\xe4\x06\xbc<\x00v\x03%>\xbb\x03J\xa06h\x9a\xd8\ry\xa0\xdf\xf8Ԧ\xa8\xbbr\xd2\x1d/\x02P+T\x96Yjj\xbd{\x14\xa89\x1b]\xe5b\x93\xc89\xd4d5\x87\xf0\xe8\x81_\xedB\x94L\xf4!Q\xe8\x1c\xe8\x91\xdfS_\xfal\xa6G\xe4Ea,\xddT\xd2̵\xeb\xa0<ψ'_\xf8\x14.+\xdeI>⬫\x83F\xe3\xe8\xeb\x18\x1bC\x06\xf6tY\xa8'\f\xf4,\x05(=aPG\xc2\xe8\xebX2\xb1\xba\v\x86\ty\xc9\xf5\xc5\xe6e\xd6\x17\xa8\xc9\xe9z(-\xad\x96\xb2L\r.\xb8\x82\xabiE\xaa\xadt>\x84yV\v륑\xa6\xa9\xa5z\xda7\x1c\xa7\xf1\xf4\x9c\x140\x85\x9b42\x94\x17\xf4]\x83\x93R<\xfa\x9b}\xa76m\xc3.A\xb3J\x8d\xc9\rzuC\x16\xf9\x1c\x1blT\x18\x96l\xd1|\x98\x89+rJN+,|\x95\xf2(\xa0d\x93Y\x9b\x82\xe2\x99\xeb\x8fR\xaf\x84\xe8l\x97N\xdc8\x8b̈́z,/\x1d\x03\xb1&\xb3W\xf9(ȍ\x94\xd4\x19\xb0\xbdb\x19\xb3\xd7\xcd0k3\xb0\xd7\x00\xa3\xddk\xd3'\xf4<\x1a\xfaU\xae#\xe8\xa2)\xf5\xf6\x90(\xb3\xa9\x9b\xf7\xf7K\x11u\xc5\a\xf6zO\xe8w\xb9[H\xca\xf6\xbd\xfc\xf0\x1ef\xcd;\x18\x02\xd3\x1f\xc6\x11؝\xbf\x8b%\x90\v\xa0\xe3%\x13\xe0\x8aB-Q0\xf4\x87\xf1\x84\xbf\xe4\xfe}y\xc2i\x06B\xa6p\x80+\xd6\xee\x0e\xa8\xb6\x84\xfc_\xcc??\xfbw\xac\xd0\xed\x9f\xfd\xe5\xc3xg\xe2/\xef\xe2\x1c\xe8>\x99\xea5\xf3ϥ\xbf\x80K\xdb\x03\xdb镙F\xdej\x93Xd\x83\xca\xe6\x90\xf1\x99U\xb7\x9d \xaf\x04ר\x10\xd8;T0\xceh2 g}\x11\x1a\x16\xaf;\x97\x97ȅ\x8ab\x9a'\xab%\xe4\x19\x9eaE\xa9|\xae\x9f\x1c9\x94\xa3unҗ\x95\xca.OZ8\xc0\xb10\x94b\xafF\xee\xb8퉧\xdc\xcc2:\xfbڿ\x8c\xc2\xf4&\xe0\x8amD\xb6̚\xf8\xcb\xf2\\\xa9\xab\xa2\xf7$\x9747$\x95\\v\x10^m\xf0\xda+\xc2-\xe0p!\xdan&\xf5\x91\xe9\x1e\xe8-\x9f_\x01\x974\xff3\xaf\x90$\x8d\xa2\xfe2d\x96\xccCa3U\x82\x7f$\x1fo\x8ev\x91[,\x13\xf0y\xa3\xef\\nэ\x95\xbc\x9e?\x17\b\xa2Q\xf5D\x12vX)o \x93\x8a\xae\xea\xbc\xf2\x9b(\xd7~\xba\fW\xdeȵ\x1c˱\x02{}\xe6<9p\a\xae\n\x90\xe0\xcc;6\xb7\v\x84i\x98=\xea\f\x9c1u\xad!\xb0\x99F[|\x90\xd3\v\xcb`\xe8\xb2N.!*\xba\xf0\xee%\xdb\xce>\xb9\xe7\x02\x94\xd0c\xebU\xfa\xa2^RׯW\xc1\x88Y\xadWW\x86U\x83D\x9f\xbd\xda9\x87d2\xd7\xfa\x8e\\8Q9\xedRS\x9by\xff\xb0\x8c\x15]\xf4\x14٤즧\xb9\xa0ƎU\x05\xd4:*\x19\xe2\xa3c\xf6b\x19\xa71,\x93d\xa7\n\xff\x1erU\x9d\x97\x1b\xb1MTZ6\x82\x9aaX\xe5K\xe2rs\x86X\x9c\xe5\r\xd7\xf6\x8auTˆ\x8a@\xab\xdb\xf3\f)\rGG\x02\x0e\x17\x8a\xb7Ɗ\x16\xc4ꪘ\xcc\xe6iF\xbb\xb9\xe1ﰈu$\x83c\xaf)\x01v\xbf\x9a\xd2\xda\xed\xacVjo\xd0\xe1\xf6\xc0\xb1TW\r\xdc\U000a2e48\xb3f\xbd\x19\xe2\xf0*\xe3\xb6\x11\xf5Y17<\x01\xb8i\xa2\xa2\x02\xaaQ\x15f\x9a\xc3\xf42\x82\xefUq\x9e.\xb9\x7f\x87\x8e\x03\xc9\x0fSr@\xe3\xfc]\xfa1\xb1feϽ\xa0.\xff_S\x90\xff\x81\xba1\xb5c\xe3\x89\xcb?`\xf9\xf9O\xd7DeU\xaa\xa1U\x94\x06t\xa7\xf6s\xcdӫ8(\xaa\x1a\x86\x98\xfd\x8c\x81!\xaeP\x1bڄ[\x8bU\x9fv\xeb\xf5a5\xb3\x8eD\x81c\xe9<\xca2.\r6D܅I\xf7ޛ\xc4%\xe7S8\xe2\xf3\xa9\x85\xf6B7\xdfU@\x81\xfb\xaaE\x00o\x19\xe2\x19\x16\x98OtE\xf4!\xfa\xce\xdfo\x8b:\x7fS\x0f\xb1\xcb\x02s\x1f\x96z\xa2\x8c3P\n\xa9\x96\xa9\x99\xb9+\x8d\xbb\v\xa1h\xb0\x02B\xb1\xe5-\xe3\xd4εT\xd9 \xb8\xdd6\xf93\x12tX\xc6d\x0e\xdb\x1f̴\xddNO\xf5Ҏ\xbd\xed,\xef\x88|\xf7z\xf3\x80\r\xef\xef\x9d\xe3\xdeO\xc1\x90{\xf9v\x1e\u05f8\xa1\xaa\x83z،\x97\b\x04\v(\xe6\x8f\xdd+\xac\xee\x125\x9c]Ñu\xf9\xf4\xdav\\X\x82\xa3\xdb\xc5\xc1Q\xc6\xee\xb1\xe8/.\xdf\xff\x81\xebG\xd8\t\x11\x94\v1I\x81;\x17\x96\x0f\x17\a\xe5\xb5\xcd\xff\xcf\u07b77\xb7mc\x8b\xe7o\x7f\x8a\x13wj\x92\x16EQN\u06dd\x9f\x1c\xa6\x9b\xc7l'\xbfI6;u\xda\xee\x8eǳC\x8b\x90Ą\"8\x04eK\xb1\x95\xcf~\xe7\x1c\x1c\x80\xa4H?\xf2\xba\xf7\ue76a\x9dX\xc2\xe3\x00888/\x00\a\xbdL\xf6\xab\xd96\xfan\xa6]\x88ӻ\xcd\x1bk+L\xef4j\xea\xb2e_\xd1\xfdr?\xcd!\xf99\t\xca\t\xe3t\xfb͌\r\xc32?qվ^eUz\xcbz\xa5\xfc\xcf[\xa9K\xac\xba\xff\xa9\xeb\xe0ۻ\xffor\x94\xddr>DW\xb17n\xf1dl\xc1a\x85\xff\xad\x7f\x98;\xd5\xff&\xae\xe2\xeaD<Y\x88\x7f\xbb˄\xd2\xd97\x87\x1b\xd8\xf4\x9b\x17\xeb}\xfd*wnc\xdfye\xd78\x04\xb1\x90q\xdf\xf1\xa9Q\xfd\xc3\xe3\xcdp<\x19\x80\x9a'^o5\xc5Z\xcd\xd7\xcb#\t֧\xe1ٖ7\xb7\xf14\x01U\x8c\xd7\xf7\xa98\xb6\x157\x9f\xd2\xe2\xa6\xd1\xe2\xe6SZ\xdcp\x8b\xf7:\x03\xc13}\xa7^\x8a\x89\x9a\x9b\xffB\x15\xd0>o2\xe3\xcfa\x9a8\x95M\x98\xa7;\xb1\xfc\xcet_r\x15\xed/\xaa\xaa\x98\x8cF\x97\x97\x97\xc1\xe5\xa3@\x96\xf3\xd1Q\x18\x86#u1߷b\xa0\xffx\xf6\xdfO\xdc\\i}\xd5J\a<N\x10\x9e1K\xd5\xe7\xae/L\xfc\x1c\xd38\xeb\xcc\x1c\xd1W\x94\xdd\xde\x1d\xdf\xda\xf7\xe8\xca|\x9d\x98/\xcc\xdd&\xa7g\xfeE\xaa&\xb5\x02\x86\x8do\xb7\xb7\x823hn\x85\r\xd4ܱ\xb9zOώ\xdfЮ\xb7v:4A\xf6mq\x9b\x97\x91\x89\b\xa2V\ap\xa7جtj\x94\xbe7\xde:\xa6ߊ\xb4YT9,P\xe6\x12W\xf7\xe0\x12,\xe1o\xe7\x13LF\x86Y\xb4Ii\xdb=/\xd3Z\x17\xedڬR\xb4x\x89n\xdc\xe0\xd9z\xb5\xa8\x7fY\xbc\x91\xab\n\x17\x9e\xfe\xa6+2\xfbl\xd8I\xb5(\x99f2\x17\xae.\xad\x0f\xd3ܥU\x98\xc0\x98\\Jcs\x17`\xa3\xadS*\x7fF\x97fx\xfew&\xa4ki6\x85\xc0\xad\x9diY\x1f\x06'=\xc1pq \x8cL\xfb\x0e\xb7)\x0e3\xc9\xfc\x03\x1c\x92\x96\x8e]S\x14\x8d\xa8y^\xf46e\xecf\x99\xd4J\xfdd\xd1\xf4U\x88n\x87j̵\xe7\xd65\nZ\xe4\xba \xbe\xact\v!`h\x1c\x1aGd\x06\x84/\xac\xdb/HG\xdb\xfbߒ2*\v\x1dZ\xb8\xc3r1E>O\x19B\x13\xf1\x1b\xd8,\x1d\x05\xe7\xb3m\x96G\xedC\xbc\x9fa\xc1|\x15\x0f\xe1W\xf4k\xed\xba\xb4\x1a\x8b\xe9\x7fƯ\xc5\x02\xf2\x85\xacԝ\xa8\xf9R\x85\xe1\x0e\x87\xd6\xfa\xff\xac\xa1\xb5\x13\x17\xf9K\xec.d\xf0\xb9A\xe8\xae\x11\x86\x9cfH\xc6\u05ce)\xd6)\xc9\x0e\xa1\xcbEZ\x89\xfdn\xf6\x8eo\xe8\x06 wy}\xdaW\x93\x8c\xf7L\xa3\x019\xd7\xdd\x14w\a\xc54\xad\xf3;\\\xa0-\xba\xb8\xc5\ry\xc3a\xd8/%\xfd/\xf17v\x15\x82[V\xe7m\"\xb9\xf9\xc6\x05\xe1\x7f'\r\x99\x80{_1u\xb2\x94\x12\x03Jv\xa5\x93\xc9\xf9\xaa\x17Yz/\xd4QN<\x9f\x97bN\\\x93B(E\xe3\xf6E\xba>\x13:XH\xf9^߆\xe6\xb7pّg\x06\xa5\x03̘\xeb\xe1\x93\x1fC\x7f\xa6\xcfV\xda\x1dZ\xb1s\x05\xba\xff\xea]}\xf5\xd8\xf8o\xb9\xef\x18$\xb1Q\x96\x03\xe3\xee\xde6\xba\xc2{tc\xba?7\x0eÛ/\xc7Qe<݉\b\xb0\xf7\xe4\xf8^g\xfbZ\x1b\xdd#\xd3g5\t]\x9d\x97L\x90\x881㱍#H\x19 W\x15\xc8\x19\xe8K~\xb0?\xa0\xd4m\xff\x14P^\xebf\x91i\xbd\xde\xe2n\x924ND\xfd\bA\a\x9c\r\xb6J\x96\x02-JS\x865\xf8ӳ\xe3䖋%/\xec6u]\xef\xc4\xe6\xa0\v\xe5r\x91f&\xf2\xfb\x8b\x86\x7f\x82\xf8R|1\xffg\x14\xfa\xf1\xc5\xfc_\x1c\x1f\x8aN\x1a\xb1\xc9B\xc59\xe2x\xe8\xf7\x8e\xc0;\xa6\n\xdd\xee%\xde\x15\x02\x1fDI\xb0\x1e\xe92|4\x05\x1b\xc3\xe4M+y\xeb\x1d\xf7\x8d\x80\x89x=A`\xfe\x06\xff\xfck\xebm\xeb\xa2u\xa1\xbe\xeamVmt\x84v\xed{\xb2\x05{\x1f\xac\xcb\x17lVt\xafe\xdf^>\xb7\xac\xe2xV\x89\xb2\xb5\x8cY'\x15(\x98{\x88\xecacYX\xa1\xccuZ\x84v7I5(\xa0onq\x173\n\xb7\r\xb3\x1bAnＯ\xac\xa7\xa6\x89B\x9dr\x03K%5\xa2\xff\xe5\x14l\xcf/\xe2LT\x95\xf0\xf5\xddE\xe5]\xf1\x97\x88\xff\xd6'\xfc\xb8dԺ\xe9\xf7\x1c\xc5\x7f\xf0\x0f\x9d\xe5r\x11f\xacU\xba\x14\xcfb%\">\xcb\xcf\x10\xeb\xf4V\x00\xf6\x9f\x1bO:\xd9\xe7\xa6=|P\f\x83\xb3\xba\xde\b\x83bNva\xd8G\xf3\xedY\x89\x9e\xc6l^\xab\xbd\xb1x4\xe9+e\x1fp{\xdbHt\x9b%<\x13\xc5\xee\xe0\x80[CT\xd6\xd7\x12\x0e\x0e\b\xebA\xaa\xe8/{\v\xae\xfaI\x86\x02\xd9\\\x99\xd0\f/+\xb1\xd4IF\xc6o}N\xae'\xce\x06s\xe7\xd6\xf17\xad\xbb\xeeC4\xa5\xbc\xdc7`=k\xd0c\xd9\xfd-U#\xfd-\xaa\xbf^_7'[\xa7\xb9\xb6\x01\x8e\xe7\x83#\x89\xec\xb7\xeb\xebS\x8a\xb7h\x13\x98!EQ\x14r\xf00\x9d\xc0?梢xz\xae\xf7$\xe4\x9b\x18\xe1YЏ\x1d\xb4A9zNC\"\xaf'\x98\x1e\xac\xfd̈́V\x90\r\x11\xddׅ\xbe\xea-\xfa\x1c\xb2!\u0558\xe1\xeb\xeb\xd0c\xe0\x94gC\xe3{\x9d@ȍ\x1f6&\x01\xa5a\xab\xcf6\xb89\xd9\xc0\x1f\xcf'r\x85\x9d\x85\xb86\x87\x9e0\xd2\xe0\x0e\x9e\xea\xb5N\xbe?,\xdf\xe3\xf3\xa3\x06PR>\xdcm\x9frv\x88L\xf3C\xfc\xa7\xe1o2\x01\xa0ڀ\x99\xde:X\\__\xbb\xd4\xdf\xc3\x1e\x04\x8e\xbd\x81Mŕ\xeao&X\xf9\xd4\xe2\xe2\xec\xfa:\xac\xf7\x81|3\xda\x1a-ֲb\x12\xd1\xe4ch\xa5\xfd\x8b\xe7\xfb\xe7\x9e4\xbc\xa9[\xe3\xa2\x06\x8fCߍ_ΓY\x87,\xe7\xd9\xc6P\x93X>\x8a\xf0_#\b8\x87o\xb74F_7\x92^xW\x1d\xe4D\xe9\x05r3[\r\xd1SW1\xef\xa2\x19\xc4E\xd5\xd6OV\xcbF\xe8\xea\xc6F̕)\xd5&i\xbf\xd9ܤ\xd3\x01z\x0eBMN϶\xb7\xce8\xb6\x92\x8bK$\x17<\x82\x86W\xe3jF\xa1\xe3+\xda٤\x90\xa1\x04\xb1^m\x1d\xb0\xb8j1\xa8\xe2\xe5\xcb\x0e-\xd9\x05M_6\xb8\xaa\xb5چ\xe0x\x01r\xc5]\x91\xe9g2Nzd9Uo\x0e\xbbo*:\x85L\xf8O\x8b\xcb\xdd\xe9he\xda\xd2\xd4M\xef\xaa\xd1\xe5\x1b\x90\xfa\xf5\xf9\tˉ\xa6\x12\xc6\x0fJ|\x10\xa5\xfc\x1bމ옣\xbb%\xd1\xe8\xe7L?\xf4\xb6\x1dH\xb3\x1e(t\fAS\xc9\xdaZ÷\xfb\xec\xed\x926\xaeOV\xaeS\x1d\xc5u\x19\xaf\xd1\x18\xcf6.\x1e\xb5\"\x92\xbay3\xcch\xbd\x9ew\xc5\x01b\x97iީ~\x8b\x8f\b\x97\xaew#|\\\xf2k\xdcl\xeb'\xe5\x84\x03\xddR\x94[]\xf8a\xb4F\xb5\x8e\xd5\xfd\xd4\x0f}\xe4\x91\xfef\x82xB&\xeb!O\xb9+t9#\x9cbu\xbfX\x95d(t\xb5\xbdv\xfe\r\xba\xdfN\x9d/\xd5\x02k\x85\x83S\xdb+\xe9\xaeg\xf6Z=6[\f\xf6ѽ&\xa8}o\xdbmk\x19\xafQjR\x00\x01\xf5\x85\x8d\xb5`\x99\x97\xfe\xfew귽\xcah\xb3\x94I\xb4\x9c\xe4~\x92\x92\x19\xcdtU\xe2{D\xf60\xcdN)\x8eҡ\v\xbd\xb4\xaa\xc9N\xa9\xc6\xf3\xc5MxaO\xedP\xe3\xba=\x99f8\xad\xd4\xffF\x1d\xbb\xd5OD\xc5 \x1a\xb7\xd2h\xe4\x83h\xbcm\xcd\xfb0r\xbb\x83\x19\xee\xc2\xe2\x10\x06ͩڍ\xe4\xd8\x02\xd0~b\xf9\xe0`\x17\xde\xe3n\x95\xa6\x16s\x8f\x1eыMc\xfd`\xd3}G~\xab\x9ePK\xfcU\xaeoW]\xad'\xae\x9b\x0e\xc7=\x83\xef\xd5\fC?\x9d\xa4\x8dCA=J2\xef\xe4\x18]\x99\xf7r\xf8\xe7\xf1\xbd\xc7a\xc4m\vE\xed[\xc7Z$\xedB|ҭ\xc6\xe8KJY`\":ж\xbe\xf9U\xf7ܻ\xba\x1f\xf2\xac\x9fhl\x9f\xb9j\xb4?\x8c\xc6w*ɭѢ\xbcy\xf0\xe7\xe7\x9b|T\x91\xe6\xc1\xa2Zf&\xe1\x1b|B|c+\f\x1f\x84a\xdfߣG?\xfc\xf4\xe3\x83\xf1ѣ\x9f~<zt4~4~\x10\x8e\xc7\xe1_~z\x00\xa1\x01\xf0-?+|\xa9\xe7A\xf8\xc5m\xed\x0e\xee?\xe4\xf3XM˴\xa8\x9e\xec\x8d\x0e\x0f\xf7\xe0\x10\x9e\xcbbC\x1b\xd7\xe0N=8\n\xc7\xe3\xe1Q8\xfe\x01\xfe&\xb2t\r\xbf\xe4\xb1RX\xecU:\x15\xb9\x12\t \x83/\xa1Z\bx\xfd\xf2-\xe0\xb2ϕ\u0603\xc3\xd1^\xcd\x1eJ)+\xdf\xec\xc6zp\xb5\xb7\a0¦\x96K\x99\xff\xff\x13,\f\x90\xce\xc0\x88\x12\xb1.dY)\x88\"p\xb4\x90t<\x80\xa5LV\x99\bl\xa6\x01\xe8z\f\xef\xe9\xeb\x17\\HCdW\x8a\x05\xab%\x11A5]s\xe0\xe0\x80Ӄx\x99x\xfc\xdd5}e\xc8\xcfJy\xa9D\t\xf3L\x9e\xc7Y\x03:\x8e,8)(nf\xabG\xdb=b\xce>X$\xe0\xb8\x01\xf6WJ\x80\xaa\xcatZ\xed\x1f#t\x94uE)f\xe9Z\xe0\x98N\x9dKq\xfe>\xad\x1c\x1f\x9c\xd7\xf2\x03\xfeY*\xfc\xf7\x8ds\x868\xfb]\xe4\x89lԠ\xae\x00\xf8\x10\xe7\xf8\x94\x05j\\\x10\xc1\xd5\x16\xcb>5IP\xae2\xa1\xe0\xbd؈\x04\xce78[i\t\xa8\xb2\xd7\xf5WJ<W\xcaVQ\b\xe0\x8f\x85\xc0-.\xa8$\xac\x94\x80\xe7''\xcdf$z\xae\xcd{S\b\x88pu\x88\xfd9\x84ߪ4K\xab\x8d\x1d>\xc2к\xbc\tϧ\x02x9\x83\\B\x15\xcfu_R\x05\xf3\xf4B\xe4\xbe\x06\x11Ë\x97\xbfC\xaa\xb8^\x12\xc0\x1bҮ\xe2,\xdb@\xa1\xdfRE]x\x1a\xe7p.\xa0\x88\x95\x12I@uqP\xb6es\xd2έ\xe2\xb9O\x15\xf5Th܋\f\"\xb8!h*\xf6\xec\xfa\x1a\x9c$\xbdp<\x04L\x98\xcaq\xda\x00O\x10\xb99\xa49C\x14\xd9i~\x06\x11\xfd:\xcdϨ\b\xcb3\x91\xed\x01l[\xe8yJ\xfb\xb4\n\xa6x\xae\xaf\x149\xc4y\xc2\xc5\x15N\x0f`\xfc\xe6\xbc\xea\x19M\x9a+Wg\xe2\fQ\xfd\xb1\xaf\xe1\x1c\xf9\x10\x04\xc1\xe1\xc8\f\x0f\xcf8\xb1.5\xf6\x81\xc2\xe3Q|Z\xeb\x17\x82\xf4q~\f\xe9``\x06ǭ6\xcf\x1c\xdaJx\x9fo\xaf9,]\xb83\xb4\x97t\xb1\a]\xb3\xe2\x12h\xc7W-\x84\xa8\x90\x00\x162Kht\x7f}/6\xb3\x12\xe7\\\x96\xf0\xfb\xebW\x9a@\xeb\xd1b\xa7u\xad\bj6ҙ6;\xb3\x0e\xb5\xe3\xf8p\x85K\x1d&\xe0Tb]\x8d\xa6J9[=6D\x9b\x9d\xe5\u07b7\x06\x9d\x85\x88\x13\a\x1f\xbb\xf1Ad^{\x021l\xac\xa8\xe0\xfa\x9a\xbecc'\x98\x80\x83w=\xaf5\xfe\xe7\xd4)\x05q\x0e\xb2\x88\xa7\xb8\b\xech\xed\xea\xa1\x01\xb7&=\xad\x14\xad\x02\x8d\x048I\U000e9025T\x15,\xe5y\x9a\t\xf8\x83\xf8\x82\x82E|!p\x9f \xcd\xe7\x90*\xb5\x12\n.\xd3jQ\xaf\xcca\"\xb2xË\xe8R\x98e\xa7D\x11\x97\xf8\x05\x9bVH\xbe\x80ϫ\xd2\xd3\xc0#%\xe6\xcb~z\xa3(\xba\fٍ\xb3b\x11\xfbx\x11<\xcd|H}\xaa\xac\x9a\xf3\x82C 6\xc6cwl\xe9\x8f\x1fu\xf5\xc3q\x18zu\xe53}\xbd\xdb\x196V\x18Jd\x9c\xfa0\b\xc70\x80tD\xcd\xc0!\x8c\xc3\xd0\x16\xfa\x00\x11\x18W\x8b;\x86!\xb8\xe3!5\xe0\xc1H\xb7\t\x87\xe0\x8e\xc3pH\xe0<\x1ft\xae\x05\xa0y(D\x1d\xd6\x17\xa8\xd59\xb2\xe8|\xee\x86]\xc6\x18\x90\x9f\xf8\xcd\xccul\x9a\xe3\xe1c\xe7\xaf\xe4\xa5(\x9f\xc7J\xb8\xad6\x88'PK\a\a\xe0\f\x1d\x18\x98\xdf\x03\xfa\x89\f\xc6A\n\xd2\"\xf0\xa1\x9dGu\x8a\xc8<3\xd8\x05 \x1a\f\xf4Ź_W\x99p9\x1d\xc0\xf9+\x83\x85\x018\x86\xda\x14`\"\xc2\xc0\xd4+\a\x06u\xf9\xf0\xfb+\x9e\xa0\t\x16\xfa\x80%\xb6\xcd\x12\x843Lm\x17$\x14v\n\xbbTz\x80\xd3\xe5\xb5\xeb\x8c\xfb\xca\xd1\xe4x\xf0=N\xe7}\x9bp\xc6\xe1\x9d}v\xb6\x8e\xcfH\x9a*\x85\x182\x9c\x8e\x19\x174ėF.D0\xa6\xacm\x8b\xb5a^\x87\xb1\xbdEo\x15ʌT\xae\x14\\\xec\b\xe2]\x06Na!A\xad\n\xd4VDb\x04֦g\x89iP\xaeȺ\xd2\tU\x02\xc3qx\b>\x14\x85\xfd\x9a\xee\xd1W\xacƲ'\x98.\xe2\xf2i\xe5\x86H\x92\xbf\x15\x85!I\xa2\x0fYp\xf4\x90\xb1ge\x18\xba:!}l\x06Rˆ\xc1\xa0&\xbd\xa2\xb0d,\xd4iz6\xc0\x169\x0f\x8fR\x9c\x16\xc5\x19<\x8c\"\xa8\x8dO\x83K\xee\xed\x96)\x1c˖\xf2\xe6\xd2\x1an\x1b\xf3'\xa2R@\xc7t\x8b\f\xb5\xa7M&\x9a\n@\\\x81̧\xa2\a\xb3S\xa5:h\xb5R\xb1\x16\xde<\x10\x83\xe8\xd3Ƅ\xe4\xde\xf5uK\xae\xb7\xe5B\xa7\xab\xe8\xa3V\b\x98\x0f\x9brxើ-E9\x17\xae<\x7f\xd7\xe9X\x1a\x8d\x8f!\x85\xc7\xd0#\xb0\x9b\x93\u0081t \xaa\v\x9e\xa6g\x9c\xd9\x1ef\"ff\x94\x9a\xcd\xc8\xf3w\xa4\xaf\xb4'\xc1\xa4\xa2\x1al\x94\x98ms\xc4\xf2\xfc]gȿ6\xa8>>W2[U\xa8\x8d\xcd\xc5P?\xb5\x87\xe7ipE\x90ng\xb4\xbf\x1e\x84\x14\x12'\xcb\f\x0f\a'Q\x95\x85\xf5\x04\x1f\xfe%P\xafĬ\xf2aS'\xbc\x95\x05wP\xbb:\\\xd2\vl\xf6?HE\xf1\xcc\xd0e\xb0\x1eD6S\x03\x93\xc1\xa6\x91\xf6V\x16-F o\x1d-\x8a%\xa0\xdd$\x98\x95r\xd9\x18\xa5\x16\x1f K\x88ѧ\xd73Zz\xcf;\x93\xa5K\xf5}H\x93\xb5\x19:\xb7\xcdV\v\xe5\x93Ѣ\x81:\xf03\xb79\xd1\x7fO\xd3d\r\xdf\xeb\xefL(g\xb6\xdb#x\xb6J\xb3jXS\xa42V\x87\xf9\x8dH\xc6\xfeiy<\x81\xf1\x91o(\x05\xff\x1b\x8d\xe0\xedB@NQ\x85p.\xa9\x18\xaasI\x19_\xea\x8a\xd4\xe8\x04\xfe\xd2WQ\xf7\b+Zu\x83*Qđ\t\xfc\xe8C_%\xc4l\xb5H\xa7\xefs\x81\x06'\x00\x94q\x92\xae\xd4\x04ơ\xdf-\xaf3\r\xa5isL_@\xd4ue\x85/SB\xab*v\xf0W\xcc@}L\x93\x13\x15\x9e\xca2\x17%\xb6\xb4\xd3Я\xf8*C.\x94\x027\f\x02f\xa1\x84\xf6\t8߅a\xe8\xd8\n\xa3\x11|W\xceϑ\x00\xbe+\xcb\xf9\xfc\xfc\x9c\n')F|\xc1\xd0\xcdM\xe0\xa3\x11\x8c'0\xcd\xe4\xf4\xfde\xaa\x84\x0fC\xfc\x89!5DiS\xa9\xbe*\x84Hv:VwMA!\xd0&\x9b\xca<\xa1\xe2$h\x11c\xbb({\x8a\x87\x89晼\xc4\x1aS\x91W\xf1\\P\r#`a<\xfa\xa1\xae3\x1a\xc1\x1bVe\x19\xc3D\x03TcV\xa8\t\x1c\xb5\x11K5\xfe\xa6u\x90\xbaKp\xb9\x109\xac\x14*\xae\xb5\xe1\xc8\xca\xd2\ar\xf4M\xe0H\xfc\xbf\x06\xa8\xd1\b~S\x02b\xc0'\x19\xe0ÐT/\xb4`\x99v\xa9\xaa\xbd9=\x01\a\xbdY\xb9(\x1d\x1f{\x80\xf6*e\"\xb1\xc6J\xa5\xf3\x1c\xbfU\vk\x83R\xfdJ\x16\x13p~\f\xbf\xaf\xa7O7\x8d\x88\x11%\xe0\x93\x9a)\x9eU\xde0\xb1Ϫn\xf9\xba\xf8B\x96\xe9\a\x99W\xb6Ba\xceW\x82cأC\x15\xb8\x13\xb6@\x83\xd7\x10QOe\xae\xaar\x85\x9e\x88\x1d\xe6\xc1\x1e\aW\x1a\x96\x81\xbe\x86@\x16\xb4\x98Y\xb0\xa0\xb1r\xb5\xf5MY\x1b/ӷ\v\xdfkp\x89_\xb4g\xc3dA\xb5@\xa9z!\xca2Mp%\n8g>\xa2&{\xd0\x01\x8a<\x84@\xe9\xc6M\xb6=\xf3\xedk\xa7\x8f\xe5\xa3(՟&\x89V\x95x\xd2\xcc\xdch9\xa1_\x80\xb5\xe2\x02}\x058HH\xf1\x04\x1b\xdaF\xa9\x828+E\x9cl\f@\x82\x93\xe6s\x1f\xd2\n\xfd\x06\x18<\x1a\xb5=\x9a;\xd0\x17\xa8\x13ͩ\xd1\xda*J\x81/\x7f(\xd3\xd49`\xc14\x9f[x\x95,\\\x0f\xe8\xcaB\x8e0\x02\xce\x19\xf1bL\xf3\x89\x9d\x13WC1\x13\xc2S\xa2A\x18\xed\xd3\x1c\xf6\x83\x88r9\x15\xed\x04\x94t\x98Cs\xd8H'qƇf\xd1\xe0UʵFo\xe8\xc3U\x83\xf6e\x1dB`\xeb\xf9pU\x93\x9d\f\xccw\xdfp\xddз+N\x06\xfa\x1b\x1b\xca\xf8\xbf\x0f\xcb4\x81\bd\xa0\xf9\xea@\xb2\\\x19Ȁ\x00\x98\x01\x19\xfdʌ٬\x0e,?\xab|\x9bJKL\xe2\x83d\x9c\xb4\xb5\x1a9*#\xbb\xa8\x03\x9e\x12\xb6u\x9e\x89\x99,\x05\xa9c\x9cN\xca5\xf9'\xae\xafq\x7f\xddt\x9d\xb5xtεO\xf1;\xa5\xcc\x04\xbaъR\xceK\xa1\xd4y\\Z\x83\xb3~\xb7\x85ڰ\xf3\xd0\xea\xe3\xc3];\xb0\xd9\xdd\xd1\b\xfe.\xdb\xfe1\xa3\xfa\x93\x01\xd9\xe2wD\xc2\"Nlm$\x8b\x14\xcd]\x9b\xd24\x82]\xa9\xfb\x06C\x18{d\xd2\xc2\x10d`E\x89\a#8j\xd5$\v\xaa\x952+\x901\xc8`V\xa8v:\xfa,\v5\x92\x01I\x96V\x9eT\x95@\xad\xdf\x1d\x0fe\xc0r\x01\xdbrg\x872 \xc9\x02#\x14-^\xbbm\xae5\x1bq\xb7\r\x0e\x01\x8e\xad'\x87\xb0d\xdd9\x8c\xe3\xc1\xe0\xb8\xf1Ӫ\xaf\xef\x101\xc7\xf0\x0e\x1e\x03C<\x86wM-\x98\r;\x1cs\xc7#\x90\u00a0\x89\xbfw\x88?\xea!Z\x9f38ԃ\xf4\xa1\x1e`\xdd]K\x19\x9cE\xb4\xf1\x0e\x0e\x9b\xb8\x87\x81\x9e&ƹ\x0f\xb2\x89\x8dm\xe3;A\xaa\xd8WZ/郃&i Z|\xf8\xf8\x11\xbd\x16\xe1hV(\xab\xb9\x02l=\xebV0pYODX\x94\xb2\xf5;<\xf6\xa4\x92\x85b?\x13\xb2?\xcdp\r{n\xb33Z\xa4\x96\x9d\xd5\b\xb6\xee6>\xd9\xcfɸpku\x1d\xff\x9bf\".\xcdX쾨\\U\xf5(t\xa5@\xbb\x0e\xf1\xe99\x0fZ?\xf9\x1a\x87y\\\xbd\xae\xc8mC\xc3\\\xe9ǆe\xac=\xd8xɜ\x1ct\xa4 -\xe4P\x8bU\xac6&\xe9E\x9a\xac\xe2\x8c\xf4O\x15\xc0\x1fi\x96\xa1o\x19\x1fؿ,Ӫ\x12\xb9\x81\x95\xe6东\xc5Yv\x1eO\xdf\xe3ƃ\x80s\x91\xc9\xcb6ZY\xa9\xb6xE*\xb2b\xbbo\xed\x7f\xee\xca\xf7A\x89\xb9!_\xd3\x1c\xe0\x81\x1dcb\xa8E\x9c\xc8\xcb\xe6\x8c1\xceZb\xc5k\xb2\xf3~\xed\xa5f\xecV\x8dwwE\x049\x82\x8au\xab\xac\x8e#\x88⁊\xf4\x94\xa8_?c㦕)\xd7'4\x84\t\x0f\xa5\x99io\\\xbc)\xd39\nf\aE\x90\xd3[d\x02N\x89\x8a\xbfp\xd1\xe1\xf4\xf1\xa3\xfb\xe8\xa7\xd0\xf0\xab\xc3t \x03\x9dKCH\xc4\xdc\xd353S\xc1\x8aE\xa7X;0p\xfc\xd0k\x0f\x82\xae\xd5\xfc\xca\xf6\x8a+\x03\xb6(\x88w\xd0ȟ<\x19\x1b\xfc\xd8zێ\x10#\x1e\xa8=\x01ܹ\x1d\a\x00\xb2\x959D_4\x7f\xb4\xeaǃ\x8f.wmt\xd47s\r\xd4\xc9`q\x19O\xa7\"\x83\x9f\xc1\xb1\x88y\x94\xb8\x18\xfa3\xf4\x1c\xf4\xbe\xb7\xea2\xff\x9c\xd4\\\xb6\x99k\x05\xe6\xa4\xe3i\x85\x83\x83\xb6\xfb\xb9\x06\x00,\x82\xccb\x19@\xda\xe6̾A\x19\x8d\x86<\xa1c#\xe60E\xd1\x12G\xc1\x9b\xcf\xf0,\x99hM\x84\xfd\x8e\xfcJ\x06f\xe1\xe0&\x82\x12s\x9f\x10N+˘}N\b!\xfcP\xac\xa9!\x9d\x88\xf3@\xc8=\x1a 6\xb7\r^\x8ep\x90\x0fXx\x04\xcbz\x04\x90`\x90\xf4!\xf5\x18\xf2\xb8XC9?\x8f5\x8e\xfd`\xec9\x9e\xe7\xf53@\x91}\x12\xfb\x8b\x93w+E\n\xbf\xb0\xfb\x15r\x061\xa0\x9d\x96\tB\x93\xe1h}\xfc\xf0ތ\x90\x81\xef\xb0\xc2\xd4G\x0fYM҈p$x|\xa4\x10\x85\x00\n\x05\xe3\xfe\xf2کxR\x91|\x81\x86* BX\xec\xb4B\xdb\xc6\xdb\xdbkZM8Ͽ\xbf~\xe5\xf2\x0e4\x8a\x86\xdb\xf6)cb\xf0\xb8\a\xc8#\xb0e.\x96\xbc\x99\x18WUY\xf7\xddpS\xb3\x12\x9d\xc7H\r\b\x00Ip\xbd\xcc0HȪ\xcc'j\xba\x10\xcbX\r\x97鴔J\xce\xe8\xc5\xd6\xc9\xc52\xdb\a\xd2\xe1\xa3}\xb4-\x86\x98\xf0\xc4\xe1V\xeaa5\xd5M\xbb.\x15\x9c\xaf*\xea\xb0\xd5;\xe3$\x81\x98\x94R\xdc\xf1\xa1\r\x1f\xccg\x8b\x8a\xcc8\xb3\xa7\x10'\t\xba\xcb]'0\r\xa3\xae|\xae\xdfF,'\xab2s\xbfcS\xef\xbb\xdf_\xbf\xf2\x1c^\"\x1d;\x8f\x05Vd\xf1\xd9'\xefJ\xd2Ew,\n\xb36|@U\xf5\xe8\xb0숲yY\xb4tF\x83n\xa5\xea\x8d\x10\xa0\xb9qP\x84\x14N\x9b\x11\x02L\xa5,\x13\x85\xe1\x90@Y\xae\xa0\xfcn\x19\xc9\"dX\xdabòQl\xeb5+]\x19\x19\xa8|+\xe1\x94]\x95\x00\xf5:\xe5/\x88\x83e\\\xce\xd3\x1c\"\x18\x1a\xc6k\x8d,\xef\xf0hW6\xf8`\xd8<!\xa1e\xdd5\xd8:\x10\xbfѠ}6\xc5\xf4\xaf\x96q\x97vP\xab\xc4\xdcM}H\xd6>*\f\x95h\x10\xb5\xde:\x9d7\a\x8c\t;\x94\xecD\x9b\xc0\xa3\x9fB\x18\x19\xce\v\x87\x90\xb2\x04uLo>~L\xd6m\xec\xd5\xe0h\xe6\xe8\xe5S\xb4lp\xf6\xe2r\xaa\xa7ˊ\xd0\xed\x8etk\xe9 e\x1bn\x8f\xc6\xd1-`\xecU-ѻ\xf9\x84\xd1!W\x7f\xf2d\xdc-\xa1\x116\xe1\xbf;ٻce\xfaD~\x8f\x03d\xe7a?\xe7\xef\xca\xcd\x1b\xa0\xe9\x1b\xea\b\xcfV\t\xb7\x1er\tb\x0f|Z@\x97B'\v\xee=R\xcbp\x9e\t\x811+`U\xc8z\xbbz\xba\xc0ۯ\xad\x96\xbc\xbd\xbe\xef\x1d\xcan\x89˽\x96-\x87J\xae\xdeֈ\f\x81\xb4\x8e\x1e\xe0\xffL\x86\xc3#6\xd4\xd3d\xf2\xe2\x9f/\x97\xf1\\\xbc5|.xmy\xe6\xb3lU\xbaE\xba\x16\x99\x9e\xbc\xe8\xc8_\xc6\xef\x85n=\x1a\xfb\xfa\v\x0f*\n\x1eY\xaeuW\x8fP\xabrSo\xaf\xc5f\x8c̞\xb78q\x97\x01\x1a$\xee\xb0@-\xe7:\xacp\xaaw+j\x7f\x06\xe7\xa1?\xc8 \x12\xd5 \xee\x1f\xfa\U0010c940\xb8\x9eb^:\x90\xf0\x18\xa6}\xf2\xb2^*\xd8P\xb3\xc8i:\x90g\xc7:\x19\x81L\x1b}\xb8!\xd9¢\x96=\x98\xf6\xc8]\xa3\x8dl\x8dk\x11y]Q\xcas\xb1\xab\xa62\x8f\xc6\xf5l\xe5\f8]I\xa3\xb51l\xf2!o\xcb\x118\x9fuO\xa4\t\xc7\xc3\x11Pr\x10'\xef\xbcZЛcZ\x1d\xcd2\xe2\xfd[\v̪\xa1\x9aHx\xd2yz\xf7\xf6\xf0\xf6\xc4\xde\xe3\x919+g\x0e\xcf\xfd\xf9\xf9\xf3\xf3\xe7\xe7\xcf\xcf\x7f\xf0\xe7\xbf\x06\x00\x96\bH\xc9\x00V\x05\x00")
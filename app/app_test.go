@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"gnd.la/app"
 	"gnd.la/app/tester"
+	"gnd.la/signal"
 	"testing"
 	"time"
 )
@@ -48,3 +49,80 @@ func TestGoWait(t *testing.T) {
 	tt.Get("/wait", nil).Expect("43")
 	tt.Get("/nowait", nil).Expect("42")
 }
+
+func TestMethods(t *testing.T) {
+	a := app.New()
+	a.HandleOptions("^/article/(\\d+)/$", func(ctx *app.Context) {
+		ctx.WriteString("get " + ctx.IndexValue(0))
+	}, &app.HandlerOptions{Name: "article", Methods: []string{"GET"}})
+	a.HandleOptions("^/article/(\\d+)/$", func(ctx *app.Context) {
+		ctx.WriteString("put " + ctx.IndexValue(0))
+	}, &app.HandlerOptions{Name: "article-update", Methods: []string{"put"}})
+	a.HandleOptions("^/any/$", func(ctx *app.Context) {
+		ctx.WriteString(ctx.R.Method)
+	}, nil)
+	tt := tester.New(t, a)
+	tt.Get("/article/1/", nil).Expect(200).Expect("get 1")
+	tt.Request("PUT", "/article/2/", nil).Expect(200).Expect("put 2")
+	tt.Request("HEAD", "/article/3/", nil).Expect(200)
+	tt.Request("DELETE", "/article/4/", nil).Expect(405).ExpectHeader("Allow", "GET, HEAD, OPTIONS, PUT")
+	tt.Request("OPTIONS", "/article/5/", nil).Expect(200).ExpectHeader("Allow", "GET, HEAD, OPTIONS, PUT")
+	tt.Request("DELETE", "/any/", nil).Expect(200).Expect("DELETE")
+	tt.Request("DELETE", "/missing/", nil).Expect(404)
+	if rev := a.MustReverse("article", 6); rev != "/article/6/" {
+		t.Errorf("expecting /article/6/, got %q", rev)
+	}
+	if rev := a.MustReverse("article-update", 7); rev != "/article/7/" {
+		t.Errorf("expecting /article/7/, got %q", rev)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	a := app.New()
+	done := false
+	a.Handle("/", func(ctx *app.Context) {
+		ctx.Go(func(bg *app.Context) {
+			time.Sleep(100 * time.Millisecond)
+			done = true
+		})
+		ctx.WriteString("ok")
+	})
+	var emitted []string
+	listener := func(name string, obj interface{}) {
+		if obj == a {
+			emitted = append(emitted, name)
+		}
+	}
+	willStop := signal.Listen(app.WILL_STOP, listener)
+	defer signal.Stop(app.WILL_STOP, willStop)
+	didStop := signal.Listen(app.DID_STOP, listener)
+	defer signal.Stop(app.DID_STOP, didStop)
+	tt := tester.New(t, a)
+	tt.Get("/", nil).Expect("ok")
+	if err := a.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+	if !done {
+		t.Error("Shutdown did not wait for background contexts")
+	}
+	if !a.Stopping() {
+		t.Error("App is not stopping after Shutdown")
+	}
+	if len(emitted) != 2 || emitted[0] != app.WILL_STOP || emitted[1] != app.DID_STOP {
+		t.Errorf("expecting WILL_STOP and DID_STOP signals, got %v", emitted)
+	}
+}
+
+func TestHSTS(t *testing.T) {
+	a := app.New()
+	a.Handle("/", func(ctx *app.Context) {
+		ctx.WriteString(ctx.URL().String())
+	})
+	a.Config().HSTSMaxAge = 3600
+	a.SetTrustXHeaders(true)
+	tt := tester.New(t, a)
+	tt.Get("/", nil).Expect("http://localhost/").ExpectHeader("Strict-Transport-Security", "")
+	tt.Get("/", nil).AddHeader("X-Scheme", "https").Expect("https://localhost/").ExpectHeader("Strict-Transport-Security", "max-age=3600")
+	a.Config().HSTSIncludeSubdomains = true
+	tt.Get("/", nil).AddHeader("X-Scheme", "https").Expect(200).ExpectHeader("Strict-Transport-Security", "max-age=3600; includeSubDomains")
+}
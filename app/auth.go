@@ -57,3 +57,34 @@ func (c *Context) BasicAuth() (*url.Userinfo, error) {
 	}
 	return parseUserinfo(string(decoded))
 }
+
+// BearerToken returns the token sent in the Authorization header using
+// the Bearer scheme (RFC 6750). If the Authorization header is not
+// present or it doesn't use the Bearer scheme, an error is returned.
+func (c *Context) BearerToken() (string, error) {
+	scheme, credentials, err := c.authorization()
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(scheme, "Bearer") || credentials == "" {
+		return "", fmt.Errorf("invalid Bearer Authorization header %q", scheme+" "+credentials)
+	}
+	return credentials, nil
+}
+
+// authorization returns the scheme and the credentials
+// sent in the Authorization header.
+func (c *Context) authorization() (string, string, error) {
+	var authorization string
+	if c.R != nil {
+		authorization = c.R.Header.Get("Authorization")
+	}
+	if authorization == "" {
+		return "", "", errNoAuthorizationHeader
+	}
+	fields := strings.SplitN(authorization, " ", 2)
+	if len(fields) != 2 {
+		return "", "", fmt.Errorf("invalid Authorization header %q", authorization)
+	}
+	return fields[0], strings.TrimSpace(fields[1]), nil
+}
@@ -0,0 +1,66 @@
+package app
+
+// Authenticator resolves the credentials sent with a request to a
+// User. Authenticators are registered in App.Authenticators and they
+// are consulted by Context.User, in order, before looking for a
+// signed in user in the session cookie.
+type Authenticator interface {
+	// Authenticate returns the User for the credentials sent with
+	// the request. If the request doesn't contain any credentials
+	// recognized by the Authenticator, it must return (nil, nil),
+	// so the next one is tried. If the credentials are recognized
+	// but they're not valid, it must return a non-nil error, which
+	// causes the request to be considered unauthenticated.
+	Authenticate(ctx *Context) (User, error)
+}
+
+// TokenAuthenticator is an Authenticator which resolves the token
+// sent using the Bearer scheme (see Context.BearerToken) to a User.
+// The function is only called for requests which include a token, and
+// it must return an error if the token is not valid. e.g.
+//
+//	App.Authenticators = []app.Authenticator{
+//		app.TokenAuthenticator(func(ctx *app.Context, token string) (app.User, error) {
+//			var user *User
+//			if ctx.Orm().MustOne(orm.Eq("APIToken", token), &user) {
+//				return user, nil
+//			}
+//			return nil, errors.New("invalid token")
+//		}),
+//	}
+type TokenAuthenticator func(ctx *Context, token string) (User, error)
+
+// Authenticate implements the Authenticator interface.
+func (t TokenAuthenticator) Authenticate(ctx *Context) (User, error) {
+	token, err := ctx.BearerToken()
+	if err != nil {
+		return nil, nil
+	}
+	return t(ctx, token)
+}
+
+// authenticate runs the App Authenticators, returning the first
+// User found. If any of them returns an error, the error is
+// saved in the Context and the request is not authenticated.
+func (c *Context) authenticate() (User, bool) {
+	for _, v := range c.app.Authenticators {
+		user, err := v.Authenticate(c)
+		if err != nil {
+			c.authErr = err
+			c.Logger().Debugf("authentication failed: %s", err)
+			return nil, true
+		}
+		if user != nil {
+			c.authenticated = true
+			return user, true
+		}
+	}
+	return nil, false
+}
+
+// AuthenticationError returns the error returned by the App
+// Authenticators for the current request, if any. It's only
+// set after Context.User has been called.
+func (c *Context) AuthenticationError() error {
+	return c.authErr
+}
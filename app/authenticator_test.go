@@ -0,0 +1,158 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func authRequest(a *App, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	return w
+}
+
+func newAuthApp(auth Authenticator) *App {
+	a := New()
+	a.Authenticators = []Authenticator{auth}
+	a.HandleOptions("^/$", func(ctx *Context) {
+		body, _ := readRequestBody(ctx.R)
+		fmt.Fprintf(ctx, "%d:%s", ctx.User().Id(), body)
+	}, &HandlerOptions{RequireUser: true})
+	return a
+}
+
+func TestBearerToken(t *testing.T) {
+	cases := map[string]string{
+		"Bearer abc123": "abc123",
+		"bearer  xyz":   "xyz",
+		"Bearer":        "",
+		"Basic abc":     "",
+		"":              "",
+	}
+	for header, expect := range cases {
+		r, _ := http.NewRequest("GET", "http://www.example.com/", nil)
+		if header != "" {
+			r.Header.Set("Authorization", header)
+		}
+		ctx := &Context{R: r}
+		token, err := ctx.BearerToken()
+		if token != expect || (expect == "") != (err != nil) {
+			t.Errorf("expecting token %q for header %q, got %q (error %v)", expect, header, token, err)
+		}
+	}
+}
+
+func TestTokenAuthenticator(t *testing.T) {
+	a := newAuthApp(TokenAuthenticator(func(ctx *Context, token string) (User, error) {
+		if token == "secret" {
+			return &accessUser{id: 7}, nil
+		}
+		return nil, errors.New("invalid token")
+	}))
+	a.HandleNamed("^/sign-in/$", func(ctx *Context) {}, SignInHandlerName)
+	cases := map[string]int{
+		"Bearer secret": http.StatusOK,
+		"Bearer wrong":  http.StatusUnauthorized,
+		"":              http.StatusFound,
+	}
+	for header, code := range cases {
+		r, _ := http.NewRequest("GET", "http://www.example.com/", nil)
+		if header != "" {
+			r.Header.Set("Authorization", header)
+		}
+		if w := authRequest(a, r); w.Code != code {
+			t.Errorf("expecting code %d for Authorization %q, got %d", code, header, w.Code)
+		}
+	}
+}
+
+func TestSignedRequests(t *testing.T) {
+	key := []byte("0123456789abcdef")
+	a := newAuthApp(&SignedRequests{
+		Key: func(ctx *Context, keyID string) ([]byte, User, error) {
+			if keyID == "k1" {
+				return key, &accessUser{id: 3}, nil
+			}
+			return nil, nil, nil
+		},
+	})
+	newRequest := func(body string) *http.Request {
+		r, _ := http.NewRequest("POST", "http://www.example.com/?a=b", strings.NewReader(body))
+		return r
+	}
+	r := newRequest("hello")
+	if err := SignRequest(r, "k1", key); err != nil {
+		t.Fatal(err)
+	}
+	auth := r.Header.Get("Authorization")
+	if w := authRequest(a, r); w.Code != http.StatusOK || w.Body.String() != "3:hello" {
+		t.Errorf("expecting signed request to succeed, got %d %q", w.Code, w.Body.String())
+	}
+	// Replay
+	r = newRequest("hello")
+	r.Header.Set("Authorization", auth)
+	if w := authRequest(a, r); w.Code != http.StatusUnauthorized {
+		t.Errorf("expecting replayed request to fail, got %d", w.Code)
+	}
+	// Tampered body
+	r = newRequest("hello")
+	SignRequest(r, "k1", key)
+	r.Body = newRequest("bye").Body
+	if w := authRequest(a, r); w.Code != http.StatusUnauthorized {
+		t.Errorf("expecting tampered request to fail, got %d", w.Code)
+	}
+	// Sent to another host
+	r = newRequest("hello")
+	SignRequest(r, "k1", key)
+	r.Host = "api.example.com"
+	if w := authRequest(a, r); w.Code != http.StatusUnauthorized {
+		t.Errorf("expecting request sent to another host to fail, got %d", w.Code)
+	}
+	// Host is case insensitive. Use another body, since
+	// the signature would be the same as the first request's.
+	r = newRequest("cased")
+	r.Host = "WWW.Example.com"
+	SignRequest(r, "k1", key)
+	r.Host = "www.example.com"
+	if w := authRequest(a, r); w.Code != http.StatusOK {
+		t.Errorf("expecting request with a differently cased host to succeed, got %d", w.Code)
+	}
+	// Unknown key
+	r = newRequest("hello")
+	SignRequest(r, "k2", key)
+	if w := authRequest(a, r); w.Code != http.StatusUnauthorized {
+		t.Errorf("expecting request with unknown key to fail, got %d", w.Code)
+	}
+	// Expired
+	r = newRequest("")
+	ts := time.Now().Add(-time.Hour).Unix()
+	signature, _ := requestSigner(key).Signature(signedRequestData("POST", "www.example.com", "/?a=b", ts, nil))
+	r.Header.Set("Authorization", fmt.Sprintf("%s KeyId=\"k1\", Timestamp=\"%d\", Signature=%q", SignedRequestScheme, ts, signature))
+	if w := authRequest(a, r); w.Code != http.StatusUnauthorized {
+		t.Errorf("expecting expired request to fail, got %d", w.Code)
+	}
+}
+
+func TestSignedRequestsMaxBodySize(t *testing.T) {
+	key := []byte("0123456789abcdef")
+	a := newAuthApp(&SignedRequests{
+		Key: func(ctx *Context, keyID string) ([]byte, User, error) {
+			return key, &accessUser{id: 3}, nil
+		},
+		MaxBodySize: 8,
+	})
+	for body, code := range map[string]int{"small": http.StatusOK, "way too large": http.StatusUnauthorized} {
+		r, _ := http.NewRequest("POST", "http://www.example.com/", strings.NewReader(body))
+		if err := SignRequest(r, "k1", key); err != nil {
+			t.Fatal(err)
+		}
+		if w := authRequest(a, r); w.Code != code {
+			t.Errorf("expecting code %d for body %q, got %d", code, body, w.Code)
+		}
+	}
+}
@@ -71,3 +71,36 @@ func BenchmarkDirectNoLog(b *testing.B) {
 func BenchmarkDirectReNoLog(b *testing.B) {
 	benchmarkDirect(b, "article/7", true)
 }
+
+func benchmarkMatch(b *testing.B, path string) {
+	app := New()
+	app.Logger = nil
+	f := func(ctx *Context) {}
+	for _, prefix := range []string{"users", "articles", "docs", "api"} {
+		for ii := 0; ii < 100; ii++ {
+			app.Handle(fmt.Sprintf("^/%s/section%d/(\\d+)/$", prefix, ii), f)
+			app.Handle(fmt.Sprintf("^/%s/section%d/$", prefix, ii), f)
+		}
+	}
+	req, err := http.NewRequest("GET", "http://localhost"+path, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for ii := 0; ii < b.N; ii++ {
+		app.ServeHTTP(discard, req)
+	}
+}
+
+func BenchmarkMatchManyHandlersFirst(b *testing.B) {
+	benchmarkMatch(b, "/users/section0/42/")
+}
+
+func BenchmarkMatchManyHandlersLast(b *testing.B) {
+	benchmarkMatch(b, "/api/section99/42/")
+}
+
+func BenchmarkMatchManyHandlersNotFound(b *testing.B) {
+	benchmarkMatch(b, "/notfound/")
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+// Deadline implements context.Context. Together with Done, Err and
+// Value, it allows using a *Context as a context.Context, which is
+// derived from the request context. This means it's cancelled when
+// the client disconnects or when the handler exceeds its timeout
+// (see HandlerOptions.Timeout). The ORM returned by Context.Orm, the
+// clients returned by gnd.la/net/httpclient.New and the templates
+// executed with the Context already honor the cancellation, while
+// other APIs receiving a context.Context might be passed the *Context
+// directly. e.g.
+//
+//	func ReportHandler(ctx *app.Context) {
+//		rows, err := db.QueryContext(ctx, "SELECT ...")
+//		...
+//	}
+//
+// Note that the *Context must not be retained once the handler
+// returns, since it's reused for other requests. Use Context.Go
+// for work that must continue running after that.
+func (c *Context) Deadline() (time.Time, bool) {
+	return c.stdContext().Deadline()
+}
+
+// Done implements context.Context. See Context.Deadline
+// for more information.
+func (c *Context) Done() <-chan struct{} {
+	return c.stdContext().Done()
+}
+
+// Err implements context.Context. See Context.Deadline
+// for more information.
+func (c *Context) Err() error {
+	return c.stdContext().Err()
+}
+
+// Value implements context.Context, returning the values
+// from the request context. Note that it doesn't return
+// the values stored with Context.Set.
+func (c *Context) Value(key interface{}) interface{} {
+	return c.stdContext().Value(key)
+}
+
+func (c *Context) stdContext() context.Context {
+	if c.stdCtx != nil {
+		return c.stdCtx
+	}
+	if c.R != nil {
+		return c.R.Context()
+	}
+	return context.Background()
+}
+
+// timeoutHandler returns a Handler which cancels the Context
+// once the given timeout expires.
+func timeoutHandler(timeout time.Duration, handler Handler) Handler {
+	if timeout <= 0 {
+		return handler
+	}
+	return func(ctx *Context) {
+		prev := ctx.stdCtx
+		c, cancel := context.WithTimeout(ctx.stdContext(), timeout)
+		ctx.stdCtx = c
+		// If the handler panics, leave the context in place, so
+		// App.recover can check if it timed out. The context is
+		// released once its timeout expires.
+		handler(ctx)
+		cancel()
+		ctx.stdCtx = prev
+	}
+}
+
+// detachedContext is the context.Context used by the
+// contexts spawned with Context.Go. It keeps the values
+// from the request, but it's not cancelled when the request
+// finishes. Instead, it's only cancelled when the App is
+// shut down and the shutdown timeout expires.
+type detachedContext struct {
+	context.Context
+	app *App
+}
+
+func (b *detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (b *detachedContext) Done() <-chan struct{} {
+	return b.app.abandoned()
+}
+
+func (b *detachedContext) Err() error {
+	select {
+	case <-b.Done():
+		return context.Canceled
+	default:
+	}
+	return nil
+}
+
+// abandoned returns a channel which is closed when the App
+// stops waiting for its background contexts during shutdown.
+func (app *App) abandoned() <-chan struct{} {
+	root := app.root()
+	root.mu.Lock()
+	defer root.mu.Unlock()
+	if root.abandon == nil {
+		root.abandon = make(chan struct{})
+	}
+	return root.abandon
+}
+
+func (app *App) abandonBackgroundContexts() {
+	root := app.root()
+	root.abandoned()
+	root.mu.Lock()
+	defer root.mu.Unlock()
+	select {
+	case <-root.abandon:
+	default:
+		close(root.abandon)
+	}
+}
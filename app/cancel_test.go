@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandlerTimeout(t *testing.T) {
+	a := New()
+	a.Logger = nil
+	a.HandleOptions("^/slow/$", func(ctx *Context) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Error("expecting a deadline in handler with timeout")
+		}
+		<-ctx.Done()
+		panic(ctx.Err())
+	}, &HandlerOptions{Timeout: 50 * time.Millisecond})
+	a.Handle("^/fast/$", func(ctx *Context) {
+		if _, ok := ctx.Deadline(); ok {
+			t.Error("expecting no deadline in handler without timeout")
+		}
+		ctx.WriteString("ok")
+	})
+	r, _ := http.NewRequest("GET", "http://www.example.com/slow/", nil)
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expecting 503 after timeout, got %d", w.Code)
+	}
+	r, _ = http.NewRequest("GET", "http://www.example.com/fast/", nil)
+	w = httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("expecting 200 without timeout, got %d", w.Code)
+	}
+}
+
+func TestHandlerTimeoutUnrelatedPanic(t *testing.T) {
+	a := New()
+	a.Logger = nil
+	var recovered interface{}
+	a.AddRecoverHandler(func(ctx *Context, err interface{}) interface{} {
+		recovered = err
+		return err
+	})
+	boom := errors.New("boom")
+	a.HandleOptions("^/$", func(ctx *Context) {
+		<-ctx.Done()
+		panic(boom)
+	}, &HandlerOptions{Timeout: 10 * time.Millisecond})
+	r, _ := http.NewRequest("GET", "http://www.example.com/", nil)
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	if recovered != boom {
+		t.Errorf("expecting recover handlers to receive the panic, got %v", recovered)
+	}
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expecting 500 for unrelated panic after timeout, got %d", w.Code)
+	}
+}
+
+type cancelTestKey struct{}
+
+func TestBackgroundContextNotCancelled(t *testing.T) {
+	a := New()
+	errs := make(chan error, 1)
+	values := make(chan interface{}, 1)
+	release := make(chan struct{})
+	a.Handle("^/$", func(ctx *Context) {
+		ctx.Go(func(bg *Context) {
+			<-release
+			errs <- bg.Err()
+			values <- bg.Value(cancelTestKey{})
+		})
+	})
+	reqCtx, cancel := context.WithCancel(context.WithValue(context.Background(), cancelTestKey{}, "value"))
+	r, _ := http.NewRequest("GET", "http://www.example.com/", nil)
+	a.ServeHTTP(httptest.NewRecorder(), r.WithContext(reqCtx))
+	cancel()
+	close(release)
+	if err := <-errs; err != nil {
+		t.Errorf("expecting background context not to be cancelled, got %v", err)
+	}
+	if v := <-values; v != "value" {
+		t.Errorf("expecting background context to keep request values, got %v", v)
+	}
+	a.abandonBackgroundContexts()
+	bg := a.NewContext(nil)
+	bg.stdCtx = &detachedContext{Context: context.Background(), app: a}
+	if err := bg.Err(); err != context.Canceled {
+		t.Errorf("expecting abandoned background context to be cancelled, got %v", err)
+	}
+}
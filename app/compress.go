@@ -0,0 +1,316 @@
+package app
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultCompressionMinSize is the minimum response size,
+	// in bytes, which will be compressed when the Compression
+	// MinSize field is zero.
+	DefaultCompressionMinSize = 1024
+)
+
+var (
+	// DefaultCompressionContentTypes are the content types which
+	// are compressed when the Compression ContentTypes field
+	// is empty.
+	DefaultCompressionContentTypes = []string{
+		"text/*",
+		"application/json",
+		"application/javascript",
+		"application/xml",
+		"application/xhtml+xml",
+		"application/rss+xml",
+		"application/atom+xml",
+		"image/svg+xml",
+	}
+
+	errCantHijack = errors.New("the ResponseWriter does not support hijacking")
+)
+
+// Compression represents the options used by the App for
+// compressing responses. To enable compression, set
+// the App Compression field to a non-nil *Compression.
+// Responses are compressed with gzip or deflate, depending
+// on the Accept-Encoding header sent by the client, only
+// when they have an allowed content type and reach a minimum
+// size. Responses which already have a Content-Encoding
+// are never compressed again.
+type Compression struct {
+	// Level is the compression level, from 1 (best speed)
+	// to 9 (best compression). Zero means the default level.
+	Level int
+	// MinSize is the minimum size in bytes for a response to
+	// be compressed. If zero, DefaultCompressionMinSize is used.
+	MinSize int
+	// ContentTypes lists the content types which might be
+	// compressed. Entries ending with /* match any subtype.
+	// If empty, DefaultCompressionContentTypes is used.
+	ContentTypes []string
+}
+
+// CompressionLevel returns the level to pass to compress/gzip or
+// compress/flate, translating zero to their default level.
+func (c *Compression) CompressionLevel() int {
+	if c.Level == 0 {
+		return flate.DefaultCompression
+	}
+	return c.Level
+}
+
+func (c *Compression) minSize() int {
+	if c.MinSize > 0 {
+		return c.MinSize
+	}
+	return DefaultCompressionMinSize
+}
+
+func (c *Compression) allowsContentType(ct string) bool {
+	if idx := strings.IndexByte(ct, ';'); idx >= 0 {
+		ct = ct[:idx]
+	}
+	ct = strings.ToLower(strings.TrimSpace(ct))
+	if ct == "" {
+		return false
+	}
+	types := c.ContentTypes
+	if len(types) == 0 {
+		types = DefaultCompressionContentTypes
+	}
+	for _, v := range types {
+		if strings.HasSuffix(v, "/*") {
+			if strings.HasPrefix(ct, v[:len(v)-1]) {
+				return true
+			}
+		} else if v == ct {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiateEncoding returns the preferred supported encoding
+// accepted by the given Accept-Encoding header value, or
+// the empty string if none of them is acceptable.
+func negotiateEncoding(accept string) string {
+	var gzipQ, deflateQ, anyQ float64 = -1, -1, -1
+	for _, v := range strings.Split(accept, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		q := 1.0
+		if idx := strings.IndexByte(v, ';'); idx >= 0 {
+			params := strings.TrimSpace(v[idx+1:])
+			v = strings.TrimSpace(v[:idx])
+			if strings.HasPrefix(params, "q=") {
+				if f, err := strconv.ParseFloat(params[2:], 64); err == nil {
+					q = f
+				}
+			}
+		}
+		switch strings.ToLower(v) {
+		case "gzip", "x-gzip":
+			gzipQ = q
+		case "deflate":
+			deflateQ = q
+		case "*":
+			anyQ = q
+		}
+	}
+	if gzipQ < 0 {
+		gzipQ = anyQ
+	}
+	if deflateQ < 0 {
+		deflateQ = anyQ
+	}
+	switch {
+	case gzipQ > 0 && gzipQ >= deflateQ:
+		return "gzip"
+	case deflateQ > 0:
+		return "deflate"
+	}
+	return ""
+}
+
+// compressWriter wraps the http.ResponseWriter used by a Context,
+// buffering the response until it's known whether it should be
+// compressed.
+type compressWriter struct {
+	http.ResponseWriter
+	opts     *Compression
+	encoding string
+	code     int
+	buf      []byte
+	decided  bool
+	closed   bool
+	w        io.WriteCloser
+}
+
+func newCompressWriter(w http.ResponseWriter, r *http.Request, opts *Compression) *compressWriter {
+	return &compressWriter{
+		ResponseWriter: w,
+		opts:           opts,
+		encoding:       negotiateEncoding(r.Header.Get("Accept-Encoding")),
+	}
+}
+
+// compressible returns true iff the response could be compressed
+// for a client which accepts any of the supported encodings. If
+// the Content-Type has not been set yet and ignoreMissingType
+// is true, any type is assumed to be allowed.
+func (w *compressWriter) compressible(ignoreMissingType bool) bool {
+	if w.code < http.StatusOK || w.code == http.StatusNoContent ||
+		w.code == http.StatusPartialContent || w.code == http.StatusNotModified {
+		return false
+	}
+	h := w.Header()
+	if h.Get("Content-Encoding") != "" || h.Get("Content-Range") != "" {
+		return false
+	}
+	if _, ok := h["Content-Type"]; !ok && ignoreMissingType {
+		return true
+	}
+	return w.opts.allowsContentType(h.Get("Content-Type"))
+}
+
+// start decides if the response will be compressed, writes the
+// headers and any buffered data. If force is true, the minimum
+// size is not taken into account.
+func (w *compressWriter) start(force bool) error {
+	if w.decided {
+		return nil
+	}
+	w.decided = true
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	h := w.Header()
+	if _, ok := h["Content-Type"]; !ok && len(w.buf) > 0 && h.Get("Content-Encoding") == "" {
+		h.Set("Content-Type", http.DetectContentType(w.buf))
+	}
+	if w.compressible(false) {
+		addVary(h, "Accept-Encoding")
+		if w.encoding != "" && (force || len(w.buf) >= w.opts.minSize()) {
+			h.Set("Content-Encoding", w.encoding)
+			h.Del("Content-Length")
+			level := w.opts.CompressionLevel()
+			var err error
+			if w.encoding == "gzip" {
+				w.w, err = gzip.NewWriterLevel(w.ResponseWriter, level)
+			} else {
+				w.w, err = flate.NewWriter(w.ResponseWriter, level)
+			}
+			if err != nil {
+				h.Del("Content-Encoding")
+				w.w = nil
+			}
+		}
+	}
+	w.ResponseWriter.WriteHeader(w.code)
+	buf := w.buf
+	w.buf = nil
+	if len(buf) > 0 {
+		_, err := w.write(buf)
+		return err
+	}
+	return nil
+}
+
+func (w *compressWriter) write(data []byte) (int, error) {
+	if w.w != nil {
+		return w.w.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *compressWriter) WriteHeader(code int) {
+	if w.decided || w.code != 0 {
+		return
+	}
+	w.code = code
+	if !w.compressible(true) {
+		w.start(false)
+		return
+	}
+	if cl := w.Header().Get("Content-Length"); cl != "" {
+		if n, err := strconv.Atoi(cl); err == nil && n < w.opts.minSize() {
+			w.start(false)
+		}
+	}
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if w.decided {
+		return w.write(data)
+	}
+	w.buf = append(w.buf, data...)
+	if len(w.buf) >= w.opts.minSize() {
+		if err := w.start(false); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+// Flush sends any buffered data to the client. Calling Flush
+// before the response reaches the minimum size compresses
+// it anyway if the content type and the client allow it,
+// since it's assumed to be streamed.
+func (w *compressWriter) Flush() {
+	w.start(true)
+	switch cw := w.w.(type) {
+	case *gzip.Writer:
+		cw.Flush()
+	case *flate.Writer:
+		cw.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *compressWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if hj, ok := w.ResponseWriter.(http.Hijacker); ok {
+		w.decided = true
+		w.closed = true
+		return hj.Hijack()
+	}
+	return nil, nil, errCantHijack
+}
+
+func (w *compressWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if err := w.start(false); err != nil {
+		return err
+	}
+	if w.w != nil {
+		return w.w.Close()
+	}
+	return nil
+}
+
+// addVary adds value to the Vary header, unless
+// it's already present.
+func addVary(h http.Header, value string) {
+	for _, v := range h["Vary"] {
+		for _, s := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(s), value) {
+				return
+			}
+		}
+	}
+	h.Add("Vary", value)
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := map[string]string{
+		"":                         "",
+		"gzip":                     "gzip",
+		"deflate":                  "deflate",
+		"gzip, deflate":            "gzip",
+		"deflate;q=1, gzip;q=0.5":  "deflate",
+		"gzip;q=0, deflate":        "deflate",
+		"gzip;q=0":                 "",
+		"*":                        "gzip",
+		"br, *;q=0.1, gzip;q=0":    "deflate",
+		"identity":                 "",
+		" GZIP ; q=0.8 , br;q=0.9": "gzip",
+	}
+	for k, v := range tests {
+		if enc := negotiateEncoding(k); enc != v {
+			t.Errorf("expecting encoding %q for %q, got %q", v, k, enc)
+		}
+	}
+}
+
+func TestCompression(t *testing.T) {
+	large := strings.Repeat("gondola ", 512)
+	a := New()
+	a.Compression = &Compression{}
+	a.Handle("^/large/$", func(ctx *Context) {
+		ctx.WriteString(large)
+	})
+	a.Handle("^/small/$", func(ctx *Context) {
+		ctx.WriteString("small")
+	})
+	a.Handle("^/binary/$", func(ctx *Context) {
+		ctx.Header().Set("Content-Type", "image/png")
+		ctx.WriteString(large)
+	})
+	a.Handle("^/precompressed/$", func(ctx *Context) {
+		ctx.Header().Set("Content-Type", "text/plain")
+		ctx.Header().Set("Content-Encoding", "gzip")
+		ctx.WriteString(large)
+	})
+	tests := []struct {
+		path     string
+		accept   string
+		encoding string
+		vary     bool
+	}{
+		{"/large/", "gzip, deflate", "gzip", true},
+		{"/large/", "deflate", "deflate", true},
+		{"/large/", "", "", true},
+		{"/small/", "gzip", "", true},
+		{"/binary/", "gzip", "", false},
+		{"/precompressed/", "gzip", "gzip", false},
+	}
+	for _, v := range tests {
+		req, _ := http.NewRequest("GET", "http://localhost"+v.path, nil)
+		if v.accept != "" {
+			req.Header.Set("Accept-Encoding", v.accept)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, req)
+		if enc := w.Header().Get("Content-Encoding"); enc != v.encoding {
+			t.Errorf("expecting Content-Encoding %q for %s with %q, got %q", v.encoding, v.path, v.accept, enc)
+		}
+		if vary := w.Header().Get("Vary") == "Accept-Encoding"; vary != v.vary {
+			t.Errorf("expecting Vary = %v for %s, got %v", v.vary, v.path, vary)
+		}
+	}
+	req, _ := http.NewRequest("GET", "http://localhost/large/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, req)
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("expecting text/plain content type, got %q", ct)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(w.Body.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != large {
+		t.Errorf("decompressed body does not match the original one")
+	}
+}
@@ -0,0 +1,187 @@
+package app
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+
+	"gnd.la/crypto/hashutil"
+)
+
+// SetETag sets the ETag header for the response. The etag
+// argument must not include the quotes, they're added by
+// this function. Weak ETags indicate that the response is
+// semantically equivalent, but not necessarily byte by byte
+// identical (e.g. because it might be compressed). Use
+// NotModified after setting the ETag to check if the client
+// already has the current version of the resource.
+func (c *Context) SetETag(etag string, weak bool) {
+	value := `"` + etag + `"`
+	if weak {
+		value = "W/" + value
+	}
+	c.SetHeader("ETag", value)
+}
+
+// SetLastModified sets the Last-Modified header for the response. Use
+// NotModified after setting it to check if the client already has the
+// current version of the resource.
+func (c *Context) SetLastModified(t time.Time) {
+	if !t.IsZero() {
+		c.SetHeader("Last-Modified", t.UTC().Format(http.TimeFormat))
+	}
+}
+
+// NotModified checks the If-None-Match and If-Modified-Since headers
+// sent by the client against the ETag and Last-Modified headers already
+// set in the response (see SetETag and SetLastModified). If the client
+// already has the current version of the resource, it writes a 304
+// response without a body and returns true, so the handler should
+// stop processing the request. Only GET and HEAD requests are
+// taken into account, as RFC 7232 mandates. The usual pattern is:
+//
+//	ctx.SetETag(post.Hash(), false)
+//	if ctx.NotModified() {
+//		return
+//	}
+//	ctx.MustExecute("post.html", post)
+func (c *Context) NotModified() bool {
+	if c.R == nil || (c.R.Method != "GET" && c.R.Method != "HEAD") {
+		return false
+	}
+	h := c.Header()
+	if inm := c.R.Header.Get("If-None-Match"); inm != "" {
+		// If-Modified-Since must be ignored when
+		// If-None-Match is present.
+		if !etagMatches(inm, h.Get("ETag")) {
+			return false
+		}
+	} else {
+		ims := c.R.Header.Get("If-Modified-Since")
+		lm := h.Get("Last-Modified")
+		if ims == "" || lm == "" {
+			return false
+		}
+		imsTime, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		lmTime, err := http.ParseTime(lm)
+		if err != nil || lmTime.After(imsTime) {
+			return false
+		}
+	}
+	delete(h, "Content-Type")
+	delete(h, "Content-Length")
+	delete(h, "Content-Encoding")
+	c.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// etagMatches returns true iff the given If-None-Match value
+// matches the etag, using the weak comparison function.
+func etagMatches(inm string, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, v := range strings.Split(inm, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ETag returns a new Handler which computes a weak ETag from the
+// response body, unless the handler already set one, and replies with
+// a 304 when the client already has the current version. Since the
+// whole body must be known in advance, the response is buffered
+// until the handler finishes, unless it's flushed. Only successful
+// responses to GET and HEAD requests are taken into account.
+// JSONHandler and ExecuteHandler use this function for setting
+// their ETags automatically.
+func ETag(handler Handler) Handler {
+	return func(ctx *Context) {
+		if m := ctx.R.Method; m != "GET" && m != "HEAD" {
+			handler(ctx)
+			return
+		}
+		rw := ctx.ResponseWriter
+		w := &etagWriter{ResponseWriter: rw}
+		ctx.ResponseWriter = w
+		defer func() {
+			ctx.ResponseWriter = rw
+		}()
+		handler(ctx)
+		ctx.ResponseWriter = rw
+		w.finish(ctx)
+	}
+}
+
+// etagWriter buffers the response body to compute its ETag.
+type etagWriter struct {
+	http.ResponseWriter
+	code    int
+	buf     bytes.Buffer
+	flushed bool
+}
+
+func (w *etagWriter) WriteHeader(code int) {
+	if w.flushed {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+func (w *etagWriter) Write(data []byte) (int, error) {
+	if w.flushed {
+		return w.ResponseWriter.Write(data)
+	}
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	return w.buf.Write(data)
+}
+
+// Flush writes the buffered data without computing the ETag,
+// since the response is being streamed.
+func (w *etagWriter) Flush() {
+	if !w.flushed {
+		w.flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *etagWriter) flush() {
+	w.flushed = true
+	if w.code != 0 {
+		w.ResponseWriter.WriteHeader(w.code)
+	}
+	if w.buf.Len() > 0 {
+		w.ResponseWriter.Write(w.buf.Bytes())
+	}
+}
+
+func (w *etagWriter) finish(ctx *Context) {
+	if w.flushed {
+		return
+	}
+	if w.code == http.StatusOK && w.buf.Len() > 0 {
+		h := w.Header()
+		if h.Get("ETag") == "" {
+			ctx.SetETag(hashutil.Md5(w.buf.Bytes()), true)
+		}
+		if ctx.NotModified() {
+			return
+		}
+	}
+	w.flush()
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestETagMatches(t *testing.T) {
+	tests := []struct {
+		inm     string
+		etag    string
+		matches bool
+	}{
+		{`"foo"`, `"foo"`, true},
+		{`W/"foo"`, `"foo"`, true},
+		{`"foo"`, `W/"foo"`, true},
+		{`"bar", "foo"`, `"foo"`, true},
+		{`*`, `"foo"`, true},
+		{`*`, ``, false},
+		{`"bar"`, `"foo"`, false},
+	}
+	for _, v := range tests {
+		if m := etagMatches(v.inm, v.etag); m != v.matches {
+			t.Errorf("expecting etagMatches(%q, %q) = %v, got %v", v.inm, v.etag, v.matches, m)
+		}
+	}
+}
+
+func TestConditional(t *testing.T) {
+	modified := time.Date(2014, 3, 1, 12, 0, 0, 0, time.UTC)
+	a := New()
+	a.Handle("^/json/$", JSONHandler(func(ctx *Context) (interface{}, error) {
+		return map[string]int{"a": 1}, nil
+	}))
+	a.Handle("^/modified/$", func(ctx *Context) {
+		ctx.SetLastModified(modified)
+		if ctx.NotModified() {
+			return
+		}
+		ctx.WriteString("hello")
+	})
+	serve := func(method, path string, header map[string]string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(method, "http://localhost"+path, nil)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, req)
+		return w
+	}
+	w := serve("GET", "/json/", nil)
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" || w.Body.Len() == 0 {
+		t.Fatalf("expecting 200 with an ETag, got %d and %q", w.Code, etag)
+	}
+	if w = serve("GET", "/json/", map[string]string{"If-None-Match": etag}); w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("expecting empty 304 with matching ETag, got %d (%d bytes)", w.Code, w.Body.Len())
+	}
+	if w = serve("GET", "/json/", map[string]string{"If-None-Match": `"other"`}); w.Code != http.StatusOK {
+		t.Errorf("expecting 200 with non-matching ETag, got %d", w.Code)
+	}
+	if w = serve("POST", "/json/", map[string]string{"If-None-Match": etag}); w.Code != http.StatusOK || w.Header().Get("ETag") != "" {
+		t.Errorf("expecting 200 without ETag for POST, got %d", w.Code)
+	}
+	ims := modified.Format(http.TimeFormat)
+	if w = serve("GET", "/modified/", map[string]string{"If-Modified-Since": ims}); w.Code != http.StatusNotModified {
+		t.Errorf("expecting 304 with If-Modified-Since, got %d", w.Code)
+	}
+	ims = modified.Add(-time.Hour).Format(http.TimeFormat)
+	if w = serve("GET", "/modified/", map[string]string{"If-Modified-Since": ims}); w.Code != http.StatusOK || w.Body.String() != "hello" {
+		t.Errorf("expecting 200 with older If-Modified-Since, got %d", w.Code)
+	}
+}
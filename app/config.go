@@ -20,7 +20,7 @@ type Config struct {
 	// or when it returns an empty string.
 	Language string `help:"Set the default language for translating strings"`
 	// Port indicates the port to listen on.
-	Port      int         `default:"8888" help:"Port to listen on"`
+	Port      int         `default:"8888" min:"0" max:"65535" help:"Port to listen on"`
 	Database  *config.URL `help:"Default database to use, used by Context.Orm()"`
 	Cache     *config.URL `help:"Default cache, returned by Context.Cache()"`
 	Blobstore *config.URL `help:"Default blobstore, returned by Context.Blobstore()"`
@@ -28,16 +28,54 @@ type Config struct {
 	// which is used for signed cookies. It should be a
 	// random string with at least 32 characters.
 	// You can use gondola random-string to generate one.
-	Secret string `help:"Secret used for, among other things, hashing cookies"`
+	Secret string `help:"Secret used for, among other things, hashing cookies" secret:"true"`
 	// EncriptionKey is the encryption key for used by the
 	// app for, among other things, encrypted cookies. It should
 	// be a random string of 16 or 24 or 32 characters.
-	EncryptionKey string `help:"Key used for encryption (e.g. encrypted cookies)"`
+	EncryptionKey string `help:"Key used for encryption (e.g. encrypted cookies)" secret:"true"`
+	// ShutdownTimeout indicates the maximum number of seconds
+	// to wait for in-flight requests and background contexts
+	// when the App is shutting down. If zero, there's no limit.
+	ShutdownTimeout int `default:"30" help:"Seconds to wait for pending requests when shutting down"`
+	// ShutdownDrainDelay indicates the number of seconds to keep
+	// accepting new requests after the App starts shutting down,
+	// while the readiness endpoint reports it as stopping, so load
+	// balancers have time to notice it. It's only used when the App
+	// has a Health (see App.Health).
+	ShutdownDrainDelay int `default:"5" min:"0" help:"Seconds to keep serving requests while reporting the app as not ready when shutting down"`
+	// TLSCertFile and TLSKeyFile indicate the certificate and
+	// private key files used for serving HTTPS. When both of
+	// them are non-empty, the App serves HTTPS on Port.
+	TLSCertFile string `help:"Certificate file for serving HTTPS"`
+	TLSKeyFile  string `help:"Private key file for serving HTTPS"`
+	// HTTPPort indicates the port for an additional plain HTTP
+	// listener which redirects all the requests to HTTPS. It's
+	// only used when the App is serving HTTPS.
+	HTTPPort int `min:"0" max:"65535" help:"When serving HTTPS, also listen on this port for HTTP and redirect to HTTPS"`
+	// HSTSMaxAge indicates the max-age, in seconds, sent in the
+	// Strict-Transport-Security header for HTTPS responses. If
+	// zero, the header is not sent.
+	HSTSMaxAge int `help:"Send a Strict-Transport-Security header with the given max-age in HTTPS responses"`
+	// HSTSIncludeSubdomains indicates if the includeSubDomains
+	// directive should be added to the Strict-Transport-Security header.
+	HSTSIncludeSubdomains bool `help:"Add includeSubDomains to the Strict-Transport-Security header"`
+	// SessionIdleTimeout indicates the number of seconds after which
+	// an unused session expires. If zero, sessions never expire
+	// because of inactivity.
+	SessionIdleTimeout int `default:"7200" help:"Seconds after which an idle session expires"`
+	// SessionMaxAge indicates the maximum number of seconds a session
+	// lasts since it was created, regardless of its activity. If zero,
+	// there's no limit.
+	SessionMaxAge int `default:"604800" help:"Maximum session lifetime in seconds"`
 }
 
 var (
 	defaultConfig = Config{
-		Port: 8888,
+		Port:               8888,
+		ShutdownTimeout:    30,
+		ShutdownDrainDelay: 5,
+		SessionIdleTimeout: 7200,
+		SessionMaxAge:      604800,
 	}
 )
 
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -41,11 +42,25 @@ type Context struct {
 	provider        ContextProvider
 	reProvider      *regexpProvider
 	handlerName     string
+	params          map[string]*PlaceholderType
 	app             *App
 	statusCode      int
+	compressor      *compressWriter
 	started         time.Time
 	cookies         *cookies.Cookies
 	user            User
+	userLoaded      bool
+	authErr         error
+	authenticated   bool
+	session         *Session
+	flashes         *flashes
+	csrfToken       string
+	requestID       string
+	stdCtx          context.Context
+	ctxOrm          *orm.Orm
+	ctxOrmCtx       context.Context
+	cspNonce        string
+	written         int64
 	translations    *table.Table
 	hasTranslations bool
 	background      bool
@@ -57,9 +72,22 @@ func (c *Context) reset() {
 	c.ResponseWriter = nil
 	c.R = nil
 	c.statusCode = 0
+	c.compressor = nil
 	c.started = time.Now()
 	c.cookies = nil
 	c.user = nil
+	c.userLoaded = false
+	c.authErr = nil
+	c.authenticated = false
+	c.session = nil
+	c.flashes = nil
+	c.csrfToken = ""
+	c.requestID = ""
+	c.stdCtx = nil
+	c.ctxOrm = nil
+	c.ctxOrmCtx = nil
+	c.cspNonce = ""
+	c.written = 0
 	c.translations = nil
 	c.hasTranslations = false
 	c.values = nil
@@ -117,10 +145,21 @@ func (c *Context) ParamValue(name string) string {
 
 // ParseParamValue uses the named captured parameter
 // with the given name and tries to parse it into
-// the given argument. See ParseFormValue
-// for examples as well as the supported types.
+// the given argument. If the parameter was declared
+// as a typed placeholder (e.g. {id:int}), the value
+// is parsed by its PlaceholderType. Otherwise, see
+// ParseFormValue for examples as well as the supported types.
 func (c *Context) ParseParamValue(name string, arg interface{}) bool {
 	val := c.ParamValue(name)
+	if t := c.params[name]; t != nil && t.Parse != nil && val != "" {
+		parsed, err := t.Parse(val)
+		if err != nil {
+			return false
+		}
+		if setParsedValue(parsed, arg) {
+			return true
+		}
+	}
 	return c.parseTypedValue(val, arg)
 }
 
@@ -333,6 +372,11 @@ func (c *Context) requestScheme() string {
 		if c.R.TLS != nil {
 			return "https"
 		}
+		// Scheme is only set for incoming requests when the
+		// App trusts X headers and the proxy has provided it.
+		if s := c.R.URL.Scheme; s != "" {
+			return s
+		}
 		return "http"
 	}
 	return ""
@@ -377,9 +421,16 @@ func (c *Context) Blobstore() *blobstore.Blobstore {
 }
 
 // Orm is a shorthand for ctx.App().Orm(), but panics in case
-// of error, rather than returning it.
+// of error, rather than returning it. The returned Orm uses the
+// Context for its operations (see orm.Orm.WithContext), so they're
+// aborted when the Context is cancelled. See Context.Deadline.
 func (c *Context) Orm() *orm.Orm {
-	return c.orm()
+	ctx := c.stdContext()
+	if c.ctxOrm == nil || c.ctxOrmCtx != ctx {
+		c.ctxOrm = c.orm().WithContext(ctx)
+		c.ctxOrmCtx = ctx
+	}
+	return c.ctxOrm
 }
 
 // Execute loads the template with the given name using the
@@ -462,7 +513,38 @@ func (c *Context) IsXHR() bool {
 // It's automatically called by the App, so you
 // don't need to call it manually
 func (c *Context) Close() {
-	// currently a no-op
+	c.saveFlashes()
+	c.saveSession()
+	if c.compressor != nil {
+		c.compressor.Close()
+	}
+}
+
+// CompressionEncoding returns the encoding the App will use to compress
+// the response body if it's eligible for compression, or an empty string
+// if compression is disabled or the client does not accept any of the
+// supported encodings. See App.Compression for more information.
+func (c *Context) CompressionEncoding() string {
+	if c.compressor != nil {
+		return c.compressor.encoding
+	}
+	return ""
+}
+
+// ResponseEncoding returns the encoding used by the App to compress
+// the response body, or an empty string if it's not compressed. Calling
+// this function makes the App decide whether the response should be
+// compressed and send the headers, so it should only be called
+// after the response has been completely written.
+func (c *Context) ResponseEncoding() string {
+	if c.compressor == nil {
+		return ""
+	}
+	c.compressor.start(false)
+	if c.compressor.w != nil {
+		return c.compressor.encoding
+	}
+	return ""
 }
 
 // BackgroundContext returns a copy of the given Context
@@ -476,7 +558,9 @@ func (c *Context) backgroundContext() *Context {
 	ctx.background = true
 	ctx.provider = c.provider
 	ctx.reProvider = c.reProvider
+	ctx.params = c.params
 	ctx.ResponseWriter = discard
+	ctx.stdCtx = &detachedContext{Context: c.stdContext(), app: c.app}
 	return ctx
 }
 
@@ -510,7 +594,10 @@ func (c *Context) finalize(wg *sync.WaitGroup) {
 // might outlast the Handler's lifetime). Additionaly, Go also
 // handles error recovering and profiling in the spawned
 // goroutine. The initial Context can also wait for all
-// background contexts to finish by calling Wait().
+// background contexts to finish by calling Wait(). Background
+// contexts keep the values from the request context, but they're
+// not cancelled when the request finishes. Instead, they're
+// cancelled if they're still running when App.Shutdown times out.
 //
 // In the following example, the handler finishes and returns the
 // executed template while CrunchData is still potentially running.
@@ -527,12 +614,17 @@ func (c *Context) Go(f func(*Context)) {
 		c.wg = new(sync.WaitGroup)
 	}
 	c.wg.Add(1)
+	// Track the goroutine in the App, so it
+	// can be waited for in App.Shutdown.
+	root := c.app.root()
+	root.pending.Add(1)
 	bg := c.backgroundContext()
 	var id int
 	if profile.On {
 		id = profile.ID()
 	}
 	go func() {
+		defer root.pending.Done()
 		if profile.On {
 			profile.Begin()
 			defer profile.End(id)
@@ -577,7 +669,13 @@ func (c *Context) Set(key string, value interface{}) {
 // unconditionally (i.e. don't check if the returned value is nil, it'll
 // never be).
 func (c *Context) Logger() log.Interface {
-	return c.logger()
+	l := c.logger()
+	if c.requestID != "" {
+		if std, ok := l.(*log.Logger); ok {
+			return &requestLogger{logger: std, prefix: "[" + c.requestID + "] "}
+		}
+	}
+	return l
 }
 
 // Intercept http.ResponseWriter calls to find response
@@ -587,6 +685,10 @@ func (c *Context) WriteHeader(code int) {
 	if c.statusCode < 0 {
 		code = -c.statusCode
 	}
+	// Sessions must be saved before sending the
+	// headers, since they might need to set a cookie.
+	c.saveFlashes()
+	c.saveSession()
 	c.statusCode = code
 	if profile.On && profile.Profiling() {
 		header := profileHeader(c)
@@ -604,7 +706,9 @@ func (c *Context) Write(data []byte) (int, error) {
 		// code will be overriden if < 0
 		c.WriteHeader(http.StatusOK)
 	}
-	return c.ResponseWriter.Write(data)
+	n, err := c.ResponseWriter.Write(data)
+	c.written += int64(n)
+	return n, err
 }
 
 func urlHost(u string) string {
@@ -0,0 +1,219 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// CORS represents a Cross-Origin Resource Sharing policy, which
+// allows browsers to make requests to the App from pages served
+// from other origins. Policies might be set for the whole App,
+// using its CORS field, or for individual handlers, using the CORS
+// field in HandlerOptions, which takes precedence over the App one.
+//
+// Preflight requests (OPTIONS requests with an
+// Access-Control-Request-Method header) to handlers with a
+// policy are answered by the App without calling the handler,
+// while the rest of requests receive the appropriate
+// Access-Control-* headers before the handler runs. Responses
+// which depend on the request Origin also include a Vary: Origin
+// header. A CORS policy must not be modified after its first use.
+type CORS struct {
+	// AllowedOrigins contains the origins which are allowed to
+	// make requests, in scheme://host[:port] form. Each entry might
+	// contain "*" wildcards (e.g. "https://*.example.com"), while
+	// an entry containing just "*" allows any origin.
+	AllowedOrigins []string
+	// AllowedMethods contains the methods allowed in cross-origin
+	// requests. If empty, the methods accepted by the handler are
+	// allowed or, if the handler accepts any method, just GET,
+	// HEAD and POST.
+	AllowedMethods []string
+	// AllowedHeaders contains the request headers, besides the simple
+	// ones, which might be used in cross-origin requests. An entry
+	// containing just "*" allows any header.
+	AllowedHeaders []string
+	// ExposedHeaders contains the response headers, besides the
+	// simple ones, which will be visible to the client.
+	ExposedHeaders []string
+	// AllowCredentials indicates if the requests might include
+	// credentials, like cookies or HTTP authentication. It can't
+	// be combined with an AllowedOrigins entry containing just "*",
+	// since that would allow any website to make authenticated
+	// requests and read the responses. Use explicit origins or
+	// wildcard patterns (e.g. "https://*.example.com") instead.
+	// HandleOptions panics and App.Prepare returns an error when
+	// they're combined, while any remaining request matched by "*"
+	// never receives the Access-Control-Allow-Credentials header.
+	AllowCredentials bool
+	// MaxAge is the number of seconds the client might cache the
+	// result of a preflight request. If zero, it's not cached.
+	MaxAge int
+}
+
+var (
+	corsSimpleMethods = []string{"GET", "HEAD", "POST"}
+
+	errCORSAnyOriginCredentials = errors.New("CORS policy can't allow credentials from any origin (\"*\"), use explicit origins instead")
+)
+
+// validate returns an error if the policy
+// combines any origin with credentials.
+func (c *CORS) validate() error {
+	if c != nil && c.AllowCredentials {
+		for _, v := range c.AllowedOrigins {
+			if v == "*" {
+				return errCORSAnyOriginCredentials
+			}
+		}
+	}
+	return nil
+}
+
+// allowedOrigin returns the value for the Access-Control-Allow-Origin
+// header if the given origin is allowed, or an empty string otherwise.
+func (c *CORS) allowedOrigin(origin string) string {
+	for _, v := range c.AllowedOrigins {
+		if v == "*" {
+			return "*"
+		}
+		if wildcardMatch(strings.ToLower(v), strings.ToLower(origin)) {
+			return origin
+		}
+	}
+	return ""
+}
+
+func (c *CORS) methods(handlerMethods []string) []string {
+	if len(c.AllowedMethods) > 0 {
+		return c.AllowedMethods
+	}
+	if len(handlerMethods) > 0 {
+		return handlerMethods
+	}
+	return corsSimpleMethods
+}
+
+func (c *CORS) allowsHeader(header string) bool {
+	for _, v := range c.AllowedHeaders {
+		if v == "*" || strings.EqualFold(v, header) {
+			return true
+		}
+	}
+	return false
+}
+
+// setOrigin sets the headers shared by both preflight and
+// actual requests. It returns false if the origin is not allowed.
+func (c *CORS) setOrigin(ctx *Context, origin string) bool {
+	h := ctx.Header()
+	allowed := c.allowedOrigin(origin)
+	if allowed != "*" {
+		addVary(h, "Origin")
+	}
+	if allowed == "" {
+		return false
+	}
+	h.Set("Access-Control-Allow-Origin", allowed)
+	// Browsers don't accept * with credentials, and echoing
+	// the origin would allow credentials from any website.
+	if c.AllowCredentials && allowed != "*" {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+	return true
+}
+
+// preflight answers a preflight request for a handler
+// which accepts the given methods.
+func (c *CORS) preflight(ctx *Context, handlerMethods []string) {
+	h := ctx.Header()
+	if c.setOrigin(ctx, ctx.R.Header.Get("Origin")) {
+		method := strings.ToUpper(ctx.R.Header.Get("Access-Control-Request-Method"))
+		methods := c.methods(handlerMethods)
+		if corsContains(methods, method) && c.allowsHeaders(ctx.R.Header.Get("Access-Control-Request-Headers")) {
+			h.Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+			if hdrs := ctx.R.Header.Get("Access-Control-Request-Headers"); hdrs != "" {
+				h.Set("Access-Control-Allow-Headers", hdrs)
+			}
+			if c.MaxAge > 0 {
+				h.Set("Access-Control-Max-Age", strconv.Itoa(c.MaxAge))
+			}
+		} else {
+			// Not allowed, remove the headers added by setOrigin
+			h.Del("Access-Control-Allow-Origin")
+			h.Del("Access-Control-Allow-Credentials")
+		}
+	}
+	ctx.WriteHeader(http.StatusNoContent)
+}
+
+func (c *CORS) allowsHeaders(requested string) bool {
+	for _, v := range strings.Split(requested, ",") {
+		if v = strings.TrimSpace(v); v != "" && !c.allowsHeader(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// isPreflight returns true iff the request is a CORS preflight request.
+func isPreflight(r *http.Request) bool {
+	return r.Method == "OPTIONS" && r.Header.Get("Origin") != "" &&
+		r.Header.Get("Access-Control-Request-Method") != ""
+}
+
+// corsHandler returns a Handler which adds the CORS headers
+// to the response before calling handler, using the given
+// policy or, if nil, the App one.
+func corsHandler(policy *CORS, handler Handler) Handler {
+	return func(ctx *Context) {
+		c := policy
+		if c == nil {
+			c = ctx.app.CORS
+		}
+		if c != nil {
+			if origin := ctx.R.Header.Get("Origin"); origin != "" {
+				if c.setOrigin(ctx, origin) && len(c.ExposedHeaders) > 0 {
+					ctx.Header().Set("Access-Control-Expose-Headers", strings.Join(c.ExposedHeaders, ", "))
+				}
+			} else if c.allowedOrigin(origin) != "*" {
+				// Response would vary if the request had an Origin
+				addVary(ctx.Header(), "Origin")
+			}
+		}
+		handler(ctx)
+	}
+}
+
+func corsContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value || (value == "HEAD" && v == "GET") {
+			return true
+		}
+	}
+	return false
+}
+
+// wildcardMatch returns true iff s matches the given
+// pattern, where "*" matches any sequence of characters.
+func wildcardMatch(pattern string, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, p := range parts[1 : len(parts)-1] {
+		idx := strings.Index(s, p)
+		if idx < 0 {
+			return false
+		}
+		s = s[idx+len(p):]
+	}
+	return len(s) >= len(last) && strings.HasSuffix(s, last)
+}
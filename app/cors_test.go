@@ -0,0 +1,142 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWildcardMatch(t *testing.T) {
+	cases := []struct {
+		pattern string
+		s       string
+		match   bool
+	}{
+		{"https://example.com", "https://example.com", true},
+		{"https://example.com", "https://example.org", false},
+		{"https://*.example.com", "https://www.example.com", true},
+		{"https://*.example.com", "https://example.com", false},
+		{"https://*.example.com", "https://evil.com/.example.com", true},
+		{"https://*.example.com", "http://www.example.com", false},
+		{"*://*.example.*", "http://a.example.org", true},
+	}
+	for _, v := range cases {
+		if m := wildcardMatch(v.pattern, v.s); m != v.match {
+			t.Errorf("wildcardMatch(%q, %q) = %v, want %v", v.pattern, v.s, m, v.match)
+		}
+	}
+}
+
+func corsRequest(a *App, method string, path string, header map[string]string) *httptest.ResponseRecorder {
+	r, _ := http.NewRequest(method, "http://www.example.com"+path, nil)
+	for k, v := range header {
+		r.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	return w
+}
+
+func TestCORS(t *testing.T) {
+	a := New()
+	a.CORS = &CORS{
+		AllowedOrigins:   []string{"https://*.example.com"},
+		AllowedHeaders:   []string{"Content-Type"},
+		ExposedHeaders:   []string{"X-Total"},
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	called := 0
+	handler := func(ctx *Context) {
+		called++
+		ctx.WriteString("ok")
+	}
+	a.HandleOptions("^/api/$", handler, &HandlerOptions{Methods: []string{"GET", "PUT"}})
+	a.HandleOptions("^/public/$", handler, &HandlerOptions{CORS: &CORS{AllowedOrigins: []string{"*"}}})
+
+	w := corsRequest(a, "OPTIONS", "/api/", map[string]string{
+		"Origin":                         "https://app.example.com",
+		"Access-Control-Request-Method":  "PUT",
+		"Access-Control-Request-Headers": "content-type",
+	})
+	if called != 0 {
+		t.Errorf("handler was called for preflight request")
+	}
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expecting preflight status 204, got %d", w.Code)
+	}
+	expect := map[string]string{
+		"Access-Control-Allow-Origin":      "https://app.example.com",
+		"Access-Control-Allow-Credentials": "true",
+		"Access-Control-Allow-Methods":     "GET, PUT",
+		"Access-Control-Allow-Headers":     "content-type",
+		"Access-Control-Max-Age":           "600",
+		"Vary":                             "Origin",
+	}
+	for k, v := range expect {
+		if h := w.Header().Get(k); h != v {
+			t.Errorf("expecting preflight header %s = %q, got %q", k, v, h)
+		}
+	}
+	// Disallowed header
+	w = corsRequest(a, "OPTIONS", "/api/", map[string]string{
+		"Origin":                         "https://app.example.com",
+		"Access-Control-Request-Method":  "PUT",
+		"Access-Control-Request-Headers": "X-Other",
+	})
+	if h := w.Header().Get("Access-Control-Allow-Origin"); h != "" {
+		t.Errorf("expecting no Access-Control-Allow-Origin for disallowed header, got %q", h)
+	}
+	// Disallowed origin
+	w = corsRequest(a, "GET", "/api/", map[string]string{"Origin": "https://evil.com"})
+	if h := w.Header().Get("Access-Control-Allow-Origin"); h != "" {
+		t.Errorf("expecting no Access-Control-Allow-Origin for disallowed origin, got %q", h)
+	}
+	if h := w.Header().Get("Vary"); h != "Origin" {
+		t.Errorf("expecting Vary: Origin, got %q", h)
+	}
+	// Actual request
+	w = corsRequest(a, "GET", "/api/", map[string]string{"Origin": "https://app.example.com"})
+	if called != 2 || w.Body.String() != "ok" {
+		t.Errorf("handler was not called for actual requests")
+	}
+	if h := w.Header().Get("Access-Control-Allow-Origin"); h != "https://app.example.com" {
+		t.Errorf("expecting Access-Control-Allow-Origin https://app.example.com, got %q", h)
+	}
+	if h := w.Header().Get("Access-Control-Expose-Headers"); h != "X-Total" {
+		t.Errorf("expecting Access-Control-Expose-Headers X-Total, got %q", h)
+	}
+	// Handler policy
+	w = corsRequest(a, "GET", "/public/", map[string]string{"Origin": "https://other.org"})
+	if h := w.Header().Get("Access-Control-Allow-Origin"); h != "*" {
+		t.Errorf("expecting Access-Control-Allow-Origin *, got %q", h)
+	}
+	if h := w.Header().Get("Vary"); h != "" {
+		t.Errorf("expecting no Vary header with any origin, got %q", h)
+	}
+}
+
+func TestCORSAnyOriginCredentials(t *testing.T) {
+	policy := &CORS{AllowedOrigins: []string{"*"}, AllowCredentials: true}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expecting HandleOptions to panic with any origin and credentials")
+			}
+		}()
+		New().HandleOptions("^/$", func(ctx *Context) {}, &HandlerOptions{CORS: policy})
+	}()
+	a := New()
+	a.CORS = policy
+	if err := a.Prepare(); err != errCORSAnyOriginCredentials {
+		t.Errorf("expecting Prepare to reject any origin with credentials, got %v", err)
+	}
+	a.Handle("^/$", func(ctx *Context) {})
+	w := corsRequest(a, "GET", "/", map[string]string{"Origin": "https://evil.com"})
+	if h := w.Header().Get("Access-Control-Allow-Origin"); h != "*" {
+		t.Errorf("expecting Access-Control-Allow-Origin *, got %q", h)
+	}
+	if h := w.Header().Get("Access-Control-Allow-Credentials"); h != "" {
+		t.Errorf("expecting no Access-Control-Allow-Credentials for any origin, got %q", h)
+	}
+}
@@ -0,0 +1,166 @@
+package app
+
+import (
+	"crypto/subtle"
+	"html/template"
+
+	"gnd.la/app/cookies"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// CSRF_COOKIE_NAME is the name of the cookie used to store the
+	// CSRF token. The cookie is signed using the gnd.la/app.App secret.
+	CSRF_COOKIE_NAME = "csrf"
+	// DefaultCSRFHeader is the default header used for submitting
+	// CSRF tokens. See CSRF for more details.
+	DefaultCSRFHeader = "X-CSRF-Token"
+	// DefaultCSRFField is the default form field used for submitting
+	// CSRF tokens. See CSRF for more details.
+	DefaultCSRFField = "csrf_token"
+
+	csrfTokenLength = 32
+)
+
+// CSRF represents the options for App-wide CSRF protection, which is
+// enabled by setting the App CSRF field. Once enabled, every request
+// using an unsafe method (anything besides GET, HEAD, OPTIONS and
+// TRACE) must include the token returned by Context.CSRFToken, either
+// in the header indicated by Header or in the form field indicated by
+// Field, otherwise the request is rejected with a 403 error.
+//
+// Protection uses the double submit pattern: the token is stored in
+// a signed cookie named CSRF_COOKIE_NAME and compared to the submitted
+// one, so the attacker can't forge it. Templates might obtain the
+// token with the csrf_token function, in order to pass it to
+// JavaScript code e.g.
+//
+//	<meta name="csrf-token" content="{{ csrf_token }}">
+//
+// HTML forms might include the token using Context.CSRFInput, which is
+// automatically done by the forms rendered with gnd.la/form.
+//
+// Requests authenticated by any of the App Authenticators using the
+// Authorization header (e.g. Bearer tokens or SignedRequests) are not
+// checked, since browsers don't add that header to cross-site requests.
+// Handlers might be exempted from these checks by setting CSRFExempt
+// in their HandlerOptions, while whole apps (e.g. included ones) might
+// be exempted by setting App.CSRFExempt. The check runs after the Transformers added
+// with App.AddTransformer and before the Group and HandlerOptions ones.
+// Note that this protection is independent from the one implemented
+// by gnd.la/form.
+type CSRF struct {
+	// Header is the name of the header which contains the token.
+	// If empty, DefaultCSRFHeader is used.
+	Header string
+	// Field is the name of the form field which contains the token.
+	// If empty, DefaultCSRFField is used.
+	Field string
+}
+
+func (c *CSRF) header() string {
+	if c.Header != "" {
+		return c.Header
+	}
+	return DefaultCSRFHeader
+}
+
+func (c *CSRF) field() string {
+	if c.Field != "" {
+		return c.Field
+	}
+	return DefaultCSRFField
+}
+
+// CSRFToken returns the CSRF token for the current client, generating
+// a new one if the client has no token yet. Since generating a token
+// requires setting a cookie, this function must be called before
+// writing the response body. See CSRF for more details.
+func (c *Context) CSRFToken() string {
+	if c.csrfToken == "" {
+		c.csrfToken = c.cookieCSRFToken()
+		if c.csrfToken == "" {
+			token := stringutil.Random(csrfTokenLength)
+			var opts cookies.Options
+			if o := c.app.CookieOptions; o != nil {
+				opts = *o
+			} else {
+				opts = *cookies.Defaults()
+			}
+			opts.HttpOnly = true
+			if err := c.Cookies().SetSecureOpts(CSRF_COOKIE_NAME, token, &opts); err != nil {
+				panic(err)
+			}
+			c.csrfToken = token
+		}
+	}
+	return c.csrfToken
+}
+
+// CSRFInput returns a hidden input field which contains the token returned
+// by CSRFToken, using the field name indicated by the App CSRF options. If
+// the App has no CSRF protection, it returns an empty string. Like CSRFToken,
+// it must be called before writing the response body.
+func (c *Context) CSRFInput() template.HTML {
+	cfg := c.app.CSRF
+	if cfg == nil {
+		return ""
+	}
+	return template.HTML(`<input type="hidden" name="` + template.HTMLEscapeString(cfg.field()) +
+		`" value="` + template.HTMLEscapeString(c.CSRFToken()) + `">`)
+}
+
+func (c *Context) cookieCSRFToken() string {
+	var token string
+	if c.Cookies().GetSecure(CSRF_COOKIE_NAME, &token) != nil || len(token) != csrfTokenLength {
+		return ""
+	}
+	return token
+}
+
+// checkCSRF returns true iff the request is allowed by the
+// App CSRF protection.
+func (c *Context) checkCSRF() bool {
+	cfg := c.app.CSRF
+	if cfg == nil || c.app.CSRFExempt {
+		return true
+	}
+	switch c.R.Method {
+	case "GET", "HEAD", "OPTIONS", "TRACE":
+		return true
+	}
+	// Requests authenticated by the credentials in the Authorization
+	// header can't be forged by other sites. Note that this runs the
+	// Authenticators before the body is read for the form field, so
+	// they can still hash it.
+	if c.R.Header.Get("Authorization") != "" && len(c.app.Authenticators) > 0 {
+		if c.User(); c.authenticated {
+			return true
+		}
+	}
+	expected := c.cookieCSRFToken()
+	if expected == "" {
+		return false
+	}
+	token := c.R.Header.Get(cfg.header())
+	if token == "" {
+		token = c.FormValue(cfg.field())
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1
+}
+
+// csrfHandler returns a Handler which checks the request against
+// the App CSRF protection before calling handler.
+func csrfHandler(handler Handler) Handler {
+	return func(ctx *Context) {
+		if !ctx.checkCSRF() {
+			ctx.Forbidden("invalid CSRF token")
+			return
+		}
+		handler(ctx)
+	}
+}
+
+func template_csrf_token(ctx *Context) string {
+	return ctx.CSRFToken()
+}
@@ -0,0 +1,148 @@
+package app
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestCSRF(t *testing.T) {
+	a := New()
+	a.Config().Secret = "0123456789abcdef0123456789abcdef"
+	a.CSRF = &CSRF{}
+	a.Handle("^/token/$", func(ctx *Context) {
+		ctx.WriteString(ctx.CSRFToken())
+	})
+	a.Handle("^/post/$", func(ctx *Context) {
+		ctx.WriteString("ok")
+	})
+	a.HandleOptions("^/exempt/$", func(ctx *Context) {
+		ctx.WriteString("ok")
+	}, &HandlerOptions{CSRFExempt: true})
+	srv := httptest.NewServer(a)
+	defer srv.Close()
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{Jar: jar}
+	post := func(path string, form url.Values, header string) int {
+		req, err := http.NewRequest("POST", srv.URL+path, strings.NewReader(form.Encode()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if header != "" {
+			req.Header.Set(DefaultCSRFHeader, header)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if code := post("/post/", nil, ""); code != http.StatusForbidden {
+		t.Errorf("expecting 403 without a token, got %d", code)
+	}
+	if code := post("/exempt/", nil, ""); code != http.StatusOK {
+		t.Errorf("expecting 200 for exempt handler, got %d", code)
+	}
+	token := sessionGet(t, client, srv.URL+"/token/")
+	if token2 := sessionGet(t, client, srv.URL+"/token/"); token2 != token {
+		t.Errorf("token changed between requests: %q != %q", token, token2)
+	}
+	if code := post("/post/", nil, "bad"); code != http.StatusForbidden {
+		t.Errorf("expecting 403 with an invalid token, got %d", code)
+	}
+	if code := post("/post/", nil, token); code != http.StatusOK {
+		t.Errorf("expecting 200 with token in header, got %d", code)
+	}
+	if code := post("/post/", url.Values{DefaultCSRFField: {token}}, ""); code != http.StatusOK {
+		t.Errorf("expecting 200 with token in form, got %d", code)
+	}
+	if v := sessionGet(t, client, srv.URL+"/post/"); v != "ok" {
+		t.Errorf("expecting safe method to be allowed, got %q", v)
+	}
+}
+
+func TestCSRFSignedRequests(t *testing.T) {
+	key := []byte("0123456789abcdef")
+	a := newAuthApp(&SignedRequests{
+		Key: func(ctx *Context, keyID string) ([]byte, User, error) {
+			if keyID == "k1" {
+				return key, &accessUser{id: 3}, nil
+			}
+			return nil, nil, nil
+		},
+	})
+	a.Config().Secret = "0123456789abcdef0123456789abcdef"
+	a.CSRF = &CSRF{}
+	newRequest := func() *http.Request {
+		r, _ := http.NewRequest("POST", "http://www.example.com/", strings.NewReader("a=b"))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return r
+	}
+	r := newRequest()
+	SignRequest(r, "k1", key)
+	if w := authRequest(a, r); w.Code != http.StatusOK || w.Body.String() != "3:a=b" {
+		t.Errorf("expecting signed request to bypass CSRF, got %d %q", w.Code, w.Body.String())
+	}
+	r = newRequest()
+	SignRequest(r, "k2", key)
+	if w := authRequest(a, r); w.Code != http.StatusForbidden {
+		t.Errorf("expecting request with invalid signature to fail the CSRF check, got %d", w.Code)
+	}
+}
+
+func TestCSRFInput(t *testing.T) {
+	a := New()
+	a.Config().Secret = "0123456789abcdef0123456789abcdef"
+	a.Handle("^/$", func(ctx *Context) {
+		ctx.WriteString(string(ctx.CSRFInput()) + "|" + ctx.CSRFToken())
+	})
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "http://www.example.com/", nil)
+	a.ServeHTTP(w, r)
+	if s := w.Body.String(); !strings.HasPrefix(s, "|") {
+		t.Errorf("expecting no input without CSRF protection, got %q", s)
+	}
+	a.CSRF = &CSRF{Field: "token"}
+	w = httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	parts := strings.SplitN(w.Body.String(), "|", 2)
+	if expect := `<input type="hidden" name="token" value="` + parts[1] + `">`; parts[0] != expect {
+		t.Errorf("expecting input %q, got %q", expect, parts[0])
+	}
+}
+
+func TestCSRFExemptApp(t *testing.T) {
+	a := New()
+	a.Config().Secret = "0123456789abcdef0123456789abcdef"
+	a.CSRF = &CSRF{}
+	ok := func(ctx *Context) { ctx.WriteString("ok") }
+	checked := New()
+	checked.SetName("checked")
+	checked.Handle("^/post/$", ok)
+	exempt := New()
+	exempt.SetName("exempt")
+	exempt.CSRFExempt = true
+	exempt.Handle("^/post/$", ok)
+	a.Include("/checked/", checked, "")
+	a.Include("/exempt/", exempt, "")
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]int{
+		"/checked/post/": http.StatusForbidden,
+		"/exempt/post/":  http.StatusOK,
+	}
+	for path, code := range cases {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("POST", "http://www.example.com"+path, nil)
+		a.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("expecting code %d for %s, got %d", code, path, w.Code)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrEventStreamClosed is returned when sending an event after
+	// the client has disconnected or the stream has been closed.
+	ErrEventStreamClosed = errors.New("event stream is closed")
+
+	errCantFlush    = errors.New("the ResponseWriter does not support flushing")
+	errInvalidField = errors.New("event id and type can't contain newlines")
+)
+
+// Event represents a Server-Sent Event. See
+// http://www.w3.org/TR/eventsource/ for more information.
+type Event struct {
+	// Id is the event id. Clients send the last received id in the
+	// Last-Event-ID header when reconnecting, so the server might
+	// resume the stream (see EventStream.LastEventId). Optional.
+	Id string
+	// Event is the event type. If empty, clients dispatch the
+	// event as a message event.
+	Event string
+	// Data is the event payload. Data containing newlines is sent
+	// as multiple data lines, which the client joins back.
+	Data string
+	// Retry, if positive, tells the client how many milliseconds it
+	// should wait before reconnecting after losing the connection.
+	Retry int
+}
+
+// EventStream writes Server-Sent Events to the client. Use
+// Context.EventStream to obtain an EventStream.
+type EventStream struct {
+	ctx     *Context
+	flusher http.Flusher
+	done    <-chan struct{}
+	closed  bool
+}
+
+// EventStream starts a Server-Sent Events stream, sending the
+// response headers to the client. Once the stream has started,
+// the handler must send data only using the returned *EventStream,
+// which flushes every event as soon as it's sent. The stream ends
+// when the handler returns, when the client disconnects or when the
+// handler timeout expires, which can be detected using EventStream.Done. e.g.
+//
+//	stream, err := ctx.EventStream()
+//	if err != nil {
+//		panic(err)
+//	}
+//	for {
+//		select {
+//		case <-stream.Done():
+//			return
+//		case p := <-progress:
+//			stream.Send(&app.Event{Event: "progress", Data: p})
+//		}
+//	}
+//
+// Note that handlers which use an EventStream must not be wrapped by
+// Transformers which buffer the response, like ETag or gnd.la/cache/layer.
+// When the App has Compression enabled, the stream is compressed too
+// (text/event-stream matches the default text/* content type), with
+// each event flushed through the compressor as soon as it's sent. Set
+// Compression.ContentTypes without it to disable compressing streams.
+func (c *Context) EventStream() (*EventStream, error) {
+	flusher, ok := c.ResponseWriter.(http.Flusher)
+	if !ok {
+		return nil, errCantFlush
+	}
+	h := c.Header()
+	h.Set("Content-Type", "text/event-stream; charset=utf-8")
+	h.Set("Cache-Control", "no-cache")
+	// Disable buffering in nginx
+	h.Set("X-Accel-Buffering", "no")
+	h.Del("Content-Length")
+	c.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	return &EventStream{
+		ctx:     c,
+		flusher: flusher,
+		done:    c.Done(),
+	}, nil
+}
+
+// LastEventId returns the id of the last event received by the
+// client, as sent in the Last-Event-ID header when reconnecting,
+// or an empty string if this is the first connection.
+func (s *EventStream) LastEventId() string {
+	return s.ctx.R.Header.Get("Last-Event-ID")
+}
+
+// Done returns a channel which is closed when the client
+// disconnects or when the handler timeout expires (see
+// HandlerOptions.Timeout).
+func (s *EventStream) Done() <-chan struct{} {
+	return s.done
+}
+
+// Closed returns true iff the client has disconnected or
+// a previous write failed.
+func (s *EventStream) Closed() bool {
+	if !s.closed {
+		select {
+		case <-s.done:
+			s.closed = true
+		default:
+		}
+	}
+	return s.closed
+}
+
+// Send sends the given event to the client and flushes it.
+// If the client has disconnected, ErrEventStreamClosed is
+// returned.
+func (s *EventStream) Send(e *Event) error {
+	if strings.ContainsAny(e.Id, "\r\n") || strings.ContainsAny(e.Event, "\r\n") {
+		return errInvalidField
+	}
+	var buf []byte
+	if e.Id != "" {
+		buf = append(buf, "id: "+e.Id+"\n"...)
+	}
+	if e.Event != "" {
+		buf = append(buf, "event: "+e.Event+"\n"...)
+	}
+	if e.Retry > 0 {
+		buf = append(buf, "retry: "+strconv.Itoa(e.Retry)+"\n"...)
+	}
+	data := strings.Replace(e.Data, "\r\n", "\n", -1)
+	for _, v := range strings.Split(data, "\n") {
+		buf = append(buf, "data: "+v+"\n"...)
+	}
+	buf = append(buf, '\n')
+	return s.write(buf)
+}
+
+// SendData is a shorthand for sending an Event with just
+// the given data.
+func (s *EventStream) SendData(data string) error {
+	return s.Send(&Event{Data: data})
+}
+
+// Comment sends a comment line, which is ignored by the client.
+// Sending comments periodically prevents proxies from closing
+// idle connections.
+func (s *EventStream) Comment(text string) error {
+	text = strings.Replace(text, "\n", " ", -1)
+	return s.write([]byte(": " + text + "\n\n"))
+}
+
+func (s *EventStream) write(data []byte) error {
+	if s.Closed() {
+		return ErrEventStreamClosed
+	}
+	if _, err := s.ctx.Write(data); err != nil {
+		s.closed = true
+		return ErrEventStreamClosed
+	}
+	s.flusher.Flush()
+	return nil
+}
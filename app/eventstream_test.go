@@ -0,0 +1,101 @@
+package app
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func readEvent(t *testing.T, r *bufio.Reader) []string {
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return lines
+		}
+		lines = append(lines, line)
+	}
+}
+
+func TestEventStream(t *testing.T) {
+	a := New()
+	disconnected := make(chan error, 1)
+	a.Handle("^/events/$", func(ctx *Context) {
+		stream, err := ctx.EventStream()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		start := 0
+		if id := stream.LastEventId(); id != "" {
+			start, _ = strconv.Atoi(id)
+			start++
+		}
+		stream.Comment("hello")
+		for ii := start; ii < start+2; ii++ {
+			stream.Send(&Event{Id: strconv.Itoa(ii), Event: "count", Data: "line1\nline2", Retry: 1000})
+		}
+		if err := stream.Send(&Event{Event: "bad\nevent"}); err == nil {
+			t.Error("expecting an error with a newline in the event type")
+		}
+		// Keep sending until the client disconnects
+		for {
+			select {
+			case <-stream.Done():
+				disconnected <- stream.SendData("gone")
+				return
+			case <-time.After(10 * time.Millisecond):
+				if err := stream.SendData("ping"); err != nil {
+					disconnected <- err
+					return
+				}
+			}
+		}
+	})
+	srv := httptest.NewServer(a)
+	defer srv.Close()
+
+	req, _ := http.NewRequest("GET", srv.URL+"/events/", nil)
+	req.Header.Set("Last-Event-ID", "4")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/event-stream") {
+		t.Errorf("expecting event stream Content-Type, got %q", ct)
+	}
+	if cc := resp.Header.Get("Cache-Control"); cc != "no-cache" {
+		t.Errorf("expecting Cache-Control no-cache, got %q", cc)
+	}
+	r := bufio.NewReader(resp.Body)
+	if lines := readEvent(t, r); len(lines) != 1 || lines[0] != ": hello" {
+		t.Errorf("expecting comment, got %q", lines)
+	}
+	for _, id := range []string{"5", "6"} {
+		expect := []string{"id: " + id, "event: count", "retry: 1000", "data: line1", "data: line2"}
+		lines := readEvent(t, r)
+		if strings.Join(lines, "|") != strings.Join(expect, "|") {
+			t.Errorf("expecting event %q, got %q", expect, lines)
+		}
+	}
+	if lines := readEvent(t, r); len(lines) != 1 || lines[0] != "data: ping" {
+		t.Errorf("expecting ping, got %q", lines)
+	}
+	resp.Body.Close()
+	select {
+	case err := <-disconnected:
+		if err != ErrEventStreamClosed {
+			t.Errorf("expecting ErrEventStreamClosed after disconnecting, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler did not detect client disconnection")
+	}
+}
@@ -0,0 +1,136 @@
+package app
+
+import (
+	"encoding/gob"
+	"fmt"
+)
+
+const (
+	// FLASH_COOKIE_NAME is the name of the cookie used to store
+	// the pending flash messages when the App does not use
+	// server side sessions. The cookie is signed using the
+	// gnd.la/app.App secret.
+	FLASH_COOKIE_NAME = "flashes"
+
+	// Key used to store the flash messages in the session
+	flashSessionKey = "gnd.la/app.flashes"
+)
+
+// FlashLevel indicates the severity of a Flash message.
+type FlashLevel int
+
+const (
+	FlashInfo FlashLevel = iota
+	FlashSuccess
+	FlashWarning
+	FlashError
+)
+
+func (l FlashLevel) String() string {
+	switch l {
+	case FlashInfo:
+		return "info"
+	case FlashSuccess:
+		return "success"
+	case FlashWarning:
+		return "warning"
+	case FlashError:
+		return "error"
+	}
+	return fmt.Sprintf("FlashLevel(%d)", int(l))
+}
+
+// Flash is a message which is stored until the next time
+// the user sees a page, usually after a redirect. See
+// Context.AddFlash and Context.Flashes for more details.
+type Flash struct {
+	Level   FlashLevel
+	Message string
+}
+
+// flashes holds the flash messages for the current request
+type flashes struct {
+	messages []*Flash
+	dirty    bool
+}
+
+// AddFlash adds a message with the given level which will be shown
+// to the user the next time Flashes is called, either during this
+// request or a subsequent one, e.g.
+//
+//	ctx.AddFlash(app.FlashSuccess, ctx.T("Your changes have been saved"))
+//	ctx.RedirectBack()
+//
+// Messages are stored in the session when the App uses server side
+// sessions (see App.SessionStore) or in a signed cookie named
+// FLASH_COOKIE_NAME otherwise. Since they're saved right before
+// the response headers are sent, messages must be added before
+// writing the response body.
+func (c *Context) AddFlash(level FlashLevel, message string) {
+	f := c.loadFlashes()
+	f.messages = append(f.messages, &Flash{Level: level, Message: message})
+	f.dirty = true
+}
+
+// Flashes returns the pending flash messages, in the same order they
+// were added, and removes them, so each message is returned only once.
+// Templates might render the pending messages using the flashes
+// function, which calls this method. See also gnd.la/frontend/bootstrap.FlashAlerts.
+func (c *Context) Flashes() []*Flash {
+	f := c.loadFlashes()
+	messages := f.messages
+	if len(messages) > 0 {
+		f.messages = nil
+		f.dirty = true
+	}
+	return messages
+}
+
+func (c *Context) loadFlashes() *flashes {
+	if c.flashes == nil {
+		var messages []*Flash
+		if c.app.usesServerSessions() {
+			messages, _ = c.Session().Get(flashSessionKey).([]*Flash)
+		} else if c.Cookies().Has(FLASH_COOKIE_NAME) {
+			if err := c.Cookies().GetSecure(FLASH_COOKIE_NAME, &messages); err != nil {
+				c.Logger().Errorf("error loading flash messages: %s", err)
+			}
+		}
+		c.flashes = &flashes{messages: messages}
+	}
+	return c.flashes
+}
+
+// saveFlashes stores the flash messages if they were modified. It
+// must be called before saving the session.
+func (c *Context) saveFlashes() {
+	f := c.flashes
+	if f == nil || !f.dirty {
+		return
+	}
+	f.dirty = false
+	if c.app.usesServerSessions() {
+		if len(f.messages) > 0 {
+			c.Session().Set(flashSessionKey, f.messages)
+		} else {
+			c.Session().Delete(flashSessionKey)
+		}
+		return
+	}
+	if len(f.messages) > 0 {
+		if err := c.Cookies().SetSecure(FLASH_COOKIE_NAME, f.messages); err != nil {
+			c.Logger().Errorf("error saving flash messages: %s", err)
+		}
+	} else if c.Cookies().Has(FLASH_COOKIE_NAME) {
+		c.Cookies().Delete(FLASH_COOKIE_NAME)
+	}
+}
+
+func template_flashes(ctx *Context) []*Flash {
+	return ctx.Flashes()
+}
+
+func init() {
+	// Flashes are stored as a session value
+	gob.Register([]*Flash(nil))
+}
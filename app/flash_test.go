@@ -0,0 +1,41 @@
+package app
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+	"testing"
+)
+
+func testFlashes(t *testing.T, store SessionStore) {
+	a, srv := newSessionApp(t, store)
+	defer srv.Close()
+	a.Handle("^/flash/add/$", func(ctx *Context) {
+		ctx.AddFlash(FlashSuccess, "saved")
+		ctx.AddFlash(FlashError, ctx.FormValue("m"))
+		ctx.Redirect("/flash/show/", false)
+	})
+	a.Handle("^/flash/show/$", func(ctx *Context) {
+		var msgs []string
+		for _, v := range ctx.Flashes() {
+			msgs = append(msgs, v.Level.String()+":"+v.Message)
+		}
+		ctx.WriteString(strings.Join(msgs, ","))
+	})
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{Jar: jar}
+	if v := sessionGet(t, client, srv.URL+"/flash/add/?m=failed"); v != "success:saved,error:failed" {
+		t.Errorf("expecting flashes after redirect, got %q", v)
+	}
+	if v := sessionGet(t, client, srv.URL+"/flash/show/"); v != "" {
+		t.Errorf("expecting no flashes after consuming them, got %q", v)
+	}
+}
+
+func TestCookieFlashes(t *testing.T) {
+	testFlashes(t, nil)
+}
+
+func TestSessionFlashes(t *testing.T) {
+	testFlashes(t, &CacheSessionStore{})
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Group represents a set of handlers which share a common path
+// prefix and, optionally, a list of Transformers. Use App.Group
+// to create a Group.
+type Group struct {
+	app          *App
+	prefix       string
+	transformers []Transformer
+}
+
+// Group returns a new Group which registers its handlers in the App,
+// prepending prefix to their patterns and wrapping them with the given
+// Transformers. The prefix must be a literal path (e.g. /admin) and
+// it's prepended after the ^ in anchored patterns. Patterns without
+// ^ are prefixed as they are, so unanchored regular expressions
+// will still match anywhere in the path.
+func (app *App) Group(prefix string, transformers ...Transformer) *Group {
+	return &Group{
+		app:          app,
+		prefix:       strings.TrimSuffix(prefix, "/"),
+		transformers: transformers,
+	}
+}
+
+// Group returns a new Group nested into g. Its prefix is appended
+// to g's prefix, and its Transformers run after g's ones.
+func (g *Group) Group(prefix string, transformers ...Transformer) *Group {
+	trs := make([]Transformer, 0, len(g.transformers)+len(transformers))
+	trs = append(trs, g.transformers...)
+	trs = append(trs, transformers...)
+	return &Group{
+		app:          g.app,
+		prefix:       g.prefix + strings.TrimSuffix(prefix, "/"),
+		transformers: trs,
+	}
+}
+
+// App returns the App this Group registers its handlers into.
+func (g *Group) App() *App {
+	return g.app
+}
+
+// Prefix returns the Group prefix.
+func (g *Group) Prefix() string {
+	return g.prefix
+}
+
+// Handle is a shorthand for HandleOptions, passing nil as the Options.
+func (g *Group) Handle(pattern string, handler Handler) {
+	g.HandleOptions(pattern, handler, nil)
+}
+
+// HandleNamed is a shorthand for HandleOptions, passing an Options instance
+// with just the name set.
+func (g *Group) HandleNamed(pattern string, handler Handler, name string) {
+	g.HandleOptions(pattern, handler, &HandlerOptions{Name: name})
+}
+
+// HandleOptions works like App.HandleOptions, but prepends the Group
+// prefix to the pattern and runs the Group Transformers before the
+// ones specified in opts.
+func (g *Group) HandleOptions(pattern string, handler Handler, opts *HandlerOptions) {
+	var o HandlerOptions
+	if opts != nil {
+		o = *opts
+	}
+	if len(g.transformers) > 0 {
+		trs := make([]Transformer, 0, len(g.transformers)+len(o.Transformers))
+		trs = append(trs, g.transformers...)
+		o.Transformers = append(trs, o.Transformers...)
+	}
+	g.app.HandleOptions(g.pattern(pattern), handler, &o)
+}
+
+func (g *Group) pattern(pattern string) string {
+	if strings.HasPrefix(pattern, "^") {
+		return "^" + regexp.QuoteMeta(g.prefix) + pattern[1:]
+	}
+	return g.prefix + pattern
+}
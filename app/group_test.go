@@ -0,0 +1,64 @@
+package app
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTransformerOrder(t *testing.T) {
+	var calls []string
+	tr := func(name string) Transformer {
+		return func(h Handler) Handler {
+			return func(ctx *Context) {
+				calls = append(calls, name+":"+ctx.HandlerName())
+				h(ctx)
+			}
+		}
+	}
+	a := New()
+	g := a.Group("/admin/", tr("group"))
+	g.HandleOptions("/users/{id:int}/", func(ctx *Context) {
+		calls = append(calls, "handler")
+	}, &HandlerOptions{Name: "users", Transformers: []Transformer{tr("h1"), tr("h2")}})
+	// Added after the handler, must still apply
+	a.AddTransformer(tr("app"))
+	child := New()
+	child.SetName("child")
+	child.AddTransformer(tr("child"))
+	child.HandleNamed("^/$", func(ctx *Context) {
+		calls = append(calls, "child-handler")
+	}, "child-index")
+	a.Include("/child/", child, "")
+
+	tests := []struct {
+		path  string
+		calls []string
+	}{
+		{"/admin/users/3/", []string{"app:users", "group:users", "h1:users", "h2:users", "handler"}},
+		{"/child/", []string{"app:", "child:child-index", "child-handler"}},
+	}
+	for _, v := range tests {
+		calls = nil
+		req, _ := http.NewRequest("GET", "http://localhost"+v.path, nil)
+		a.ServeHTTP(discard, req)
+		if !reflect.DeepEqual(calls, v.calls) {
+			t.Errorf("expecting calls %v for %s, got %v", v.calls, v.path, calls)
+		}
+	}
+	testReverse(t, "/admin/users/3/", a, "users", []interface{}{3})
+}
+
+func TestGroupPattern(t *testing.T) {
+	a := New()
+	g := a.Group("/api").Group("/v1/")
+	tests := map[string]string{
+		"/users/":         "/api/v1/users/",
+		"^/users/(\\d+)$": "^/api/v1/users/(\\d+)$",
+	}
+	for k, v := range tests {
+		if p := g.pattern(k); p != v {
+			t.Errorf("expecting pattern %q for %q, got %q", v, k, p)
+		}
+	}
+}
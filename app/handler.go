@@ -1,6 +1,9 @@
 package app
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 // Handler is the function type used to satisfy a request
 // (not necessarily HTTP) with a given *Context.
@@ -19,6 +22,48 @@ type HandlerOptions struct {
 	// Host specifies the host the Handler will match. If non-empty,
 	// only requests to this specific host will match the Handler.
 	Host string
+	// Methods indicates the HTTP methods accepted by the Handler
+	// (e.g. "GET", "POST"). If empty, the Handler accepts any method.
+	// Handlers accepting GET also accept HEAD, while OPTIONS requests
+	// are answered automatically for Handlers which declare their
+	// methods. When the path matches at least one Handler but
+	// none of them accepts the request method, the App replies with
+	// a 405 status code and an Allow header.
+	Methods []string
+	// Transformers are applied to the Handler when it's registered,
+	// in such a way that the first one is the outermost and runs
+	// first. See App.AddTransformer for how they interact with
+	// the App and Group Transformers.
+	Transformers []Transformer
+	// CSRFExempt disables the App CSRF protection for the
+	// Handler. See App.CSRF for more details.
+	CSRFExempt bool
+	// CORS is the CORS policy for the Handler. If nil, the App
+	// policy is used. See CORS for more details.
+	CORS *CORS
+	// RequireUser indicates that the Handler requires a signed in
+	// user. Requests without one made from a browser are redirected
+	// to the App sign in handler (see App.SignInHandler), passing the
+	// current URL in the SignInFromParameterName parameter, while XHR
+	// requests and requests with an Authorization header (see
+	// Authenticator) receive a 401 status code.
+	RequireUser bool
+	// RequireAdmin indicates that the Handler requires a signed in
+	// user which is an administrator (see User.IsAdmin). It implies
+	// RequireUser. Signed in users which are not administrators
+	// receive a 403 status code.
+	RequireAdmin bool
+	// Authorize, if non-nil, is called with the signed in user to
+	// decide if it can access the Handler. It implies RequireUser.
+	// Users which are not authorized receive a 403 status code.
+	Authorize func(User) bool
+	// Timeout, if positive, is the maximum time the Handler might
+	// run for. Once it expires, the Context is cancelled (see
+	// Context.Deadline), which aborts any in-flight ORM queries,
+	// HTTP requests made with gnd.la/net/httpclient and template
+	// executions using it. If the Handler panics after the timeout
+	// has expired, the client receives a 503 status code.
+	Timeout time.Duration
 }
 
 type HandlerInfo struct {
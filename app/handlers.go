@@ -80,9 +80,10 @@ type DataHandler func(*Context) (interface{}, error)
 
 // JSONHandler returns a Handler which executes the given DataHandler
 // to obtain the data and, if it succeeds, serializes the data using
-// JSON and returns it back to the client.
+// JSON and returns it back to the client. An ETag computed from the
+// serialized data is added to the response (see ETag).
 func JSONHandler(dataHandler DataHandler) Handler {
-	return func(ctx *Context) {
+	return ETag(func(ctx *Context) {
 		data, err := dataHandler(ctx)
 		if err != nil {
 			panic(err)
@@ -90,18 +91,19 @@ func JSONHandler(dataHandler DataHandler) Handler {
 		if _, err := ctx.WriteJSON(data); err != nil {
 			panic(err)
 		}
-	}
+	})
 }
 
 // ExecuteHandler returns a Handler which executes the given DataHandler
 // to obtain the data and, if it succeeds, executes the given template
-// passing it the obtained data.
+// passing it the obtained data. An ETag computed from the template
+// output is added to the response (see ETag).
 func ExecuteHandler(dataHandler DataHandler, template string) Handler {
-	return func(ctx *Context) {
+	return ETag(func(ctx *Context) {
 		data, err := dataHandler(ctx)
 		if err != nil {
 			panic(err)
 		}
 		ctx.MustExecute(template, data)
-	}
+	})
 }
@@ -0,0 +1,247 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultHealthPath is the default path for the
+	// health endpoint. See Health.
+	DefaultHealthPath = "/_gondola_health"
+	// DefaultReadinessPath is the default path for the
+	// readiness endpoint. See Health.
+	DefaultReadinessPath = "/_gondola_ready"
+	// DefaultHealthTimeout is the default timeout, in
+	// seconds, for each health check.
+	DefaultHealthTimeout = 5
+)
+
+// HealthCheck is a function which checks one component the App
+// depends on, returning a non-nil error when the component is
+// not working.
+type HealthCheck func() error
+
+type namedHealthCheck struct {
+	name  string
+	check HealthCheck
+}
+
+// Health serves the health and readiness endpoints, which might be
+// used as liveness and readiness probes by an orchestrator. Assign
+// a Health to App.Health to enable them.
+//
+// Both endpoints run all the checks concurrently and reply with a JSON
+// object which contains the status of each component, using a 200 status
+// code when all the checks succeed and 503 otherwise. The ORM, cache and
+// blobstore are checked automatically when they're configured, while
+// additional checks might be registered using AddCheck. e.g.
+//
+//	health := &app.Health{}
+//	health.AddCheck("payments", func() error {
+//		return payments.Ping()
+//	})
+//	App.Health = health
+//
+// Additionally, the readiness endpoint reports the App as not ready
+// until it has started listening (see DID_LISTEN) and once it starts
+// shutting down (see App.Shutdown), so load balancers can stop sending
+// it new requests. The App keeps serving requests while it reports itself
+// as stopping for the number of seconds indicated by the ShutdownDrainDelay
+// configuration field. Apps which are served without calling ListenAndServe
+// (e.g. from their own http.Server) must call App.SetReady once they're
+// able to handle requests.
+//
+// The errors returned by the checks are only included in the responses
+// to requests from the local host which don't come through a proxy, since
+// they might reveal details about the backends, unless ShowErrors is true.
+type Health struct {
+	// Path is the path for the health endpoint. If empty,
+	// DefaultHealthPath is used.
+	Path string
+	// ReadinessPath is the path for the readiness endpoint.
+	// If empty, DefaultReadinessPath is used.
+	ReadinessPath string
+	// Timeout is the maximum number of seconds to wait for
+	// each check. If zero, DefaultHealthTimeout is used.
+	Timeout int
+	// ShowErrors includes the errors returned by the checks
+	// in the responses to any request, not just the local ones.
+	ShowErrors bool
+	mu         sync.RWMutex
+	checks     []*namedHealthCheck
+}
+
+// AddCheck registers an additional check with the given name,
+// which is used as the component name in the responses.
+func (h *Health) AddCheck(name string, check HealthCheck) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checks = append(h.checks, &namedHealthCheck{name: name, check: check})
+}
+
+func (h *Health) healthPath() string {
+	if h.Path != "" {
+		return h.Path
+	}
+	return DefaultHealthPath
+}
+
+func (h *Health) readinessPath() string {
+	if h.ReadinessPath != "" {
+		return h.ReadinessPath
+	}
+	return DefaultReadinessPath
+}
+
+func (h *Health) timeout() time.Duration {
+	t := h.Timeout
+	if t <= 0 {
+		t = DefaultHealthTimeout
+	}
+	return time.Duration(t) * time.Second
+}
+
+// ComponentStatus represents the result of checking
+// a component.
+type ComponentStatus struct {
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+	Elapsed string `json:"elapsed"`
+}
+
+// HealthStatus is the response returned by the health
+// and readiness endpoints.
+type HealthStatus struct {
+	// Status is either "ok", "error" or, only for
+	// readiness, "starting" or "stopping".
+	Status     string                      `json:"status"`
+	Components map[string]*ComponentStatus `json:"components"`
+}
+
+func (app *App) healthChecks(h *Health) []*namedHealthCheck {
+	var checks []*namedHealthCheck
+	if app.cfg.Database != nil {
+		checks = append(checks, &namedHealthCheck{"orm", func() error {
+			o, err := app.Orm()
+			if err != nil {
+				return err
+			}
+			return o.Driver().Check()
+		}})
+	}
+	if app.cfg.Cache != nil {
+		checks = append(checks, &namedHealthCheck{"cache", func() error {
+			c, err := app.Cache()
+			if err != nil {
+				return err
+			}
+			return c.Check()
+		}})
+	}
+	if app.cfg.Blobstore != nil {
+		checks = append(checks, &namedHealthCheck{"blobstore", func() error {
+			s, err := app.Blobstore()
+			if err != nil {
+				return err
+			}
+			return s.Check()
+		}})
+	}
+	h.mu.RLock()
+	checks = append(checks, h.checks...)
+	h.mu.RUnlock()
+	return checks
+}
+
+func runHealthCheck(check HealthCheck, timeout time.Duration) *ComponentStatus {
+	started := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+		done <- check()
+	}()
+	var err error
+	select {
+	case err = <-done:
+	case <-time.After(timeout):
+		// The check goroutine is left running, but
+		// it won't block since done is buffered.
+		err = fmt.Errorf("timed out after %s", timeout)
+	}
+	st := &ComponentStatus{Status: "ok", Elapsed: time.Since(started).String()}
+	if err != nil {
+		st.Status = "error"
+		st.Error = err.Error()
+	}
+	return st
+}
+
+// checkHealth runs all the checks concurrently.
+func (app *App) checkHealth(h *Health) *HealthStatus {
+	checks := app.healthChecks(h)
+	status := &HealthStatus{
+		Status:     "ok",
+		Components: make(map[string]*ComponentStatus, len(checks)),
+	}
+	timeout := h.timeout()
+	results := make([]*ComponentStatus, len(checks))
+	var wg sync.WaitGroup
+	for ii, v := range checks {
+		wg.Add(1)
+		go func(ii int, check HealthCheck) {
+			results[ii] = runHealthCheck(check, timeout)
+			wg.Done()
+		}(ii, v.check)
+	}
+	wg.Wait()
+	for ii, v := range checks {
+		status.Components[v.name] = results[ii]
+		if results[ii].Status != "ok" {
+			status.Status = "error"
+		}
+	}
+	return status
+}
+
+func (app *App) serveHealth(ctx *Context) bool {
+	h := app.Health
+	if h == nil {
+		return false
+	}
+	var status *HealthStatus
+	switch ctx.R.URL.Path {
+	case h.healthPath():
+		status = app.checkHealth(h)
+	case h.readinessPath():
+		status = app.checkHealth(h)
+		app.locked(func() {
+			if app.stopping {
+				status.Status = "stopping"
+			} else if !app.listening {
+				status.Status = "starting"
+			}
+		})
+	default:
+		return false
+	}
+	if !h.ShowErrors && !isLocalRequest(ctx.R) {
+		for _, v := range status.Components {
+			v.Error = ""
+		}
+	}
+	ctx.SetHeader("Cache-Control", "no-cache")
+	if status.Status != "ok" {
+		ctx.statusCode = -http.StatusServiceUnavailable
+	}
+	if _, err := ctx.WriteJSON(status); err != nil {
+		panic(err)
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func healthRequest(t *testing.T, a *App, path string) (int, *HealthStatus) {
+	r, _ := http.NewRequest("GET", "http://www.example.com"+path, nil)
+	r.RemoteAddr = "127.0.0.1:1234"
+	return serveHealthRequest(t, a, r)
+}
+
+func serveHealthRequest(t *testing.T, a *App, r *http.Request) (int, *HealthStatus) {
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	var status HealthStatus
+	if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+		t.Fatalf("error decoding %s response %q: %s", r.URL.Path, w.Body.String(), err)
+	}
+	return w.Code, &status
+}
+
+func TestHealth(t *testing.T) {
+	a := New()
+	health := &Health{Timeout: 1}
+	health.AddCheck("ok", func() error { return nil })
+	a.Health = health
+	if code, status := healthRequest(t, a, DefaultHealthPath); code != http.StatusOK || status.Status != "ok" || status.Components["ok"].Status != "ok" {
+		t.Errorf("expecting healthy status, got %d %+v", code, status)
+	}
+	health.AddCheck("failing", func() error { return errors.New("unreachable") })
+	health.AddCheck("slow", func() error {
+		time.Sleep(3 * time.Second)
+		return nil
+	})
+	code, status := healthRequest(t, a, DefaultHealthPath)
+	if code != http.StatusServiceUnavailable || status.Status != "error" {
+		t.Errorf("expecting unhealthy status, got %d %+v", code, status)
+	}
+	if c := status.Components["failing"]; c == nil || c.Error != "unreachable" {
+		t.Errorf("expecting failing component with error, got %+v", c)
+	}
+	if c := status.Components["slow"]; c == nil || c.Status != "error" {
+		t.Errorf("expecting slow component to time out, got %+v", c)
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	a := New()
+	a.Health = &Health{}
+	expect := func(code int, st string) {
+		c, status := healthRequest(t, a, DefaultReadinessPath)
+		if c != code || status.Status != st {
+			t.Errorf("expecting readiness %d %q, got %d %q", code, st, c, status.Status)
+		}
+	}
+	expect(http.StatusServiceUnavailable, "starting")
+	a.SetReady(true)
+	expect(http.StatusOK, "ok")
+	a.stopping = true
+	expect(http.StatusServiceUnavailable, "stopping")
+}
+
+func TestHealthErrors(t *testing.T) {
+	a := New()
+	health := &Health{}
+	health.AddCheck("failing", func() error { return errors.New("unreachable") })
+	a.Health = health
+	r, _ := http.NewRequest("GET", "http://www.example.com"+DefaultHealthPath, nil)
+	r.RemoteAddr = "10.0.0.1:1234"
+	_, status := serveHealthRequest(t, a, r)
+	if c := status.Components["failing"]; c == nil || c.Status != "error" || c.Error != "" {
+		t.Errorf("expecting failing component without error for remote request, got %+v", c)
+	}
+	health.ShowErrors = true
+	_, status = serveHealthRequest(t, a, r)
+	if c := status.Components["failing"]; c == nil || c.Error != "unreachable" {
+		t.Errorf("expecting failing component with error when ShowErrors is set, got %+v", c)
+	}
+}
+
+func TestShutdownDrain(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	l.Close()
+	a := New()
+	a.Health = &Health{}
+	a.SetAddress("127.0.0.1")
+	a.Config().Port = port
+	a.Config().ShutdownDrainDelay = 1
+	served := make(chan error, 1)
+	go func() {
+		served <- a.ListenAndServe()
+	}()
+	readiness := func() int {
+		resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d%s", port, DefaultReadinessPath))
+		if err != nil {
+			return 0
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	waitFor := func(code int) {
+		deadline := time.Now().Add(2 * time.Second)
+		for readiness() != code {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for readiness %d", code)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	waitFor(http.StatusOK)
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- a.Shutdown()
+	}()
+	// The listener must keep accepting requests while
+	// the readiness endpoint reports the App as stopping.
+	waitFor(http.StatusServiceUnavailable)
+	if err := <-stopped; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-served; err != nil {
+		t.Fatal(err)
+	}
+	if c := readiness(); c != 0 {
+		t.Errorf("expecting listener to be closed after Shutdown, got status %d", c)
+	}
+}
@@ -0,0 +1,185 @@
+package app
+
+import (
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"strings"
+
+	"gnd.la/app/metrics"
+)
+
+// DefaultMetricsPath is the default path used for
+// serving the metrics. See Metrics.
+const DefaultMetricsPath = "/_gondola_metrics"
+
+var (
+	requestsTotal = metrics.NewCounter("gondola_http_requests_total",
+		"Number of HTTP requests by handler and status code class", "handler", "code")
+	requestDuration = metrics.NewHistogram("gondola_http_request_duration_seconds",
+		"HTTP request latencies in seconds by handler", nil, "handler")
+	statusClasses = []string{"1xx", "2xx", "3xx", "4xx", "5xx"}
+)
+
+// Metrics enables collecting metrics about the requests served by
+// the App, the ORM queries, the cache and the tasks, and serves them
+// using the Prometheus text format. Requests are keyed by their
+// handler name (see Context.HandlerName), so requests served by
+// unnamed handlers are aggregated together. Applications might
+// define additional metrics using gnd.la/app/metrics.
+//
+// Since metrics might leak information about the App, the endpoint
+// is protected. If neither Token nor Check are set, metrics are only
+// served to requests coming from the loopback interface without any
+// proxy headers (Forwarded or any of IPXHeaders). Note that when the
+// App runs behind a reverse proxy on the same host which doesn't add
+// those headers, every request looks local, so Token or Check must be
+// set in that case.
+type Metrics struct {
+	// Path is the path where metrics are served. If empty,
+	// DefaultMetricsPath is used.
+	Path string
+	// Token, if non-empty, must be sent by the client as a
+	// bearer token in the Authorization header. e.g. Prometheus
+	// can be configured to send it using bearer_token.
+	Token string
+	// Check, if non-nil, is called to decide if the metrics
+	// should be served for the given request. If Token is also
+	// set, both checks must succeed.
+	Check func(ctx *Context) bool
+}
+
+func (m *Metrics) path() string {
+	if m.Path != "" {
+		return m.Path
+	}
+	return DefaultMetricsPath
+}
+
+func (m *Metrics) allowed(ctx *Context) bool {
+	if m.Token == "" && m.Check == nil {
+		return isLocalRequest(ctx.R)
+	}
+	if m.Token != "" {
+		auth := ctx.R.Header.Get("Authorization")
+		const prefix = "Bearer "
+		if !strings.HasPrefix(auth, prefix) ||
+			subtle.ConstantTimeCompare([]byte(auth[len(prefix):]), []byte(m.Token)) != 1 {
+			return false
+		}
+	}
+	return m.Check == nil || m.Check(ctx)
+}
+
+// isLocalRequest returns true iff the request comes from the
+// loopback interface and it doesn't include any of the headers
+// added by proxies, since a reverse proxy running on the same
+// host would make every request look local.
+func isLocalRequest(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return false
+	}
+	if r.Header.Get("Forwarded") != "" {
+		return false
+	}
+	for _, v := range IPXHeaders {
+		if r.Header.Get(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Metrics) serve(ctx *Context) {
+	if !m.allowed(ctx) {
+		if m.Token != "" {
+			ctx.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(ctx, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		http.Error(ctx, "Forbidden", http.StatusForbidden)
+		return
+	}
+	ctx.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := metrics.Default.WriteText(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) serveMetrics(ctx *Context) bool {
+	if m := app.Metrics; m != nil && ctx.R.URL.Path == m.path() {
+		m.serve(ctx)
+		return true
+	}
+	return false
+}
+
+func statusClass(code int) string {
+	if code == 0 {
+		// Nothing was written, net/http sends a 200
+		return "2xx"
+	}
+	if idx := code/100 - 1; idx >= 0 && idx < len(statusClasses) {
+		return statusClasses[idx]
+	}
+	return "other"
+}
+
+func (app *App) recordRequest(ctx *Context) {
+	name := ctx.HandlerName()
+	requestsTotal.Inc(name, statusClass(ctx.statusCode))
+	requestDuration.Observe(ctx.Elapsed().Seconds(), name)
+}
+
+// monitorMetrics returns the aggregated metrics
+// charted in the monitor page.
+func monitorMetrics() map[string]interface{} {
+	requests := make(map[string]float64)
+	for _, v := range statusClasses {
+		requests[v] = 0
+	}
+	requestsTotal.Each(func(values []string, v float64) {
+		requests[values[1]] += v
+	})
+	cache := map[string]float64{"hit": 0, "miss": 0}
+	countByLabel("gondola_cache_requests_total", 0, cache)
+	tasks := map[string]float64{"ok": 0, "error": 0}
+	countByLabel("gondola_task_runs_total", 1, tasks)
+	return map[string]interface{}{
+		"requests": requests,
+		"latency":  histogramTotals(requestDuration),
+		"orm":      histogramTotals(metrics.Default.Lookup("gondola_orm_query_duration_seconds")),
+		"cache":    cache,
+		"tasks":    tasks,
+	}
+}
+
+// countByLabel adds the values of the counter with the given name
+// to m, grouped by the value of the label at idx.
+func countByLabel(name string, idx int, m map[string]float64) {
+	if c, ok := metrics.Default.Lookup(name).(*metrics.Counter); ok {
+		c.Each(func(values []string, v float64) {
+			m[values[idx]] += v
+		})
+	}
+}
+
+func histogramTotals(m metrics.Metric) map[string]interface{} {
+	var count uint64
+	var sum float64
+	if h, ok := m.(*metrics.Histogram); ok {
+		h.Each(func(_ []string, c uint64, s float64) {
+			count += c
+			sum += s
+		})
+	}
+	return map[string]interface{}{
+		"count": count,
+		"sum":   sum,
+	}
+}
@@ -0,0 +1,363 @@
+// Package metrics implements counters and histograms which can be
+// exported using the Prometheus text exposition format.
+//
+// Gondola uses this package for collecting metrics about the requests
+// served by an App, the ORM queries, the cache and the tasks. Metrics
+// are only collected after calling Enable, which is done by the App
+// when its Metrics field is set or when it's running in debug mode.
+// Applications might also define their own metrics, which are exported
+// alongside the builtin ones. e.g.
+//
+//	var signups = metrics.NewCounter("myapp_signups_total", "Number of sign ups", "provider")
+//
+//	func SignUpHandler(ctx *app.Context) {
+//		...
+//		signups.Inc("facebook")
+//	}
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	enabled int32
+
+	// Default is the Registry used by NewCounter and NewHistogram.
+	Default = &Registry{}
+
+	// DefaultBuckets are the buckets used by histograms when no
+	// buckets are provided, suitable for measuring durations in
+	// seconds.
+	DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+	labelReplacer = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n")
+	helpReplacer  = strings.NewReplacer("\\", "\\\\", "\n", "\\n")
+)
+
+// Enable enables collecting the builtin metrics. Note that metrics
+// can't be disabled once they've been enabled.
+func Enable() {
+	atomic.StoreInt32(&enabled, 1)
+}
+
+// Enabled returns true iff Enable has been called.
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) != 0
+}
+
+// Metric is the interface implemented by the metrics which can
+// be added to a Registry.
+type Metric interface {
+	// Name returns the metric name.
+	Name() string
+	writeText(w *bufio.Writer)
+}
+
+// Registry holds a set of metrics, which are exported in the
+// same order they were registered.
+type Registry struct {
+	mu      sync.RWMutex
+	metrics []Metric
+	names   map[string]bool
+}
+
+// Register adds the given metric to the registry. If there's already
+// a metric with the same name, it panics.
+func (r *Registry) Register(m Metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	name := m.Name()
+	if r.names[name] {
+		panic(fmt.Errorf("there's already a metric named %q", name))
+	}
+	if r.names == nil {
+		r.names = make(map[string]bool)
+	}
+	r.names[name] = true
+	r.metrics = append(r.metrics, m)
+}
+
+// Lookup returns the metric with the given name, or nil
+// if there's no such metric in the registry.
+func (r *Registry) Lookup(name string) Metric {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, v := range r.metrics {
+		if v.Name() == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// WriteText writes all the metrics in the registry to w, using
+// the Prometheus text exposition format.
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.RLock()
+	metrics := r.metrics
+	r.mu.RUnlock()
+	bw := bufio.NewWriter(w)
+	for _, v := range metrics {
+		v.writeText(bw)
+	}
+	return bw.Flush()
+}
+
+type labeled struct {
+	name   string
+	help   string
+	labels []string
+}
+
+func (l *labeled) Name() string {
+	return l.name
+}
+
+func (l *labeled) key(values []string) string {
+	if len(values) != len(l.labels) {
+		panic(fmt.Errorf("metric %s has %d labels, %d values provided", l.name, len(l.labels), len(values)))
+	}
+	return strings.Join(values, "\xff")
+}
+
+func (l *labeled) writeHeader(w *bufio.Writer, typ string) {
+	if l.help != "" {
+		fmt.Fprintf(w, "# HELP %s %s\n", l.name, helpReplacer.Replace(l.help))
+	}
+	fmt.Fprintf(w, "# TYPE %s %s\n", l.name, typ)
+}
+
+// writeSample writes a sample. If extra is non-empty, it's
+// added as the last label (used for histogram buckets).
+func (l *labeled) writeSample(w *bufio.Writer, name string, values []string, extra string, v float64) {
+	w.WriteString(name)
+	if len(values) > 0 || extra != "" {
+		w.WriteByte('{')
+		for ii, lv := range values {
+			if ii > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(l.labels[ii])
+			w.WriteString("=\"")
+			w.WriteString(labelReplacer.Replace(lv))
+			w.WriteByte('"')
+		}
+		if extra != "" {
+			if len(values) > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(extra)
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(v))
+	w.WriteByte('\n')
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func sortedKeys(n int, each func(func(string))) []string {
+	keys := make([]string, 0, n)
+	each(func(k string) { keys = append(keys, k) })
+	sort.Strings(keys)
+	return keys
+}
+
+type counterValue struct {
+	values []string
+	value  float64
+}
+
+// Counter is a metric which can only increase, optionally
+// partitioned by a set of labels. Counter is safe for
+// concurrent use.
+type Counter struct {
+	labeled
+	mu     sync.Mutex
+	values map[string]*counterValue
+}
+
+// NewCounter returns a new Counter with the given name, help and
+// label names, registered in the Default Registry.
+func NewCounter(name string, help string, labels ...string) *Counter {
+	c := &Counter{
+		labeled: labeled{name: name, help: help, labels: labels},
+		values:  make(map[string]*counterValue),
+	}
+	Default.Register(c)
+	return c
+}
+
+// Add adds delta, which must be non-negative, to the counter
+// with the given label values.
+func (c *Counter) Add(delta float64, values ...string) {
+	if delta < 0 {
+		panic(fmt.Errorf("counter %s can't decrease", c.name))
+	}
+	key := c.key(values)
+	c.mu.Lock()
+	cv := c.values[key]
+	if cv == nil {
+		cv = &counterValue{values: append([]string(nil), values...)}
+		c.values[key] = cv
+	}
+	cv.value += delta
+	c.mu.Unlock()
+}
+
+// Inc adds 1 to the counter with the given label values.
+func (c *Counter) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Value returns the value of the counter with the given
+// label values.
+func (c *Counter) Value(values ...string) float64 {
+	key := c.key(values)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cv := c.values[key]; cv != nil {
+		return cv.value
+	}
+	return 0
+}
+
+// Each calls f for every combination of label values
+// in the counter.
+func (c *Counter) Each(f func(values []string, value float64)) {
+	c.mu.Lock()
+	samples := make([]counterValue, 0, len(c.values))
+	for _, v := range c.values {
+		samples = append(samples, *v)
+	}
+	c.mu.Unlock()
+	for _, v := range samples {
+		f(v.values, v.value)
+	}
+}
+
+func (c *Counter) writeText(w *bufio.Writer) {
+	c.writeHeader(w, "counter")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	keys := sortedKeys(len(c.values), func(f func(string)) {
+		for k := range c.values {
+			f(k)
+		}
+	})
+	for _, k := range keys {
+		cv := c.values[k]
+		c.writeSample(w, c.name, cv.values, "", cv.value)
+	}
+}
+
+type histogramValue struct {
+	values []string
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// Histogram is a metric which counts observations in configurable
+// buckets, optionally partitioned by a set of labels. Histogram is
+// safe for concurrent use.
+type Histogram struct {
+	labeled
+	buckets []float64
+	mu      sync.Mutex
+	values  map[string]*histogramValue
+}
+
+// NewHistogram returns a new Histogram with the given name, help,
+// buckets and label names, registered in the Default Registry. If
+// buckets is empty, DefaultBuckets is used.
+func NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	b := append([]float64(nil), buckets...)
+	sort.Float64s(b)
+	h := &Histogram{
+		labeled: labeled{name: name, help: help, labels: labels},
+		buckets: b,
+		values:  make(map[string]*histogramValue),
+	}
+	Default.Register(h)
+	return h
+}
+
+// Observe adds an observation with the given value to the
+// histogram with the given label values.
+func (h *Histogram) Observe(v float64, values ...string) {
+	key := h.key(values)
+	h.mu.Lock()
+	hv := h.values[key]
+	if hv == nil {
+		hv = &histogramValue{
+			values: append([]string(nil), values...),
+			counts: make([]uint64, len(h.buckets)),
+		}
+		h.values[key] = hv
+	}
+	if idx := sort.SearchFloat64s(h.buckets, v); idx < len(h.buckets) {
+		hv.counts[idx]++
+	}
+	hv.count++
+	hv.sum += v
+	h.mu.Unlock()
+}
+
+// Each calls f for every combination of label values in the
+// histogram, with the number of observations and their sum.
+func (h *Histogram) Each(f func(values []string, count uint64, sum float64)) {
+	h.mu.Lock()
+	samples := make([]histogramValue, 0, len(h.values))
+	for _, v := range h.values {
+		samples = append(samples, *v)
+	}
+	h.mu.Unlock()
+	for _, v := range samples {
+		f(v.values, v.count, v.sum)
+	}
+}
+
+func (h *Histogram) writeText(w *bufio.Writer) {
+	h.writeHeader(w, "histogram")
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	keys := sortedKeys(len(h.values), func(f func(string)) {
+		for k := range h.values {
+			f(k)
+		}
+	})
+	bucket := h.name + "_bucket"
+	for _, k := range keys {
+		hv := h.values[k]
+		var cumulative uint64
+		for ii, b := range h.buckets {
+			cumulative += hv.counts[ii]
+			h.writeSample(w, bucket, hv.values, "le=\""+formatFloat(b)+"\"", float64(cumulative))
+		}
+		h.writeSample(w, bucket, hv.values, "le=\"+Inf\"", float64(hv.count))
+		h.writeSample(w, h.name+"_sum", hv.values, "", hv.sum)
+		h.writeSample(w, h.name+"_count", hv.values, "", float64(hv.count))
+	}
+}
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTextFormat(t *testing.T) {
+	r := &Registry{}
+	c := &Counter{labeled: labeled{name: "test_total", help: "Test\ncounter", labels: []string{"a", "b"}}, values: make(map[string]*counterValue)}
+	r.Register(c)
+	h := &Histogram{labeled: labeled{name: "test_seconds", labels: []string{"op"}}, buckets: []float64{0.1, 1}, values: make(map[string]*histogramValue)}
+	r.Register(h)
+	c.Inc("x", "y\"z")
+	c.Add(2.5, "x", "y\"z")
+	c.Inc("1", "2")
+	h.Observe(0.05, "get")
+	h.Observe(0.1, "get")
+	h.Observe(0.5, "get")
+	h.Observe(5, "get")
+	var buf bytes.Buffer
+	if err := r.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expect := `# HELP test_total Test\ncounter
+# TYPE test_total counter
+test_total{a="1",b="2"} 1
+test_total{a="x",b="y\"z"} 3.5
+# TYPE test_seconds histogram
+test_seconds_bucket{op="get",le="0.1"} 2
+test_seconds_bucket{op="get",le="1"} 3
+test_seconds_bucket{op="get",le="+Inf"} 4
+test_seconds_sum{op="get"} 5.65
+test_seconds_count{op="get"} 4
+`
+	if s := buf.String(); s != expect {
+		t.Errorf("unexpected output:\n%s\nexpecting:\n%s", s, expect)
+	}
+	if v := c.Value("x", "y\"z"); v != 3.5 {
+		t.Errorf("expecting value 3.5, got %v", v)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	NewCounter("test_register_total", "")
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(r.(error).Error(), "test_register_total") {
+			t.Errorf("expecting panic when registering a duplicate metric, got %v", r)
+		}
+	}()
+	NewHistogram("test_register_total", "", nil)
+}
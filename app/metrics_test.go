@@ -0,0 +1,70 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func metricsRequest(a *App, remote string, auth string) *httptest.ResponseRecorder {
+	r, _ := http.NewRequest("GET", "http://www.example.com"+DefaultMetricsPath, nil)
+	r.RemoteAddr = remote
+	if auth != "" {
+		r.Header.Set("Authorization", auth)
+	}
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	return w
+}
+
+func TestMetrics(t *testing.T) {
+	a := New()
+	a.Metrics = &Metrics{}
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	a.HandleOptions("^/hello/$", func(ctx *Context) {
+		ctx.WriteString("hello")
+	}, &HandlerOptions{Name: "metrics-hello"})
+	for _, p := range []string{"/hello/", "/hello/", "/missing/"} {
+		r, _ := http.NewRequest("GET", "http://www.example.com"+p, nil)
+		a.ServeHTTP(httptest.NewRecorder(), r)
+	}
+	if w := metricsRequest(a, "192.0.2.1:1234", ""); w.Code != http.StatusForbidden {
+		t.Errorf("expecting status 403 for non-loopback request, got %d", w.Code)
+	}
+	r, _ := http.NewRequest("GET", "http://www.example.com"+DefaultMetricsPath, nil)
+	r.RemoteAddr = "127.0.0.1:1234"
+	r.Header.Set("X-Forwarded-For", "192.0.2.1")
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expecting status 403 for proxied loopback request, got %d", w.Code)
+	}
+	w = metricsRequest(a, "127.0.0.1:1234", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expecting status 200 for loopback request, got %d", w.Code)
+	}
+	body := w.Body.String()
+	for _, v := range []string{
+		`gondola_http_requests_total{handler="metrics-hello",code="2xx"} 2`,
+		`gondola_http_requests_total{handler="",code="4xx"}`,
+		`gondola_http_request_duration_seconds_count{handler="metrics-hello"} 2`,
+	} {
+		if !strings.Contains(body, v) {
+			t.Errorf("metrics do not contain %q:\n%s", v, body)
+		}
+	}
+	a.Metrics.Token = "secret"
+	if w := metricsRequest(a, "127.0.0.1:1234", "Bearer wrong"); w.Code != http.StatusUnauthorized {
+		t.Errorf("expecting status 401 with invalid token, got %d", w.Code)
+	}
+	if w := metricsRequest(a, "192.0.2.1:1234", "Bearer secret"); w.Code != http.StatusOK {
+		t.Errorf("expecting status 200 with valid token, got %d", w.Code)
+	}
+	m := monitorMetrics()
+	if requests := m["requests"].(map[string]float64); requests["2xx"] < 2 {
+		t.Errorf("expecting at least 2 successful requests in monitor metrics, got %v", requests)
+	}
+}
@@ -0,0 +1,245 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"gnd.la/encoding/codec"
+)
+
+var (
+	// FormatParameterName is the name of the form parameter
+	// which might be used to override the content negotiation
+	// performed by NegotiateHandler (e.g. ?format=json).
+	FormatParameterName = "format"
+)
+
+// representation is one of the formats which might be
+// chosen by NegotiateHandler.
+type representation struct {
+	// name is used in the format parameter and as the extension
+	name         string
+	contentTypes []string
+	write        func(ctx *Context, data interface{}) error
+}
+
+func templateRepresentation(template string) *representation {
+	return &representation{
+		name:         "html",
+		contentTypes: []string{"text/html", "application/xhtml+xml"},
+		write: func(ctx *Context, data interface{}) error {
+			return ctx.Execute(template, data)
+		},
+	}
+}
+
+func codecRepresentation(name string) *representation {
+	c := codec.Get(name)
+	if c == nil {
+		imp := codec.RequiredImport(name)
+		if imp != "" {
+			panic(fmt.Errorf("codec %q is not registered, did you forget to import %s?", name, imp))
+		}
+		panic(fmt.Errorf("unknown codec %q", name))
+	}
+	if c.ContentType == "" {
+		panic(fmt.Errorf("codec %q has no ContentType, it can't be used with NegotiateHandler", name))
+	}
+	return &representation{
+		name:         name,
+		contentTypes: []string{c.ContentType},
+		write: func(ctx *Context, data interface{}) error {
+			encoded, err := c.Encode(data)
+			if err != nil {
+				return err
+			}
+			header := ctx.Header()
+			header.Set("Content-Type", c.ContentType)
+			header.Set("Content-Length", strconv.Itoa(len(encoded)))
+			_, err = ctx.Write(encoded)
+			return err
+		},
+	}
+}
+
+var (
+	jsonRepresentation = &representation{
+		name:         "json",
+		contentTypes: []string{"application/json", "text/json"},
+		write: func(ctx *Context, data interface{}) error {
+			_, err := ctx.WriteJSON(data)
+			return err
+		},
+	}
+	xmlRepresentation = &representation{
+		name:         "xml",
+		contentTypes: []string{"application/xml", "text/xml"},
+		write: func(ctx *Context, data interface{}) error {
+			_, err := ctx.WriteXML(data)
+			return err
+		},
+	}
+)
+
+// NegotiateHandler returns a Handler which executes the given DataHandler
+// to obtain the data and, if it succeeds, writes it back to the client
+// using the representation it prefers, according to its Accept header.
+// The available representations are, in the order used for breaking
+// ties:
+//
+//   - The given template, as text/html, if template is not empty.
+//   - JSON, as application/json.
+//   - XML, as application/xml.
+//   - The given codecs from gnd.la/encoding/codec (e.g. msgpack), using
+//     their ContentType. Note that the codecs must be registered before
+//     calling this function, otherwise it panics.
+//
+// Clients might also override the Accept header by specifying the
+// representation name (html, json, xml or the codec name) either in
+// the FormatParameterName parameter (e.g. ?format=json) or as the
+// extension of the request path (e.g. /articles/1.json), as long as
+// the handler pattern matches it. When none of the representations is
+// acceptable, the client receives a 406 error. As with JSONHandler and
+// ExecuteHandler, an ETag computed from the response is added.
+func NegotiateHandler(dataHandler DataHandler, template string, codecs ...string) Handler {
+	var reprs []*representation
+	if template != "" {
+		reprs = append(reprs, templateRepresentation(template))
+	}
+	reprs = append(reprs, jsonRepresentation, xmlRepresentation)
+	for _, v := range codecs {
+		reprs = append(reprs, codecRepresentation(v))
+	}
+	return ETag(func(ctx *Context) {
+		addVary(ctx.Header(), "Accept")
+		repr := negotiateRepresentation(ctx, reprs)
+		if repr == nil {
+			ctx.Error(http.StatusNotAcceptable)
+			return
+		}
+		data, err := dataHandler(ctx)
+		if err != nil {
+			panic(err)
+		}
+		if err := repr.write(ctx, data); err != nil {
+			panic(err)
+		}
+	})
+}
+
+func negotiateRepresentation(ctx *Context, reprs []*representation) *representation {
+	format := ctx.FormValue(FormatParameterName)
+	if format == "" {
+		if ext := path.Ext(ctx.R.URL.Path); ext != "" {
+			format = ext[1:]
+			// Don't fail with unknown extensions, since the
+			// path might contain a dot for other reasons.
+			if findRepresentation(reprs, format) == nil {
+				format = ""
+			}
+		}
+	}
+	if format != "" {
+		return findRepresentation(reprs, format)
+	}
+	accept := ctx.R.Header.Get("Accept")
+	if accept == "" {
+		return reprs[0]
+	}
+	ranges := parseAccept(accept)
+	var best *representation
+	bestQ := 0.0
+	for _, r := range reprs {
+		// When several content types match, use the
+		// most specific range e.g. json is not acceptable
+		// with "application/json;q=0, */*".
+		q := -1.0
+		specificity := -1
+		for _, ct := range r.contentTypes {
+			cq, cs := acceptQuality(ranges, ct)
+			if cs > specificity || (cs == specificity && cq > q) {
+				q = cq
+				specificity = cs
+			}
+		}
+		if q > bestQ {
+			best = r
+			bestQ = q
+		}
+	}
+	return best
+}
+
+func findRepresentation(reprs []*representation, name string) *representation {
+	for _, v := range reprs {
+		if v.name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// mediaRange represents a media range in an Accept header.
+type mediaRange struct {
+	typ     string
+	subtype string
+	q       float64
+}
+
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, v := range strings.Split(accept, ",") {
+		params := strings.Split(v, ";")
+		mt := strings.ToLower(strings.TrimSpace(params[0]))
+		slash := strings.IndexByte(mt, '/')
+		if slash < 0 {
+			if mt != "*" {
+				continue
+			}
+			// Some clients send * instead of */*
+			mt, slash = "*/*", 1
+		}
+		r := mediaRange{typ: mt[:slash], subtype: mt[slash+1:], q: 1}
+		for _, p := range params[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				if q, err := strconv.ParseFloat(p[2:], 64); err == nil {
+					r.q = q
+				}
+			}
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+// acceptQuality returns the quality assigned by the given ranges to
+// the content type, using the most specific matching range, as well
+// as its specificity. If no range matches, both values are -1.
+func acceptQuality(ranges []mediaRange, contentType string) (float64, int) {
+	slash := strings.IndexByte(contentType, '/')
+	typ, subtype := contentType[:slash], contentType[slash+1:]
+	q := -1.0
+	specificity := -1
+	for _, r := range ranges {
+		var s int
+		switch {
+		case r.typ == typ && r.subtype == subtype:
+			s = 2
+		case r.typ == typ && r.subtype == "*":
+			s = 1
+		case r.typ == "*" && r.subtype == "*":
+			s = 0
+		default:
+			continue
+		}
+		if s > specificity {
+			specificity = s
+			q = r.q
+		}
+	}
+	return q, specificity
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type negotiateTest struct {
+	path        string
+	accept      string
+	code        int
+	contentType string
+}
+
+var negotiateTests = []negotiateTest{
+	{"/data/", "", 200, "application/json"},
+	{"/data/", "application/json", 200, "application/json"},
+	{"/data/", "text/xml", 200, "application/xml"},
+	{"/data/", "application/xml;q=0.9, application/json;q=0.8", 200, "application/xml"},
+	{"/data/", "application/*;q=0.5, application/x-gob", 200, "application/x-gob"},
+	{"/data/", "*/*", 200, "application/json"},
+	{"/data/", "application/json;q=0, */*", 200, "application/xml"},
+	{"/data/", "image/png", 406, ""},
+	{"/data/?format=xml", "application/json", 200, "application/xml"},
+	{"/data/?format=yaml", "", 406, ""},
+	{"/data.gob", "application/json", 200, "application/x-gob"},
+}
+
+type negotiateData struct {
+	A int
+}
+
+func TestNegotiate(t *testing.T) {
+	a := New()
+	handler := NegotiateHandler(func(ctx *Context) (interface{}, error) {
+		return &negotiateData{A: 1}, nil
+	}, "", "gob")
+	a.Handle("^/data/$", handler)
+	a.Handle("^/data\\.\\w+$", handler)
+	for _, v := range negotiateTests {
+		req, _ := http.NewRequest("GET", "http://localhost"+v.path, nil)
+		if v.accept != "" {
+			req.Header.Set("Accept", v.accept)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, req)
+		if w.Code != v.code {
+			t.Errorf("expecting code %d for %s with Accept %q, got %d", v.code, v.path, v.accept, w.Code)
+			continue
+		}
+		if v.contentType != "" && !strings.HasPrefix(w.Header().Get("Content-Type"), v.contentType) {
+			t.Errorf("expecting content type %q for %s with Accept %q, got %q", v.contentType, v.path, v.accept, w.Header().Get("Content-Type"))
+		}
+		if w.Header().Get("Vary") != "Accept" {
+			t.Errorf("expecting Vary: Accept for %s, got %q", v.path, w.Header().Get("Vary"))
+		}
+	}
+}
+
+func TestNegotiateUnknownCodec(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expecting a panic with an unknown codec")
+		}
+	}()
+	NegotiateHandler(nil, "", "nonexistent")
+}
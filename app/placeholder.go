@@ -0,0 +1,218 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gnd.la/util/types"
+)
+
+// PlaceholderType represents a type which can be used in
+// handler patterns using the placeholder syntax e.g.
+// /users/{id:int}/. Users might define their own types
+// and register them using RegisterPlaceholderType.
+type PlaceholderType struct {
+	// Pattern is the regular expression matched by the
+	// placeholder. It must not contain any capturing
+	// groups.
+	Pattern string
+	// Parse parses the matched value and returns it as
+	// the type's Go representation. It might be nil,
+	// in which case the value is used as a string.
+	Parse func(s string) (interface{}, error)
+
+	re *regexp.Regexp
+}
+
+func (t *PlaceholderType) valid(s string) bool {
+	if !t.re.MatchString(s) {
+		return false
+	}
+	if t.Parse != nil {
+		if _, err := t.Parse(s); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+const (
+	// DefaultPlaceholderPattern is the pattern used for
+	// placeholders without an explicit type e.g. {slug}.
+	DefaultPlaceholderPattern = `[^/]+`
+)
+
+var (
+	placeholderRe    = regexp.MustCompile(`\{([a-zA-Z_]\w*)(?::(\w+))?\}`)
+	placeholderTypes = map[string]*PlaceholderType{}
+)
+
+// RegisterPlaceholderType registers a new type to be used in placeholder
+// patterns. If there was already a type with the same name, it's overwritten
+// by the new one. It panics if the type pattern is invalid or contains
+// capturing groups. Keep in mind that this function is not thread safe, so
+// it should only be called from the main goroutine.
+func RegisterPlaceholderType(name string, t *PlaceholderType) {
+	re, err := regexp.Compile("^(?:" + t.Pattern + ")$")
+	if err != nil {
+		panic(fmt.Errorf("invalid pattern for placeholder type %q: %s", name, err))
+	}
+	if re.NumSubexp() > 0 {
+		panic(fmt.Errorf("pattern for placeholder type %q can't contain capturing groups", name))
+	}
+	t.re = re
+	placeholderTypes[name] = t
+}
+
+// expandPlaceholders replaces the {name} and {name:type} placeholders
+// in the given pattern with named capture groups. If the pattern does
+// not start with ^, its non-placeholder parts are considered literals
+// and the resulting regexp is anchored at both ends. It returns the
+// expanded pattern and the types for each placeholder, or nil if the
+// pattern has no placeholders.
+func expandPlaceholders(pattern string) (string, map[string]*PlaceholderType, error) {
+	literal := !strings.HasPrefix(pattern, "^")
+	matches := placeholderRe.FindAllStringSubmatchIndex(pattern, -1)
+	if !literal {
+		matches = regexpPlaceholders(pattern, matches)
+	}
+	if len(matches) == 0 {
+		return pattern, nil, nil
+	}
+	var buf bytes.Buffer
+	writeLiteral := func(s string) {
+		if literal {
+			s = regexp.QuoteMeta(s)
+		}
+		buf.WriteString(s)
+	}
+	if literal {
+		buf.WriteByte('^')
+	}
+	params := make(map[string]*PlaceholderType, len(matches))
+	prev := 0
+	for _, m := range matches {
+		writeLiteral(pattern[prev:m[0]])
+		prev = m[1]
+		name := pattern[m[2]:m[3]]
+		if _, dup := params[name]; dup {
+			return "", nil, fmt.Errorf("duplicate placeholder %q in pattern %q", name, pattern)
+		}
+		var t *PlaceholderType
+		if m[4] >= 0 {
+			typ := pattern[m[4]:m[5]]
+			t = placeholderTypes[typ]
+			if t == nil {
+				return "", nil, fmt.Errorf("unknown placeholder type %q in pattern %q", typ, pattern)
+			}
+		} else {
+			t = defaultPlaceholderType
+		}
+		params[name] = t
+		fmt.Fprintf(&buf, "(?P<%s>%s)", name, t.Pattern)
+	}
+	writeLiteral(pattern[prev:])
+	if literal {
+		buf.WriteByte('$')
+	}
+	return buf.String(), params, nil
+}
+
+// regexpPlaceholders filters the placeholder matches found in a
+// regular expression, discarding the ones which are part of the regexp
+// syntax: braces following an escape sequence (e.g. \p{L} or \{) and
+// braces inside a character class (e.g. [{}]).
+func regexpPlaceholders(pattern string, matches [][]int) [][]int {
+	valid := make(map[int]bool)
+	inClass := false
+	for ii := 0; ii < len(pattern); ii++ {
+		switch c := pattern[ii]; {
+		case c == '\\':
+			ii++
+			// \pL, \p{Greek}, \PL...
+			if ii < len(pattern) && (pattern[ii] == 'p' || pattern[ii] == 'P') {
+				ii++
+			}
+		case inClass:
+			// [:alpha:] and friends can't contain braces,
+			// so only the end of the class matters.
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			// A ] right after [ or [^ is a literal
+			if ii+1 < len(pattern) && pattern[ii+1] == '^' {
+				ii++
+			}
+			if ii+1 < len(pattern) && pattern[ii+1] == ']' {
+				ii++
+			}
+		case c == '{':
+			valid[ii] = true
+		}
+	}
+	var filtered [][]int
+	for _, m := range matches {
+		if valid[m[0]] {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered
+}
+
+// checkPlaceholders returns an error if any of the arguments
+// provided for a placeholder does not match its type.
+func (h *handlerInfo) checkPlaceholders(args []interface{}) error {
+	if h.params == nil {
+		return nil
+	}
+	for ii, name := range h.re.SubexpNames() {
+		t := h.params[name]
+		if t == nil || ii == 0 || ii > len(args) {
+			continue
+		}
+		if s := types.ToString(args[ii-1]); !t.valid(s) {
+			return fmt.Errorf("invalid value %q for placeholder %q", s, name)
+		}
+	}
+	return nil
+}
+
+// setParsedValue stores the value returned by a PlaceholderType
+// Parse function into arg, returning false if the types are
+// not compatible.
+func setParsedValue(val interface{}, arg interface{}) bool {
+	v, err := types.SettableValue(arg)
+	if err != nil {
+		panic(err)
+	}
+	pv := reflect.ValueOf(val)
+	switch {
+	case pv.Type().AssignableTo(v.Type()):
+		v.Set(pv)
+	case types.IsNumeric(pv.Type()) && types.IsNumeric(v.Type()):
+		v.Set(pv.Convert(v.Type()))
+	default:
+		return false
+	}
+	return true
+}
+
+func parseInt(s string) (interface{}, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+var defaultPlaceholderType = &PlaceholderType{Pattern: DefaultPlaceholderPattern}
+
+func init() {
+	defaultPlaceholderType.re = regexp.MustCompile("^(?:" + DefaultPlaceholderPattern + ")$")
+	RegisterPlaceholderType("int", &PlaceholderType{Pattern: `-?\d+`, Parse: parseInt})
+	RegisterPlaceholderType("slug", &PlaceholderType{Pattern: `[\w\-]+`})
+	RegisterPlaceholderType("uuid", &PlaceholderType{Pattern: `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`})
+	RegisterPlaceholderType("path", &PlaceholderType{Pattern: `.+`})
+}
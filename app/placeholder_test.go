@@ -0,0 +1,81 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+)
+
+type placeholderTest struct {
+	pattern  string
+	expanded string
+}
+
+var placeholderTests = []placeholderTest{
+	{"/users/{id:int}/", "^/users/(?P<id>-?\\d+)/$"},
+	{"/users/{id:int}/posts/{slug}/", "^/users/(?P<id>-?\\d+)/posts/(?P<slug>[^/]+)/$"},
+	{"/files/{name:path}.txt", "^/files/(?P<name>.+)\\.txt$"},
+	{"^/users/{id:int}/(\\d{4})/$", "^/users/(?P<id>-?\\d+)/(\\d{4})/$"},
+	{"^/archive/(\\d{4})/$", "^/archive/(\\d{4})/$"},
+	{"^/tag/(\\p{L}+)/$", "^/tag/(\\p{L}+)/$"},
+	{"^/tag/(\\P{Greek}+)/{id:int}/$", "^/tag/(\\P{Greek}+)/(?P<id>-?\\d+)/$"},
+	{"^/brace/\\{name}/$", "^/brace/\\{name}/$"},
+	{"^/class/[{name}]/{id}/$", "^/class/[{name}]/(?P<id>[^/]+)/$"},
+}
+
+func TestExpandPlaceholders(t *testing.T) {
+	for _, v := range placeholderTests {
+		expanded, _, err := expandPlaceholders(v.pattern)
+		if err != nil {
+			t.Errorf("error expanding %q: %s", v.pattern, err)
+			continue
+		}
+		if expanded != v.expanded {
+			t.Errorf("expanding %q, expected %q, got %q", v.pattern, v.expanded, expanded)
+		}
+	}
+	for _, v := range []string{"/{id:foo}/", "/{id}/{id}/"} {
+		if _, _, err := expandPlaceholders(v); err == nil {
+			t.Errorf("expecting an error when expanding %q", v)
+		}
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	a := New()
+	var id int
+	var slug string
+	a.HandleOptions("/users/{id:int}/posts/{slug:slug}/", func(ctx *Context) {
+		if !ctx.ParseParamValue("id", &id) {
+			t.Errorf("can't parse id %q", ctx.ParamValue("id"))
+		}
+		slug = ctx.ParamValue("slug")
+	}, &HandlerOptions{Name: "post"})
+	a.HandleOptions("/items/{uuid:uuid}/", helloHandler, &HandlerOptions{Name: "item"})
+	a.HandleOptions("/users/{id:int}/", helloHandler, &HandlerOptions{Name: "user"})
+	req, _ := http.NewRequest("GET", "http://localhost/users/42/posts/hello-world/", nil)
+	a.ServeHTTP(discard, req)
+	if id != 42 || slug != "hello-world" {
+		t.Errorf("expecting id = 42 and slug = hello-world, got %d and %q", id, slug)
+	}
+	testReverse(t, "/users/7/posts/foo-bar/", a, "post", []interface{}{7, "foo-bar"})
+	testReverse(t, "", a, "post", []interface{}{"7a", "foo-bar"})
+	testReverse(t, "", a, "post", []interface{}{7, "foo/bar"})
+	testReverse(t, "/items/0b7e8a4a-2a3c-4ef1-9d5b-5c7d7f2c1e0a/", a, "item", []interface{}{"0b7e8a4a-2a3c-4ef1-9d5b-5c7d7f2c1e0a"})
+	testReverse(t, "", a, "item", []interface{}{"not-an-uuid"})
+	// Optional parts of a placeholder must not cut the rest of the pattern
+	testReverse(t, "/users/7/", a, "user", []interface{}{7})
+	testReverse(t, "/users/-7/", a, "user", []interface{}{-7})
+}
+
+func TestUnicodeClassPattern(t *testing.T) {
+	a := New()
+	var tag string
+	a.Handle(`^/tag/(\p{L}+)/$`, func(ctx *Context) {
+		tag = ctx.IndexValue(0)
+	})
+	req, _ := http.NewRequest("GET", "http://localhost/tag/café/", nil)
+	a.ServeHTTP(discard, req)
+	if tag != "café" {
+		t.Errorf("expecting tag café, got %q", tag)
+	}
+}
@@ -0,0 +1,216 @@
+// Package ratelimit implements rate limiting for app.Handler.
+//
+// A Limiter counts the requests made by each client, identified
+// by its remote address, its signed in user or any other key, and
+// replies with a 429 (Too Many Requests) status code when a client
+// exceeds the allowed limit. Limiters might use either a token
+// bucket or a fixed window policy.
+//
+// Counters are stored in the App cache (see app.Context.Cache) when
+// one is configured, so limits are shared among all the instances of
+// the App. Otherwise, they're stored in memory and only apply to
+// the current process. Note that, since gnd.la/cache does not support
+// atomic operations, limits enforced using a shared cache might be
+// slightly exceeded under heavy concurrent load.
+//
+// A Limiter can be used as an app.Transformer by passing its Wrap
+// method e.g.
+//
+//	limiter := &ratelimit.Limiter{Name: "sign-in", Limit: 10, Window: 60}
+//	App.HandleOptions("^/sign-in/$", SignInHandler, &app.HandlerOptions{
+//		Transformers: []app.Transformer{limiter.Wrap},
+//	})
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gnd.la/app"
+)
+
+// Policy indicates the algorithm used by a Limiter.
+type Policy int
+
+const (
+	// TokenBucket allows bursts of up to Limit requests, refilling
+	// the bucket at a constant rate of Limit requests per Window.
+	TokenBucket Policy = iota
+	// FixedWindow allows up to Limit requests in each Window, counting
+	// from the start of the window rather than from the first request.
+	FixedWindow
+)
+
+func (p Policy) String() string {
+	switch p {
+	case TokenBucket:
+		return "token-bucket"
+	case FixedWindow:
+		return "fixed-window"
+	}
+	return fmt.Sprintf("Policy(%d)", int(p))
+}
+
+// KeyFunc returns the key which identifies the client making
+// the request. Requests with the same key share the same limit.
+type KeyFunc func(ctx *app.Context) string
+
+// ByRemoteAddress is a KeyFunc which limits requests by the client
+// address. If the App trusts X headers (see app.App.TrustsXHeaders),
+// they're used to determine the address.
+func ByRemoteAddress(ctx *app.Context) string {
+	return "addr:" + ctx.RemoteAddress()
+}
+
+// ByUser is a KeyFunc which limits requests by the signed in user id.
+// Requests without a signed in user are limited by their remote address.
+func ByUser(ctx *app.Context) string {
+	if u := ctx.User(); u != nil {
+		return "user:" + strconv.FormatInt(u.Id(), 10)
+	}
+	return ByRemoteAddress(ctx)
+}
+
+// Limiter limits the number of requests a client can make. The
+// Limit and Window fields are required, while the rest of them
+// have sensible defaults. A Limiter must not be modified
+// after its first use.
+type Limiter struct {
+	// Name is used to separate the counters of different Limiters, so
+	// each Limiter must have an unique name, unless they're meant to
+	// share their counters.
+	Name string
+	// Policy indicates the algorithm used for limiting requests. The
+	// default is TokenBucket.
+	Policy Policy
+	// Limit is the number of requests allowed per Window. When using
+	// the TokenBucket policy, it's also the maximum burst size.
+	Limit int
+	// Window is the duration, in seconds, of the period of time
+	// the Limit applies to.
+	Window int
+	// Key returns the key which identifies each client. If nil,
+	// ByRemoteAddress is used.
+	Key KeyFunc
+}
+
+// Result contains the outcome of checking a request against a Limiter.
+type Result struct {
+	// Allowed indicates if the request is allowed.
+	Allowed bool
+	// Limit is the Limiter Limit.
+	Limit int
+	// Remaining is the number of requests the client can still
+	// make without being limited.
+	Remaining int
+	// Reset is the time when the limit will be completely
+	// reset for the client.
+	Reset time.Time
+	// RetryAfter is the time the client should wait before
+	// making another request. Zero for allowed requests.
+	RetryAfter time.Duration
+}
+
+// Allow checks if the request in the given Context should be allowed,
+// updating the counters for its client. Most users should use Wrap
+// rather than calling Allow directly.
+func (l *Limiter) Allow(ctx *app.Context) (*Result, error) {
+	if l.Limit <= 0 || l.Window <= 0 {
+		return nil, fmt.Errorf("limiter %q has an invalid Limit (%d) or Window (%d)", l.Name, l.Limit, l.Window)
+	}
+	keyFunc := l.Key
+	if keyFunc == nil {
+		keyFunc = ByRemoteAddress
+	}
+	key := "gnd.la/app/ratelimit:" + l.Name + ":" + l.Policy.String() + ":" + keyFunc(ctx)
+	now := time.Now()
+	var res *Result
+	err := storeFor(ctx).update(key, l.Window+1, func(st *state) {
+		switch l.Policy {
+		case FixedWindow:
+			res = l.fixedWindow(st, now)
+		default:
+			res = l.tokenBucket(st, now)
+		}
+	})
+	return res, err
+}
+
+func (l *Limiter) window() time.Duration {
+	return time.Duration(l.Window) * time.Second
+}
+
+func (l *Limiter) fixedWindow(st *state, now time.Time) *Result {
+	window := l.window()
+	start := now.Truncate(window)
+	if st.Time != start.UnixNano() {
+		st.Value = 0
+		st.Time = start.UnixNano()
+	}
+	res := &Result{Limit: l.Limit, Reset: start.Add(window)}
+	if st.Value < float64(l.Limit) {
+		st.Value++
+		res.Allowed = true
+	} else {
+		res.RetryAfter = res.Reset.Sub(now)
+	}
+	res.Remaining = l.Limit - int(st.Value)
+	return res
+}
+
+func (l *Limiter) tokenBucket(st *state, now time.Time) *Result {
+	limit := float64(l.Limit)
+	// tokens per nanosecond
+	rate := limit / float64(l.window())
+	tokens := limit
+	if st.Time != 0 {
+		elapsed := now.UnixNano() - st.Time
+		tokens = math.Min(limit, st.Value+float64(elapsed)*rate)
+	}
+	res := &Result{Limit: l.Limit}
+	if tokens >= 1 {
+		tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = time.Duration((1 - tokens) / rate)
+	}
+	st.Value = tokens
+	st.Time = now.UnixNano()
+	res.Remaining = int(tokens)
+	res.Reset = now.Add(time.Duration((limit - tokens) / rate))
+	return res
+}
+
+// Wrap returns a new app.Handler which limits the requests using the
+// Limiter before calling handler. Limited requests receive a 429
+// response with a Retry-After header, while every response includes the
+// X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset (as
+// an Unix timestamp) headers. If the counters can't be updated (e.g.
+// the cache is down), the error is logged and the request is allowed.
+func (l *Limiter) Wrap(handler app.Handler) app.Handler {
+	return func(ctx *app.Context) {
+		res, err := l.Allow(ctx)
+		if err != nil {
+			ctx.Logger().Errorf("error checking rate limit %q: %s", l.Name, err)
+			handler(ctx)
+			return
+		}
+		h := ctx.Header()
+		h.Set("X-RateLimit-Limit", strconv.Itoa(res.Limit))
+		h.Set("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		h.Set("X-RateLimit-Reset", strconv.FormatInt(res.Reset.Unix(), 10))
+		if !res.Allowed {
+			retry := int(math.Ceil(res.RetryAfter.Seconds()))
+			if retry < 1 {
+				retry = 1
+			}
+			h.Set("Retry-After", strconv.Itoa(retry))
+			ctx.Error(http.StatusTooManyRequests, "too many requests")
+			return
+		}
+		handler(ctx)
+	}
+}
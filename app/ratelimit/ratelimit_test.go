@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+)
+
+func TestFixedWindow(t *testing.T) {
+	l := &Limiter{Policy: FixedWindow, Limit: 3, Window: 60}
+	now := time.Now().Truncate(time.Minute)
+	var st state
+	for ii := 0; ii < 3; ii++ {
+		if res := l.fixedWindow(&st, now.Add(time.Duration(ii)*time.Second)); !res.Allowed || res.Remaining != 2-ii {
+			t.Fatalf("request %d should be allowed with %d remaining, got %+v", ii, 2-ii, res)
+		}
+	}
+	res := l.fixedWindow(&st, now.Add(10*time.Second))
+	if res.Allowed || res.RetryAfter != 50*time.Second {
+		t.Errorf("expecting limited request with 50s retry, got %+v", res)
+	}
+	if res := l.fixedWindow(&st, now.Add(time.Minute)); !res.Allowed {
+		t.Errorf("expecting allowed request in the next window, got %+v", res)
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	l := &Limiter{Limit: 2, Window: 10}
+	now := time.Now()
+	var st state
+	for ii := 0; ii < 2; ii++ {
+		if res := l.tokenBucket(&st, now); !res.Allowed {
+			t.Fatalf("request %d should be allowed, got %+v", ii, res)
+		}
+	}
+	res := l.tokenBucket(&st, now)
+	if res.Allowed || res.RetryAfter != 5*time.Second {
+		t.Errorf("expecting limited request with 5s retry, got %+v", res)
+	}
+	// One token is refilled every 5 seconds
+	if res := l.tokenBucket(&st, now.Add(5*time.Second)); !res.Allowed || res.Remaining != 0 {
+		t.Errorf("expecting allowed request after refill, got %+v", res)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	a := app.New()
+	l := &Limiter{Name: "test", Policy: FixedWindow, Limit: 2, Window: 3600}
+	a.Handle("^/$", l.Wrap(func(ctx *app.Context) {
+		ctx.WriteString("ok")
+	}))
+	serve := func(addr string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", "http://localhost/", nil)
+		req.RemoteAddr = addr + ":1234"
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, req)
+		return w
+	}
+	for ii := 0; ii < 2; ii++ {
+		w := serve("10.0.0.1")
+		if w.Code != http.StatusOK {
+			t.Fatalf("expecting 200, got %d", w.Code)
+		}
+		if rem := w.Header().Get("X-RateLimit-Remaining"); rem != strconv.Itoa(1-ii) {
+			t.Errorf("expecting X-RateLimit-Remaining = %d, got %q", 1-ii, rem)
+		}
+	}
+	w := serve("10.0.0.1")
+	if w.Code != http.StatusTooManyRequests {
+		t.Errorf("expecting 429, got %d", w.Code)
+	}
+	if w.Header().Get("Retry-After") == "" || w.Header().Get("X-RateLimit-Reset") == "" {
+		t.Errorf("missing Retry-After or X-RateLimit-Reset headers: %v", w.Header())
+	}
+	if w := serve("10.0.0.2"); w.Code != http.StatusOK {
+		t.Errorf("expecting 200 from a different address, got %d", w.Code)
+	}
+}
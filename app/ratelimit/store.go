@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/cache"
+)
+
+// state is the per-client data stored by a Limiter. Its
+// meaning depends on the Policy.
+type state struct {
+	Value float64
+	Time  int64
+}
+
+type store interface {
+	// update calls f with the current state for the given key,
+	// (or a zero one if there's no state yet) and then stores it.
+	// The state expires after the given number of seconds.
+	update(key string, expiration int, f func(st *state)) error
+}
+
+// storeFor returns the store used for the given Context, which
+// is the App cache if there's one configured or the in-process
+// store otherwise.
+func storeFor(ctx *app.Context) store {
+	a := ctx.App()
+	if a.Config().Cache != nil {
+		if c, err := a.Cache(); err == nil {
+			return &cacheStore{c}
+		}
+	}
+	return memStore
+}
+
+type cacheStore struct {
+	c *cache.Cache
+}
+
+func (s *cacheStore) update(key string, expiration int, f func(st *state)) error {
+	var st state
+	if err := s.c.Get(key, &st); err != nil && err != cache.ErrNotFound {
+		return err
+	}
+	f(&st)
+	return s.c.Set(key, &st, expiration)
+}
+
+// sweepInterval is the minimum time between sweeps
+// of expired entries in the memory store.
+const sweepInterval = time.Minute
+
+type memoryEntry struct {
+	state   state
+	expires time.Time
+}
+
+type memoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]*memoryEntry
+	lastSweep time.Time
+}
+
+func (s *memoryStore) update(key string, expiration int, f func(st *state)) error {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if now.Sub(s.lastSweep) > sweepInterval {
+		for k, v := range s.entries {
+			if now.After(v.expires) {
+				delete(s.entries, k)
+			}
+		}
+		s.lastSweep = now
+	}
+	e := s.entries[key]
+	if e == nil || now.After(e.expires) {
+		e = &memoryEntry{}
+		s.entries[key] = e
+	}
+	f(&e.state)
+	e.expires = now.Add(time.Duration(expiration) * time.Second)
+	return nil
+}
+
+var memStore = &memoryStore{entries: make(map[string]*memoryEntry)}
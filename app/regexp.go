@@ -80,24 +80,21 @@ func formatRegexp(re *regexpCache, args []interface{}) (string, error) {
 			stack = stack[:len(stack)-1]
 			return false
 		}
+		// Check if this node was already provided by a previous
+		// replacement. If we're inside a capture group, the provided
+		// replacement must have already satisfied this node, otherwise
+		// it would have failed.
+		for _, v := range stack {
+			if v.Op == syntax.OpCapture {
+				stack = append(stack, r)
+				return false
+			}
+		}
 		stack = append(stack, r)
 		switch r.Op {
 		case syntax.OpLiteral:
-			// Check if this literal was already provided by a previous
-			// replacement. If we're inside a capture group, the provided
-			// replacement must have already satisfied this literal, otherwise
-			// it would have failed.
-			provided := false
-			for _, v := range stack {
-				if v.Op == syntax.OpCapture {
-					provided = true
-					break
-				}
-			}
-			if !provided {
-				for _, ru := range r.Rune {
-					buf.WriteRune(ru)
-				}
+			for _, ru := range r.Rune {
+				buf.WriteRune(ru)
 			}
 			if rem == 0 {
 				return true
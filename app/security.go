@@ -0,0 +1,100 @@
+package app
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+)
+
+// CSPNonceToken is replaced by the nonce for the current request
+// when it appears in SecurityHeaders.ContentSecurityPolicy.
+const CSPNonceToken = "{nonce}"
+
+const cspNonceLength = 16
+
+// SecurityHeaders contains the security related headers sent with
+// every response served by the App. Empty fields are not sent.
+// Assign a SecurityHeaders to App.SecurityHeaders to enable them.
+// e.g.
+//
+//	App.SecurityHeaders = &app.SecurityHeaders{
+//		ContentSecurityPolicy: "default-src 'self'; script-src 'self' {nonce}; style-src 'self' {nonce}",
+//		FrameOptions:          "DENY",
+//		ReferrerPolicy:        "strict-origin-when-cross-origin",
+//		PermissionsPolicy:     "geolocation=(), camera=()",
+//		NoSniff:               true,
+//	}
+//
+// When the Content-Security-Policy contains CSPNonceToken, a random
+// nonce is generated for each request and the token is replaced by
+// 'nonce-<value>'. HTML templates add this nonce to the <script> and
+// <style> tags in their source, as well as to the <link> tags for
+// stylesheets and preloads, including the ones generated for their
+// assets (see gnd.la/template.NonceProvider), so a strict
+// policy without 'unsafe-inline' works out of the box. Code which
+// writes HTML by itself can retrieve the nonce using Context.CSPNonce.
+//
+// Note that pages which use nonces must not be cached as a whole
+// (e.g. using gnd.la/cache/layer), since the nonce changes on every
+// request.
+type SecurityHeaders struct {
+	// ContentSecurityPolicy is the value for the Content-Security-Policy
+	// header. It might contain CSPNonceToken.
+	ContentSecurityPolicy string
+	// CSPReportOnly makes the policy be sent using the
+	// Content-Security-Policy-Report-Only header, so violations
+	// are reported but not enforced.
+	CSPReportOnly bool
+	// FrameOptions is the value for the X-Frame-Options
+	// header, usually DENY or SAMEORIGIN.
+	FrameOptions string
+	// ReferrerPolicy is the value for the Referrer-Policy header.
+	ReferrerPolicy string
+	// PermissionsPolicy is the value for the Permissions-Policy header.
+	PermissionsPolicy string
+	// NoSniff indicates if the X-Content-Type-Options: nosniff
+	// header should be sent.
+	NoSniff bool
+}
+
+func (s *SecurityHeaders) apply(ctx *Context) {
+	header := ctx.Header()
+	if csp := s.ContentSecurityPolicy; csp != "" {
+		if strings.Contains(csp, CSPNonceToken) {
+			ctx.cspNonce = newCSPNonce()
+			csp = strings.Replace(csp, CSPNonceToken, "'nonce-"+ctx.cspNonce+"'", -1)
+		}
+		name := "Content-Security-Policy"
+		if s.CSPReportOnly {
+			name += "-Report-Only"
+		}
+		header.Set(name, csp)
+	}
+	if s.FrameOptions != "" {
+		header.Set("X-Frame-Options", s.FrameOptions)
+	}
+	if s.ReferrerPolicy != "" {
+		header.Set("Referrer-Policy", s.ReferrerPolicy)
+	}
+	if s.PermissionsPolicy != "" {
+		header.Set("Permissions-Policy", s.PermissionsPolicy)
+	}
+	if s.NoSniff {
+		header.Set("X-Content-Type-Options", "nosniff")
+	}
+}
+
+func newCSPNonce() string {
+	b := make([]byte, cspNonceLength)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// CSPNonce returns the nonce for the Content-Security-Policy of the
+// current request, or an empty string if the policy doesn't use
+// nonces. See SecurityHeaders for more details.
+func (c *Context) CSPNonce() string {
+	return c.cspNonce
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	a := New()
+	a.SecurityHeaders = &SecurityHeaders{
+		ContentSecurityPolicy: "script-src 'self' {nonce}",
+		FrameOptions:          "DENY",
+		ReferrerPolicy:        "no-referrer",
+		PermissionsPolicy:     "camera=()",
+		NoSniff:               true,
+	}
+	a.Handle("^/$", func(ctx *Context) {
+		ctx.WriteString(ctx.CSPNonce())
+	})
+	var nonces []string
+	for ii := 0; ii < 2; ii++ {
+		r, _ := http.NewRequest("GET", "http://www.example.com/", nil)
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		nonce := w.Body.String()
+		if len(nonce) != 24 {
+			t.Fatalf("expecting base64 encoded nonce, got %q", nonce)
+		}
+		expect := map[string]string{
+			"Content-Security-Policy": "script-src 'self' 'nonce-" + nonce + "'",
+			"X-Frame-Options":         "DENY",
+			"Referrer-Policy":         "no-referrer",
+			"Permissions-Policy":      "camera=()",
+			"X-Content-Type-Options":  "nosniff",
+		}
+		for k, v := range expect {
+			if h := w.Header().Get(k); h != v {
+				t.Errorf("expecting header %s = %q, got %q", k, v, h)
+			}
+		}
+		nonces = append(nonces, nonce)
+	}
+	if nonces[0] == nonces[1] {
+		t.Errorf("expecting different nonces for each request, got %q twice", nonces[0])
+	}
+}
+
+func TestCSPReportOnly(t *testing.T) {
+	a := New()
+	a.SecurityHeaders = &SecurityHeaders{
+		ContentSecurityPolicy: "default-src 'self'",
+		CSPReportOnly:         true,
+	}
+	a.Handle("^/$", func(ctx *Context) {
+		ctx.WriteString(ctx.CSPNonce())
+	})
+	r, _ := http.NewRequest("GET", "http://www.example.com/", nil)
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	if h := w.Header().Get("Content-Security-Policy-Report-Only"); h != "default-src 'self'" {
+		t.Errorf("expecting report only policy, got %q", h)
+	}
+	if h := w.Header().Get("Content-Security-Policy"); h != "" {
+		t.Errorf("expecting no enforced policy, got %q", h)
+	}
+	if s := w.Body.String(); s != "" {
+		t.Errorf("expecting no nonce when the policy doesn't use it, got %q", s)
+	}
+}
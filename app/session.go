@@ -0,0 +1,315 @@
+package app
+
+import (
+	"time"
+
+	"gnd.la/app/cookies"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// The name of the cookie used to store the session id.
+	// The cookie is signed using the gnd.la/app.App secret.
+	SESSION_COOKIE_NAME = "session"
+
+	sessionIdLength = 32
+	// Minimum interval between session saves when the
+	// session only needs to update its access time.
+	sessionTouchInterval = time.Minute
+	// Key used to store the user id in the session
+	userSessionKey = "gnd.la/app.user"
+)
+
+// SessionData is the data stored for each session by a SessionStore.
+type SessionData struct {
+	// Values contains the values set with Session.Set. Keep in mind
+	// that stores usually encode the values using encoding/gob, so
+	// any non-basic types stored in a session must be registered
+	// using encoding/gob.Register.
+	Values map[string]interface{}
+	// Created is the time when the session was created.
+	Created time.Time
+	// Accessed is the last time the session was used.
+	Accessed time.Time
+}
+
+// SessionStore is the interface implemented by the session backends.
+// Gondola includes CacheSessionStore, OrmSessionStore and
+// CookieSessionStore. Set the App SessionStore field to choose
+// the store used by an App.
+type SessionStore interface {
+	// Load returns the data for the session with the given id, or
+	// nil if there's no such session.
+	Load(ctx *Context, id string) (*SessionData, error)
+	// Save stores the data for the session with the given id, which
+	// should expire after the given number of seconds. Zero means
+	// the session should not expire.
+	Save(ctx *Context, id string, data *SessionData, expiration int) error
+	// Delete removes the session with the given id.
+	Delete(ctx *Context, id string) error
+}
+
+// Session represents a server side session. Use Context.Session to
+// obtain the Session for the current request. Sessions are identified by
+// a random id stored in a signed cookie named SESSION_COOKIE_NAME, while
+// the session data is handled by the App SessionStore.
+//
+// Sessions expire after being idle for Config.SessionIdleTimeout seconds
+// or after Config.SessionMaxAge seconds since they were created,
+// whatever happens first.
+//
+// Modified sessions are saved right before the response headers are
+// sent, so any modification to the session must be done before writing
+// the response body.
+type Session struct {
+	ctx         *Context
+	id          string
+	data        *SessionData
+	isNew       bool
+	dirty       bool
+	sendCookie  bool
+	cookieReady bool
+}
+
+// Id returns the session id. Note that the id might change
+// when calling Regenerate or Destroy.
+func (s *Session) Id() string {
+	return s.id
+}
+
+// IsNew returns true iff the session was created during the current
+// request.
+func (s *Session) IsNew() bool {
+	return s.isNew
+}
+
+// Created returns the time when the session was created.
+func (s *Session) Created() time.Time {
+	return s.data.Created
+}
+
+// Get returns the value associated with the given key,
+// or nil if there's no such value.
+func (s *Session) Get(key string) interface{} {
+	return s.data.Values[key]
+}
+
+// Has returns true iff the session has a value for the given key.
+func (s *Session) Has(key string) bool {
+	_, ok := s.data.Values[key]
+	return ok
+}
+
+// Set associates the given value with key.
+func (s *Session) Set(key string, value interface{}) {
+	if s.data.Values == nil {
+		s.data.Values = make(map[string]interface{})
+	}
+	s.data.Values[key] = value
+	s.dirty = true
+}
+
+// Delete removes the value for the given key, if any.
+func (s *Session) Delete(key string) {
+	if _, ok := s.data.Values[key]; ok {
+		delete(s.data.Values, key)
+		s.dirty = true
+	}
+}
+
+// Regenerate changes the session id while keeping its data, deleting
+// the session stored with the previous id. This should be done every
+// time the privileges associated with the session change (e.g. when
+// the user signs in) to prevent session fixation attacks. Note that
+// Context.SignIn already calls this function.
+func (s *Session) Regenerate() error {
+	if !s.isNew {
+		if err := s.ctx.app.sessionStore().Delete(s.ctx, s.id); err != nil {
+			return err
+		}
+	}
+	s.id = newSessionId()
+	s.dirty = true
+	s.sendCookie = true
+	return nil
+}
+
+// Destroy deletes the session data from the store and removes the
+// session cookie. After calling Destroy, the Session is empty
+// and it behaves like a new one. Note that Context.SignOut
+// already calls this function.
+func (s *Session) Destroy() error {
+	if !s.isNew {
+		if err := s.ctx.app.sessionStore().Delete(s.ctx, s.id); err != nil {
+			return err
+		}
+	}
+	if s.cookieReady || s.ctx.Cookies().Has(SESSION_COOKIE_NAME) {
+		s.ctx.Cookies().Delete(SESSION_COOKIE_NAME)
+	}
+	now := time.Now()
+	s.id = newSessionId()
+	s.data = &SessionData{Created: now, Accessed: now}
+	s.isNew = true
+	s.dirty = false
+	s.sendCookie = false
+	s.cookieReady = false
+	return nil
+}
+
+// save stores the session if it has been modified or if its
+// access time needs to be updated. It also sets the session
+// cookie when required.
+func (s *Session) save() error {
+	now := time.Now()
+	if s.isNew && len(s.data.Values) == 0 {
+		// Don't store empty sessions
+		return nil
+	}
+	if !s.dirty && now.Sub(s.data.Accessed) < sessionTouchInterval {
+		return nil
+	}
+	cfg := s.ctx.app.cfg
+	expiration := cfg.SessionIdleTimeout
+	if maxAge := cfg.SessionMaxAge; maxAge > 0 {
+		remaining := int(s.data.Created.Add(time.Duration(maxAge)*time.Second).Sub(now) / time.Second)
+		if remaining < 1 {
+			remaining = 1
+		}
+		if expiration <= 0 || remaining < expiration {
+			expiration = remaining
+		}
+	}
+	s.data.Accessed = now
+	if err := s.ctx.app.sessionStore().Save(s.ctx, s.id, s.data, expiration); err != nil {
+		return err
+	}
+	if s.isNew || s.sendCookie {
+		if err := s.setCookie(); err != nil {
+			return err
+		}
+	}
+	s.isNew = false
+	s.dirty = false
+	s.sendCookie = false
+	return nil
+}
+
+func (s *Session) setCookie() error {
+	var opts cookies.Options
+	if o := s.ctx.app.CookieOptions; o != nil {
+		opts = *o
+	} else {
+		opts = *cookies.Defaults()
+	}
+	opts.HttpOnly = true
+	opts.MaxAge = 0
+	opts.Expires = time.Time{}
+	if maxAge := s.ctx.app.cfg.SessionMaxAge; maxAge > 0 {
+		opts.Expires = s.data.Created.Add(time.Duration(maxAge) * time.Second)
+	}
+	if err := s.ctx.Cookies().SetSecureOpts(SESSION_COOKIE_NAME, s.id, &opts); err != nil {
+		return err
+	}
+	s.cookieReady = true
+	return nil
+}
+
+func (s *Session) expired(now time.Time) bool {
+	cfg := s.ctx.app.cfg
+	if idle := cfg.SessionIdleTimeout; idle > 0 && now.Sub(s.data.Accessed) > time.Duration(idle)*time.Second {
+		return true
+	}
+	if maxAge := cfg.SessionMaxAge; maxAge > 0 && now.Sub(s.data.Created) > time.Duration(maxAge)*time.Second {
+		return true
+	}
+	return false
+}
+
+func newSessionId() string {
+	return stringutil.Random(sessionIdLength)
+}
+
+// Session returns the Session for the current request, creating a new
+// one if the client has no session or if it has expired. See Session
+// and App.SessionStore for more details.
+func (c *Context) Session() *Session {
+	if c.session == nil {
+		c.session = c.loadSession()
+	}
+	return c.session
+}
+
+func (c *Context) loadSession() *Session {
+	now := time.Now()
+	var id string
+	if err := c.Cookies().GetSecure(SESSION_COOKIE_NAME, &id); err == nil && id != "" {
+		store := c.app.sessionStore()
+		data, err := store.Load(c, id)
+		if err != nil {
+			c.Logger().Errorf("error loading session: %s", err)
+		}
+		if data != nil {
+			s := &Session{ctx: c, id: id, data: data, cookieReady: true}
+			if !s.expired(now) {
+				return s
+			}
+			if err := store.Delete(c, id); err != nil {
+				c.Logger().Errorf("error deleting expired session: %s", err)
+			}
+		}
+	}
+	return &Session{
+		ctx:   c,
+		id:    newSessionId(),
+		data:  &SessionData{Created: now, Accessed: now},
+		isNew: true,
+	}
+}
+
+// needsCookie returns true iff saving the session requires
+// sending a cookie to the client.
+func (s *Session) needsCookie() bool {
+	return !s.ctx.app.usesServerSessions() || s.sendCookie || (s.isNew && len(s.data.Values) > 0)
+}
+
+// saveSession saves the session, if it was loaded. It's
+// called before sending the headers and when the Context
+// is closed. Once the headers have been sent, sessions which
+// need a cookie can't be saved anymore, so any changes to
+// them are dropped and logged as an error.
+func (c *Context) saveSession() {
+	s := c.session
+	if s == nil {
+		return
+	}
+	if c.statusCode > 0 && s.needsCookie() {
+		if s.dirty {
+			c.Logger().Errorf("session modified after sending the response headers, changes were not saved")
+		}
+		return
+	}
+	if err := s.save(); err != nil {
+		c.Logger().Errorf("error saving session: %s", err)
+	}
+}
+
+// sessionStore returns the SessionStore used by the App,
+// defaulting to the CookieSessionStore.
+func (app *App) sessionStore() SessionStore {
+	if app.SessionStore != nil {
+		return app.SessionStore
+	}
+	return defaultSessionStore
+}
+
+// usesServerSessions returns true iff the App stores its sessions
+// on the server side, so the signed in user is stored in the
+// session rather than in its own cookie.
+func (app *App) usesServerSessions() bool {
+	if app.SessionStore == nil {
+		return false
+	}
+	_, isCookie := app.SessionStore.(*CookieSessionStore)
+	return !isCookie
+}
@@ -0,0 +1,230 @@
+package app
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+
+	"gnd.la/app/cookies"
+	"gnd.la/cache"
+	"gnd.la/encoding/codec"
+	"gnd.la/orm"
+)
+
+const (
+	// SESSION_DATA_COOKIE_NAME is the name of the cookie used by
+	// CookieSessionStore to store the session data.
+	SESSION_DATA_COOKIE_NAME = "session-data"
+)
+
+var (
+	defaultSessionStore = &CookieSessionStore{}
+	sessionCodec        = codec.Get("gob")
+
+	errNoSessionOrm = errors.New("OrmSessionStore requires an ORM, configure one with the database option")
+)
+
+// CacheSessionStore stores the sessions in the App cache (see
+// Context.Cache). Keep in mind that sessions might be lost if
+// the cache evicts them.
+type CacheSessionStore struct {
+}
+
+func (s *CacheSessionStore) key(id string) string {
+	return "gnd.la/app/session:" + id
+}
+
+func (s *CacheSessionStore) Load(ctx *Context, id string) (*SessionData, error) {
+	var data *SessionData
+	if err := ctx.Cache().Get(s.key(id), &data); err != nil {
+		if err == cache.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *CacheSessionStore) Save(ctx *Context, id string, data *SessionData, expiration int) error {
+	return ctx.Cache().Set(s.key(id), data, expiration)
+}
+
+func (s *CacheSessionStore) Delete(ctx *Context, id string) error {
+	err := ctx.Cache().Delete(s.key(id))
+	if err == cache.ErrNotFound {
+		err = nil
+	}
+	return err
+}
+
+// sessionExpires returns the expiration time for a session
+// which expires after the given number of seconds. Zero
+// means no expiration.
+func sessionExpires(expiration int) time.Time {
+	if expiration <= 0 {
+		return cookies.Permanent
+	}
+	return time.Now().Add(time.Duration(expiration) * time.Second)
+}
+
+// sessionRecord is the model used by OrmSessionStore.
+type sessionRecord struct {
+	Id      string `orm:",primary_key,max_length=64"`
+	Data    []byte
+	Expires time.Time `orm:",index"`
+}
+
+var registerSessionModel sync.Once
+
+// OrmSessionStore stores the sessions in a table managed by the App
+// ORM (see Context.Orm). Use NewOrmSessionStore to create an
+// OrmSessionStore. Expired sessions are deleted when they're
+// accessed, but sessions which are never accessed again must be
+// periodically removed using DeleteExpired (e.g. from a task).
+type OrmSessionStore struct {
+}
+
+// NewOrmSessionStore returns a new OrmSessionStore, registering its
+// model with the ORM. Since models must be registered before the
+// ORM is initialized, this function should be called before the App
+// starts listening (e.g. from an init function).
+func NewOrmSessionStore() *OrmSessionStore {
+	registerSessionModel.Do(func() {
+		orm.Register(&sessionRecord{}, &orm.Options{
+			Table: "gondola_sessions",
+			Name:  "Session",
+		})
+	})
+	return &OrmSessionStore{}
+}
+
+func (s *OrmSessionStore) orm(ctx *Context) (*orm.Orm, error) {
+	if ctx.app.cfg.Database == nil {
+		return nil, errNoSessionOrm
+	}
+	return ctx.Orm(), nil
+}
+
+func (s *OrmSessionStore) Load(ctx *Context, id string) (*SessionData, error) {
+	o, err := s.orm(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var rec sessionRecord
+	found, err := o.One(orm.Eq("Id", id), &rec)
+	if err != nil || !found {
+		return nil, err
+	}
+	if time.Now().After(rec.Expires) {
+		return nil, o.Delete(&rec)
+	}
+	var data *SessionData
+	if err := sessionCodec.Decode(rec.Data, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *OrmSessionStore) Save(ctx *Context, id string, data *SessionData, expiration int) error {
+	o, err := s.orm(ctx)
+	if err != nil {
+		return err
+	}
+	encoded, err := sessionCodec.Encode(data)
+	if err != nil {
+		return err
+	}
+	rec := &sessionRecord{
+		Id:      id,
+		Data:    encoded,
+		Expires: sessionExpires(expiration),
+	}
+	_, err = o.Save(rec)
+	return err
+}
+
+func (s *OrmSessionStore) Delete(ctx *Context, id string) error {
+	o, err := s.orm(ctx)
+	if err != nil {
+		return err
+	}
+	return o.Delete(&sessionRecord{Id: id})
+}
+
+// DeleteExpired removes all the expired sessions from the given ORM.
+func (s *OrmSessionStore) DeleteExpired(o *orm.Orm) error {
+	table := o.TypeTable(reflect.TypeOf(sessionRecord{}))
+	if table == nil {
+		return errors.New("session model is not registered, use NewOrmSessionStore")
+	}
+	_, err := o.DeleteFrom(table, orm.Lt("Expires", time.Now()))
+	return err
+}
+
+// cookieSession is the value stored by CookieSessionStore.
+type cookieSession struct {
+	Id   string
+	Data *SessionData
+}
+
+// CookieSessionStore stores the session data in a cookie named
+// SESSION_DATA_COOKIE_NAME, which is encrypted when the App has an
+// EncryptionKey or just signed otherwise. Since the data is stored
+// on the client side, sessions can't be revoked from the server and
+// their total size is limited to cookies.MaxSize. This is the store
+// used when the App has no SessionStore.
+type CookieSessionStore struct {
+}
+
+func (s *CookieSessionStore) encrypted(ctx *Context) bool {
+	return ctx.app.cfg.EncryptionKey != ""
+}
+
+func (s *CookieSessionStore) Load(ctx *Context, id string) (*SessionData, error) {
+	var cs *cookieSession
+	var err error
+	c := ctx.Cookies()
+	if !c.Has(SESSION_DATA_COOKIE_NAME) {
+		return nil, nil
+	}
+	if s.encrypted(ctx) {
+		err = c.GetEncrypted(SESSION_DATA_COOKIE_NAME, &cs)
+	} else {
+		err = c.GetSecure(SESSION_DATA_COOKIE_NAME, &cs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cs == nil || cs.Id != id {
+		return nil, nil
+	}
+	return cs.Data, nil
+}
+
+func (s *CookieSessionStore) Save(ctx *Context, id string, data *SessionData, expiration int) error {
+	opts := &cookies.Options{
+		Path:     "/",
+		HttpOnly: true,
+		Expires:  sessionExpires(expiration),
+	}
+	if o := ctx.app.CookieOptions; o != nil {
+		opts.Path = o.Path
+		opts.Domain = o.Domain
+		opts.Secure = o.Secure
+	}
+	cs := &cookieSession{Id: id, Data: data}
+	c := ctx.Cookies()
+	if s.encrypted(ctx) {
+		return c.SetEncryptedOpts(SESSION_DATA_COOKIE_NAME, cs, opts)
+	}
+	return c.SetSecureOpts(SESSION_DATA_COOKIE_NAME, cs, opts)
+}
+
+func (s *CookieSessionStore) Delete(ctx *Context, id string) error {
+	c := ctx.Cookies()
+	if c.Has(SESSION_DATA_COOKIE_NAME) {
+		c.Delete(SESSION_DATA_COOKIE_NAME)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package app
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"gnd.la/config"
+	"gnd.la/log"
+)
+
+type sessionUser int64
+
+func (u sessionUser) Id() int64     { return int64(u) }
+func (u sessionUser) IsAdmin() bool { return false }
+
+func newSessionApp(t *testing.T, store SessionStore) (*App, *httptest.Server) {
+	a := New()
+	a.Config().Secret = "0123456789abcdef0123456789abcdef"
+	a.SessionStore = store
+	if _, ok := store.(*CacheSessionStore); ok {
+		u, err := config.ParseURL("memory://")
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.Config().Cache = u
+	}
+	a.SetUserFunc(func(ctx *Context, id int64) User {
+		return sessionUser(id)
+	})
+	a.Handle("^/set/$", func(ctx *Context) {
+		ctx.Session().Set("value", ctx.FormValue("v"))
+	})
+	a.Handle("^/set-late/$", func(ctx *Context) {
+		ctx.WriteString("ok")
+		ctx.Session().Set("value", ctx.FormValue("v"))
+	})
+	a.Handle("^/get/$", func(ctx *Context) {
+		v, _ := ctx.Session().Get("value").(string)
+		ctx.WriteString(v)
+	})
+	a.Handle("^/id/$", func(ctx *Context) {
+		ctx.WriteString(ctx.Session().Id())
+	})
+	a.Handle("^/sign-in/$", func(ctx *Context) {
+		ctx.MustSignIn(sessionUser(42))
+	})
+	a.Handle("^/sign-out/$", SignOutHandler)
+	a.Handle("^/user/$", func(ctx *Context) {
+		if u := ctx.User(); u != nil {
+			ctx.WriteString("signed-in")
+		}
+	})
+	return a, httptest.NewServer(a)
+}
+
+func sessionGet(t *testing.T, client *http.Client, u string) string {
+	resp, err := client.Get(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var buf [128]byte
+	n, _ := resp.Body.Read(buf[:])
+	return string(buf[:n])
+}
+
+func testSessionStore(t *testing.T, store SessionStore) {
+	_, srv := newSessionApp(t, store)
+	defer srv.Close()
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{Jar: jar}
+	sessionGet(t, client, srv.URL+"/set/?v=hello")
+	if v := sessionGet(t, client, srv.URL+"/get/"); v != "hello" {
+		t.Errorf("expecting session value hello, got %q", v)
+	}
+	prevId := sessionGet(t, client, srv.URL+"/id/")
+	sessionGet(t, client, srv.URL+"/sign-in/")
+	if id := sessionGet(t, client, srv.URL+"/id/"); id == prevId {
+		t.Errorf("session id was not regenerated on sign in")
+	}
+	if v := sessionGet(t, client, srv.URL+"/get/"); v != "hello" {
+		t.Errorf("expecting session value hello after sign in, got %q", v)
+	}
+	if u := sessionGet(t, client, srv.URL+"/user/"); u != "signed-in" {
+		t.Errorf("expecting signed in user")
+	}
+	srvURL, _ := url.Parse(srv.URL)
+	saved := jar.Cookies(srvURL)
+	sessionGet(t, client, srv.URL+"/sign-out/")
+	if v := sessionGet(t, client, srv.URL+"/get/"); v != "" {
+		t.Errorf("expecting empty session after sign out, got %q", v)
+	}
+	if _, ok := store.(*CacheSessionStore); ok {
+		// Server side sessions must be revoked, even
+		// if the client sends the old cookies.
+		jar2, _ := cookiejar.New(nil)
+		jar2.SetCookies(srvURL, saved)
+		client2 := &http.Client{Jar: jar2}
+		if u := sessionGet(t, client2, srv.URL+"/user/"); u != "" {
+			t.Errorf("expecting revoked session after sign out")
+		}
+	}
+}
+
+func TestCookieSession(t *testing.T) {
+	testSessionStore(t, nil)
+}
+
+func TestCacheSession(t *testing.T) {
+	testSessionStore(t, &CacheSessionStore{})
+}
+
+func TestSessionModifiedAfterHeaders(t *testing.T) {
+	var buf bytes.Buffer
+	a, srv := newSessionApp(t, nil)
+	defer srv.Close()
+	a.Logger = log.New(log.NewIOWriter(&buf, log.LDebug), 0, log.LDebug)
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{Jar: jar}
+	sessionGet(t, client, srv.URL+"/set/?v=hello")
+	if v := sessionGet(t, client, srv.URL+"/set-late/?v=bye"); v != "ok" {
+		t.Errorf("expecting response ok, got %q", v)
+	}
+	if v := sessionGet(t, client, srv.URL+"/get/"); v != "hello" {
+		t.Errorf("expecting session value hello, got %q", v)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("session modified after sending the response headers")) {
+		t.Errorf("expecting an error about the dropped session changes, got %q", buf.String())
+	}
+}
+
+func TestSessionExpiration(t *testing.T) {
+	a := New()
+	a.Config().SessionIdleTimeout = 60
+	a.Config().SessionMaxAge = 3600
+	ctx := a.NewContext(nil)
+	now := time.Now()
+	tests := []struct {
+		created  time.Time
+		accessed time.Time
+		expired  bool
+	}{
+		{now, now, false},
+		{now.Add(-time.Minute * 30), now.Add(-time.Second * 30), false},
+		{now.Add(-time.Minute * 30), now.Add(-time.Minute * 2), true},
+		{now.Add(-time.Hour * 2), now, true},
+	}
+	for _, v := range tests {
+		s := &Session{ctx: ctx, data: &SessionData{Created: v.created, Accessed: v.accessed}}
+		if exp := s.expired(now); exp != v.expired {
+			t.Errorf("expecting expired = %v for session created at %v and accessed at %v, got %v", v.expired, v.created, v.accessed, exp)
+		}
+	}
+}
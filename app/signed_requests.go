@@ -0,0 +1,277 @@
+package app
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gnd.la/crypto/cryptoutil"
+)
+
+const (
+	// SignedRequestScheme is the scheme used in the Authorization
+	// header of the requests signed with SignRequest.
+	SignedRequestScheme = "HMAC-SHA256"
+	// DefaultSignedRequestMaxSkew is the default value for
+	// SignedRequests.MaxSkew.
+	DefaultSignedRequestMaxSkew = 300
+	// DefaultSignedRequestMaxBodySize is the default value for
+	// SignedRequests.MaxBodySize.
+	DefaultSignedRequestMaxBodySize = 10 << 20
+)
+
+var (
+	errUnknownKeyID     = errors.New("unknown key id")
+	errRequestExpired   = errors.New("request timestamp is too far from the current time")
+	errRequestReplayed  = errors.New("request has already been received")
+	errInvalidSignature = errors.New("invalid request signature")
+)
+
+// ReplayCache records the signatures of the requests received by
+// SignedRequests, so they can't be replayed. Applications running
+// multiple instances should provide an implementation backed by
+// a shared storage.
+type ReplayCache interface {
+	// Add records the given key until the expiration time, returning
+	// false if the key was already recorded and hasn't expired yet.
+	Add(key string, expires time.Time) bool
+}
+
+type memoryReplayCache struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+	swept   time.Time
+}
+
+func (m *memoryReplayCache) Add(key string, expires time.Time) bool {
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if now.Sub(m.swept) > time.Minute {
+		for k, v := range m.entries {
+			if v.Before(now) {
+				delete(m.entries, k)
+			}
+		}
+		m.swept = now
+	}
+	if exp, ok := m.entries[key]; ok && exp.After(now) {
+		return false
+	}
+	if m.entries == nil {
+		m.entries = make(map[string]time.Time)
+	}
+	m.entries[key] = expires
+	return true
+}
+
+// SignedRequests is an Authenticator which verifies requests signed
+// using a shared key, identified by a key id. Clients sign the request
+// method, the host, the request URI (path plus query), a Unix timestamp
+// and the SHA-256 of the request body using HMAC-SHA256, and send the
+// signature in the Authorization header as:
+//
+//	Authorization: HMAC-SHA256 KeyId="<key id>", Timestamp="<unix time>", Signature="<signature>"
+//
+// Where the signature is the HMAC of the following string, encoded using
+// unpadded URL safe base64 (see gnd.la/crypto/cryptoutil.Signer.Signature):
+//
+//	<METHOD>\n<lowercase host>\n<request URI>\n<timestamp>\n<hex encoded SHA-256 of the body>
+//
+// Where the host is the value of the Host header, including the port
+// if it's present, so signed requests can't be redirected to other
+// hosts sharing the same keys. Go clients might use SignRequest. Requests are rejected when their
+// timestamp differs from the current time by more than MaxSkew seconds
+// or when the same signature has been already received, so captured
+// requests can't be replayed. Note that, in order to verify the body
+// hash, the request body is read into memory (up to MaxBodySize bytes),
+// so Context.User must be called before the handler reads the body
+// (HandlerOptions.RequireUser takes care of this). e.g.
+//
+//	App.Authenticators = []app.Authenticator{
+//		&app.SignedRequests{
+//			Key: func(ctx *app.Context, keyID string) ([]byte, app.User, error) {
+//				var key *APIKey
+//				if !ctx.Orm().MustOne(orm.Eq("KeyID", keyID), &key) {
+//					return nil, nil, nil
+//				}
+//				return key.Secret, key.User(ctx), nil
+//			},
+//		},
+//	}
+type SignedRequests struct {
+	// Key returns the secret key for the given key id and the User
+	// which owns it. If the key id is unknown, it must return a nil
+	// key and a nil error.
+	Key func(ctx *Context, keyID string) ([]byte, User, error)
+	// MaxSkew is the maximum number of seconds between the request
+	// timestamp and the current time. If zero,
+	// DefaultSignedRequestMaxSkew is used.
+	MaxSkew int
+	// ReplayCache is used for rejecting replayed requests. If nil,
+	// an in-memory cache is used.
+	ReplayCache ReplayCache
+	// MaxBodySize is the maximum size in bytes of the body of a
+	// signed request, which must be read into memory to verify its
+	// hash. Requests with larger bodies fail to authenticate. If zero,
+	// DefaultSignedRequestMaxBodySize is used, while a negative value
+	// disables the limit.
+	MaxBodySize int64
+	once        sync.Once
+	cache       ReplayCache
+}
+
+func (s *SignedRequests) maxSkew() time.Duration {
+	skew := s.MaxSkew
+	if skew <= 0 {
+		skew = DefaultSignedRequestMaxSkew
+	}
+	return time.Duration(skew) * time.Second
+}
+
+func (s *SignedRequests) maxBodySize() int64 {
+	if s.MaxBodySize == 0 {
+		return DefaultSignedRequestMaxBodySize
+	}
+	return s.MaxBodySize
+}
+
+func (s *SignedRequests) replayCache() ReplayCache {
+	s.once.Do(func() {
+		s.cache = s.ReplayCache
+		if s.cache == nil {
+			s.cache = &memoryReplayCache{}
+		}
+	})
+	return s.cache
+}
+
+// Authenticate implements the Authenticator interface.
+func (s *SignedRequests) Authenticate(ctx *Context) (User, error) {
+	scheme, credentials, err := ctx.authorization()
+	if err != nil || scheme != SignedRequestScheme {
+		return nil, nil
+	}
+	params := parseAuthParams(credentials)
+	keyID, signature := params["KeyId"], params["Signature"]
+	if keyID == "" || signature == "" {
+		return nil, fmt.Errorf("invalid %s Authorization header", SignedRequestScheme)
+	}
+	ts, err := strconv.ParseInt(params["Timestamp"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s timestamp: %s", SignedRequestScheme, err)
+	}
+	timestamp := time.Unix(ts, 0)
+	skew := s.maxSkew()
+	if d := time.Since(timestamp); d > skew || d < -skew {
+		return nil, errRequestExpired
+	}
+	key, user, err := s.Key(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, errUnknownKeyID
+	}
+	if max := s.maxBodySize(); max > 0 && ctx.R.Body != nil {
+		ctx.R.Body = http.MaxBytesReader(ctx.ResponseWriter, ctx.R.Body, max)
+	}
+	body, err := readRequestBody(ctx.R)
+	if err != nil {
+		return nil, err
+	}
+	uri := ctx.R.RequestURI
+	if uri == "" {
+		uri = ctx.R.URL.RequestURI()
+	}
+	signer := requestSigner(key)
+	if err := signer.Verify(signedRequestData(ctx.R.Method, ctx.R.Host, uri, ts, body), signature); err != nil {
+		return nil, errInvalidSignature
+	}
+	if !s.replayCache().Add(keyID+":"+signature, timestamp.Add(skew)) {
+		return nil, errRequestReplayed
+	}
+	return user, nil
+}
+
+// SignRequest signs the given request using the given key id and
+// key, adding the Authorization header expected by SignedRequests.
+// The signature covers r.Host or, when it's empty, r.URL.Host, so
+// the Host header must not be changed after signing the request.
+// Note that the request body, if any, is read into memory.
+func SignRequest(r *http.Request, keyID string, key []byte) error {
+	body, err := readRequestBody(r)
+	if err != nil {
+		return err
+	}
+	host := r.Host
+	if host == "" {
+		host = r.URL.Host
+	}
+	ts := time.Now().Unix()
+	signature, err := requestSigner(key).Signature(signedRequestData(r.Method, host, r.URL.RequestURI(), ts, body))
+	if err != nil {
+		return err
+	}
+	r.Header.Set("Authorization", fmt.Sprintf("%s KeyId=%q, Timestamp=\"%d\", Signature=%q",
+		SignedRequestScheme, keyID, ts, signature))
+	return nil
+}
+
+func requestSigner(key []byte) *cryptoutil.Signer {
+	return &cryptoutil.Signer{
+		Hasher: func(key []byte) (hash.Hash, error) {
+			return hmac.New(sha256.New, key), nil
+		},
+		Key: key,
+	}
+}
+
+func signedRequestData(method string, host string, uri string, ts int64, body []byte) []byte {
+	sum := sha256.Sum256(body)
+	return []byte(strings.ToUpper(method) + "\n" + strings.ToLower(host) + "\n" + uri + "\n" + strconv.FormatInt(ts, 10) + "\n" + hex.EncodeToString(sum[:]))
+}
+
+// readRequestBody reads the whole request body and
+// replaces it, so it can be read again.
+func readRequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// parseAuthParams parses the comma separated key=value
+// pairs in the Authorization header credentials. Values
+// might be quoted.
+func parseAuthParams(s string) map[string]string {
+	params := make(map[string]string)
+	for _, v := range strings.Split(s, ",") {
+		kv := strings.SplitN(strings.TrimSpace(v), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value := kv[1]
+		if uq, err := strconv.Unquote(value); err == nil {
+			value = uq
+		}
+		params[kv[0]] = value
+	}
+	return params
+}
@@ -20,11 +20,15 @@ var (
 	errNoLoadedTemplate   = errors.New("this template was not loaded from App.LoadTemplate nor NewTemplate")
 
 	templateFuncs = template.FuncMap{
-		"!t":   template_t,
-		"!tn":  template_tn,
-		"!tc":  template_tc,
-		"!tnc": template_tnc,
-		"app":  nop,
+		"!t":                                template_t,
+		"!tn":                               template_tn,
+		"!tc":                               template_tc,
+		"!tnc":                              template_tnc,
+		"!flashes":                          template_flashes,
+		"!csrf_token":                       template_csrf_token,
+		"!can_access":                       template_can_access,
+		"!reverse_accessible":               template_reverse_accessible,
+		"app":                               nop,
 		templateutil.BeginTranslatableBlock: nop,
 		templateutil.EndTranslatableBlock:   nop,
 	}
@@ -37,6 +37,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"regexp"
 	"runtime"
@@ -47,6 +48,7 @@ import (
 
 	"gnd.la/app"
 	"gnd.la/internal"
+	"gnd.la/net/websocket"
 	"gnd.la/util/types"
 )
 
@@ -570,6 +572,39 @@ func (t *Tester) Form(path string, params map[string]interface{}) *Request {
 	return req
 }
 
+// WebSocket connects to the WebSocket handler at the given path (see
+// gnd.la/app.Context.WebSocket), sending the given headers, which
+// might be nil, in the handshake request. Unless the -H flag is used,
+// the App is served from a temporary server, which is stopped once the
+// connection is established. The caller is responsible for closing
+// the returned connection.
+//
+//  conn, err := te.WebSocket("/echo/", nil, nil)
+//  if err != nil {
+//	t.Fatal(err)
+//  }
+//  defer conn.Close()
+func (t *Tester) WebSocket(path string, header http.Header, opts *websocket.Options) (*websocket.Conn, error) {
+	var base string
+	if *remoteHost != "" {
+		base = *remoteHost
+		if !strings.Contains(base, "://") {
+			base = "http://" + base
+		}
+		base = strings.TrimSuffix(base, "/")
+	} else {
+		srv := httptest.NewServer(t.App)
+		// Upgraded connections are hijacked, so they're
+		// not closed when the server is.
+		defer srv.Close()
+		base = srv.URL
+	}
+	u := "ws" + strings.TrimPrefix(base, "http") + path
+	t.Reporter.Log(fmt.Sprintf("connecting to WebSocket %s", u))
+	conn, _, err := websocket.Dial(u, header, opts)
+	return conn, err
+}
+
 func encode(params map[string]interface{}) string {
 	values := make(url.Values)
 	for k, v := range params {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"gnd.la/app"
 	"gnd.la/app/tester"
+	"gnd.la/net/websocket"
 	"gnd.la/util/generic"
 	"gnd.la/util/stringutil"
 	"io/ioutil"
@@ -132,6 +133,27 @@ func TestExpectErrors(t *testing.T) {
 	}
 }
 
+func TestWebSocket(t *testing.T) {
+	tt := tester.New(t, testApp)
+	conn, err := tt.WebSocket("/ws", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if err := conn.WriteText("hello"); err != nil {
+		t.Fatal(err)
+	}
+	typ, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typ != websocket.TextMessage || string(data) != "hello" {
+		t.Errorf("expecting text message \"hello\", got %s message %q", typ, string(data))
+	}
+	// Non WebSocket requests are rejected
+	tt.Get("/ws", nil).Expect(400)
+}
+
 func init() {
 	testApp = app.New()
 	testApp.Config().Secret = stringutil.Random(32)
@@ -141,6 +163,22 @@ func init() {
 		ctx.WriteString("hello world")
 	})
 	testApp.Handle("^/empty$", func(ctx *app.Context) {})
+	testApp.Handle("^/ws$", func(ctx *app.Context) {
+		conn, err := ctx.WebSocket(nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			typ, data, err := conn.ReadMessage()
+			if err != nil {
+				break
+			}
+			if err := conn.WriteMessage(typ, data); err != nil {
+				break
+			}
+		}
+	})
 	testApp.Handle("^/echo$", func(ctx *app.Context) {
 		if ctx.R.Method == "POST" {
 			data, err := ioutil.ReadAll(ctx.R.Body)
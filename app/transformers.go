@@ -10,6 +10,15 @@ import (
 // added (e.g. requiring a signed in user, or adding a cache layer).
 type Transformer func(Handler) Handler
 
+// wrapHandler applies the given transformers to handler, making
+// the first one the outermost.
+func wrapHandler(handler Handler, transformers []Transformer) Handler {
+	for ii := len(transformers) - 1; ii >= 0; ii-- {
+		handler = transformers[ii](handler)
+	}
+	return handler
+}
+
 // SignedIn returns a new Handler which requires a signed in
 // user to be executed. If there's no signed in user, it returns
 // a redirect to the handler named "sign-in", indicating the
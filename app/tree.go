@@ -0,0 +1,137 @@
+package app
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+)
+
+// handlerTree is a radix tree which indexes the handlers
+// by the literal prefix of their patterns, so only the
+// handlers which might match a given path need to be tested.
+// Each node stores the indexes (in the App handlers slice)
+// of the handlers whose prefix ends at the node.
+type handlerTree struct {
+	prefix   string
+	children []*handlerTree
+	handlers []int
+}
+
+func (t *handlerTree) insert(key string, idx int) {
+	node := t
+	for {
+		if key == "" {
+			node.handlers = append(node.handlers, idx)
+			return
+		}
+		var child *handlerTree
+		for _, v := range node.children {
+			if v.prefix[0] == key[0] {
+				child = v
+				break
+			}
+		}
+		if child == nil {
+			node.children = append(node.children, &handlerTree{prefix: key, handlers: []int{idx}})
+			return
+		}
+		common := commonPrefixLen(key, child.prefix)
+		if common < len(child.prefix) {
+			// Split the child
+			split := &handlerTree{
+				prefix:   child.prefix[common:],
+				children: child.children,
+				handlers: child.handlers,
+			}
+			child.prefix = child.prefix[:common]
+			child.children = []*handlerTree{split}
+			child.handlers = nil
+		}
+		node = child
+		key = key[common:]
+	}
+}
+
+// candidates returns the indexes of the handlers which might
+// match the given path, in ascending order. buf might be
+// used to avoid allocations.
+func (t *handlerTree) candidates(path string, buf []int) []int {
+	var stack [8][]int
+	lists := stack[:0]
+	node := t
+	for {
+		if len(node.handlers) > 0 {
+			lists = append(lists, node.handlers)
+		}
+		var next *handlerTree
+		for _, v := range node.children {
+			if strings.HasPrefix(path, v.prefix) {
+				next = v
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+		path = path[len(next.prefix):]
+		node = next
+	}
+	if len(lists) == 1 {
+		return lists[0]
+	}
+	// Merge the lists, which are already sorted, to
+	// preserve the registration order.
+	res := buf[:0]
+	for {
+		min := -1
+		for ii, v := range lists {
+			if len(v) > 0 && (min < 0 || v[0] < lists[min][0]) {
+				min = ii
+			}
+		}
+		if min < 0 {
+			break
+		}
+		res = append(res, lists[min][0])
+		lists[min] = lists[min][1:]
+	}
+	return res
+}
+
+func newHandlerTree(handlers []*handlerInfo) *handlerTree {
+	t := new(handlerTree)
+	for ii, v := range handlers {
+		t.insert(literalPrefix(v.re), ii)
+	}
+	return t
+}
+
+func commonPrefixLen(a, b string) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	ii := 0
+	for ii < n && a[ii] == b[ii] {
+		ii++
+	}
+	return ii
+}
+
+// literalPrefix returns the literal string which every string
+// matched by the given regexp must start with. Unanchored
+// regexps return the empty string.
+func literalPrefix(r *regexp.Regexp) string {
+	re, err := syntax.Parse(r.String(), syntax.Perl)
+	if err != nil || re.Op != syntax.OpConcat || len(re.Sub) < 2 || re.Sub[0].Op != syntax.OpBeginText {
+		return ""
+	}
+	var prefix []rune
+	for _, v := range re.Sub[1:] {
+		if v.Op != syntax.OpLiteral || v.Flags&syntax.FoldCase != 0 {
+			break
+		}
+		prefix = append(prefix, v.Rune...)
+	}
+	return string(prefix)
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestLiteralPrefix(t *testing.T) {
+	tests := map[string]string{
+		"^/foobar/$":             "/foobar/",
+		"^/article/(\\d+)/$":     "/article/",
+		"^/debug/pprof":          "/debug/pprof",
+		"/_gondola_monitor":      "",
+		"^/(.*)$":                "/",
+		"^/foo/bar|^/foo/baz":    "",
+		"(?i)^/foo/$":            "",
+		"^/users/(?P<id>\\d+)/$": "/users/",
+	}
+	for k, v := range tests {
+		if p := literalPrefix(regexp.MustCompile(k)); p != v {
+			t.Errorf("expecting prefix %q for %q, got %q", v, k, p)
+		}
+	}
+}
+
+func TestHandlerTreeCandidates(t *testing.T) {
+	var tree handlerTree
+	for ii, v := range []string{"/", "/foo/", "/foo/bar/", "/fob/", "", "/foo/"} {
+		tree.insert(v, ii)
+	}
+	tests := map[string][]int{
+		"/foo/bar/baz": {0, 1, 2, 4, 5},
+		"/fob/":        {0, 3, 4},
+		"/other":       {0, 4},
+		"":             {4},
+	}
+	for k, v := range tests {
+		if c := tree.candidates(k, nil); !reflect.DeepEqual(c, v) {
+			t.Errorf("expecting candidates %v for %q, got %v", v, k, c)
+		}
+	}
+}
+
+func TestMatchOrder(t *testing.T) {
+	a := New()
+	var matched string
+	handler := func(name string) Handler {
+		return func(ctx *Context) {
+			matched = name
+		}
+	}
+	a.Handle("^/articles/(.*)$", handler("catch-all"))
+	a.Handle("^/articles/latest/$", handler("latest"))
+	a.Handle("^/art", handler("art"))
+	a.Handle("^/docs/latest/$", handler("docs"))
+	a.Handle("^/(.*)$", handler("root"))
+	tests := map[string]string{
+		"/articles/latest/": "catch-all",
+		"/artwork/":         "art",
+		"/docs/latest/":     "docs",
+		"/docs/other/":      "root",
+	}
+	for k, v := range tests {
+		matched = ""
+		req, _ := http.NewRequest("GET", "http://localhost"+k, nil)
+		a.ServeHTTP(discard, req)
+		if matched != v {
+			t.Errorf("expecting handler %q for %q, got %q", v, k, matched)
+		}
+	}
+}
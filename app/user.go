@@ -30,28 +30,61 @@ type User interface {
 type UserFunc func(ctx *Context, id int64) User
 
 // User returns the currently signed in user, or nil if there's
-// no user. In order to find the user, the App must have a
-// UserFunc defined.
+// no user. The App Authenticators are consulted first (see
+// Authenticator) and, if none of them recognizes the request
+// credentials, the user is looked up using the session, which
+// requires the App to have a UserFunc defined. The user is looked
+// up only once per request.
 func (c *Context) User() User {
-	if c.user == nil && c.app.userFunc != nil {
-		var id int64
-		err := c.Cookies().GetSecure(USER_COOKIE_NAME, &id)
-		if err == nil {
-			c.user = c.app.userFunc(c, id)
+	if c.user != nil || c.userLoaded {
+		return c.user
+	}
+	c.userLoaded = true
+	if user, done := c.authenticate(); done {
+		c.user = user
+		return c.user
+	}
+	if c.app.userFunc != nil {
+		if c.app.usesServerSessions() {
+			if id, ok := c.Session().Get(userSessionKey).(int64); ok {
+				c.user = c.app.userFunc(c, id)
+			}
+		} else {
+			var id int64
+			err := c.Cookies().GetSecure(USER_COOKIE_NAME, &id)
+			if err == nil {
+				c.user = c.app.userFunc(c, id)
+			}
 		}
 	}
 	return c.user
 }
 
-// SignIn sets the cookie for signin in the given user. The default
-// cookie options for the App are used.
+// SignIn signs in the given user. If the App uses a server side
+// SessionStore, the user id is stored in the session. Otherwise, it's
+// stored in a signed cookie, using the default cookie options for the
+// App. In both cases, the session id is regenerated to avoid
+// session fixation attacks.
 func (c *Context) SignIn(user User) error {
 	if c.app.userFunc == nil {
 		return errNoUserFunc
 	}
-	err := c.Cookies().SetSecure(USER_COOKIE_NAME, user.Id())
-	if err != nil {
-		return err
+	if c.app.usesServerSessions() {
+		s := c.Session()
+		if err := s.Regenerate(); err != nil {
+			return err
+		}
+		s.Set(userSessionKey, user.Id())
+	} else {
+		if c.session != nil || c.Cookies().Has(SESSION_COOKIE_NAME) {
+			if err := c.Session().Regenerate(); err != nil {
+				return err
+			}
+		}
+		err := c.Cookies().SetSecure(USER_COOKIE_NAME, user.Id())
+		if err != nil {
+			return err
+		}
 	}
 	c.user = user
 	return nil
@@ -64,8 +97,17 @@ func (c *Context) MustSignIn(user User) {
 	}
 }
 
-// SignOut deletes the signed in cookie for the current user. If there's
+// SignOut signs out the current user, deleting its cookie and
+// destroying its session, including the server side data. If there's
 // no current signed in user, it does nothing.
 func (c *Context) SignOut() {
-	c.Cookies().Delete(USER_COOKIE_NAME)
+	if c.Cookies().Has(USER_COOKIE_NAME) {
+		c.Cookies().Delete(USER_COOKIE_NAME)
+	}
+	if c.session != nil || c.Cookies().Has(SESSION_COOKIE_NAME) {
+		if err := c.Session().Destroy(); err != nil {
+			c.Logger().Errorf("error destroying session: %s", err)
+		}
+	}
+	c.user = nil
 }
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+
+	"gnd.la/net/websocket"
+)
+
+// hijackableContext allows passing a *Context to
+// websocket.Upgrade, so errors are written through
+// the Context.
+type hijackableContext struct {
+	*Context
+}
+
+func (c hijackableContext) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if hj, ok := c.ResponseWriter.(http.Hijacker); ok {
+		return hj.Hijack()
+	}
+	return nil, nil, errCantHijack
+}
+
+// WebSocket upgrades the connection to a WebSocket, using the given
+// options, which might be nil. If the request is not a valid WebSocket
+// handshake, an error response is sent to the client and a non-nil
+// error is returned. Once the connection has been upgraded, the
+// handler must not write to the Context anymore, but rather use
+// the returned *websocket.Conn. See gnd.la/net/websocket for more
+// details.
+//
+// Any pending session changes and flash messages are saved before
+// upgrading the connection, since the handshake response is the only
+// chance for sending cookies. For the same reason, handlers which
+// upgrade the connection must not be wrapped by Transformers which
+// replace the Context ResponseWriter, like ETag or gnd.la/cache/layer.
+func (c *Context) WebSocket(opts *websocket.Options) (*websocket.Conn, error) {
+	c.saveFlashes()
+	c.saveSession()
+	conn, err := websocket.Upgrade(hijackableContext{c}, c.R, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.statusCode = http.StatusSwitchingProtocols
+	return conn, nil
+}
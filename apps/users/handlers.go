@@ -59,17 +59,26 @@ var (
 	SignInTwitterHandler    = app.NamedHandler(SignInTwitterHandlerName, app.Anonymous(signInTwitterHandler))
 	SignInGithubHandler     = app.NamedHandler(SignInGithubHandlerName, app.Anonymous(signInGithubHandler))
 	SignUpHandler           = app.NamedHandler(SignUpHandlerName, app.Anonymous(signUpHandler))
-	SignOutHandler          = app.NamedHandler(SignOutHandlerName, app.SignOutHandler)
+	SignOutHandler          = csrfExempt(app.NamedHandler(SignOutHandlerName, app.SignOutHandler))
 	ForgotHandler           = app.NamedHandler(ForgotHandlerName, app.Anonymous(forgotHandler))
 	ResetHandler            = app.NamedHandler(ResetHandlerName, resetHandler)
 	JSSignInHandler         = app.NamedHandler(JSSignInHandlerName, app.Anonymous(jsSignInHandler))
-	JSSignInFacebookHandler = app.NamedHandler(JSSignInFacebookHandlerName, app.Anonymous(jsSignInFacebookHandler))
-	JSSignInGoogleHandler   = app.NamedHandler(JSSignInGoogleHandlerName, app.Anonymous(jsSignInGoogleHandler))
+	JSSignInFacebookHandler = csrfExempt(app.NamedHandler(JSSignInFacebookHandlerName, app.Anonymous(jsSignInFacebookHandler)))
+	JSSignInGoogleHandler   = csrfExempt(app.NamedHandler(JSSignInGoogleHandlerName, app.Anonymous(jsSignInGoogleHandler)))
 	JSSignUpHandler         = app.NamedHandler(JSSignUpHandlerName, app.Anonymous(jsSignUpHandler))
 	FacebookChannelHandler  = app.NamedHandler(FacebookChannelHandlerName, facebookChannelHandler)
 	UserImageHandler        = app.NamedHandler(ImageHandlerName, imageHandler)
 )
 
+// csrfExempt disables the App CSRF protection for handlers which
+// are invoked via XHR from users.js, which doesn't send the token.
+// Note that the rest of the handlers use gnd.la/form, which includes
+// the App CSRF token in the forms.
+func csrfExempt(h *app.HandlerInfo) *app.HandlerInfo {
+	h.Options.CSRFExempt = true
+	return h
+}
+
 func signInHandler(ctx *app.Context) {
 	modal := ctx.FormValue("modal") != ""
 	st := enabledSocialTypes()
@@ -170,6 +170,31 @@ func (s *Blobstore) Remove(id string) error {
 	return s.drv.Remove(id)
 }
 
+// Check checks that the blobstore backend is reachable without
+// modifying it. If the driver does not implement driver.Checker,
+// the check is performed by iterating over its files. Drivers which
+// implement neither driver.Checker nor driver.Iterable are assumed to
+// be reachable.
+func (s *Blobstore) Check() error {
+	if checker, ok := s.drv.(driver.Checker); ok {
+		return checker.Check()
+	}
+	if iterable, ok := s.drv.(driver.Iterable); ok {
+		iter, err := iterable.Iter()
+		if err != nil {
+			return err
+		}
+		var id string
+		iter.Next(&id)
+		if err := iter.Err(); err != nil {
+			iter.Close()
+			return err
+		}
+		return iter.Close()
+	}
+	return nil
+}
+
 // Driver returns the underlying driver
 func (s *Blobstore) Driver() driver.Driver {
 	return s.drv
@@ -43,6 +43,12 @@ type Range interface {
 	String() string
 }
 
+// Checker is the interface implemented by drivers which can
+// check if they're able to reach their storage backend.
+type Checker interface {
+	Check() error
+}
+
 type Server interface {
 	// Serve serves the file directly from the driver to the given
 	// http.ResponseWriter. If this function returns (false, nil)
@@ -92,6 +92,17 @@ func (f *fsDriver) Close() error {
 	return nil
 }
 
+func (f *fsDriver) Check() error {
+	st, err := os.Stat(f.dir)
+	if err != nil {
+		return err
+	}
+	if !st.IsDir() {
+		return fmt.Errorf("%s is not a directory", f.dir)
+	}
+	return nil
+}
+
 func (f *fsDriver) Iter() (driver.Iter, error) {
 	res, err := ioutil.ReadDir(f.dir)
 	if err != nil {
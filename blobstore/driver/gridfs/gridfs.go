@@ -95,6 +95,10 @@ func (d *gridfsDriver) Close() error {
 	return nil
 }
 
+func (d *gridfsDriver) Check() error {
+	return d.session.Ping()
+}
+
 func gridfsOpener(url *config.URL) (driver.Driver, error) {
 	value := url.Value
 	connections.RLock()
@@ -90,6 +90,11 @@ func (d *s3Driver) Close() error {
 	return nil
 }
 
+func (d *s3Driver) Check() error {
+	_, err := d.bucket.List("", "", "", 1)
+	return err
+}
+
 func s3Opener(url *config.URL) (driver.Driver, error) {
 	accessKey := url.Fragment.Get("access_key")
 	if accessKey == "" {
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 
+	"gnd.la/app/metrics"
 	"gnd.la/app/profile"
 	"gnd.la/cache/driver"
 	"gnd.la/config"
@@ -22,7 +23,10 @@ var (
 	}
 )
 
-const cache = "cache"
+const (
+	cache    = "cache"
+	checkKey = "gondola-cache-check"
+)
 
 type Cache struct {
 	// The Logger to log debug messages and, more importantly, errors.
@@ -136,6 +140,9 @@ func (c *Cache) GetMulti(out map[string]interface{}, typer Typer) error {
 		c.error(gerr)
 		return gerr
 	}
+	if metrics.Enabled() {
+		recordLookups(qkeys, data)
+	}
 	if typer == nil {
 		typer = mapTyper(out)
 	}
@@ -221,6 +228,13 @@ func (c *Cache) GetBytes(key string) ([]byte, error) {
 		c.error(gerr)
 		return nil, gerr
 	}
+	if metrics.Enabled() {
+		if b == nil {
+			requestsTotal.Inc("miss")
+		} else {
+			requestsTotal.Inc("hit")
+		}
+	}
 	if b == nil {
 		return nil, ErrNotFound
 	}
@@ -271,6 +285,14 @@ func (c *Cache) Close() error {
 	return c.driver.Close()
 }
 
+// Check checks that the cache backend is reachable, by
+// requesting a key which is not expected to exist. A
+// non-nil error is returned only when the request fails.
+func (c *Cache) Check() error {
+	_, err := c.driver.Get(c.backendKey(checkKey))
+	return err
+}
+
 // Connection returns a interface{} wrapping the native connection
 // type for the cache client (e.g. a memcache or redis connection).
 // Some drivers might return a nil connection (like the fs or the
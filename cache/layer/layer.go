@@ -1,13 +1,19 @@
 package layer
 
 import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
 	"encoding/gob"
 	"errors"
+	"io"
 	"net/http"
 	"os"
+	"strings"
 
 	"gnd.la/app"
 	"gnd.la/cache"
+	"gnd.la/crypto/hashutil"
 	"gnd.la/encoding/codec"
 	"gnd.la/internal"
 	"gnd.la/log"
@@ -64,16 +70,33 @@ func (la *Layer) Mediator() Mediator {
 // received (id est, it does nothing). This is done in
 // order to simplify profiling Gondola apps (gondola dev
 // -profile sets this environment variable).
+//
+// Responses without an ETag get one computed from their
+// body (see app.ETag), which is also cached, so clients can
+// revalidate cached responses without transferring them again.
 func (la *Layer) Wrap(handler app.Handler) app.Handler {
 	if noCacheLayer {
 		return handler
 	}
+	handler = app.ETag(handler)
 	return func(ctx *app.Context) {
 		if la.mediator.Skip(ctx) {
 			handler(ctx)
 			return
 		}
 		key := la.mediator.Key(ctx)
+		// Responses compressed by the App are cached
+		// separately for each encoding.
+		encoding := ctx.CompressionEncoding()
+		if encoding != "" {
+			key += "-" + encoding
+		}
+		// Responses to cross-origin requests might depend on
+		// the Origin (e.g. CORS headers, see app.CORS), so
+		// they're cached separately for each origin.
+		if origin := ctx.R.Header.Get("Origin"); origin != "" {
+			key += "-" + hashutil.Md5(origin)
+		}
 		data, _ := la.cache.GetBytes(key)
 		if data != nil {
 			// has cached data
@@ -86,6 +109,9 @@ func (la *Layer) Wrap(handler app.Handler) app.Handler {
 					header[k] = v
 				}
 				header["X-Gondola-From-Layer"] = fromLayer
+				if response.StatusCode == http.StatusOK && ctx.NotModified() {
+					return
+				}
 				ctx.WriteHeader(response.StatusCode)
 				ctx.Write(response.Data)
 				return
@@ -98,7 +124,30 @@ func (la *Layer) Wrap(handler app.Handler) app.Handler {
 		handler(ctx)
 		ctx.ResponseWriter = rw
 		if la.mediator.Cache(ctx, w.statusCode, w.header) {
-			response := &cachedResponse{w.header, w.statusCode, w.buf.Bytes()}
+			body := w.buf.Bytes()
+			if encoding != "" && w.header != nil {
+				// The writer sees the response before it's
+				// compressed by the App, so it must be compressed
+				// here too. Since it will have a Content-Encoding
+				// header when served from the cache, the App won't
+				// compress it again.
+				if enc := ctx.ResponseEncoding(); enc != "" {
+					compressed, err := compress(enc, ctx.App().Compression, body)
+					if err != nil {
+						log.Errorf("Error compressing cached response: %v", err)
+						return
+					}
+					body = compressed
+					w.header.Set("Content-Encoding", enc)
+					w.header.Del("Content-Length")
+				}
+				if !hasVary(w.header, "Accept-Encoding") {
+					if vary := ctx.Header()["Vary"]; len(vary) > 0 {
+						w.header["Vary"] = vary
+					}
+				}
+			}
+			response := &cachedResponse{w.header, w.statusCode, body}
 			data, err := layerCodec.Encode(response)
 			if err == nil {
 				ctx.Set(internal.LayerCachedKey, true)
@@ -111,6 +160,42 @@ func (la *Layer) Wrap(handler app.Handler) app.Handler {
 	}
 }
 
+func compress(encoding string, opts *app.Compression, data []byte) ([]byte, error) {
+	level := flate.DefaultCompression
+	if opts != nil {
+		level = opts.CompressionLevel()
+	}
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	var err error
+	if encoding == "gzip" {
+		w, err = gzip.NewWriterLevel(&buf, level)
+	} else {
+		w, err = flate.NewWriter(&buf, level)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func hasVary(h http.Header, value string) bool {
+	for _, v := range h["Vary"] {
+		for _, s := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(s), value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func init() {
 	gob.Register(&cachedResponse{})
 }
@@ -0,0 +1,23 @@
+package cache
+
+import (
+	"gnd.la/app/metrics"
+)
+
+var requestsTotal = metrics.NewCounter("gondola_cache_requests_total",
+	"Number of cache lookups by result (hit or miss)", "result")
+
+func recordLookups(keys []string, data map[string][]byte) {
+	hits := 0
+	for _, k := range keys {
+		if data[k] != nil {
+			hits++
+		}
+	}
+	if hits > 0 {
+		requestsTotal.Add(float64(hits), "hit")
+	}
+	if misses := len(keys) - hits; misses > 0 {
+		requestsTotal.Add(float64(misses), "miss")
+	}
+}
@@ -606,6 +606,10 @@ func (p *Project) Handler(ctx *app.Context) {
 		time.Sleep(10 * time.Millisecond)
 	}
 	// Proxy
+	if ctx.R.TLS != nil {
+		// Let the project know the original scheme
+		ctx.R.Header.Set("X-Forwarded-Proto", "https")
+	}
 	p.proxy.ServeHTTP(ctx, ctx.R)
 }
 
@@ -676,6 +680,7 @@ type devOptions struct {
 	Profile   bool   `help:"Compiles and runs the project with profiling enabled"`
 	Race      bool   `help:"Enable -race when building. If the platform does not support -race, this option is ignored"`
 	NoBrowser bool   `name:"no-browser" help:"Don't open the default browser when starting the development server"`
+	TLS       bool   `help:"Serve the development server over HTTPS, using a self-signed certificate which is generated the first time"`
 	Verbose   bool   `name:"v" help:"Enable verbose output"`
 }
 
@@ -707,6 +712,17 @@ func devCommand(opts *devOptions) error {
 	p.noDebug = opts.NoDebug
 	p.noCache = opts.NoCache
 	p.profile = opts.Profile
+	scheme := "http"
+	if opts.TLS {
+		certFile, keyFile, err := devCertificate()
+		if err != nil {
+			return err
+		}
+		cfg := p.App.Config()
+		cfg.TLSCertFile = certFile
+		cfg.TLSKeyFile = keyFile
+		scheme = "https"
+	}
 	clean(dir)
 	go p.Build()
 	eof := "C"
@@ -726,7 +742,7 @@ func devCommand(opts *devOptions) error {
 					}
 				}
 			}
-			browser.Open(fmt.Sprintf("http://%s:%d", host, p.port))
+			browser.Open(fmt.Sprintf("%s://%s:%d", scheme, host, p.port))
 		})
 	}
 	p.Listen()
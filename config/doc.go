@@ -1,6 +1,7 @@
-// Package config implements a file/command line based configuration.
+// Package config implements a file/environment/command line based configuration.
 //
 // Configuration values are defined using a struct, which can be tagged
 // to include default values and help strings. Then, values can be read
-// from a config file or specified in the command line.
+// from a config file (in the Gondola, JSON or YAML formats), from environment
+// variables or specified in the command line, in increasing order of precedence.
 package config
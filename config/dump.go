@@ -0,0 +1,93 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gnd.la/util/types"
+)
+
+// redacted replaces the secret values
+// in Dump and URL.Redacted.
+const redacted = "REDACTED"
+
+// Dump writes the effective configuration, after all the sources
+// have been parsed, to the given io.Writer using the Gondola config
+// file format. Fields with a "secret" struct tag set to true (like
+// gnd.la/app.Config.Secret) are redacted, as well as the passwords
+// in *URL fields (see URL.Redacted). Fields with the "secret" struct
+// tag set to "credentials" contain an optional user:password@ prefix
+// (like gnd.la/net/mail.Config.MailServer), so only the password
+// is redacted. e.g.
+//
+//	config.MustParse()
+//	if debug {
+//		config.Dump(os.Stderr)
+//	}
+func Dump(w io.Writer) error {
+	fields, err := registeredFields(false)
+	if err != nil {
+		return err
+	}
+	return dumpFields(w, fields)
+}
+
+func dumpFields(w io.Writer, fields fieldMap) error {
+	names := make([]string, 0, len(fields))
+	values := make(map[string]string, len(fields))
+	for k, v := range fields {
+		name := parameterName(k)
+		names = append(names, name)
+		values[name] = dumpValue(v)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := fmt.Fprintf(w, "%s = %s\n", name, values[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dumpValue(f *fieldValue) string {
+	val := f.Value
+	tag := f.Tag.Get("secret")
+	if tag == "credentials" && val.Kind() == reflect.String {
+		return redactedCredentials(val.String())
+	}
+	if secret, _ := strconv.ParseBool(tag); secret {
+		if val.Kind() == reflect.String && val.String() == "" {
+			return ""
+		}
+		return redacted
+	}
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array:
+		return sliceToString(val)
+	case reflect.Map:
+		return mapToString(val)
+	}
+	if u, ok := val.Interface().(*URL); ok && u != nil {
+		return u.Redacted()
+	}
+	return types.ToString(val.Interface())
+}
+
+// redactedCredentials replaces the password in the
+// user:password@ prefix of the given value, if any.
+// The password might contain @, so the last one is used.
+func redactedCredentials(s string) string {
+	at := strings.LastIndex(s, "@")
+	if at < 0 {
+		return s
+	}
+	colon := strings.Index(s[:at], ":")
+	if colon < 0 {
+		return s
+	}
+	return s[:colon+1] + redacted + s[at:]
+}
@@ -0,0 +1,31 @@
+package config_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gnd.la/config"
+	"gnd.la/net/mail"
+)
+
+func TestDumpMailServer(t *testing.T) {
+	prev := mail.Config.MailServer
+	defer func() {
+		mail.Config.MailServer = prev
+	}()
+	tests := map[string]string{
+		"localhost:25":                   "localhost:25",
+		"user:pass@smtp.example.com:587": "user:REDACTED@smtp.example.com:587",
+	}
+	for k, v := range tests {
+		mail.Config.MailServer = k
+		var buf bytes.Buffer
+		if err := config.Dump(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if s := buf.String(); !strings.Contains(s, "mail-server = "+v+"\n") || strings.Contains(s, "pass@") {
+			t.Errorf("expecting mail-server = %s, got %q", v, s)
+		}
+	}
+}
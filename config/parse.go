@@ -19,6 +19,8 @@ import (
 var (
 	DefaultFilename = pathutil.Relative("app.conf")
 	configName      *string
+	// flag values from Parse, used by Reload
+	parsedFlags varMap
 )
 
 type fieldValue struct {
@@ -143,7 +145,7 @@ func parseValue(v reflect.Value, raw string) error {
 }
 
 // ParseFile parses the given config file into the given config
-// struct. No signal is emitted. Look at the documentation of
+// struct, using the format returned by FileFormat. No signal is emitted. Look at the documentation of
 // Parse() for information on the supported types as well as
 // the name mangling performed in the struct fields to convert
 // them to config file keys.
@@ -174,7 +176,7 @@ func parseFile(filename string, fields fieldMap) error {
 		return err
 	}
 	defer f.Close()
-	return parseReader(f, fields)
+	return parseFormat(f, FileFormat(filename), fields)
 }
 
 func parseReader(r io.Reader, fields fieldMap) error {
@@ -310,16 +312,19 @@ func configFields(config interface{}) (fieldMap, error) {
 	if err != nil {
 		return nil, err
 	}
-	return configValueFields(val)
+	return configValueFields(val, true)
 }
 
-func configValueFields(value reflect.Value) (fieldMap, error) {
+// configValueFields returns the config fields in the given struct
+// value, flattening embedded structs. If defaults is true, the values
+// from the "default" struct tags are assigned to the fields.
+func configValueFields(value reflect.Value, defaults bool) (fieldMap, error) {
 	fields := make(fieldMap)
 	valueType := value.Type()
 	for ii := 0; ii < value.NumField(); ii++ {
 		field := value.Field(ii)
 		if field.Type().Kind() == reflect.Struct {
-			subfields, err := configFields(field.Addr().Interface())
+			subfields, err := configValueFields(field, defaults)
 			if err != nil {
 				return nil, err
 			}
@@ -331,7 +336,7 @@ func configValueFields(value reflect.Value) (fieldMap, error) {
 			}
 		} else {
 			sfield := valueType.Field(ii)
-			if def := sfield.Tag.Get("default"); def != "" {
+			if def := sfield.Tag.Get("default"); defaults && def != "" {
 				err := parseValue(field, def)
 				if err != nil {
 					return nil, fmt.Errorf("error parsing default value for field %q: %s", sfield.Name, err)
@@ -388,20 +393,45 @@ func mapToString(v reflect.Value) string {
 	return strings.Join(s, ", ")
 }
 
-// Parse parses all configurations previously registered using Register or RegisterFunc.
-// See those functions for information about adding your own configuration parameters.
-func Parse() error {
-	configName = flag.String("config", DefaultFilename, "Config file name")
+func registeredFields(defaults bool) (fieldMap, error) {
 	fields := make(fieldMap)
 	for _, v := range registry {
-		valueFields, err := configValueFields(v.value)
+		valueFields, err := configValueFields(v.value, defaults)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for k, v := range valueFields {
 			fields[k] = v
 		}
 	}
+	return fields, nil
+}
+
+// Parse parses all configurations previously registered using Register or RegisterFunc.
+// See those functions for information about adding your own configuration parameters.
+//
+// Values are read from the following sources, with each one taking precedence
+// over the previous ones:
+//
+//   - Defaults, from the "default" struct tag.
+//   - The config file returned by Filename, in any of the formats supported by FileFormat.
+//   - Environment variables (see EnvName).
+//   - Command line flags.
+//
+// Once all the sources have been parsed, the values are checked against their
+// validation tags (see Validate) and any invalid fields are reported together
+// as a ValidationErrors.
+func Parse() error {
+	configName = flag.String("config", DefaultFilename, "Config file name")
+	// Save the initial values, so Reload can start from them
+	for _, v := range registry {
+		v.initial = reflect.New(v.value.Type()).Elem()
+		v.initial.Set(v.value)
+	}
+	fields, err := registeredFields(true)
+	if err != nil {
+		return err
+	}
 	/* Setup flags before calling flag.Parse() */
 	flagValues, err := setupFlags(fields)
 	if err != nil {
@@ -409,6 +439,26 @@ func Parse() error {
 	}
 	/* Now parse the flags */
 	flag.Parse()
+	if err := parseSources(fields, flagValues); err != nil {
+		return err
+	}
+	parsedFlags = flagValues
+	for _, v := range registry {
+		v.parsed = reflect.New(v.value.Type()).Elem()
+		v.parsed.Set(v.value)
+	}
+	// Call registry functions
+	for _, v := range registry {
+		if v.f != nil {
+			v.f()
+		}
+	}
+	return nil
+}
+
+// parseSources reads the config file, the environment and the command
+// line flags into the given fields, in that order, and then validates them.
+func parseSources(fields fieldMap, flagValues varMap) error {
 	/* Read config file first */
 	if fn := Filename(); fn != "" {
 		if err := parseFile(fn, fields); err != nil {
@@ -417,17 +467,15 @@ func Parse() error {
 			}
 		}
 	}
-	/* Command line overrides config file */
-	if err := copyFlagValues(fields, flagValues); err != nil {
+	/* Environment overrides config file */
+	if err := parseEnv(fields); err != nil {
 		return err
 	}
-	// Call registry functions
-	for _, v := range registry {
-		if v.f != nil {
-			v.f()
-		}
+	/* Command line overrides environment */
+	if err := copyFlagValues(fields, flagValues); err != nil {
+		return err
 	}
-	return nil
+	return validateFields(fields)
 }
 
 // MustParse works like Parse, but panics if there's an error.
@@ -11,6 +11,12 @@ var (
 type entry struct {
 	value reflect.Value
 	f     func()
+	// initial is a copy of value made before
+	// parsing, used as the starting point by Reload.
+	initial reflect.Value
+	// parsed is a copy of value made after the last
+	// Parse or Reload, used to detect changes.
+	parsed reflect.Value
 }
 
 // Register is a shorthand for RegisterFunc(value, nil).
@@ -22,20 +28,25 @@ func Register(value interface{}) {
 //
 // Supported field types include bool, string, u?int(|8|6|32|62) and float(32|64). If
 // any config field type is not supported, Register will panic. Additionally,
-// two struct tags are taken into account. The "help" tag is used when to provide
+// some struct tags are taken into account. The "help" tag is used when to provide
 // a help string to the user when defining command like flags, while the "default"
 // tag is used to provide a default value for the field in case it hasn't been
-// provided as a config key nor a command line flag.
+// provided as a config key, an environment variable nor a command line flag.
+// Fields with the "secret" tag set to true are redacted by Dump, while the
+// ones with the "reload" tag set to true are updated by Reload. See Validate
+// for the tags used for validating the values.
 //
 // The parsing process starts by reading the config file returned by Filename()
-// (which might be overriden by the -config command line flag), and then parses
-// any flags provided in the command line. This means any value in the config
-// file might be overriden by a command line flag.
+// (which might be overriden by the -config command line flag), then reads the
+// environment variables and finally parses any flags provided in the command
+// line. This means any value in the config file might be overriden by an
+// environment variable, which might be in turn overriden by a command line flag.
 //
 // Go's idiomatic camel-cased struct field names are mangled into lowercase words
 // to produce the flag names and config fields. e.g. a field named "FooBar" will
-// produce a "-foo-bar" flag and a "foo-bar" config key. Embedded struct are
-// flattened, as if their fields were part of the container struct.
+// produce a "-foo-bar" flag, a "foo-bar" config key and a GONDOLA_FOO_BAR
+// environment variable (see EnvPrefix). Embedded struct are flattened, as if
+// their fields were part of the container struct.
 //
 //  var MyConfig struct {
 //	MyStringValue	string
@@ -46,8 +57,9 @@ func Register(value interface{}) {
 //	config.Register(&MyConfig)
 //	config.MustParse()
 //  }
-//  // This config would define the flags -my-string-value and -my-int-value
-//  // as well as the config file keys my-string-value and my-int-value.
+//  // This config would define the flags -my-string-value and -my-int-value,
+//  // the config file keys my-string-value and my-int-value and the environment
+//  // variables GONDOLA_MY_STRING_VALUE and GONDOLA_MY_INT_VALUE.
 //
 // Note that registering several structs with the same field names will cause
 // Parse to return an error. Gondola itself registers a few flags. To see them
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"errors"
+	"os"
+	ossignal "os/signal"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"gnd.la/signal"
+)
+
+const (
+	// DID_RELOAD is emitted after Reload finds changes in the
+	// configuration sources. The object is a *Changes.
+	DID_RELOAD = "gnd.la/config.did-reload"
+	// RELOAD_FAILED is emitted when a Watcher fails to reload
+	// the configuration. The object is the error returned by
+	// Reload. Note that the previous configuration is kept.
+	RELOAD_FAILED = "gnd.la/config.reload-failed"
+	// DefaultWatchInterval is the interval used by Watch
+	// when it receives a non-positive one.
+	DefaultWatchInterval = 2 * time.Second
+)
+
+var (
+	errNotParsed = errors.New("config has not been parsed yet")
+	reloadMu     sync.Mutex
+)
+
+// Changes represents the changes found by Reload. Field
+// names are the struct field names (e.g. LogDebug).
+type Changes struct {
+	// Applied contains the fields with a "reload" struct tag set
+	// to true whose values changed. Their new values have been
+	// already assigned when DID_RELOAD is emitted.
+	Applied []string
+	// Pending contains the fields which changed, but can't be
+	// reloaded. Their values are not changed and the new ones
+	// are only used after the application is restarted.
+	Pending []string
+}
+
+// Reload parses all the sources again (see Parse), starting from the
+// values the registered configs had before Parse was called, and
+// validates them. If there are no errors, the values are compared with
+// the ones obtained by the previous parse, so values assigned by the
+// program after Parse are kept unless their source changes.
+//
+// Only the fields with the "reload" struct tag set to true are updated,
+// while the rest of changes are reported as pending, since most settings
+// are only read when the application starts (e.g. gnd.la/app.New makes
+// a copy of its configuration). If anything changed, DID_RELOAD is emitted
+// with the *Changes, which are also returned. The functions passed to
+// RegisterFunc are not called again, so packages which can apply some of
+// their settings at runtime should tag them and listen for DID_RELOAD. e.g.
+//
+//	var myConfig struct {
+//		MyVerbose bool `reload:"true"`
+//	}
+//
+//	signal.Listen(config.DID_RELOAD, func(_ string, obj interface{}) {
+//		for _, v := range obj.(*config.Changes).Applied {
+//			if v == "MyVerbose" {
+//				setVerbose(myConfig.MyVerbose)
+//			}
+//		}
+//	})
+//
+// Reloadable fields are assigned from the goroutine which calls Reload
+// (the Watcher one, when using Watch), so they should only be read from
+// the DID_RELOAD listeners or by fields which tolerate concurrent updates.
+// DID_RELOAD is emitted once the reload has finished, so its listeners
+// might call Reload too.
+func Reload() (*Changes, error) {
+	changes, err := reload()
+	if err != nil {
+		return nil, err
+	}
+	if len(changes.Applied) > 0 || len(changes.Pending) > 0 {
+		signal.Emit(DID_RELOAD, changes)
+	}
+	return changes, nil
+}
+
+func reload() (*Changes, error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	if parsedFlags == nil {
+		return nil, errNotParsed
+	}
+	values := make([]reflect.Value, len(registry))
+	fields := make(fieldMap)
+	for ii, v := range registry {
+		val := reflect.New(v.value.Type()).Elem()
+		if v.initial.IsValid() {
+			val.Set(v.initial)
+		}
+		valueFields, err := configValueFields(val, true)
+		if err != nil {
+			return nil, err
+		}
+		for k, f := range valueFields {
+			fields[k] = f
+		}
+		values[ii] = val
+	}
+	if err := parseSources(fields, parsedFlags); err != nil {
+		return nil, err
+	}
+	changes := new(Changes)
+	for ii, v := range registry {
+		newFields, err := configValueFields(values[ii], false)
+		if err != nil {
+			return nil, err
+		}
+		// Compare with the values from the previous parse
+		// rather than the current ones, so changes made by
+		// the program are not reverted.
+		var prevFields fieldMap
+		if v.parsed.IsValid() {
+			if prevFields, err = configValueFields(v.parsed, false); err != nil {
+				return nil, err
+			}
+		}
+		curFields, err := configValueFields(v.value, false)
+		if err != nil {
+			return nil, err
+		}
+		for k, f := range newFields {
+			if prev := prevFields[k]; prev != nil && reflect.DeepEqual(prev.Value.Interface(), f.Value.Interface()) {
+				continue
+			}
+			if reload, _ := strconv.ParseBool(f.Tag.Get("reload")); reload {
+				curFields[k].Value.Set(f.Value)
+				changes.Applied = append(changes.Applied, k)
+			} else {
+				changes.Pending = append(changes.Pending, k)
+			}
+		}
+		v.parsed = values[ii]
+	}
+	sort.Strings(changes.Applied)
+	sort.Strings(changes.Pending)
+	return changes, nil
+}
+
+// Watcher reloads the configuration when the config file
+// changes or when the process receives a SIGHUP. Use Watch
+// to start a Watcher.
+type Watcher struct {
+	interval time.Duration
+	sigs     chan os.Signal
+	stop     chan struct{}
+	done     chan struct{}
+	once     sync.Once
+}
+
+// Watch starts a Watcher which checks the config file returned by Filename
+// for changes every interval and calls Reload when its modification time or
+// its size change, as well as when the process receives a SIGHUP. If interval
+// is not positive, DefaultWatchInterval is used. Errors returned by Reload
+// are reported by emitting RELOAD_FAILED. Watch must be called after Parse.
+func Watch(interval time.Duration) *Watcher {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	w := &Watcher{
+		interval: interval,
+		sigs:     make(chan os.Signal, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	// Set up the signal before returning, so the process
+	// is not terminated by a SIGHUP received right after.
+	ossignal.Notify(w.sigs, syscall.SIGHUP)
+	go w.run(fileStamp(Filename()))
+	return w
+}
+
+func (w *Watcher) run(last stamp) {
+	defer close(w.done)
+	defer ossignal.Stop(w.sigs)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-w.sigs:
+			last = fileStamp(Filename())
+			w.reload()
+		case <-ticker.C:
+			if stamp := fileStamp(Filename()); stamp != last {
+				last = stamp
+				w.reload()
+			}
+		}
+	}
+}
+
+func (w *Watcher) reload() {
+	if _, err := Reload(); err != nil {
+		signal.Emit(RELOAD_FAILED, err)
+	}
+}
+
+// Stop stops the Watcher. It's safe to call it
+// multiple times.
+func (w *Watcher) Stop() {
+	w.once.Do(func() {
+		close(w.stop)
+	})
+	<-w.done
+}
+
+type stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func fileStamp(filename string) stamp {
+	if st, err := os.Stat(filename); err == nil {
+		return stamp{st.ModTime(), st.Size()}
+	}
+	return stamp{}
+}
@@ -0,0 +1,172 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gnd.la/form/input"
+	"gnd.la/util/stringutil"
+	"gnd.la/util/yaml"
+)
+
+// EnvPrefix is the prefix used for the environment variables
+// which set configuration values. See Register for the name
+// mangling performed to convert struct field names to environment
+// variable names.
+var EnvPrefix = "GONDOLA_"
+
+// Format represents a config file format.
+type Format int
+
+const (
+	// FormatIni is the Gondola config file format, with
+	// a key = value pair per line.
+	FormatIni Format = iota
+	// FormatJSON is a JSON object, with a key per
+	// config field.
+	FormatJSON
+	// FormatYAML is a YAML mapping, with a key per
+	// config field.
+	FormatYAML
+)
+
+// FileFormat returns the format used for parsing the given config
+// file, based on its extension. Files ending with .json are parsed
+// as JSON, files ending with .yaml or .yml are parsed as YAML, while
+// any other files are parsed using the Gondola config file format.
+func FileFormat(filename string) Format {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		return FormatJSON
+	case ".yaml", ".yml":
+		return FormatYAML
+	}
+	return FormatIni
+}
+
+// EnvName returns the name of the environment variable for the
+// config field with the given struct field name. e.g. a field named
+// "EncryptionKey" is read from the variable GONDOLA_ENCRYPTION_KEY.
+func EnvName(name string) string {
+	return EnvPrefix + strings.ToUpper(stringutil.CamelCaseToLower(name, "_"))
+}
+
+// ParseEnv parses the config from the environment variables into
+// the given config struct. No signal is emitted. Look at the
+// documentation of Register for information on the name mangling
+// performed to convert struct fields to environment variables.
+func ParseEnv(config interface{}) error {
+	fields, err := configFields(config)
+	if err != nil {
+		return err
+	}
+	return parseEnv(fields)
+}
+
+func parseEnv(fields fieldMap) error {
+	for k, v := range fields {
+		name := EnvName(k)
+		if raw := os.Getenv(name); raw != "" {
+			if err := parseValue(v.Value, raw); err != nil {
+				return fmt.Errorf("error parsing environment variable %s (struct field %q): %s", name, k, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseReaderFormat works like ParseReader, but parses the config
+// using the given format.
+func ParseReaderFormat(r io.Reader, format Format, config interface{}) error {
+	fields, err := configFields(config)
+	if err != nil {
+		return err
+	}
+	return parseFormat(r, format, fields)
+}
+
+func parseFormat(r io.Reader, format Format, fields fieldMap) error {
+	var values map[string]interface{}
+	switch format {
+	case FormatIni:
+		return parseReader(r, fields)
+	case FormatJSON:
+		dec := json.NewDecoder(r)
+		// Keep numbers as written, so integers
+		// don't go through a float64
+		dec.UseNumber()
+		if err := dec.Decode(&values); err != nil {
+			return err
+		}
+	case FormatYAML:
+		if err := yaml.UnmarshalReader(r, &values); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown config format %d", format)
+	}
+	keys := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		keys[strings.Replace(strings.ToLower(k), "_", "-", -1)] = v
+	}
+	for k, v := range fields {
+		name := parameterName(k)
+		if raw, ok := keys[name]; ok && raw != nil {
+			if err := parseStructured(v.Value, raw); err != nil {
+				return fmt.Errorf("error parsing config file field %q (struct field %q): %s", name, k, err)
+			}
+		}
+	}
+	return nil
+}
+
+// parseStructured parses a value decoded from a JSON
+// or YAML file. Lists and mappings are only accepted
+// for slice and map fields respectively.
+func parseStructured(v reflect.Value, raw interface{}) error {
+	switch x := raw.(type) {
+	case []interface{}:
+		if v.Kind() != reflect.Slice {
+			return fmt.Errorf("can't assign a list to a field of type %s", v.Type())
+		}
+		count := len(x)
+		v.Set(reflect.MakeSlice(v.Type(), count, count))
+		for ii, elem := range x {
+			if err := input.Parse(fmt.Sprint(elem), v.Index(ii).Addr().Interface()); err != nil {
+				return fmt.Errorf("error parsing field at index %d: %s", ii, err)
+			}
+		}
+		return nil
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k, val := range x {
+			m[fmt.Sprint(k)] = val
+		}
+		return parseStructured(v, m)
+	case map[string]interface{}:
+		if v.Kind() != reflect.Map {
+			return fmt.Errorf("can't assign a mapping to a field of type %s", v.Type())
+		}
+		v.Set(reflect.MakeMap(v.Type()))
+		ktyp := v.Type().Key()
+		etyp := v.Type().Elem()
+		for key, val := range x {
+			k := reflect.New(ktyp)
+			if err := input.Parse(key, k.Interface()); err != nil {
+				return fmt.Errorf("error parsing key %q: %s", key, err)
+			}
+			elem := reflect.New(etyp)
+			if err := input.Parse(fmt.Sprint(val), elem.Interface()); err != nil {
+				return fmt.Errorf("error parsing value for key %q: %s", key, err)
+			}
+			v.SetMapIndex(k.Elem(), elem.Elem())
+		}
+		return nil
+	}
+	return parseValue(v, fmt.Sprint(raw))
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -90,6 +91,45 @@ func (u *URL) String() string {
 	return s
 }
 
+var (
+	passwordKeys    = []string{"password", "passwd", "pass", "pwd", "secret"}
+	valuePasswordRe = regexp.MustCompile(`(?i)\b(password|passwd|pwd)=[^\s&]*`)
+	valueUserInfoRe = regexp.MustCompile(`^([^:/@\s]*):[^@\s]*@`)
+)
+
+// Redacted returns the URL as a string, like String, but with
+// any passwords replaced. Passwords are detected in the options
+// with a password-like name (e.g. password or pass), in password=
+// pairs in the Value (e.g. postgres://dbname=foo password=bar) and
+// in user:password@ prefixes in the Value.
+func (u *URL) Redacted() string {
+	r := *u
+	r.Value = valuePasswordRe.ReplaceAllString(r.Value, "$1="+redacted)
+	r.Value = valueUserInfoRe.ReplaceAllString(r.Value, "$1:"+redacted+"@")
+	r.Query = redactedMap(r.Query)
+	r.Fragment = redactedMap(r.Fragment)
+	return r.String()
+}
+
+func redactedMap(m Map) Map {
+	var r Map
+	for _, k := range passwordKeys {
+		if _, ok := m[k]; ok {
+			if r == nil {
+				r = make(Map, len(m))
+				for key, value := range m {
+					r[key] = value
+				}
+			}
+			r[k] = redacted
+		}
+	}
+	if r == nil {
+		return m
+	}
+	return r
+}
+
 func parseURL(u *URL, s string) (*URL, error) {
 	p := strings.Index(s, "://")
 	if p < 0 {
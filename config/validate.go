@@ -0,0 +1,172 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gnd.la/util/types"
+)
+
+// ValidationErrors is returned by Parse, Reload and Validate when
+// one or more config fields don't satisfy their validation tags.
+// It contains an error for each invalid field.
+type ValidationErrors []error
+
+func (v ValidationErrors) Error() string {
+	s := make([]string, len(v))
+	for ii, err := range v {
+		s[ii] = err.Error()
+	}
+	return "invalid config: " + strings.Join(s, "; ")
+}
+
+// Validate checks the fields in the given config struct against
+// their validation tags, returning a ValidationErrors with all the
+// invalid fields, if any. Parse and Reload automatically validate
+// all the registered configs. The supported tags are:
+//
+//	required	When set to true, the field must not have the zero
+//			value for its type, and a *URL field must not be nil.
+//	min, max	For numeric fields, the minimum and maximum values
+//			(inclusive). For strings, slices and maps, the minimum
+//			and maximum lengths.
+//	oneof		Comma separated list of the allowed values. Empty
+//			values are accepted, use required to reject them.
+//	schemes		For *URL fields, comma separated list of the allowed
+//			schemes. Nil URLs are accepted, use required to reject them.
+//
+// e.g.
+//
+//	var MyConfig struct {
+//		Workers  int         `default:"4" min:"1" max:"64"`
+//		Mode     string      `default:"fast" oneof:"fast,safe"`
+//		Database *config.URL `required:"true" schemes:"postgres,sqlite"`
+//	}
+func Validate(config interface{}) error {
+	val, err := reflectValue(config)
+	if err != nil {
+		return err
+	}
+	fields, err := configValueFields(val, false)
+	if err != nil {
+		return err
+	}
+	return validateFields(fields)
+}
+
+func validateFields(fields fieldMap) error {
+	names := make([]string, 0, len(fields))
+	for k := range fields {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var errs ValidationErrors
+	for _, k := range names {
+		if err := validateField(fields[k]); err != nil {
+			errs = append(errs, fmt.Errorf("config field %q (struct field %q) %s", parameterName(k), k, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateField(f *fieldValue) error {
+	val := f.Value
+	if required, _ := strconv.ParseBool(f.Tag.Get("required")); required && isZero(val) {
+		return errors.New("is required")
+	}
+	if min := f.Tag.Get("min"); min != "" {
+		if err := checkBound(val, min, true); err != nil {
+			return err
+		}
+	}
+	if max := f.Tag.Get("max"); max != "" {
+		if err := checkBound(val, max, false); err != nil {
+			return err
+		}
+	}
+	if oneof := f.Tag.Get("oneof"); oneof != "" && !isZero(val) {
+		s := types.ToString(val.Interface())
+		allowed := splitList(oneof)
+		if !contains(allowed, s) {
+			return fmt.Errorf("must be one of %s, not %q", strings.Join(allowed, ", "), s)
+		}
+	}
+	if schemes := f.Tag.Get("schemes"); schemes != "" {
+		u, ok := val.Interface().(*URL)
+		if !ok {
+			return fmt.Errorf("has schemes tag, but its type is %s rather than *config.URL", val.Type())
+		}
+		if u != nil {
+			allowed := splitList(schemes)
+			if !contains(allowed, u.Scheme) {
+				return fmt.Errorf("must use one of the schemes %s, not %q", strings.Join(allowed, ", "), u.Scheme)
+			}
+		}
+	}
+	return nil
+}
+
+func checkBound(val reflect.Value, bound string, min bool) error {
+	tag := "max"
+	if min {
+		tag = "min"
+	}
+	limit, err := strconv.ParseFloat(bound, 64)
+	if err != nil {
+		return fmt.Errorf("has invalid %s tag %q: %s", tag, bound, err)
+	}
+	var value float64
+	what := "value"
+	switch {
+	case types.IsInt(val.Type()):
+		value = float64(val.Int())
+	case types.IsUint(val.Type()):
+		value = float64(val.Uint())
+	case types.IsFloat(val.Type()):
+		value = val.Float()
+	case val.Kind() == reflect.String || val.Kind() == reflect.Slice || val.Kind() == reflect.Map:
+		value = float64(val.Len())
+		what = "length"
+	default:
+		return fmt.Errorf("has %s tag, but its type %s does not support it", tag, val.Type())
+	}
+	if min && value < limit {
+		return fmt.Errorf("%s must be at least %s, not %v", what, bound, value)
+	}
+	if !min && value > limit {
+		return fmt.Errorf("%s must be at most %s, not %v", what, bound, value)
+	}
+	return nil
+}
+
+func isZero(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Slice, reflect.Map:
+		return val.Len() == 0
+	}
+	return val.IsZero()
+}
+
+func splitList(s string) []string {
+	values := strings.Split(s, ",")
+	for ii, v := range values {
+		values[ii] = strings.TrimSpace(v)
+	}
+	return values
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
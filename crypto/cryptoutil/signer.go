@@ -105,3 +105,31 @@ func (s *Signer) Unsign(signed string) ([]byte, error) {
 	}
 	return data, nil
 }
+
+// Signature returns just the signature for the given data, encoded
+// in the same way as the signatures returned by Sign. It's useful when
+// the data is transmitted separately, e.g. when signing requests.
+func (s *Signer) Signature(data []byte) (string, error) {
+	signature, err := s.sign(data)
+	if err != nil {
+		return "", err
+	}
+	return base64.Encode(signature), nil
+}
+
+// Verify checks that the given signature, previously returned from
+// Signature, is valid for data. If it's not, ErrTampered is returned.
+func (s *Signer) Verify(data []byte, signature string) error {
+	decoded, err := base64.Decode(signature)
+	if err != nil {
+		return ErrTampered
+	}
+	sign, err := s.sign(data)
+	if err != nil {
+		return err
+	}
+	if len(sign) != len(decoded) || subtle.ConstantTimeCompare(sign, decoded) != 1 {
+		return ErrTampered
+	}
+	return nil
+}
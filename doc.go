@@ -1,3 +1,5 @@
 // Package gondola provides a full featured web framework -
 // see the documentation on the different subpackages for details.
+//
+// Gondola requires Go 1.13 or later.
 package gondola
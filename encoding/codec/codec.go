@@ -37,6 +37,10 @@ type Codec struct {
 	Decode func(data []byte, v interface{}) error
 	// Binary indicates if the codec returns binary or text data
 	Binary bool
+	// ContentType is the MIME type of the encoded data. It's
+	// used when the codec is chosen by content negotiation
+	// (see gnd.la/app.NegotiateHandler).
+	ContentType string
 }
 
 // Register registers a codec to be made available for
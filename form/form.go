@@ -41,6 +41,9 @@ type Form struct {
 	// form has been rendered or validated has no effect.
 	DisableCSRF bool
 	hasCSRF     bool
+	// appCSRF is true once the App CSRF
+	// token has been rendered.
+	appCSRF bool
 }
 
 func (f *Form) validate() {
@@ -589,6 +592,12 @@ func (f *Form) render(fields []*Field) (template.HTML, error) {
 	}
 	var buf bytes.Buffer
 	var err error
+	if !f.appCSRF {
+		// Include the token for the App CSRF protection,
+		// if enabled. See gnd.la/app.CSRF.
+		buf.WriteString(string(f.ctx.CSRFInput()))
+		f.appCSRF = true
+	}
 	for _, v := range fields {
 		if err = f.renderField(&buf, v); err != nil {
 			break
@@ -0,0 +1,57 @@
+package bootstrap
+
+import (
+	htemplate "html/template"
+
+	"gnd.la/app"
+	"gnd.la/html"
+	"gnd.la/template"
+)
+
+// AlertClass returns the bootstrap class used for rendering
+// an alert with the given flash level e.g. alert-success.
+func AlertClass(level app.FlashLevel) string {
+	switch level {
+	case app.FlashSuccess:
+		return "alert-success"
+	case app.FlashWarning:
+		return "alert-warning"
+	case app.FlashError:
+		return "alert-danger"
+	}
+	return "alert-info"
+}
+
+// FlashAlerts renders the given flash messages (see gnd.la/app.Context.Flashes)
+// as dismissable bootstrap alerts. This function is also available in templates
+// as bootstrap_alerts, so base templates can render the pending messages with:
+//
+//	{{ bootstrap_alerts flashes }}
+func FlashAlerts(flashes []*app.Flash) htemplate.HTML {
+	var root *html.Node
+	for ii := len(flashes) - 1; ii >= 0; ii-- {
+		f := flashes[ii]
+		button := &html.Node{
+			Tag:      "button",
+			Attrs:    html.Attrs{"type": "button", "class": "close", "data-dismiss": "alert", "aria-hidden": "true"},
+			Children: html.Text("&times;"),
+		}
+		button.Next = html.Text(html.Escape(f.Message))
+		div := html.Div(button)
+		div.AddClass("alert")
+		div.AddClass(AlertClass(f.Level))
+		div.AddClass("alert-dismissable")
+		div.Next = root
+		root = div
+	}
+	if root == nil {
+		return ""
+	}
+	return root.HTML()
+}
+
+func init() {
+	template.AddFuncs(template.FuncMap{
+		"bootstrap_alerts": FlashAlerts,
+	})
+}
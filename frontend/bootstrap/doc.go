@@ -16,6 +16,9 @@
 // See gnd.la/template and gnd.la/template/assets for more information
 // about template functions and the assets pipeline.
 //
+// This package also registers the bootstrap_alerts template function,
+// which renders the pending flash messages as alerts (see FlashAlerts).
+//
 // Importing this package will also register FormRenderer as the default
 // gnd.la/form renderer and PaginatorRenderer as the default
 // gnd.la/html/paginator renderer.
@@ -19,6 +19,11 @@ var (
 	inTest      bool
 	goRun       bool
 	inAppEngine bool
+	// Debugf is used for debug logging by the packages which can't
+	// import gnd.la/log without creating an import cycle (e.g.
+	// gnd.la/signal, since gnd.la/log listens for config signals).
+	// gnd.la/log sets it to its Debugf function.
+	Debugf = func(format string, v ...interface{}) {}
 )
 
 // InTest returns true iff called when running
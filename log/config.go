@@ -1,15 +1,21 @@
 package log
 
 import (
+	"strings"
+
 	"gnd.la/config"
+	"gnd.la/internal"
 	"gnd.la/net/mail"
+	"gnd.la/signal"
 )
 
 var logConfig struct {
-	LogDebug bool
+	LogDebug bool `reload:"true"`
 }
 
 func init() {
+	// Used by packages which can't import log
+	internal.Debugf = Debugf
 	config.RegisterFunc(&logConfig, func() {
 		if logConfig.LogDebug {
 			Std.SetLevel(LDebug)
@@ -24,4 +30,22 @@ func init() {
 			}
 		}
 	})
+	signal.Listen(config.DID_RELOAD, func(_ string, obj interface{}) {
+		changes := obj.(*config.Changes)
+		for _, v := range changes.Applied {
+			if v == "LogDebug" {
+				if logConfig.LogDebug {
+					Std.SetLevel(LDebug)
+				} else {
+					Std.SetLevel(LDefault)
+				}
+			}
+		}
+		if len(changes.Pending) > 0 {
+			Std.Warningf("config fields %s changed, restart to apply them", strings.Join(changes.Pending, ", "))
+		}
+	})
+	signal.Listen(config.RELOAD_FAILED, func(_ string, obj interface{}) {
+		Std.Errorf("error reloading config: %s", obj)
+	})
 }
@@ -1,6 +1,7 @@
 package httpclient
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/url"
@@ -36,13 +37,18 @@ type Client struct {
 	transport *transport
 	c         *http.Client
 	logger    log.Interface
+	ctx       context.Context
 }
 
 // New returns a new *Client. The ctx parameter will usually be
 // the *app.Context received by the handler which is calling this
 // function. Note that passing a nil ctx will work under some circunstances
 // but will fail when running on App Engine, so it's advisable to always
-// pass an *app.Context to this function, for portability.
+// pass an *app.Context to this function, for portability. If ctx
+// also implements context.Context (as *app.Context does), the
+// requests sent by the Client use it, so they're aborted when
+// ctx is cancelled (e.g. the client disconnects or the handler
+// timeout expires).
 func New(ctx Context) *Client {
 	tr := newTransport(ctx)
 	client := &Client{
@@ -54,6 +60,7 @@ func New(ctx Context) *Client {
 	if log, ok := ctx.(logger); ok {
 		client.logger = log.Logger()
 	}
+	client.ctx, _ = ctx.(context.Context)
 	return client
 }
 
@@ -74,6 +81,7 @@ func (c *Client) Clone(ctx Context) *Client {
 	} else {
 		cp.logger = nil
 	}
+	cp.ctx, _ = ctx.(context.Context)
 	return cp
 }
 
@@ -122,7 +130,11 @@ func (c *Client) Get(url string) (*Response, error) {
 		defer profile.Start(profileName).Note("GET", url).End()
 	}
 	c.debugf("GET %s", url)
-	return makeResponse(c.c.Get(url))
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return makeResponse(c.c.Do(c.withContext(req)))
 }
 
 // Head is a wrapper around http.Client.Head, returning a Response rather than an
@@ -132,7 +144,11 @@ func (c *Client) Head(url string) (*Response, error) {
 		defer profile.Start(profileName).Note("HEAD", url).End()
 	}
 	c.debugf("HEAD %s", url)
-	return makeResponse(c.c.Head(url))
+	req, err := http.NewRequest("HEAD", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return makeResponse(c.c.Do(c.withContext(req)))
 }
 
 // GetForm appends the given data to the given url and performs a GET
@@ -156,7 +172,12 @@ func (c *Client) Post(url string, bodyType string, body io.Reader) (*Response, e
 		defer profile.Start(profileName).Note("POST", url).End()
 	}
 	c.debugf("POST %s", url)
-	return makeResponse(c.c.Post(url, bodyType, body))
+	req, err := http.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", bodyType)
+	return makeResponse(c.c.Do(c.withContext(req)))
 }
 
 // PostForm is a wrapper around http.Client.PostForm, returning a Response rather than an
@@ -172,7 +193,7 @@ func (c *Client) Do(req *http.Request) (*Response, error) {
 		defer profile.Start(profileName).Note(req.Method, req.URL.String()).End()
 	}
 	c.debugf("DO %s %s", req.Method, req.URL)
-	return makeResponse(c.c.Do(req))
+	return makeResponse(c.c.Do(c.withContext(req)))
 }
 
 // Trip performs a roundtrip with the given http.Request, without following
@@ -183,7 +204,7 @@ func (c *Client) Trip(req *http.Request) (*Response, error) {
 		defer profile.Start(profileName).Note("TRIP-"+req.Method, req.URL.String()).End()
 	}
 	c.debugf("TRIP %s %s", req.Method, req.URL)
-	return makeResponse(c.transport.RoundTrip(req))
+	return makeResponse(c.transport.RoundTrip(c.withContext(req)))
 }
 
 // Proxy returns the Proxy function for this client, if any. Note that
@@ -220,6 +241,15 @@ func (c *Client) Iter(req *http.Request) *Iter {
 	return &Iter{c: c, req: req}
 }
 
+// withContext returns req using the Client context, unless
+// the request has its own context.
+func (c *Client) withContext(req *http.Request) *http.Request {
+	if c.ctx != nil && req.Context() == context.Background() {
+		return req.WithContext(c.ctx)
+	}
+	return req
+}
+
 func (c *Client) debugf(format string, args ...interface{}) {
 	if c.logger != nil {
 		c.logger.Debugf("[httpclient] "+format, args...)
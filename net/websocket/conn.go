@@ -0,0 +1,482 @@
+package websocket
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// MessageType indicates the type of a WebSocket message.
+type MessageType int
+
+const (
+	// TextMessage indicates a message containing UTF-8 encoded text.
+	TextMessage MessageType = 1
+	// BinaryMessage indicates a message containing binary data.
+	BinaryMessage MessageType = 2
+)
+
+func (t MessageType) String() string {
+	switch t {
+	case TextMessage:
+		return "text"
+	case BinaryMessage:
+		return "binary"
+	}
+	return fmt.Sprintf("MessageType(%d)", int(t))
+}
+
+// Close codes defined in RFC 6455, section 7.4.1.
+const (
+	CloseNormal          = 1000
+	CloseGoingAway       = 1001
+	CloseProtocolError   = 1002
+	CloseUnsupportedData = 1003
+	CloseNoStatus        = 1005
+	CloseAbnormal        = 1006
+	CloseInvalidPayload  = 1007
+	ClosePolicyViolation = 1008
+	CloseMessageTooBig   = 1009
+	CloseInternalError   = 1011
+)
+
+const (
+	opContinuation = 0
+	opText         = 1
+	opBinary       = 2
+	opClose        = 8
+	opPing         = 9
+	opPong         = 10
+
+	maxControlPayload = 125
+
+	// DefaultMaxMessageSize is the maximum message size used
+	// when Options.MaxMessageSize is zero.
+	DefaultMaxMessageSize = 1 << 20
+)
+
+var (
+	// ErrClosed is returned when writing to a connection
+	// after it has been closed.
+	ErrClosed = errors.New("websocket: connection closed")
+
+	errInvalidMessageType = errors.New("websocket: invalid message type")
+	errControlTooBig      = errors.New("websocket: control frame payload is too big")
+)
+
+// CloseError is returned by Conn.ReadMessage when the connection
+// is closed by the peer or due to a protocol error.
+type CloseError struct {
+	// Code is the close code, as defined in RFC 6455.
+	Code int
+	// Reason is the close reason, which might be empty.
+	Reason string
+}
+
+func (e *CloseError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("websocket: connection closed with code %d: %s", e.Code, e.Reason)
+	}
+	return fmt.Sprintf("websocket: connection closed with code %d", e.Code)
+}
+
+// Options specify the parameters used for establishing and
+// handling a WebSocket connection. The zero Options value
+// is valid and uses the defaults for every field.
+type Options struct {
+	// Subprotocols contains the supported subprotocols in order
+	// of preference (for servers) or the requested ones (for
+	// clients). Servers pick the first of their subprotocols which
+	// was requested by the client.
+	Subprotocols []string
+	// CheckOrigin is used by servers to decide if the request
+	// should be accepted, based on its Origin header. If nil,
+	// requests with an Origin header which doesn't match the
+	// request Host are rejected.
+	CheckOrigin func(r *http.Request) bool
+	// MaxMessageSize is the maximum size in bytes for received
+	// messages. Larger messages close the connection with the
+	// CloseMessageTooBig code. If zero, DefaultMaxMessageSize
+	// is used.
+	MaxMessageSize int64
+	// ReadTimeout is the maximum time to wait for each frame
+	// sent by the peer. If zero, there's no timeout. Note that
+	// it includes ping and pong frames, so when used in
+	// conjunction with PingInterval, ReadTimeout should be
+	// greater than PingInterval.
+	ReadTimeout time.Duration
+	// WriteTimeout is the maximum time for writing each frame. If
+	// zero, there's no timeout.
+	WriteTimeout time.Duration
+	// PingInterval indicates the interval for sending ping
+	// frames, in order to keep the connection alive and detect
+	// unresponsive peers. If zero, no pings are sent.
+	PingInterval time.Duration
+}
+
+// Conn represents a WebSocket connection. Use Upgrade or Dial to obtain
+// a Conn. Only one goroutine might call ReadMessage at the same time,
+// while the write methods (WriteMessage, WriteText, Ping and Close) might
+// be called concurrently.
+type Conn struct {
+	conn        net.Conn
+	br          *bufio.Reader
+	server      bool
+	opts        Options
+	subprotocol string
+
+	// wmu serializes writes
+	wmu       sync.Mutex
+	closeSent bool
+
+	closeOnce sync.Once
+	done      chan struct{}
+	readErr   error
+}
+
+func newConn(conn net.Conn, br *bufio.Reader, server bool, opts *Options, subprotocol string) *Conn {
+	c := &Conn{
+		conn:        conn,
+		br:          br,
+		server:      server,
+		subprotocol: subprotocol,
+		done:        make(chan struct{}),
+	}
+	if opts != nil {
+		c.opts = *opts
+	}
+	if c.opts.MaxMessageSize <= 0 {
+		c.opts.MaxMessageSize = DefaultMaxMessageSize
+	}
+	if c.opts.PingInterval > 0 {
+		go c.keepAlive(c.opts.PingInterval)
+	}
+	return c
+}
+
+// Subprotocol returns the subprotocol negotiated during the
+// handshake, or an empty string if there's none.
+func (c *Conn) Subprotocol() string {
+	return c.subprotocol
+}
+
+// LocalAddr returns the local network address.
+func (c *Conn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+// RemoteAddr returns the remote network address.
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// SetReadDeadline sets the deadline for reading from the connection.
+// Note that if Options.ReadTimeout is non-zero, it overrides this
+// deadline every time a frame is read.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	return c.conn.SetReadDeadline(t)
+}
+
+// SetWriteDeadline sets the deadline for writing to the connection.
+// Note that if Options.WriteTimeout is non-zero, it overrides this
+// deadline every time a frame is written.
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
+
+// Done returns a channel which is closed when the
+// connection is closed.
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+type frame struct {
+	fin     bool
+	opcode  byte
+	payload []byte
+}
+
+func protocolError(reason string) *CloseError {
+	return &CloseError{Code: CloseProtocolError, Reason: reason}
+}
+
+func (c *Conn) readFrame() (*frame, error) {
+	if t := c.opts.ReadTimeout; t > 0 {
+		c.conn.SetReadDeadline(time.Now().Add(t))
+	}
+	var hdr [8]byte
+	if _, err := io.ReadFull(c.br, hdr[:2]); err != nil {
+		return nil, err
+	}
+	if hdr[0]&0x70 != 0 {
+		return nil, protocolError("reserved bits are set")
+	}
+	f := &frame{
+		fin:    hdr[0]&0x80 != 0,
+		opcode: hdr[0] & 0x0f,
+	}
+	masked := hdr[1]&0x80 != 0
+	if masked != c.server {
+		if c.server {
+			return nil, protocolError("client frames must be masked")
+		}
+		return nil, protocolError("server frames must not be masked")
+	}
+	length := int64(hdr[1] & 0x7f)
+	switch length {
+	case 126:
+		if _, err := io.ReadFull(c.br, hdr[:2]); err != nil {
+			return nil, err
+		}
+		length = int64(binary.BigEndian.Uint16(hdr[:2]))
+	case 127:
+		if _, err := io.ReadFull(c.br, hdr[:8]); err != nil {
+			return nil, err
+		}
+		length = int64(binary.BigEndian.Uint64(hdr[:8]))
+		if length < 0 {
+			return nil, protocolError("invalid payload length")
+		}
+	}
+	if f.opcode >= opClose && (length > maxControlPayload || !f.fin) {
+		return nil, protocolError("invalid control frame")
+	}
+	if length > c.opts.MaxMessageSize {
+		return nil, &CloseError{Code: CloseMessageTooBig}
+	}
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.br, mask[:]); err != nil {
+			return nil, err
+		}
+	}
+	f.payload = make([]byte, length)
+	if _, err := io.ReadFull(c.br, f.payload); err != nil {
+		return nil, err
+	}
+	if masked {
+		maskBytes(mask, f.payload)
+	}
+	return f, nil
+}
+
+// ReadMessage reads the next data message from the connection, answering
+// any ping frames received in the meantime. When the peer closes the
+// connection, the returned error is a *CloseError. Once ReadMessage
+// returns an error, the connection is closed and subsequent calls
+// return the same error.
+func (c *Conn) ReadMessage() (MessageType, []byte, error) {
+	if c.readErr != nil {
+		return 0, nil, c.readErr
+	}
+	var typ MessageType
+	var data []byte
+	for {
+		f, err := c.readFrame()
+		if err != nil {
+			return 0, nil, c.fail(err)
+		}
+		switch f.opcode {
+		case opPing:
+			if err := c.writeFrame(opPong, f.payload); err != nil && err != ErrClosed {
+				return 0, nil, c.fail(err)
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			if len(f.payload) == 1 {
+				// The payload must be empty or start with a 2 byte code
+				return 0, nil, c.fail(protocolError("invalid close frame payload"))
+			}
+			cerr := &CloseError{Code: CloseNoStatus}
+			if len(f.payload) >= 2 {
+				cerr.Code = int(binary.BigEndian.Uint16(f.payload))
+				cerr.Reason = string(f.payload[2:])
+			}
+			// Reply with the same code, as suggested by RFC 6455
+			var payload []byte
+			if cerr.Code != CloseNoStatus {
+				payload = f.payload[:2]
+			}
+			c.writeFrame(opClose, payload)
+			c.closeConn()
+			c.readErr = cerr
+			return 0, nil, cerr
+		case opText, opBinary:
+			if typ != 0 {
+				return 0, nil, c.fail(protocolError("expecting continuation frame"))
+			}
+			typ = MessageType(f.opcode)
+		case opContinuation:
+			if typ == 0 {
+				return 0, nil, c.fail(protocolError("unexpected continuation frame"))
+			}
+		default:
+			return 0, nil, c.fail(protocolError(fmt.Sprintf("unknown opcode %d", f.opcode)))
+		}
+		if int64(len(data)+len(f.payload)) > c.opts.MaxMessageSize {
+			return 0, nil, c.fail(&CloseError{Code: CloseMessageTooBig})
+		}
+		data = append(data, f.payload...)
+		if f.fin {
+			if typ == TextMessage && !utf8.Valid(data) {
+				return 0, nil, c.fail(&CloseError{Code: CloseInvalidPayload, Reason: "invalid UTF-8"})
+			}
+			return typ, data, nil
+		}
+	}
+}
+
+// fail closes the connection due to the given error, which is
+// returned from all subsequent ReadMessage calls. If the error is
+// a *CloseError, its code is sent to the peer.
+func (c *Conn) fail(err error) error {
+	if cerr, ok := err.(*CloseError); ok {
+		c.writeFrame(opClose, closePayload(cerr.Code, cerr.Reason))
+	}
+	c.closeConn()
+	c.readErr = err
+	return err
+}
+
+// WriteMessage sends a message with the given type and data.
+func (c *Conn) WriteMessage(typ MessageType, data []byte) error {
+	if typ != TextMessage && typ != BinaryMessage {
+		return errInvalidMessageType
+	}
+	return c.writeFrame(byte(typ), data)
+}
+
+// WriteText is a shorthand for sending a TextMessage with the
+// given string.
+func (c *Conn) WriteText(s string) error {
+	return c.WriteMessage(TextMessage, []byte(s))
+}
+
+// Ping sends a ping frame with the given data, which must not
+// be longer than 125 bytes. The peer will answer it with a pong
+// frame, which is handled by ReadMessage.
+func (c *Conn) Ping(data []byte) error {
+	if len(data) > maxControlPayload {
+		return errControlTooBig
+	}
+	return c.writeFrame(opPing, data)
+}
+
+// Close sends a close frame with the CloseNormal code and closes
+// the connection. Calling Close more than once is a no-op.
+func (c *Conn) Close() error {
+	return c.CloseWithReason(CloseNormal, "")
+}
+
+// CloseWithReason works like Close, but allows specifying the
+// close code and reason sent to the peer.
+func (c *Conn) CloseWithReason(code int, reason string) error {
+	payload := closePayload(code, reason)
+	if len(payload) > maxControlPayload {
+		return errControlTooBig
+	}
+	err := c.writeFrame(opClose, payload)
+	if err == ErrClosed {
+		err = nil
+	}
+	if cerr := c.closeConn(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (c *Conn) closeConn() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.done)
+		err = c.conn.Close()
+	})
+	return err
+}
+
+func closePayload(code int, reason string) []byte {
+	if code == CloseNoStatus {
+		return nil
+	}
+	payload := make([]byte, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, uint16(code))
+	copy(payload[2:], reason)
+	return payload
+}
+
+func (c *Conn) writeFrame(opcode byte, payload []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.closeSent {
+		return ErrClosed
+	}
+	if opcode == opClose {
+		c.closeSent = true
+	}
+	length := len(payload)
+	buf := make([]byte, 0, 14+length)
+	buf = append(buf, 0x80|opcode)
+	var maskBit byte
+	if !c.server {
+		maskBit = 0x80
+	}
+	switch {
+	case length <= 125:
+		buf = append(buf, maskBit|byte(length))
+	case length <= 0xffff:
+		buf = append(buf, maskBit|126, 0, 0)
+		binary.BigEndian.PutUint16(buf[len(buf)-2:], uint16(length))
+	default:
+		buf = append(buf, maskBit|127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(buf[len(buf)-8:], uint64(length))
+	}
+	if c.server {
+		buf = append(buf, payload...)
+	} else {
+		var mask [4]byte
+		if _, err := io.ReadFull(rand.Reader, mask[:]); err != nil {
+			return err
+		}
+		buf = append(buf, mask[:]...)
+		start := len(buf)
+		buf = append(buf, payload...)
+		maskBytes(mask, buf[start:])
+	}
+	if t := c.opts.WriteTimeout; t > 0 {
+		c.conn.SetWriteDeadline(time.Now().Add(t))
+	}
+	_, err := c.conn.Write(buf)
+	return err
+}
+
+func (c *Conn) keepAlive(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if err := c.Ping(nil); err != nil {
+				c.closeConn()
+				return
+			}
+		}
+	}
+}
+
+func maskBytes(mask [4]byte, b []byte) {
+	for ii := range b {
+		b[ii] ^= mask[ii&3]
+	}
+}
@@ -0,0 +1,31 @@
+// Package websocket implements the WebSocket protocol, as defined
+// in RFC 6455, for both servers and clients.
+//
+// Servers upgrade HTTP requests to WebSocket connections using
+// Upgrade, although Gondola apps should usually call
+// gnd.la/app.Context.WebSocket from their handlers, while clients
+// connect to a server using Dial. Once the connection is
+// established, both ends exchange text and binary messages using
+// Conn.ReadMessage and Conn.WriteMessage. Ping frames are answered
+// automatically, while Options.PingInterval might be used to
+// send periodic pings which keep the connection alive.
+//
+//	App.Handle("^/echo/$", func(ctx *app.Context) {
+//		conn, err := ctx.WebSocket(nil)
+//		if err != nil {
+//			return
+//		}
+//		defer conn.Close()
+//		for {
+//			typ, data, err := conn.ReadMessage()
+//			if err != nil {
+//				break
+//			}
+//			if err := conn.WriteMessage(typ, data); err != nil {
+//				break
+//			}
+//		}
+//	})
+//
+// Use Hub for broadcasting messages to groups of connections.
+package websocket
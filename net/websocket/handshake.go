@@ -0,0 +1,230 @@
+package websocket
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+var (
+	// ErrBadHandshake is returned by Dial when the server
+	// response is not a valid WebSocket handshake.
+	ErrBadHandshake = errors.New("websocket: bad handshake")
+
+	errCantHijack = errors.New("websocket: the ResponseWriter does not support hijacking")
+
+	// handshakeHeaders are the headers which are set by Upgrade,
+	// so they're not copied from the ResponseWriter.
+	handshakeHeaders = map[string]bool{
+		"Upgrade":                true,
+		"Connection":             true,
+		"Sec-Websocket-Accept":   true,
+		"Sec-Websocket-Protocol": true,
+		"Content-Type":           true,
+		"Content-Length":         true,
+		"Content-Encoding":       true,
+	}
+)
+
+func acceptKey(key string) string {
+	h := sha1.New()
+	io.WriteString(h, key+acceptGUID)
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// headerContains returns true iff the given header contains the
+// token in its comma separated list of values (case insensitive).
+func headerContains(h http.Header, name string, token string) bool {
+	for _, v := range h[http.CanonicalHeaderKey(name)] {
+		for _, s := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(s), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+func handshakeError(w http.ResponseWriter, code int, message string) error {
+	http.Error(w, http.StatusText(code), code)
+	return errors.New("websocket: " + message)
+}
+
+// Upgrade upgrades the given HTTP request to a WebSocket connection,
+// writing the handshake response, which also includes the headers
+// previously set in the ResponseWriter. If the request is not a valid
+// WebSocket handshake, an HTTP error is written to w and a non-nil
+// error is returned. The ResponseWriter must implement http.Hijacker
+// and, once the connection has been upgraded, it must not be used
+// anymore.
+func Upgrade(w http.ResponseWriter, r *http.Request, opts *Options) (*Conn, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if r.Method != "GET" {
+		return nil, handshakeError(w, http.StatusMethodNotAllowed, "handshake method must be GET")
+	}
+	if !headerContains(r.Header, "Connection", "upgrade") || !headerContains(r.Header, "Upgrade", "websocket") {
+		return nil, handshakeError(w, http.StatusBadRequest, "not a WebSocket handshake")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		return nil, handshakeError(w, http.StatusUpgradeRequired, "unsupported protocol version")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if decoded, err := base64.StdEncoding.DecodeString(key); err != nil || len(decoded) != 16 {
+		return nil, handshakeError(w, http.StatusBadRequest, "invalid Sec-WebSocket-Key")
+	}
+	checkOrigin := opts.CheckOrigin
+	if checkOrigin == nil {
+		checkOrigin = sameOrigin
+	}
+	if !checkOrigin(r) {
+		return nil, handshakeError(w, http.StatusForbidden, "origin not allowed")
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return nil, errCantHijack
+	}
+	var subprotocol string
+	if len(opts.Subprotocols) > 0 {
+		for _, v := range opts.Subprotocols {
+			if headerContains(r.Header, "Sec-WebSocket-Protocol", v) {
+				subprotocol = v
+				break
+			}
+		}
+	}
+	conn, brw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString("HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(key) + "\r\n")
+	if subprotocol != "" {
+		buf.WriteString("Sec-WebSocket-Protocol: " + subprotocol + "\r\n")
+	}
+	// Include any headers already set by the handler (e.g. cookies)
+	w.Header().WriteSubset(&buf, handshakeHeaders)
+	buf.WriteString("\r\n")
+	if t := opts.WriteTimeout; t > 0 {
+		conn.SetWriteDeadline(time.Now().Add(t))
+	}
+	if _, err := conn.Write(buf.Bytes()); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return newConn(conn, brw.Reader, true, opts, subprotocol), nil
+}
+
+// Dial connects to the WebSocket server at the given URL, which must
+// use either the ws or the wss scheme. The given headers, which might
+// be nil, are added to the handshake request. The handshake response
+// is also returned, even when the handshake fails, if the server
+// sent one.
+func Dial(urlStr string, header http.Header, opts *Options) (*Conn, *http.Response, error) {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, nil, err
+	}
+	var secure bool
+	switch u.Scheme {
+	case "ws":
+		u.Scheme = "http"
+	case "wss":
+		u.Scheme = "https"
+		secure = true
+	default:
+		return nil, nil, fmt.Errorf("websocket: invalid URL scheme %q", u.Scheme)
+	}
+	addr := u.Host
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		if secure {
+			addr += ":443"
+		} else {
+			addr += ":80"
+		}
+	}
+	var conn net.Conn
+	if secure {
+		host, _, _ := net.SplitHostPort(addr)
+		conn, err = tls.Dial("tcp", addr, &tls.Config{ServerName: host})
+	} else {
+		conn, err = net.Dial("tcp", addr)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	var nonce [16]byte
+	if _, err := io.ReadFull(rand.Reader, nonce[:]); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce[:])
+	req := &http.Request{
+		Method:     "GET",
+		URL:        u,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+		Host:       u.Host,
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	if opts != nil && len(opts.Subprotocols) > 0 {
+		req.Header.Set("Sec-WebSocket-Protocol", strings.Join(opts.Subprotocols, ", "))
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols ||
+		!headerContains(resp.Header, "Upgrade", "websocket") ||
+		!headerContains(resp.Header, "Connection", "upgrade") ||
+		resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		conn.Close()
+		return nil, resp, ErrBadHandshake
+	}
+	return newConn(conn, br, false, opts, resp.Header.Get("Sec-WebSocket-Protocol")), resp, nil
+}
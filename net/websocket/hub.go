@@ -0,0 +1,95 @@
+package websocket
+
+import (
+	"sync"
+)
+
+// Hub keeps track of groups of connections, allowing messages to
+// be broadcast to all the connections in a group (e.g. all the
+// clients watching the same dashboard). A connection might belong
+// to any number of groups. The zero Hub is ready to use and it's
+// safe for concurrent use.
+//
+// Connections must be removed from the Hub when they're closed,
+// usually by calling Remove after ReadMessage fails. Connections
+// which fail to receive a broadcast message are closed and
+// removed automatically.
+type Hub struct {
+	mu     sync.RWMutex
+	groups map[string]map[*Conn]struct{}
+}
+
+// Join adds the connection to the given group.
+func (h *Hub) Join(group string, c *Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.groups == nil {
+		h.groups = make(map[string]map[*Conn]struct{})
+	}
+	conns := h.groups[group]
+	if conns == nil {
+		conns = make(map[*Conn]struct{})
+		h.groups[group] = conns
+	}
+	conns[c] = struct{}{}
+}
+
+// Leave removes the connection from the given group.
+func (h *Hub) Leave(group string, c *Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.leave(group, c)
+}
+
+func (h *Hub) leave(group string, c *Conn) {
+	if conns := h.groups[group]; conns != nil {
+		delete(conns, c)
+		if len(conns) == 0 {
+			delete(h.groups, group)
+		}
+	}
+}
+
+// Remove removes the connection from all the groups.
+func (h *Hub) Remove(c *Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for k := range h.groups {
+		h.leave(k, c)
+	}
+}
+
+// Count returns the number of connections in the given group.
+func (h *Hub) Count(group string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.groups[group])
+}
+
+// Broadcast sends a message with the given type and data to all
+// the connections in the given group, returning the number of
+// connections the message was successfully sent to.
+func (h *Hub) Broadcast(group string, typ MessageType, data []byte) int {
+	h.mu.RLock()
+	conns := make([]*Conn, 0, len(h.groups[group]))
+	for c := range h.groups[group] {
+		conns = append(conns, c)
+	}
+	h.mu.RUnlock()
+	sent := 0
+	for _, c := range conns {
+		if err := c.WriteMessage(typ, data); err != nil {
+			c.closeConn()
+			h.Remove(c)
+			continue
+		}
+		sent++
+	}
+	return sent
+}
+
+// BroadcastText is a shorthand for broadcasting a TextMessage
+// with the given string.
+func (h *Hub) BroadcastText(group string, s string) int {
+	return h.Broadcast(group, TextMessage, []byte(s))
+}
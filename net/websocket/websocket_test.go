@@ -0,0 +1,264 @@
+package websocket
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testServer struct {
+	*httptest.Server
+	closed chan error
+}
+
+func newTestServer(t *testing.T, opts *Options, hub *Hub) *testServer {
+	closed := make(chan error, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := Upgrade(w, r, opts)
+		if err != nil {
+			return
+		}
+		if hub != nil {
+			hub.Join(r.URL.Path, conn)
+			defer hub.Remove(conn)
+		}
+		defer conn.Close()
+		for {
+			typ, data, err := conn.ReadMessage()
+			if err == nil {
+				err = conn.WriteMessage(typ, data)
+			}
+			if err != nil {
+				select {
+				case closed <- err:
+				default:
+				}
+				return
+			}
+		}
+	}))
+	return &testServer{Server: srv, closed: closed}
+}
+
+func (s *testServer) dial(t *testing.T, path string, opts *Options) *Conn {
+	conn, _, err := Dial("ws"+strings.TrimPrefix(s.URL, "http")+path, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+func expectMessage(t *testing.T, conn *Conn, typ MessageType, data []byte) {
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	mt, msg, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mt != typ || !bytes.Equal(msg, data) {
+		t.Errorf("expecting %s message with %d bytes, got %s message with %d bytes", typ, len(data), mt, len(msg))
+	}
+}
+
+func TestEcho(t *testing.T) {
+	srv := newTestServer(t, nil, nil)
+	defer srv.Close()
+	conn := srv.dial(t, "/", nil)
+	defer conn.Close()
+	large := make([]byte, 70000)
+	io.ReadFull(rand.Reader, large)
+	messages := []struct {
+		typ  MessageType
+		data []byte
+	}{
+		{TextMessage, []byte("hello")},
+		{BinaryMessage, large[:200]},
+		{BinaryMessage, large},
+		{TextMessage, nil},
+	}
+	for _, v := range messages {
+		if err := conn.WriteMessage(v.typ, v.data); err != nil {
+			t.Fatal(err)
+		}
+		if v.data == nil {
+			v.data = []byte{}
+		}
+		expectMessage(t, conn, v.typ, v.data)
+	}
+	// Pings are answered automatically and pongs are
+	// ignored by ReadMessage.
+	if err := conn.Ping([]byte("ping")); err != nil {
+		t.Fatal(err)
+	}
+	conn.WriteText("after ping")
+	expectMessage(t, conn, TextMessage, []byte("after ping"))
+}
+
+// writeRawFrame writes a masked frame, allowing
+// fragmented messages.
+func writeRawFrame(c *Conn, fin bool, opcode byte, payload []byte) error {
+	var b0 byte
+	if fin {
+		b0 = 0x80
+	}
+	buf := []byte{b0 | opcode, 0x80 | byte(len(payload))}
+	mask := [4]byte{1, 2, 3, 4}
+	buf = append(buf, mask[:]...)
+	start := len(buf)
+	buf = append(buf, payload...)
+	maskBytes(mask, buf[start:])
+	_, err := c.conn.Write(buf)
+	return err
+}
+
+func TestFragmented(t *testing.T) {
+	srv := newTestServer(t, nil, nil)
+	defer srv.Close()
+	conn := srv.dial(t, "/", nil)
+	defer conn.Close()
+	writeRawFrame(conn, false, opText, []byte("hel"))
+	// Control frames might be interleaved
+	writeRawFrame(conn, true, opPing, nil)
+	writeRawFrame(conn, false, opContinuation, []byte("lo "))
+	writeRawFrame(conn, true, opContinuation, []byte("world"))
+	expectMessage(t, conn, TextMessage, []byte("hello world"))
+	// Continuation without a previous frame
+	writeRawFrame(conn, true, opContinuation, []byte("bad"))
+	_, _, err := conn.ReadMessage()
+	if cerr, ok := err.(*CloseError); !ok || cerr.Code != CloseProtocolError {
+		t.Errorf("expecting protocol error, got %v", err)
+	}
+}
+
+func TestClose(t *testing.T) {
+	srv := newTestServer(t, &Options{MaxMessageSize: 10}, nil)
+	defer srv.Close()
+	conn := srv.dial(t, "/", nil)
+	if err := conn.CloseWithReason(4000, "bye"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-srv.closed:
+		if cerr, ok := err.(*CloseError); !ok || cerr.Code != 4000 || cerr.Reason != "bye" {
+			t.Errorf("expecting close error with code 4000, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not receive close frame")
+	}
+	if err := conn.WriteText("closed"); err != ErrClosed {
+		t.Errorf("expecting ErrClosed after closing, got %v", err)
+	}
+	// Messages bigger than MaxMessageSize
+	conn = srv.dial(t, "/", nil)
+	defer conn.Close()
+	conn.WriteText(strings.Repeat("a", 20))
+	_, _, err := conn.ReadMessage()
+	if cerr, ok := err.(*CloseError); !ok || cerr.Code != CloseMessageTooBig {
+		t.Errorf("expecting message too big error, got %v", err)
+	}
+}
+
+func TestInvalidClose(t *testing.T) {
+	srv := newTestServer(t, nil, nil)
+	defer srv.Close()
+	conn := srv.dial(t, "/", nil)
+	defer conn.Close()
+	// Close frames with a payload must contain at least the 2 byte code
+	writeRawFrame(conn, true, opClose, []byte{3})
+	select {
+	case err := <-srv.closed:
+		if cerr, ok := err.(*CloseError); !ok || cerr.Code != CloseProtocolError {
+			t.Errorf("expecting protocol error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not receive close frame")
+	}
+	_, _, err := conn.ReadMessage()
+	if cerr, ok := err.(*CloseError); !ok || cerr.Code != CloseProtocolError {
+		t.Errorf("expecting protocol error from the server, got %v", err)
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	srv := newTestServer(t, &Options{Subprotocols: []string{"v2", "v1"}}, nil)
+	defer srv.Close()
+	u := "ws" + strings.TrimPrefix(srv.URL, "http") + "/"
+	conn, _, err := Dial(u, nil, &Options{Subprotocols: []string{"v1", "v2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := conn.Subprotocol(); p != "v2" {
+		t.Errorf("expecting subprotocol v2, got %q", p)
+	}
+	conn.Close()
+	header := http.Header{"Origin": {"http://example.com"}}
+	_, resp, err := Dial(u, header, nil)
+	if err != ErrBadHandshake || resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("expecting forbidden cross-origin handshake, got %v", err)
+	}
+	resp, err = http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expecting status 400 for non WebSocket request, got %d", resp.StatusCode)
+	}
+}
+
+func TestKeepAlive(t *testing.T) {
+	srv := newTestServer(t, &Options{ReadTimeout: 200 * time.Millisecond}, nil)
+	defer srv.Close()
+	conn := srv.dial(t, "/", &Options{PingInterval: 50 * time.Millisecond})
+	defer conn.Close()
+	time.Sleep(400 * time.Millisecond)
+	conn.WriteText("alive")
+	expectMessage(t, conn, TextMessage, []byte("alive"))
+}
+
+func TestHub(t *testing.T) {
+	var hub Hub
+	srv := newTestServer(t, nil, &hub)
+	defer srv.Close()
+	c1 := srv.dial(t, "/a", nil)
+	defer c1.Close()
+	c2 := srv.dial(t, "/a", nil)
+	defer c2.Close()
+	c3 := srv.dial(t, "/b", nil)
+	defer c3.Close()
+	// Wait until the connections have joined the hub, by
+	// sending a message through each one of them.
+	for _, c := range []*Conn{c1, c2, c3} {
+		c.WriteText("join")
+		expectMessage(t, c, TextMessage, []byte("join"))
+	}
+	if n := hub.Count("/a"); n != 2 {
+		t.Errorf("expecting 2 connections in /a, got %d", n)
+	}
+	if n := hub.BroadcastText("/a", "news"); n != 2 {
+		t.Errorf("expecting broadcast to 2 connections, got %d", n)
+	}
+	expectMessage(t, c1, TextMessage, []byte("news"))
+	expectMessage(t, c2, TextMessage, []byte("news"))
+	hub.BroadcastText("/b", "other")
+	expectMessage(t, c3, TextMessage, []byte("other"))
+}
+
+func TestFrameLength(t *testing.T) {
+	// Check 64 bit lengths with the MSB set are rejected
+	var buf bytes.Buffer
+	buf.Write([]byte{0x82, 0xff})
+	var l [8]byte
+	binary.BigEndian.PutUint64(l[:], 1<<63)
+	buf.Write(l[:])
+	c := &Conn{br: bufio.NewReader(&buf), server: true, opts: Options{MaxMessageSize: DefaultMaxMessageSize}}
+	if _, err := c.readFrame(); err == nil {
+		t.Error("expecting an error with an invalid length")
+	}
+}
@@ -1,6 +1,8 @@
 package driver
 
 import (
+	"context"
+
 	"gnd.la/orm/operation"
 	"gnd.la/orm/query"
 )
@@ -16,3 +18,11 @@ type Conn interface {
 	Delete(m Model, q query.Q) (Result, error)
 	Connection() interface{}
 }
+
+// ContextConn is implemented by the connections which support
+// cancelling their operations using a context.Context.
+type ContextConn interface {
+	// WithContext returns a copy of the connection which uses
+	// the given context for all its operations.
+	WithContext(ctx context.Context) Conn
+}
@@ -2,6 +2,7 @@ package sql
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"errors"
 	"hash/crc32"
@@ -31,11 +32,27 @@ type queryExecutor interface {
 	Executor
 }
 
+// contextQueryExecutor is implemented by both
+// *sql.DB and *sql.Tx.
+type contextQueryExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
 type cacheEntry struct {
 	sql  string
 	stmt *sql.Stmt
 }
 
+// stmtCache is shared by all the copies of a DB,
+// (e.g. transactions), since they share the same
+// *sql.DB.
+type stmtCache struct {
+	mu      sync.RWMutex
+	entries map[uint32]cacheEntry
+}
+
 type DB struct {
 	// database/sql.DB
 	sqlDb *sql.DB
@@ -47,8 +64,10 @@ type DB struct {
 	conn                 queryExecutor
 	driver               *Driver
 	replacesPlaceholders bool
-	mu                   sync.RWMutex
-	cache                map[uint32]cacheEntry
+	stmts                *stmtCache
+	// non-nil when the queries should use
+	// a context, see Driver.WithContext.
+	ctx context.Context
 }
 
 func (d *DB) replacePlaceholders(query string) string {
@@ -89,9 +108,15 @@ func (d *DB) Exec(query string, args ...interface{}) (sql.Result, error) {
 	d.driver.debugq(query, args)
 	if len(args) > 0 {
 		if stmt := d.preparedStmt(query); stmt != nil {
+			if d.ctx != nil {
+				return stmt.ExecContext(d.ctx, args...)
+			}
 			return stmt.Exec(args...)
 		}
 	}
+	if d.ctx != nil {
+		return d.conn.(contextQueryExecutor).ExecContext(d.ctx, query, args...)
+	}
 	return d.conn.Exec(query, args...)
 }
 
@@ -102,9 +127,15 @@ func (d *DB) Query(query string, args ...interface{}) (*sql.Rows, error) {
 	d.driver.debugq(query, args)
 	if len(args) > 0 {
 		if stmt := d.preparedStmt(query); stmt != nil {
+			if d.ctx != nil {
+				return stmt.QueryContext(d.ctx, args...)
+			}
 			return stmt.Query(args...)
 		}
 	}
+	if d.ctx != nil {
+		return d.conn.(contextQueryExecutor).QueryContext(d.ctx, query, args...)
+	}
 	return d.conn.Query(query, args...)
 }
 
@@ -116,9 +147,15 @@ func (d *DB) QueryRow(query string, args ...interface{}) *sql.Row {
 	d.driver.debugq(query, args)
 	if len(args) > 0 {
 		if stmt := d.preparedStmt(query); stmt != nil {
+			if d.ctx != nil {
+				return stmt.QueryRowContext(d.ctx, args...)
+			}
 			return stmt.QueryRow(args...)
 		}
 	}
+	if d.ctx != nil {
+		return d.conn.(contextQueryExecutor).QueryRowContext(d.ctx, query, args...)
+	}
 	return d.conn.QueryRow(query, args...)
 }
 
@@ -126,7 +163,13 @@ func (d *DB) Begin() (*DB, error) {
 	if d.tx != nil {
 		return nil, driver.ErrInTransaction
 	}
-	tx, err := d.sqlDb.Begin()
+	var tx *sql.Tx
+	var err error
+	if d.ctx != nil {
+		tx, err = d.sqlDb.BeginTx(d.ctx, nil)
+	} else {
+		tx, err = d.sqlDb.Begin()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -183,9 +226,9 @@ func (d *DB) quoteWith(s string, q byte) string {
 
 func (d *DB) preparedStmt(s string) *sql.Stmt {
 	key := crc32.ChecksumIEEE(internal.StringToBytes(s))
-	d.mu.RLock()
-	cached, ok := d.cache[key]
-	d.mu.RUnlock()
+	d.stmts.mu.RLock()
+	cached, ok := d.stmts.entries[key]
+	d.stmts.mu.RUnlock()
 	if ok && cached.sql == s {
 		if d.tx != nil {
 			return d.tx.Stmt(cached.stmt)
@@ -197,12 +240,12 @@ func (d *DB) preparedStmt(s string) *sql.Stmt {
 		// Let the non-prepared method report the error
 		return nil
 	}
-	d.mu.Lock()
-	if d.cache == nil {
-		d.cache = make(map[uint32]cacheEntry)
+	d.stmts.mu.Lock()
+	if d.stmts.entries == nil {
+		d.stmts.entries = make(map[uint32]cacheEntry)
 	}
-	d.cache[key] = cacheEntry{sql: s, stmt: stmt}
-	d.mu.Unlock()
+	d.stmts.entries[key] = cacheEntry{sql: s, stmt: stmt}
+	d.stmts.mu.Unlock()
 	if d.tx != nil {
 		return d.tx.Stmt(stmt)
 	}
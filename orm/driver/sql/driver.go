@@ -2,6 +2,7 @@ package sql
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
@@ -886,6 +887,17 @@ func (d *Driver) Begin() (driver.Tx, error) {
 	return &drv, nil
 }
 
+// WithContext returns a copy of the Driver which uses
+// the given context for all its queries, including the
+// ones performed in transactions started from it.
+func (d *Driver) WithContext(ctx context.Context) driver.Conn {
+	drv := *d
+	db := *d.db
+	db.ctx = ctx
+	drv.db = &db
+	return &drv
+}
+
 func (d *Driver) Commit() error {
 	return d.db.Commit()
 }
@@ -934,7 +946,7 @@ func NewDriver(b Backend, url *config.URL) (*Driver, error) {
 		}
 	}
 	driver := &Driver{backend: b, transforms: transforms}
-	driver.db = &DB{sqlDb: conn, conn: conn, driver: driver, replacesPlaceholders: b.Placeholder(0) != "?"}
+	driver.db = &DB{sqlDb: conn, conn: conn, driver: driver, replacesPlaceholders: b.Placeholder(0) != "?", stmts: &stmtCache{}}
 	return driver, nil
 }
 
@@ -0,0 +1,14 @@
+package orm
+
+import (
+	"time"
+
+	"gnd.la/app/metrics"
+)
+
+var queryDuration = metrics.NewHistogram("gondola_orm_query_duration_seconds",
+	"ORM operation latencies in seconds by operation", nil, "operation")
+
+func observeQuery(op string, started time.Time) {
+	queryDuration.Observe(time.Since(started).Seconds(), op)
+}
@@ -1,11 +1,14 @@
 package orm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
+	"gnd.la/app/metrics"
 	"gnd.la/app/profile"
 	"gnd.la/config"
 	"gnd.la/log"
@@ -136,6 +139,9 @@ func (o *Orm) insert(m *model, obj interface{}) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("insert", m.name).End()
 	}
+	if metrics.Enabled() {
+		defer observeQuery("insert", time.Now())
+	}
 	var pkName string
 	var pkVal reflect.Value
 	f := m.fields
@@ -210,6 +216,9 @@ func (o *Orm) update(m *model, q query.Q, obj interface{}) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("update", m.name).End()
 	}
+	if metrics.Enabled() {
+		defer observeQuery("update", time.Now())
+	}
 	return o.conn.Update(m, q, obj)
 }
 
@@ -230,6 +239,9 @@ func (o *Orm) Upsert(q query.Q, obj interface{}) (Result, error) {
 		if profile.On && profile.Profiling() {
 			defer profile.Start(orm).Note("upsert", "").End()
 		}
+		if metrics.Enabled() {
+			defer observeQuery("upsert", time.Now())
+		}
 		return o.conn.Upsert(m, q, obj)
 	}
 	res, err := o.update(m, q, obj)
@@ -382,6 +394,9 @@ func (o *Orm) delete(m *model, q query.Q) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("delete", m.name).End()
 	}
+	if metrics.Enabled() {
+		defer observeQuery("delete", time.Now())
+	}
 	return o.conn.Delete(m, q)
 }
 
@@ -474,6 +489,26 @@ func (o *Orm) Close() error {
 	return nil
 }
 
+// WithContext returns a copy of the Orm which uses the given context
+// for all its operations, so they're aborted once the context is
+// cancelled or its deadline expires. Transactions started from the
+// returned Orm also use the context. If the driver doesn't support
+// contexts (see driver.ContextConn), the Orm is returned unchanged.
+// Note that *gnd.la/app.Context implements context.Context, so
+// it might be passed directly to this method.
+func (o *Orm) WithContext(ctx context.Context) *Orm {
+	cc, ok := o.conn.(driver.ContextConn)
+	if !ok || ctx == nil {
+		return o
+	}
+	cpy := *o
+	cpy.conn = cc.WithContext(ctx)
+	if drv, ok := cpy.conn.(driver.Driver); ok {
+		cpy.driver = drv
+	}
+	return &cpy
+}
+
 // Driver returns the underlying driver.
 func (o *Orm) Driver() driver.Driver {
 	return o.driver
@@ -2,10 +2,12 @@ package orm
 
 import (
 	"fmt"
+	"gnd.la/app/metrics"
 	"gnd.la/app/profile"
 	"gnd.la/orm/driver"
 	"gnd.la/orm/query"
 	"reflect"
+	"time"
 )
 
 type Query struct {
@@ -122,6 +124,9 @@ func (q *Query) Exists() (bool, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("exists", q.model.String()).End()
 	}
+	if metrics.Enabled() {
+		defer observeQuery("exists", time.Now())
+	}
 	return q.orm.driver.Exists(q.model, q.q)
 }
 
@@ -193,6 +198,9 @@ func (q *Query) Count() (uint64, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("count", q.model.String()).End()
 	}
+	if metrics.Enabled() {
+		defer observeQuery("count", time.Now())
+	}
 	return q.orm.driver.Count(q.model, q.q, q.limit, q.offset)
 }
 
@@ -230,6 +238,9 @@ func (q *Query) exec(limit int) driver.Iter {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("query", q.model.String()).End()
 	}
+	if metrics.Enabled() {
+		defer observeQuery("query", time.Now())
+	}
 	return q.orm.conn.Query(q.model, q.q, q.sort, limit, q.offset)
 }
 
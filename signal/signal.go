@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
+	"gnd.la/internal"
 	"gnd.la/internal/runtimeutil"
-	"gnd.la/log"
 )
 
 var (
@@ -68,7 +68,7 @@ func Stop(name string, t *Token) {
 
 // Emit calls all the listeners for the given signal.
 func Emit(name string, object interface{}) {
-	log.Debugf("Emitting signal %s with %T object", name, object)
+	internal.Debugf("Emitting signal %s with %T object", name, object)
 	if rec := signals[name]; rec != nil {
 		params := []reflect.Value{reflect.ValueOf(name), reflect.ValueOf(object)}
 		for _, v := range rec {
@@ -0,0 +1,23 @@
+package tasks
+
+import (
+	"time"
+
+	"gnd.la/app/metrics"
+)
+
+var (
+	runsTotal = metrics.NewCounter("gondola_task_runs_total",
+		"Number of task runs by task and result (ok or error)", "task", "result")
+	runDuration = metrics.NewHistogram("gondola_task_duration_seconds",
+		"Task run durations in seconds by task", []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 3600}, "task")
+)
+
+func recordRun(name string, elapsed time.Duration, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	runsTotal.Inc(name, result)
+	runDuration.Observe(elapsed.Seconds(), name)
+}
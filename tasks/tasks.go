@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"gnd.la/app"
+	"gnd.la/app/metrics"
 	"gnd.la/internal/runtimeutil"
 	"gnd.la/signal"
 )
@@ -139,6 +140,9 @@ func afterTask(ctx *app.Context, task *Task, started time.Time, terr *error) {
 		*terr = errors.New(buf.String())
 	}
 	end := time.Now()
+	if metrics.Enabled() {
+		recordRun(name, end.Sub(started), *terr)
+	}
 	running.Lock()
 	defer running.Unlock()
 	c := running.tasks[task] - 1
@@ -282,4 +286,16 @@ func init() {
 		onListenTasks.tasks = pending
 		onListenTasks.Unlock()
 	})
+	// Stop the scheduled tasks when their App
+	// is shutting down.
+	signal.Listen(app.WILL_STOP, func(_ string, obj interface{}) {
+		a := obj.(*app.App)
+		registered.RLock()
+		defer registered.RUnlock()
+		for _, v := range registered.tasks {
+			if v.App == a {
+				v.Stop()
+			}
+		}
+	})
 }
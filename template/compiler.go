@@ -59,6 +59,7 @@ const (
 	opVAL
 	opVAR
 	opWB
+	opNONCE
 )
 
 type valType uint32
@@ -216,6 +217,28 @@ type State struct {
 	res       []reflect.Value // used for storing return values in fast paths
 	resPtr    *reflect.Value
 	context   reflect.Value
+	canceler  canceler
+	nonce     string
+}
+
+// canceler is implemented by template contexts which might be
+// cancelled (e.g. a context.Context). Execution is aborted when
+// they're done, which is checked before executing each template
+// and on every iteration of a range loop.
+type canceler interface {
+	Done() <-chan struct{}
+	Err() error
+}
+
+func (s *State) cancelled() error {
+	if s.canceler != nil {
+		select {
+		case <-s.canceler.Done():
+			return s.canceler.Err()
+		default:
+		}
+	}
+	return nil
 }
 
 func newState(p *program, w *bytes.Buffer) *State {
@@ -260,6 +283,8 @@ func (s *State) reset() {
 	s.marks = s.marks[:0]
 	s.dot = s.dot[:0]
 	s.iterators = s.iterators[:0]
+	s.canceler = nil
+	s.nonce = ""
 }
 
 func (s *State) formatTreeErr(name string, tr *parse.Tree, node parse.Node, err error) error {
@@ -455,6 +480,9 @@ func (s *State) execute(tmpl string, ns string, dot reflect.Value) (err error) {
 			}
 		}
 	}
+	if err := s.cancelled(); err != nil {
+		return err
+	}
 	var pc int
 	defer s.recover(&pc, &tmpl, &err)
 	for pc = 0; pc < len(code); pc++ {
@@ -571,6 +599,9 @@ func (s *State) execute(tmpl string, ns string, dot reflect.Value) (err error) {
 			// let it crash if there are no iterators, it would
 			// be a compiler error
 			iter := s.iterators[p]
+			if err := s.cancelled(); err != nil {
+				return err
+			}
 			next, idx, val := iter.Next()
 			if next {
 				s.stack = append(s.stack, idx, val)
@@ -650,6 +681,12 @@ func (s *State) execute(tmpl string, ns string, dot reflect.Value) (err error) {
 			if _, err := s.w.Write(s.p.bs[int(v.val)]); err != nil {
 				return s.formatErr(pc, tmpl, err)
 			}
+		case opNONCE:
+			if s.nonce != "" {
+				if _, err := fmt.Fprintf(s.w, ` nonce="%s"`, template.HTMLEscapeString(s.nonce)); err != nil {
+					return s.formatErr(pc, tmpl, err)
+				}
+			}
 		default:
 			return s.errorf(pc, tmpl, "invalid opcode %d", v.op)
 		}
@@ -901,6 +938,22 @@ func (p *program) addWB(b []byte) {
 	p.inst(opWB, valType(pos))
 }
 
+// addText adds a WB for the given literal template text. When
+// the template is HTML, the text is split at the positions which
+// require a nonce attribute, adding a NONCE between the parts.
+func (p *program) addText(b []byte) {
+	if strings.Contains(p.tmpl.contentType, "html") {
+		last := 0
+		for _, v := range nonceOffsets(b) {
+			p.addWB(b[last:v])
+			p.inst(opNONCE, 0)
+			last = v
+		}
+		b = b[last:]
+	}
+	p.addWB(b)
+}
+
 func (p *program) addSTRING(s string) {
 	p.inst(opSTRING, p.addString(s))
 }
@@ -1121,7 +1174,7 @@ func (p *program) walk(n parse.Node) error {
 				b = p.tmpl.bottomAssets
 			}
 			if len(b) > 0 {
-				p.addWB(b)
+				p.addText(b)
 			}
 			p.s.noPrint = true
 			break
@@ -1269,7 +1322,7 @@ func (p *program) walk(n parse.Node) error {
 			text = text[1:]
 		}
 		if len(text) > 0 {
-			p.addWB(text)
+			p.addText(text)
 		}
 	case *parse.VariableNode:
 		// Remove $ sign
@@ -1367,6 +1420,8 @@ func (p *program) stitch() {
 func (p *program) execute(w *bytes.Buffer, name string, data interface{}, context interface{}, vars VarMap) error {
 	s := newState(p, w)
 	s.context = reflect.ValueOf(context)
+	s.canceler, _ = context.(canceler)
+	s.nonce = contextNonce(context)
 	s.pushVar("Vars", reflect.ValueOf(vars))
 	err := s.execute(name, "", reflect.ValueOf(data))
 	putState(s)
@@ -0,0 +1,165 @@
+package template
+
+import (
+	"bytes"
+	"strings"
+)
+
+// NonceProvider is implemented by template contexts (see
+// Template.ExecuteContext) which provide a nonce for the
+// Content-Security-Policy of the current request.
+//
+// When a HTML template is compiled, the positions for a nonce attribute
+// are recorded for every <script> and <style> tag, as well as for every
+// <link> tag with a rel attribute of stylesheet or preload, found in the
+// template source, including the tags generated for its assets. Only the
+// literal text of the template is considered, so content generated while
+// executing the template, like user data, never receives a nonce. When
+// the template is executed, the attribute is written with the value
+// returned by CSPNonce, or omitted when the context doesn't implement
+// NonceProvider or CSPNonce returns an empty string.
+type NonceProvider interface {
+	CSPNonce() string
+}
+
+var (
+	nonceTags = [][]byte{[]byte("<script"), []byte("<style"), []byte("<link")}
+	nonceRels = []string{"stylesheet", "preload"}
+)
+
+// nonceOffsets returns the offsets in b where a nonce attribute
+// must be inserted, right after the name of each tag which might
+// require a nonce.
+func nonceOffsets(b []byte) []int {
+	var offsets []int
+	for ii := 0; ii < len(b); ii++ {
+		if b[ii] != '<' {
+			continue
+		}
+		for _, tag := range nonceTags {
+			end := ii + len(tag)
+			if end > len(b) || !bytes.EqualFold(b[ii:end], tag) || !isTagNameEnd(b, end) || hasNonce(b[end:]) {
+				continue
+			}
+			ii = end - 1
+			if tag[1] == 'l' {
+				if linkNeedsNonce(b[end:]) {
+					offsets = append(offsets, end)
+				}
+				break
+			}
+			offsets = append(offsets, end)
+			// Don't look for tags in the element contents, since they
+			// might appear e.g. in a JS string.
+			ii = skipElement(b, end, tag[1:])
+			break
+		}
+	}
+	return offsets
+}
+
+// skipElement returns the position of the closing tag for the element
+// whose name is given, starting at pos. If there's no closing tag, it
+// returns len(b).
+func skipElement(b []byte, pos int, name []byte) int {
+	closing := append([]byte("</"), name...)
+	if idx := bytes.Index(bytes.ToLower(b[pos:]), closing); idx >= 0 {
+		return pos + idx
+	}
+	return len(b)
+}
+
+func isTagNameEnd(b []byte, pos int) bool {
+	if pos == len(b) {
+		// Tag continues in the next node, e.g. <script{{ if .Async }} async{{ end }}>
+		return true
+	}
+	switch b[pos] {
+	case ' ', '\t', '\n', '\r', '\f', '>', '/':
+		return true
+	}
+	return false
+}
+
+// tagAttributes returns the attributes of the tag which start at
+// the beginning of b, in lowercase. If the tag doesn't end in b,
+// the second return value is false.
+func tagAttributes(b []byte) ([]byte, bool) {
+	end := bytes.IndexByte(b, '>')
+	if end < 0 {
+		return bytes.ToLower(b), false
+	}
+	return bytes.ToLower(b[:end]), true
+}
+
+// hasNonce returns true iff the attributes of the tag, which start
+// at the beginning of b, already include a nonce.
+func hasNonce(b []byte) bool {
+	attrs, _ := tagAttributes(b)
+	return bytes.Contains(attrs, []byte("nonce="))
+}
+
+// linkNeedsNonce returns true iff the attributes of the <link> tag,
+// which start at the beginning of b, include a rel attribute which
+// is subject to the Content-Security-Policy (see nonceRels). Tags
+// whose attributes are generated while executing the template are
+// not considered.
+func linkNeedsNonce(b []byte) bool {
+	attrs, ok := tagAttributes(b)
+	if !ok {
+		return false
+	}
+	for _, rel := range strings.Fields(attrValue(attrs, "rel")) {
+		for _, v := range nonceRels {
+			if rel == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// attrValue returns the value of the given attribute in attrs,
+// without quotes, or an empty string if there's no such attribute.
+func attrValue(attrs []byte, name string) string {
+	key := []byte(name + "=")
+	for pos := 0; pos < len(attrs); {
+		idx := bytes.Index(attrs[pos:], key)
+		if idx < 0 {
+			break
+		}
+		idx += pos
+		pos = idx + len(key)
+		if idx > 0 && !isSpace(attrs[idx-1]) {
+			// e.g. data-rel=
+			continue
+		}
+		value := attrs[pos:]
+		if len(value) > 0 && (value[0] == '"' || value[0] == '\'') {
+			if end := bytes.IndexByte(value[1:], value[0]); end >= 0 {
+				return string(value[1 : end+1])
+			}
+			break
+		}
+		if fields := bytes.Fields(value); len(fields) > 0 {
+			return string(fields[0])
+		}
+		break
+	}
+	return ""
+}
+
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\f':
+		return true
+	}
+	return false
+}
+
+func contextNonce(context interface{}) string {
+	if np, ok := context.(NonceProvider); ok {
+		return np.CSPNonce()
+	}
+	return ""
+}
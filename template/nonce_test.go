@@ -0,0 +1,67 @@
+package template
+
+import (
+	"bytes"
+	"testing"
+)
+
+type nonceContext string
+
+func (n nonceContext) CSPNonce() string {
+	return string(n)
+}
+
+func TestNonces(t *testing.T) {
+	const text = `<script>var s = "<script src=x></script>";</script><STYLE type="text/css">p{}</STYLE>` +
+		`<link rel="stylesheet" href="a.css"><script nonce="mine"></script><scripts></scripts>{{ . }}`
+	tmpl := parseNamedText(t, "nonces.html", text, nil, "text/html; charset=utf-8")
+	data := "<script>"
+	cases := []struct {
+		ctx    interface{}
+		expect string
+	}{
+		{nonceContext("abc"), `<script nonce="abc">var s = "<script src=x></script>";</script><STYLE nonce="abc" type="text/css">p{}</STYLE>` +
+			`<link nonce="abc" rel="stylesheet" href="a.css"><script nonce="mine"></script><scripts></scripts>&lt;script&gt;`},
+		{nil, `<script>var s = "<script src=x></script>";</script><STYLE type="text/css">p{}</STYLE>` +
+			`<link rel="stylesheet" href="a.css"><script nonce="mine"></script><scripts></scripts>&lt;script&gt;`},
+		{nonceContext(""), `<script>var s = "<script src=x></script>";</script><STYLE type="text/css">p{}</STYLE>` +
+			`<link rel="stylesheet" href="a.css"><script nonce="mine"></script><scripts></scripts>&lt;script&gt;`},
+	}
+	for _, v := range cases {
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteContext(&buf, data, v.ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+		if s := buf.String(); s != v.expect {
+			t.Errorf("expecting %q with context %v, got %q instead", v.expect, v.ctx, s)
+		}
+	}
+}
+
+func TestNoNoncesInText(t *testing.T) {
+	tmpl := parseNamedText(t, "nonces.txt", "<script></script>", nil, "text/plain")
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteContext(&buf, nil, nonceContext("abc"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "<script></script>" {
+		t.Errorf("expecting no nonces in text templates, got %q", s)
+	}
+}
+
+func TestLinkNonces(t *testing.T) {
+	const text = `<link rel="stylesheet" href="a.css"><link rel=preload href="b.js" as="script">` +
+		`<link href="c.css" rel="preload stylesheet"><link rel="icon" href="favicon.ico"><link data-rel="stylesheet">` +
+		`<link rel="{{ . }}" href="d.css">`
+	tmpl := parseNamedText(t, "links.html", text, nil, "text/html; charset=utf-8")
+	const expect = `<link nonce="abc" rel="stylesheet" href="a.css"><link nonce="abc" rel=preload href="b.js" as="script">` +
+		`<link nonce="abc" href="c.css" rel="preload stylesheet"><link rel="icon" href="favicon.ico"><link data-rel="stylesheet">` +
+		`<link rel="stylesheet" href="d.css">`
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteContext(&buf, "stylesheet", nonceContext("abc"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != expect {
+		t.Errorf("expecting %q, got %q instead", expect, s)
+	}
+}
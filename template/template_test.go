@@ -2,6 +2,7 @@ package template
 
 import (
 	"bytes"
+	gocontext "context"
 	"fmt"
 	"html/template"
 	"io/ioutil"
@@ -372,6 +373,15 @@ func TestTemplateNoPipeMissedInstructions(t *testing.T) {
 	}
 }
 
+func TestCancelledContext(t *testing.T) {
+	tmpl := parseNamedText(t, "cancelled", "{{ range . }}{{ . }}{{ end }}", nil, "text/plain")
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	cancel()
+	if err := tmpl.ExecuteContext(ioutil.Discard, []int{1, 2, 3}, ctx, nil); err != gocontext.Canceled {
+		t.Errorf("expecting context.Canceled when executing with a cancelled context, got %v", err)
+	}
+}
+
 func BenchmarkRange(b *testing.B) {
 	benchmarkTemplate(b, rangeTests())
 }